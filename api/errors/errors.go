@@ -67,6 +67,12 @@ var ErrInvalidBlockNonce = errors.New("invalid block nonce")
 // ErrInvalidBlockRound signals that an invalid block round was provided
 var ErrInvalidBlockRound = errors.New("invalid block round")
 
+// ErrInvalidEpoch signals that an invalid epoch was provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrValidationEmptyPublicKey signals that an empty public key was provided
+var ErrValidationEmptyPublicKey = errors.New("public key is empty")
+
 // ErrInvalidQueryParameter signals and invalid query parameter was provided
 var ErrInvalidQueryParameter = errors.New("invalid query parameter")
 
@@ -76,6 +82,9 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrValidationEmptyToken signals that an empty token was provided
 var ErrValidationEmptyToken = errors.New("token is empty")
 
+// ErrValidationEmptyProposalReference signals that an empty governance proposal reference was provided
+var ErrValidationEmptyProposalReference = errors.New("proposal reference is empty")
+
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
@@ -88,6 +97,12 @@ var ErrQueryError = errors.New("query error")
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetConsensusRounds signals that an error occurred while getting the traced consensus rounds
+var ErrGetConsensusRounds = errors.New("error getting consensus rounds")
+
+// ErrGetSlashingEvidences signals that an error occurred while getting the detected slashing evidences
+var ErrGetSlashingEvidences = errors.New("error getting slashing evidences")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
 
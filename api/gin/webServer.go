@@ -150,6 +150,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["block"] = blockGroup
 
+	delegationGroup, err := groups.NewDelegationGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["delegation"] = delegationGroup
+
 	hardforkGroup, err := groups.NewHardforkGroup(ws.facade)
 	if err != nil {
 		return err
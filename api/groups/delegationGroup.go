@@ -0,0 +1,113 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const delegationContractPath = "/:contract"
+
+// delegationFacadeHandler defines the methods to be implemented by a facade for delegation requests
+type delegationFacadeHandler interface {
+	GetDelegationContract(contract string) (*common.DelegationContractResponse, error)
+	IsInterfaceNil() bool
+}
+
+type delegationGroup struct {
+	*baseGroup
+	facade    delegationFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewDelegationGroup returns a new instance of delegationGroup
+func NewDelegationGroup(facade delegationFacadeHandler) (*delegationGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for delegation group", errors.ErrNilFacadeHandler)
+	}
+
+	dg := &delegationGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    delegationContractPath,
+			Method:  http.MethodGet,
+			Handler: dg.getDelegationContract,
+		},
+	}
+	dg.endpoints = endpoints
+
+	return dg, nil
+}
+
+// getDelegationContract will return the configuration, the nodes, the rewards history and the delegators positions
+// of a delegation contract
+func (dg *delegationGroup) getDelegationContract(c *gin.Context) {
+	contract := c.Param("contract")
+	if contract == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	delegationContract, err := dg.getFacade().GetDelegationContract(contract)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"delegation": delegationContract},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (dg *delegationGroup) getFacade() delegationFacadeHandler {
+	dg.mutFacade.RLock()
+	defer dg.mutFacade.RUnlock()
+
+	return dg.facade
+}
+
+// UpdateFacade will update the facade
+func (dg *delegationGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(delegationFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for delegation group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	dg.mutFacade.Lock()
+	dg.facade = castFacade
+	dg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dg *delegationGroup) IsInterfaceNil() bool {
+	return dg == nil
+}
@@ -0,0 +1,113 @@
+package groups_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type delegationContractResponse struct {
+	Data struct {
+		Delegation *common.DelegationContractResponse `json:"delegation"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewDelegationGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		dg, err := groups.NewDelegationGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, dg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		dg, err := groups.NewDelegationGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, dg)
+	})
+}
+
+func TestGetDelegationContract_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	delegationContract := &common.DelegationContractResponse{
+		Address:      "erd1contract",
+		CurrentEpoch: 20,
+		Nodes: []*common.DelegationNodeResponse{
+			{BLSKey: "bls", Status: "staked"},
+		},
+		Delegators: []*common.DelegationDelegatorResponse{
+			{Address: "erd1delegator", ActiveStake: "1000", ClaimableRewards: "10"},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetDelegationContractCalled: func(contract string) (*common.DelegationContractResponse, error) {
+			assert.Equal(t, delegationContract.Address, contract)
+			return delegationContract, nil
+		},
+	}
+
+	delegationGroup, err := groups.NewDelegationGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(delegationGroup, "delegation", getDelegationRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/delegation/erd1contract", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := delegationContractResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, delegationContract, response.Data.Delegation)
+}
+
+func TestGetDelegationContract_CannotGetDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetDelegationContractCalled: func(contract string) (*common.DelegationContractResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	delegationGroup, err := groups.NewDelegationGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(delegationGroup, "delegation", getDelegationRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/delegation/erd1contract", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
+func getDelegationRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"delegation": {
+				Routes: []config.RouteConfig{
+					{Name: "/:contract", Open: true},
+				},
+			},
+		},
+	}
+}
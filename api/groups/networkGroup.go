@@ -2,8 +2,11 @@ package groups
 
 import (
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -16,17 +19,35 @@ import (
 )
 
 const (
-	getConfigPath        = "/config"
-	getStatusPath        = "/status"
-	economicsPath        = "/economics"
-	enableEpochsPath     = "/enable-epochs"
-	getESDTsPath         = "/esdts"
-	getFFTsPath          = "/esdt/fungible-tokens"
-	getSFTsPath          = "/esdt/semi-fungible-tokens"
-	getNFTsPath          = "/esdt/non-fungible-tokens"
-	getESDTSupplyPath    = "/esdt/supply/:token"
-	directStakedInfoPath = "/direct-staked-info"
-	delegatedInfoPath    = "/delegated-info"
+	getConfigPath            = "/config"
+	getStatusPath            = "/status"
+	economicsPath            = "/economics"
+	enableEpochsPath         = "/enable-epochs"
+	getESDTsPath             = "/esdts"
+	getFFTsPath              = "/esdt/fungible-tokens"
+	getSFTsPath              = "/esdt/semi-fungible-tokens"
+	getNFTsPath              = "/esdt/non-fungible-tokens"
+	getESDTSupplyPath        = "/esdt/supply/:token"
+	getESDTSupplyHistoryPath = "/esdt/supply/:token/history"
+	getESDTTokenPath         = "/esdt/token/:token"
+	getESDTHoldersPath       = "/esdt/token/:token/holders"
+	directStakedInfoPath     = "/direct-staked-info"
+	delegatedInfoPath        = "/delegated-info"
+	governanceProposalsPath  = "/governance/proposals"
+	governanceProposalPath   = "/governance/proposal/:reference"
+	governanceVotesPath      = "/governance/votes/:address"
+	epochEconomicsPath       = "/economics/epoch/:epoch"
+
+	queryParamOffset = "offset"
+	queryParamLimit  = "limit"
+	defaultPageLimit = 100
+	maximumPageLimit = 1000
+
+	queryParamStartEpoch    = "startEpoch"
+	queryParamEndEpoch      = "endEpoch"
+	queryParamStartDate     = "startDate"
+	queryParamEndDate       = "endDate"
+	supplyHistoryDateLayout = "2006-01-02"
 )
 
 // networkFacadeHandler defines the methods to be implemented by a facade for handling network requests
@@ -37,6 +58,13 @@ type networkFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetTokenSupply(token string) (string, error)
+	GetESDTTokenData(token string) (*common.ESDTTokenResponse, error)
+	GetESDTHolders(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error)
+	GetESDTSupplyHistory(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error)
+	GetGovernanceProposals() (*common.GovernanceProposalsResponse, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error)
+	GetEpochEconomicsReport(epoch uint32) (*common.EpochEconomicsResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -113,6 +141,41 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
 		},
+		{
+			Path:    getESDTSupplyHistoryPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTSupplyHistory,
+		},
+		{
+			Path:    getESDTTokenPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTTokenData,
+		},
+		{
+			Path:    getESDTHoldersPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTHolders,
+		},
+		{
+			Path:    governanceProposalsPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposals,
+		},
+		{
+			Path:    governanceProposalPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposal,
+		},
+		{
+			Path:    governanceVotesPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceVotes,
+		},
+		{
+			Path:    epochEconomicsPath,
+			Method:  http.MethodGet,
+			Handler: ng.getEpochEconomics,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -295,6 +358,334 @@ func (ng *networkGroup) getESDTTokenSupply(c *gin.Context) {
 	)
 }
 
+// getESDTTokenData is the endpoint that will return the properties and role holders of the provided token
+func (ng *networkGroup) getESDTTokenData(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToken.Error()),
+		)
+		return
+	}
+
+	tokenData, err := ng.getFacade().GetESDTTokenData(token)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"token": tokenData},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getESDTHolders is the endpoint that will return a page of the holders of the provided token
+func (ng *networkGroup) getESDTHolders(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToken.Error()),
+		)
+		return
+	}
+
+	offset, limit, err := getQueryParamsPagination(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	holders, err := ng.getFacade().GetESDTHolders(token, offset, limit)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"holders": holders},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamsPagination(c *gin.Context) (uint32, uint32, error) {
+	offset := uint64(0)
+	limit := uint64(defaultPageLimit)
+	var err error
+
+	offsetStr := c.Request.URL.Query().Get(queryParamOffset)
+	if offsetStr != "" {
+		offset, err = strconv.ParseUint(offsetStr, 10, 32)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	limitStr := c.Request.URL.Query().Get(queryParamLimit)
+	if limitStr != "" {
+		limit, err = strconv.ParseUint(limitStr, 10, 32)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if limit == 0 || limit > maximumPageLimit {
+		return 0, 0, errors.ErrInvalidQueryParameter
+	}
+
+	return uint32(offset), uint32(limit), nil
+}
+
+// getESDTSupplyHistory is the endpoint that will return the supply history of the provided token. The results can be
+// restricted to an epoch range and to a date range, both inclusive, with the dates given as YYYY-MM-DD in UTC, while
+// the matching events are returned a page at a time
+func (ng *networkGroup) getESDTSupplyHistory(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToken.Error()),
+		)
+		return
+	}
+
+	filter, err := getQueryParamsSupplyHistoryFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	history, err := ng.getFacade().GetESDTSupplyHistory(token, filter)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"history": history},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getEpochEconomics will expose the economics and rewards breakdown computed by the metachain at the start of an epoch
+func (ng *networkGroup) getEpochEconomics(c *gin.Context) {
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	report, err := ng.getFacade().GetEpochEconomicsReport(uint32(epoch))
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"economics": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamsSupplyHistoryFilter(c *gin.Context) (common.ESDTSupplyHistoryFilter, error) {
+	filter := common.ESDTSupplyHistoryFilter{
+		StartEpoch:     0,
+		EndEpoch:       math.MaxUint32,
+		StartTimestamp: 0,
+		EndTimestamp:   math.MaxUint64,
+	}
+
+	query := c.Request.URL.Query()
+	startEpochStr := query.Get(queryParamStartEpoch)
+	if startEpochStr != "" {
+		startEpoch, err := strconv.ParseUint(startEpochStr, 10, 32)
+		if err != nil {
+			return filter, err
+		}
+		filter.StartEpoch = uint32(startEpoch)
+	}
+
+	endEpochStr := query.Get(queryParamEndEpoch)
+	if endEpochStr != "" {
+		endEpoch, err := strconv.ParseUint(endEpochStr, 10, 32)
+		if err != nil {
+			return filter, err
+		}
+		filter.EndEpoch = uint32(endEpoch)
+	}
+
+	startDateStr := query.Get(queryParamStartDate)
+	if startDateStr != "" {
+		startDate, err := time.Parse(supplyHistoryDateLayout, startDateStr)
+		if err != nil {
+			return filter, err
+		}
+		filter.StartTimestamp = uint64(startDate.Unix())
+	}
+
+	endDateStr := query.Get(queryParamEndDate)
+	if endDateStr != "" {
+		endDate, err := time.Parse(supplyHistoryDateLayout, endDateStr)
+		if err != nil {
+			return filter, err
+		}
+		// the end date is inclusive so the range lasts until the last second of that day
+		filter.EndTimestamp = uint64(endDate.AddDate(0, 0, 1).Unix()) - 1
+	}
+
+	if filter.StartEpoch > filter.EndEpoch || filter.StartTimestamp > filter.EndTimestamp {
+		return filter, errors.ErrInvalidQueryParameter
+	}
+
+	offset, limit, err := getQueryParamsPagination(c)
+	if err != nil {
+		return filter, err
+	}
+	filter.Offset = offset
+	filter.Limit = limit
+
+	return filter, nil
+}
+
+// getGovernanceProposals is the endpoint that will return the governance proposals
+func (ng *networkGroup) getGovernanceProposals(c *gin.Context) {
+	proposals, err := ng.getFacade().GetGovernanceProposals()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"governance": proposals},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getGovernanceProposal is the endpoint that will return the governance proposal identified by the provided reference
+func (ng *networkGroup) getGovernanceProposal(c *gin.Context) {
+	reference := c.Param("reference")
+	if reference == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyProposalReference.Error()),
+		)
+		return
+	}
+
+	proposal, err := ng.getFacade().GetGovernanceProposal(reference)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposal": proposal},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getGovernanceVotes is the endpoint that will return the governance votes of the provided address
+func (ng *networkGroup) getGovernanceVotes(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	votes, err := ng.getFacade().GetGovernanceVotes(address)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"votes": votes},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (ng *networkGroup) getFacade() networkFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
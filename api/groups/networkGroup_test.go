@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -455,6 +456,475 @@ func TestGetESDTTotalSupply(t *testing.T) {
 	require.True(t, keyAndValueInResponse)
 }
 
+type governanceProposalsResponse struct {
+	Data struct {
+		Governance *common.GovernanceProposalsResponse `json:"governance"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceVotesResponse struct {
+	Data struct {
+		Votes *common.GovernanceVotesResponse `json:"votes"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetGovernanceProposals_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proposals := &common.GovernanceProposalsResponse{
+		CurrentNonce: 37,
+		Proposals: []*common.GovernanceProposalResponse{
+			{
+				Reference: "commit hash",
+				Yes:       "100",
+				Status:    "active",
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetGovernanceProposalsCalled: func() (*common.GovernanceProposalsResponse, error) {
+			return proposals, nil
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := governanceProposalsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, proposals, response.Data.Governance)
+}
+
+func TestGetGovernanceProposals_CannotGetProposals(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetGovernanceProposalsCalled: func() (*common.GovernanceProposalsResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
+func TestGetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetGovernanceProposalCalled: func(reference string) (*common.GovernanceProposalResponse, error) {
+			if reference == "missing" {
+				return nil, expectedErr
+			}
+
+			return &common.GovernanceProposalResponse{
+				Reference: reference,
+				Status:    "closed",
+			}, nil
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/governance/proposal/commit-hash", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.Contains(respStr, "commit-hash") && strings.Contains(respStr, "closed"))
+
+	req, _ = http.NewRequest("GET", "/network/governance/proposal/missing", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ = ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
+func TestGetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	votes := &common.GovernanceVotesResponse{
+		Address: "erd1voter",
+		Votes: []*common.GovernanceVoteSetResponse{
+			{
+				Proposal:  "commit hash",
+				UsedPower: "10",
+			},
+		},
+		DelegatedVotes: make([]*common.GovernanceVoteSetResponse, 0),
+	}
+	facade := mock.FacadeStub{
+		GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesResponse, error) {
+			if address != votes.Address {
+				return nil, expectedErr
+			}
+
+			return votes, nil
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/governance/votes/erd1voter", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := governanceVotesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, votes, response.Data.Votes)
+
+	req, _ = http.NewRequest("GET", "/network/governance/votes/erd1other", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
+type esdtTokenDataResponse struct {
+	Data struct {
+		Token *common.ESDTTokenResponse `json:"token"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type esdtHoldersResponse struct {
+	Data struct {
+		Holders *common.ESDTHoldersResponse `json:"holders"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type esdtSupplyHistoryResponse struct {
+	Data struct {
+		History *common.ESDTSupplyHistoryResponse `json:"history"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetESDTTokenData(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	tokenData := &common.ESDTTokenResponse{
+		Identifier:         "TKN-abcdef",
+		Name:               "token",
+		Owner:              "erd1owner",
+		MintedValue:        "100",
+		BurntValue:         "0",
+		NFTCreateRoleOwner: "erd1creator",
+		Roles: []*common.ESDTRolesResponse{
+			{
+				Address: "erd1creator",
+				Roles:   []string{"ESDTRoleNFTCreate"},
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetESDTTokenDataCalled: func(token string) (*common.ESDTTokenResponse, error) {
+			if token != tokenData.Identifier {
+				return nil, expectedErr
+			}
+
+			return tokenData, nil
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/esdt/token/TKN-abcdef", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokenDataResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, tokenData, response.Data.Token)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/token/OTHER-abcdef", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
+func TestGetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetESDTHoldersCalled: func(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error) {
+			if token != "TKN-abcdef" {
+				return nil, expectedErr
+			}
+
+			return &common.ESDTHoldersResponse{
+				Token:      token,
+				NumHolders: 3,
+				Offset:     offset,
+				Limit:      limit,
+				Holders: []*common.ESDTHolderResponse{
+					{
+						Address: "erd1holder",
+						Balance: "10",
+					},
+				},
+			}, nil
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/esdt/token/TKN-abcdef/holders?offset=2&limit=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtHoldersResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(3), response.Data.Holders.NumHolders)
+	assert.Equal(t, uint32(2), response.Data.Holders.Offset)
+	assert.Equal(t, uint32(1), response.Data.Holders.Limit)
+	require.Equal(t, 1, len(response.Data.Holders.Holders))
+	assert.Equal(t, "erd1holder", response.Data.Holders.Holders[0].Address)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/token/TKN-abcdef/holders", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = esdtHoldersResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(0), response.Data.Holders.Offset)
+	assert.Equal(t, uint32(100), response.Data.Holders.Limit)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/token/TKN-abcdef/holders?limit=100000", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/token/TKN-abcdef/holders?offset=abc", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/token/OTHER-abcdef/holders", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
+func TestGetESDTSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	var receivedFilter common.ESDTSupplyHistoryFilter
+	facade := mock.FacadeStub{
+		GetESDTSupplyHistoryCalled: func(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error) {
+			if token != "TKN-abcdef" {
+				return nil, expectedErr
+			}
+
+			receivedFilter = filter
+			return &common.ESDTSupplyHistoryResponse{
+				Token:  token,
+				Supply: "90",
+				Minted: "100",
+				Burned: "10",
+				Snapshots: []*common.ESDTSupplySnapshotResponse{
+					{
+						Epoch:  3,
+						Supply: "90",
+					},
+				},
+				Events: []*common.ESDTSupplyEventResponse{
+					{
+						TxHash:     "aabb",
+						Identifier: "ESDTLocalMint",
+						Amount:     "100",
+					},
+				},
+			}, nil
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/esdt/supply/TKN-abcdef/history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtSupplyHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "100", response.Data.History.Minted)
+	require.Equal(t, 1, len(response.Data.History.Snapshots))
+	assert.Equal(t, uint32(3), response.Data.History.Snapshots[0].Epoch)
+	require.Equal(t, 1, len(response.Data.History.Events))
+	assert.Equal(t, "aabb", response.Data.History.Events[0].TxHash)
+	assert.Equal(t, common.ESDTSupplyHistoryFilter{
+		StartEpoch:     0,
+		EndEpoch:       math.MaxUint32,
+		StartTimestamp: 0,
+		EndTimestamp:   math.MaxUint64,
+		Offset:         0,
+		Limit:          100,
+	}, receivedFilter)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/supply/TKN-abcdef/history?startEpoch=2&endEpoch=5&startDate=2021-10-01&endDate=2021-10-02&offset=10&limit=20", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, common.ESDTSupplyHistoryFilter{
+		StartEpoch:     2,
+		EndEpoch:       5,
+		StartTimestamp: 1633046400,
+		EndTimestamp:   1633219199,
+		Offset:         10,
+		Limit:          20,
+	}, receivedFilter)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/supply/TKN-abcdef/history?limit=1001", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/supply/TKN-abcdef/history?startEpoch=5&endEpoch=2", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/supply/TKN-abcdef/history?startDate=01-10-2021", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/network/esdt/supply/OTHER-abcdef/history", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
+type epochEconomicsResponse struct {
+	Data struct {
+		Economics *common.EpochEconomicsResponse `json:"economics"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetEpochEconomics(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetEpochEconomicsReportCalled: func(epoch uint32) (*common.EpochEconomicsResponse, error) {
+			if epoch != 7 {
+				return nil, expectedErr
+			}
+
+			return &common.EpochEconomicsResponse{
+				Epoch:       epoch,
+				BaseRewards: "1000",
+				Shards: []*common.ShardEconomicsResponse{
+					{ShardID: 0, BaseRewards: "1000"},
+				},
+				RewardAddresses: []*common.RewardAddressEconomicsResponse{
+					{Address: "erd1", Total: "1000"},
+				},
+			}, nil
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/economics/epoch/7", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := epochEconomicsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.Economics)
+	assert.Equal(t, uint32(7), response.Data.Economics.Epoch)
+	assert.Equal(t, "1000", response.Data.Economics.BaseRewards)
+	require.Equal(t, 1, len(response.Data.Economics.Shards))
+	require.Equal(t, 1, len(response.Data.Economics.RewardAddresses))
+	assert.Equal(t, "erd1", response.Data.Economics.RewardAddresses[0].Address)
+
+	req, _ = http.NewRequest("GET", "/network/economics/epoch/not-an-epoch", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/network/economics/epoch/8", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
 func getNetworkRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -469,6 +939,13 @@ func getNetworkRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
 					{Name: "/esdt/supply/:token", Open: true},
+					{Name: "/esdt/supply/:token/history", Open: true},
+					{Name: "/esdt/token/:token", Open: true},
+					{Name: "/esdt/token/:token/holders", Open: true},
+					{Name: "/governance/proposals", Open: true},
+					{Name: "/governance/proposal/:reference", Open: true},
+					{Name: "/governance/votes/:address", Open: true},
+					{Name: "/economics/epoch/:epoch", Open: true},
 				},
 			},
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -23,6 +24,8 @@ const (
 	p2pStatusPath       = "/p2pstatus"
 	peerInfoPath        = "/peerinfo"
 	statusPath          = "/status"
+	consensusRoundsPath = "/consensus/rounds"
+	slashingPath        = "/slashing/evidences"
 
 	// AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
 	AccStateCheckpointsKey = "erd_num_accounts_state_checkpoints"
@@ -37,6 +40,8 @@ type nodeFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRounds() ([]*consensus.RoundTrace, error)
+	GetSlashingEvidences() ([]*consensus.SlashingEvidence, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	IsInterfaceNil() bool
@@ -96,6 +101,16 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
 		},
+		{
+			Path:    consensusRoundsPath,
+			Method:  http.MethodGet,
+			Handler: ng.consensusRounds,
+		},
+		{
+			Path:    slashingPath,
+			Method:  http.MethodGet,
+			Handler: ng.slashingEvidences,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -227,6 +242,56 @@ func (ng *nodeGroup) peerInfo(c *gin.Context) {
 	)
 }
 
+// consensusRounds returns the timelines of the last consensus rounds traced by the node
+func (ng *nodeGroup) consensusRounds(c *gin.Context) {
+	rounds, err := ng.getFacade().GetConsensusRounds()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetConsensusRounds.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rounds": rounds},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// slashingEvidences returns the slashing evidences detected by the node
+func (ng *nodeGroup) slashingEvidences(c *gin.Context) {
+	evidences, err := ng.getFacade().GetSlashingEvidences()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetSlashingEvidences.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"evidences": evidences},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -341,6 +342,136 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestConsensusRounds_GetConsensusRoundsErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetConsensusRoundsCalled: func() ([]*consensus.RoundTrace, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestConsensusRounds_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetConsensusRoundsCalled: func() ([]*consensus.RoundTrace, error) {
+			return []*consensus.RoundTrace{
+				{
+					Round:   37,
+					Outcome: "committed",
+				},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type roundsResponse struct {
+		Data struct {
+			Rounds []*consensus.RoundTrace `json:"rounds"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}
+	response := &roundsResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	require.Equal(t, 1, len(response.Data.Rounds))
+	assert.Equal(t, int64(37), response.Data.Rounds[0].Round)
+	assert.Equal(t, "committed", response.Data.Rounds[0].Outcome)
+}
+
+func TestSlashingEvidences_GetSlashingEvidencesErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetSlashingEvidencesCalled: func() ([]*consensus.SlashingEvidence, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/slashing/evidences", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSlashingEvidences_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetSlashingEvidencesCalled: func() ([]*consensus.SlashingEvidence, error) {
+			return []*consensus.SlashingEvidence{
+				{
+					Type:  consensus.DoubleSigningEvidence,
+					Round: 37,
+				},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/slashing/evidences", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type evidencesResponse struct {
+		Data struct {
+			Evidences []*consensus.SlashingEvidence `json:"evidences"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}
+	response := &evidencesResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	require.Equal(t, 1, len(response.Data.Evidences))
+	assert.Equal(t, uint64(37), response.Data.Evidences[0].Round)
+	assert.Equal(t, consensus.DoubleSigningEvidence, response.Data.Evidences[0].Type)
+}
+
 func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	statusMetricsProvider := statusHandler.NewStatusMetrics()
 	key := "test-key"
@@ -390,6 +521,8 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/consensus/rounds", Open: true},
+					{Name: "/slashing/evidences", Open: true},
 				},
 			},
 		},
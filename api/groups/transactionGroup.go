@@ -31,6 +31,7 @@ const (
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamProfile        = "profile"
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
@@ -192,6 +193,19 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withProfile, err := getQueryParamProfile(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	tx, txHash, err := tg.getFacade().CreateTransaction(
 		gtx.Nonce,
 		gtx.Value,
@@ -246,6 +260,9 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	}
 
 	executionResults.Hash = hex.EncodeToString(txHash)
+	if !withProfile {
+		executionResults.GasProfile = nil
+	}
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
@@ -549,6 +566,15 @@ func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(bypassSignatureStr)
 }
 
+func getQueryParamProfile(c *gin.Context) (bool, error) {
+	profileStr := c.Request.URL.Query().Get(queryParamProfile)
+	if profileStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(profileStr)
+}
+
 func (tg *transactionGroup) getFacade() transactionFacadeHandler {
 	tg.mutFacade.RLock()
 	defer tg.mutFacade.RUnlock()
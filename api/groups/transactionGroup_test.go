@@ -823,6 +823,77 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransaction_ProfileQueryParameter(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+			return &txSimData.SimulationResults{
+				Status: "success",
+				GasProfile: &txSimData.GasProfile{
+					GasScheduleVersion: "gasScheduleV3.toml",
+					GasLimit:           100000,
+					GasUsed:            50000,
+				},
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	type simulateTxProfileResponse struct {
+		Data struct {
+			Result txSimData.SimulationResults `json:"result"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+
+	t.Run("invalid profile parameter should err", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/transaction/simulate?profile=tttt", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateTxProfileResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("without profile parameter should not return the gas profile", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateTxProfileResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Nil(t, response.Data.Result.GasProfile)
+	})
+	t.Run("with profile parameter should return the gas profile", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/transaction/simulate?profile=true", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateTxProfileResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.NotNil(t, response.Data.Result.GasProfile)
+		assert.Equal(t, "gasScheduleV3.toml", response.Data.Result.GasProfile.GasScheduleVersion)
+		assert.Equal(t, uint64(50000), response.Data.Result.GasProfile.GasUsed)
+	})
+}
+
 func getTransactionRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -8,15 +8,26 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath         = "/statistics"
+	validatorRewardsPath   = "/:pubkey/rewards"
+	validatorLifecyclePath = "/:pubkey"
+	stakingQueuePath       = "/queue"
+
+	queryParamOwner = "owner"
+)
 
 // validatorFacadeHandler defines the methods to be implemented by a facade for validator requests
 type validatorFacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorRewards(publicKey string) (*common.ValidatorRewardsResponse, error)
+	GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error)
+	GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -43,6 +54,21 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.statistics,
 		},
+		{
+			Path:    validatorRewardsPath,
+			Method:  http.MethodGet,
+			Handler: ng.rewards,
+		},
+		{
+			Path:    stakingQueuePath,
+			Method:  http.MethodGet,
+			Handler: ng.stakingQueue,
+		},
+		{
+			Path:    validatorLifecyclePath,
+			Method:  http.MethodGet,
+			Handler: ng.lifecycle,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -74,6 +100,110 @@ func (vg *validatorGroup) statistics(c *gin.Context) {
 	)
 }
 
+// rewards will return the per-epoch rewards history of a validator
+func (vg *validatorGroup) rewards(c *gin.Context) {
+	publicKey := c.Param("pubkey")
+	if publicKey == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyPublicKey.Error()),
+		)
+		return
+	}
+
+	rewards, err := vg.getFacade().GetValidatorRewards(publicKey)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rewards": rewards},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// lifecycle will return the staking, rating and shuffling status of a validator
+func (vg *validatorGroup) lifecycle(c *gin.Context) {
+	publicKey := c.Param("pubkey")
+	if publicKey == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyPublicKey.Error()),
+		)
+		return
+	}
+
+	lifecycle, err := vg.getFacade().GetValidatorLifecycle(publicKey)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"validator": lifecycle},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// stakingQueue will return a page of the staking queue, optionally filtered by the owner of the queued keys
+func (vg *validatorGroup) stakingQueue(c *gin.Context) {
+	offset, limit, err := getQueryParamsPagination(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	filter := common.StakingQueueFilter{
+		Owner:  c.Request.URL.Query().Get(queryParamOwner),
+		Offset: offset,
+		Limit:  limit,
+	}
+	queue, err := vg.getFacade().GetStakingQueue(filter)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"queue": queue},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (vg *validatorGroup) getFacade() validatorFacadeHandler {
 	vg.mutFacade.RLock()
 	defer vg.mutFacade.RUnlock()
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/stretchr/testify/assert"
@@ -106,12 +107,239 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+type validatorRewardsResponse struct {
+	Data struct {
+		Rewards *common.ValidatorRewardsResponse `json:"rewards"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestValidatorRewards_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetValidatorRewardsCalled: func(publicKey string) (*common.ValidatorRewardsResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/aabb/rewards", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorRewardsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestValidatorRewards_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetValidatorRewardsCalled: func(publicKey string) (*common.ValidatorRewardsResponse, error) {
+			return &common.ValidatorRewardsResponse{
+				PublicKey: publicKey,
+				Epochs: []*common.ValidatorEpochRewardsResponse{
+					{Epoch: 4, BaseReward: "10", Total: "10", Distributed: true},
+				},
+			}, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/aabb/rewards", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorRewardsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.Rewards)
+	assert.Equal(t, "aabb", response.Data.Rewards.PublicKey)
+	require.Equal(t, 1, len(response.Data.Rewards.Epochs))
+	assert.Equal(t, uint32(4), response.Data.Rewards.Epochs[0].Epoch)
+	assert.Equal(t, "10", response.Data.Rewards.Epochs[0].Total)
+}
+
+type validatorLifecycleResponse struct {
+	Data struct {
+		Validator *common.ValidatorLifecycleResponse `json:"validator"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestValidatorLifecycle_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetValidatorLifecycleCalled: func(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorLifecycleResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestValidatorLifecycle_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetValidatorLifecycleCalled: func(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+			return &common.ValidatorLifecycleResponse{
+				PublicKey: blsKey,
+				Staking:   &common.ValidatorStakingResponse{Status: "queued", Waiting: true},
+				Queue:     &common.ValidatorQueuePositionResponse{Position: 3, Length: 10},
+			}, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorLifecycleResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.Validator)
+	assert.Equal(t, "aabb", response.Data.Validator.PublicKey)
+	assert.Equal(t, "queued", response.Data.Validator.Staking.Status)
+	assert.Equal(t, uint32(3), response.Data.Validator.Queue.Position)
+}
+
+type stakingQueueResponse struct {
+	Data struct {
+		Queue *common.StakingQueueResponse `json:"queue"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestStakingQueue_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/queue?limit=0", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stakingQueueResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrInvalidQueryParameter.Error())
+}
+
+func TestStakingQueue_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetStakingQueueCalled: func(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stakingQueueResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestStakingQueue_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	var providedFilter common.StakingQueueFilter
+	facade := mock.FacadeStub{
+		GetStakingQueueCalled: func(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+			providedFilter = filter
+			return &common.StakingQueueResponse{
+				Length:      5,
+				NumMatching: 2,
+				Entries: []*common.StakingQueueEntryResponse{
+					{Position: 4, BLSKey: "aabb", OwnerAddress: filter.Owner},
+				},
+			}, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/queue?owner=erd1owner&offset=1&limit=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stakingQueueResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, common.StakingQueueFilter{Owner: "erd1owner", Offset: 1, Limit: 1}, providedFilter)
+	require.NotNil(t, response.Data.Queue)
+	assert.Equal(t, uint32(5), response.Data.Queue.Length)
+	require.Equal(t, 1, len(response.Data.Queue.Entries))
+	assert.Equal(t, uint32(4), response.Data.Queue.Entries[0].Position)
+}
+
 func getValidatorRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
 			"validator": {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/:pubkey/rewards", Open: true},
+					{Name: "/queue", Open: true},
+					{Name: "/:pubkey", Open: true},
 				},
 			},
 		},
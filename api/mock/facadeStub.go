@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -40,6 +41,8 @@ type FacadeStub struct {
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsCalled                func() ([]*consensus.RoundTrace, error)
+	GetSlashingEvidencesCalled              func() ([]*consensus.SlashingEvidence, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
 	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
@@ -63,6 +66,80 @@ type FacadeStub struct {
 	GetProofDataTrieCalled                  func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                       func(string, string, [][]byte) (bool, error)
 	GetTokenSupplyCalled                    func(token string) (string, error)
+	GetGovernanceProposalsCalled            func() (*common.GovernanceProposalsResponse, error)
+	GetGovernanceProposalCalled             func(reference string) (*common.GovernanceProposalResponse, error)
+	GetGovernanceVotesCalled                func(address string) (*common.GovernanceVotesResponse, error)
+	GetDelegationContractCalled             func(contract string) (*common.DelegationContractResponse, error)
+	GetESDTTokenDataCalled                  func(token string) (*common.ESDTTokenResponse, error)
+	GetESDTHoldersCalled                    func(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error)
+	GetESDTSupplyHistoryCalled              func(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error)
+	GetEpochEconomicsReportCalled           func(epoch uint32) (*common.EpochEconomicsResponse, error)
+	GetValidatorRewardsCalled               func(publicKey string) (*common.ValidatorRewardsResponse, error)
+	GetValidatorLifecycleCalled             func(blsKey string) (*common.ValidatorLifecycleResponse, error)
+	GetStakingQueueCalled                   func(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error)
+}
+
+// GetESDTTokenData -
+func (f *FacadeStub) GetESDTTokenData(token string) (*common.ESDTTokenResponse, error) {
+	if f.GetESDTTokenDataCalled != nil {
+		return f.GetESDTTokenDataCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetESDTHolders -
+func (f *FacadeStub) GetESDTHolders(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error) {
+	if f.GetESDTHoldersCalled != nil {
+		return f.GetESDTHoldersCalled(token, offset, limit)
+	}
+
+	return nil, nil
+}
+
+// GetESDTSupplyHistory -
+func (f *FacadeStub) GetESDTSupplyHistory(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error) {
+	if f.GetESDTSupplyHistoryCalled != nil {
+		return f.GetESDTSupplyHistoryCalled(token, filter)
+	}
+
+	return nil, nil
+}
+
+// GetEpochEconomicsReport -
+func (f *FacadeStub) GetEpochEconomicsReport(epoch uint32) (*common.EpochEconomicsResponse, error) {
+	if f.GetEpochEconomicsReportCalled != nil {
+		return f.GetEpochEconomicsReportCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorRewards -
+func (f *FacadeStub) GetValidatorRewards(publicKey string) (*common.ValidatorRewardsResponse, error) {
+	if f.GetValidatorRewardsCalled != nil {
+		return f.GetValidatorRewardsCalled(publicKey)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorLifecycle -
+func (f *FacadeStub) GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+	if f.GetValidatorLifecycleCalled != nil {
+		return f.GetValidatorLifecycleCalled(blsKey)
+	}
+
+	return nil, nil
+}
+
+// GetStakingQueue -
+func (f *FacadeStub) GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+	if f.GetStakingQueueCalled != nil {
+		return f.GetStakingQueueCalled(filter)
+	}
+
+	return nil, nil
 }
 
 // GetTokenSupply -
@@ -304,6 +381,42 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return f.GetDelegatorsListHandler()
 }
 
+// GetGovernanceProposals -
+func (f *FacadeStub) GetGovernanceProposals() (*common.GovernanceProposalsResponse, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *FacadeStub) GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(reference)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (f *FacadeStub) GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error) {
+	if f.GetGovernanceVotesCalled != nil {
+		return f.GetGovernanceVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationContract -
+func (f *FacadeStub) GetDelegationContract(contract string) (*common.DelegationContractResponse, error) {
+	if f.GetDelegationContractCalled != nil {
+		return f.GetDelegationContractCalled(contract)
+	}
+
+	return nil, nil
+}
+
 // ComputeTransactionGasLimit -
 func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return f.ComputeTransactionGasLimitHandler(tx)
@@ -334,6 +447,24 @@ func (f *FacadeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetConsensusRounds -
+func (f *FacadeStub) GetConsensusRounds() ([]*consensus.RoundTrace, error) {
+	if f.GetConsensusRoundsCalled != nil {
+		return f.GetConsensusRoundsCalled()
+	}
+
+	return make([]*consensus.RoundTrace, 0), nil
+}
+
+// GetSlashingEvidences -
+func (f *FacadeStub) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	if f.GetSlashingEvidencesCalled != nil {
+		return f.GetSlashingEvidencesCalled()
+	}
+
+	return make([]*consensus.SlashingEvidence, 0), nil
+}
+
 // GetNumCheckpointsFromAccountState -
 func (f *FacadeStub) GetNumCheckpointsFromAccountState() uint32 {
 	if f.GetNumCheckpointsFromAccountStateCalled != nil {
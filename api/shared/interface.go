@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -78,12 +79,25 @@ type FacadeHandler interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetGovernanceProposals() (*common.GovernanceProposalsResponse, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error)
+	GetDelegationContract(contract string) (*common.DelegationContractResponse, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTokenSupply(token string) (string, error)
+	GetESDTTokenData(token string) (*common.ESDTTokenResponse, error)
+	GetESDTHolders(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error)
+	GetESDTSupplyHistory(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error)
+	GetEpochEconomicsReport(epoch uint32) (*common.EpochEconomicsResponse, error)
+	GetValidatorRewards(publicKey string) (*common.ValidatorRewardsResponse, error)
+	GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error)
+	GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRounds() ([]*consensus.RoundTrace, error)
+	GetSlashingEvidences() ([]*consensus.SlashingEvidence, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
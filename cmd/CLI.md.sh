@@ -6,6 +6,13 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForRewardSimulator
+    generateForGasScheduleValidator
+    generateForHardforkVerifier
+    generateForHardforkReadable
+    generateForGenesisBuilder
+    generateForRemoteSigner
+    generateForTxTool
 }
 
 generateForNode() {
@@ -78,6 +85,104 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForRewardSimulator() {
+    HELP="
+# Elrond Rewards Simulator CLI
+
+The **Rewards simulator Tool** exposes the following Command Line Interface:
+$(code)
+\$ rewardsimulator --help
+
+$(./rewardsimulator/rewardsimulator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./rewardsimulator/CLI.md
+}
+
+generateForGasScheduleValidator() {
+    HELP="
+# Elrond Gas Schedule Validator CLI
+
+The **Gas schedule validator Tool** exposes the following Command Line Interface:
+$(code)
+\$ gasschedulevalidator --help
+
+$(./gasschedulevalidator/gasschedulevalidator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./gasschedulevalidator/CLI.md
+}
+
+generateForHardforkVerifier() {
+    HELP="
+# Elrond Hardfork Export Verifier CLI
+
+The **Hardfork export verifier Tool** exposes the following Command Line Interface:
+$(code)
+\$ hardforkverifier --help
+
+$(./hardforkverifier/hardforkverifier --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./hardforkverifier/CLI.md
+}
+
+generateForHardforkReadable() {
+    HELP="
+# Elrond Hardfork Readable Export CLI
+
+The **Hardfork readable export Tool** exposes the following Command Line Interface:
+$(code)
+\$ hardforkreadable --help
+
+$(./hardforkreadable/hardforkreadable --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./hardforkreadable/CLI.md
+}
+
+generateForGenesisBuilder() {
+    HELP="
+# Elrond Genesis Builder CLI
+
+The **Genesis builder Tool** exposes the following Command Line Interface:
+$(code)
+\$ genesisbuilder --help
+
+$(./genesisbuilder/genesisbuilder --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./genesisbuilder/CLI.md
+}
+
+generateForRemoteSigner() {
+    HELP="
+# Elrond Remote Signer CLI
+
+The **Elrond Remote Signer** exposes the following Command Line Interface:
+$(code)
+\$ remotesigner --help
+
+$(./remotesigner/remotesigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./remotesigner/CLI.md
+}
+
+generateForTxTool() {
+    HELP="
+# Elrond Transaction Tool CLI
+
+The **Transaction Tool** exposes the following Command Line Interface:
+$(code)
+\$ txtool --help
+
+$(./txtool/txtool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./txtool/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
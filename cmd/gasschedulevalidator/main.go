@@ -0,0 +1,568 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/display"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/gasScheduleValidator"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/urfave/cli"
+)
+
+const (
+	addressPubkeyLen  = 32
+	batchDelaySeconds = 2
+	maxBatchSize      = 100
+	maxOpenFiles      = 10
+	noUpgradeEpoch    = math.MaxUint32
+)
+
+type cfg struct {
+	configFile          string
+	epochConfigFile     string
+	economicsConfigFile string
+	gasSchedulesDir     string
+	upgradeEpoch        uint
+	corpusFile          string
+	dbPath              string
+	maxTransactions     uint
+	allowPartialReplay  bool
+	outputFile          string
+}
+
+// gasScheduleValidatorHandler defines the gas schedule validator operations used by the tool
+type gasScheduleValidatorHandler interface {
+	GetGasSchedule(fileName string) (map[string]map[string]uint64, error)
+	GetUpgradeVersions(epoch uint32) (config.GasScheduleByEpochs, config.GasScheduleByEpochs, error)
+	LatestUpgradeEpoch() uint32
+	Diff(oldFileName string, newFileName string) (*gasScheduleValidator.ScheduleDiff, error)
+}
+
+// output is the content of the json output file
+type output struct {
+	Validation *gasScheduleValidator.ValidationReport `json:"validation"`
+	Diff       *gasScheduleValidator.ScheduleDiff     `json:"diff,omitempty"`
+	FeeReplay  *gasScheduleValidator.FeeReplayReport  `json:"feeReplay,omitempty"`
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// configFile defines a flag for the path to the main toml file holding the arwen versions
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the main configuration file, used for the arwen versions by epoch",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// epochConfigFile defines a flag for the path to the toml file holding the gas schedule versions by epoch
+	epochConfigFile = cli.StringFlag{
+		Name:        "epoch-config",
+		Usage:       "The `filepath` for the epoch configuration file, used for the gas schedule versions by epoch",
+		Value:       "./config/enableEpochs.toml",
+		Destination: &argsConfig.epochConfigFile,
+	}
+	// economicsConfigFile defines a flag for the path to the economics toml file used when replaying fees
+	economicsConfigFile = cli.StringFlag{
+		Name:        "economics-config",
+		Usage:       "The `filepath` for the economics configuration file, used when replaying the transactions fees",
+		Value:       "./config/economics.toml",
+		Destination: &argsConfig.economicsConfigFile,
+	}
+	// gasSchedulesDir defines a flag for the directory holding the gas schedule files
+	gasSchedulesDir = cli.StringFlag{
+		Name:        "gas-schedules-dir",
+		Usage:       "The `directory` holding the gas schedule files",
+		Value:       "./config/gasSchedules",
+		Destination: &argsConfig.gasSchedulesDir,
+	}
+	// upgradeEpoch defines a flag for the epoch of the gas schedule upgrade to be analyzed
+	upgradeEpoch = cli.UintFlag{
+		Name:        "upgrade-epoch",
+		Usage:       "The epoch of the gas schedule upgrade to be diffed and replayed. Defaults to the latest configured upgrade",
+		Value:       noUpgradeEpoch,
+		Destination: &argsConfig.upgradeEpoch,
+	}
+	// corpusFile defines a flag for the path to a json file holding the transactions to be replayed
+	corpusFile = cli.StringFlag{
+		Name:        "corpus-file",
+		Usage:       "The `filepath` for a json file holding an array of transactions, in the format accepted by the send transaction route",
+		Destination: &argsConfig.corpusFile,
+	}
+	// dbPath defines a flag for the path to the transactions database of a node
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The `directory` of the Transactions database of a node. Used when no corpus file is provided",
+		Destination: &argsConfig.dbPath,
+	}
+	// maxTransactions defines a flag for the maximum number of transactions read from the database
+	maxTransactions = cli.UintFlag{
+		Name:        "max-transactions",
+		Usage:       "The maximum number of transactions read from the database",
+		Value:       10000,
+		Destination: &argsConfig.maxTransactions,
+	}
+	// allowPartialReplay defines a flag that allows a fees replay dominated by not replayed transactions
+	allowPartialReplay = cli.BoolFlag{
+		Name: "allow-partial-replay",
+		Usage: "Do not fail when most of the transactions whose fees depend on the gas schedule, as the smart contract " +
+			"calls, could not be replayed",
+		Destination: &argsConfig.allowPartialReplay,
+	}
+	// outputFile defines a flag for the json file the full result is written to
+	outputFile = cli.StringFlag{
+		Name:        "output-file",
+		Usage:       "The `filepath` for the json file the validation, diff and replay results are written to. Optional",
+		Destination: &argsConfig.outputFile,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("gasschedulevalidator")
+
+	addressPubkeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(addressPubkeyLen, log)
+	marshalizer               = &marshal.GogoProtoMarshalizer{}
+	hasher                    = blake2b.NewBlake2b()
+
+	errInvalidGasSchedules = errors.New("gas schedules validation failed")
+	errPartialFeeReplay    = errors.New("fees replay dominated by not replayed transactions, the reported totals miss most of the fee changes")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Gas schedule validator Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary checks all the configured gas schedule versions against the node's gas consumers, diffs " +
+		"the versions of a gas schedule upgrade and replays a transactions corpus to report the fee changes"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		configFile,
+		epochConfigFile,
+		economicsConfigFile,
+		gasSchedulesDir,
+		upgradeEpoch,
+		corpusFile,
+		dbPath,
+		maxTransactions,
+		allowPartialReplay,
+		outputFile,
+	}
+	app.Action = func(_ *cli.Context) error {
+		return validate()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func validate() error {
+	mainConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+	epochConfig, err := common.LoadEpochConfig(argsConfig.epochConfigFile)
+	if err != nil {
+		return err
+	}
+
+	validator, err := gasScheduleValidator.NewGasScheduleValidator(gasScheduleValidator.ArgsGasScheduleValidator{
+		GasScheduleConfig: epochConfig.GasSchedule,
+		ArwenVersions:     mainConfig.VirtualMachine.Execution.ArwenVersions,
+		ConfigDir:         argsConfig.gasSchedulesDir,
+	})
+	if err != nil {
+		return err
+	}
+
+	result := &output{
+		Validation: validator.Validate(),
+	}
+	displayValidationReport(result.Validation)
+
+	err = analyzeUpgrade(validator, epochConfig.EnableEpochs, result)
+	if err != nil {
+		return err
+	}
+
+	err = saveResult(result)
+	if err != nil {
+		return err
+	}
+
+	if !result.Validation.IsValid() {
+		return errInvalidGasSchedules
+	}
+	if result.FeeReplay != nil && result.FeeReplay.Partial && !argsConfig.allowPartialReplay {
+		return errPartialFeeReplay
+	}
+
+	return nil
+}
+
+func analyzeUpgrade(
+	validator gasScheduleValidatorHandler,
+	enableEpochs config.EnableEpochs,
+	result *output,
+) error {
+	epoch := uint32(argsConfig.upgradeEpoch)
+	if argsConfig.upgradeEpoch == noUpgradeEpoch {
+		epoch = validator.LatestUpgradeEpoch()
+	}
+
+	oldVersion, newVersion, err := validator.GetUpgradeVersions(epoch)
+	if err != nil {
+		log.Info("no gas schedule upgrade to analyze", "reason", err)
+		return nil
+	}
+
+	result.Diff, err = validator.Diff(oldVersion.FileName, newVersion.FileName)
+	if err != nil {
+		return err
+	}
+	displayDiff(result.Diff)
+
+	txs, err := loadTransactions()
+	if err != nil {
+		return err
+	}
+	if len(txs) == 0 {
+		return nil
+	}
+
+	result.FeeReplay, err = replayFees(validator, enableEpochs, txs, epoch, oldVersion.FileName, newVersion.FileName)
+	if err != nil {
+		return err
+	}
+	displayFeeReplay(result.FeeReplay)
+
+	return nil
+}
+
+func loadTransactions() ([]*transaction.Transaction, error) {
+	if len(argsConfig.corpusFile) > 0 {
+		return loadTransactionsFromCorpus(argsConfig.corpusFile)
+	}
+	if len(argsConfig.dbPath) > 0 {
+		return loadTransactionsFromDB(argsConfig.dbPath)
+	}
+
+	log.Info("no transactions corpus provided, fees replay skipped")
+
+	return nil, nil
+}
+
+func loadTransactionsFromCorpus(filePath string) ([]*transaction.Transaction, error) {
+	frontendTxs := make([]*transaction.FrontendTransaction, 0)
+	err := core.LoadJsonFile(&frontendTxs, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(frontendTxs))
+	for idx, frontendTx := range frontendTxs {
+		tx, errConvert := convertFrontendTransaction(frontendTx)
+		if errConvert != nil {
+			return nil, fmt.Errorf("%w for transaction at index %d", errConvert, idx)
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func convertFrontendTransaction(frontendTx *transaction.FrontendTransaction) (*transaction.Transaction, error) {
+	sender, err := addressPubkeyConverter.Decode(frontendTx.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := addressPubkeyConverter.Decode(frontendTx.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := big.NewInt(0).SetString(frontendTx.Value, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid value %s", frontendTx.Value)
+	}
+	signature, err := hex.DecodeString(frontendTx.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.Transaction{
+		Nonce:       frontendTx.Nonce,
+		Value:       value,
+		RcvAddr:     receiver,
+		RcvUserName: frontendTx.ReceiverUsername,
+		SndAddr:     sender,
+		SndUserName: frontendTx.SenderUsername,
+		GasPrice:    frontendTx.GasPrice,
+		GasLimit:    frontendTx.GasLimit,
+		Data:        frontendTx.Data,
+		ChainID:     []byte(frontendTx.ChainID),
+		Version:     frontendTx.Version,
+		Signature:   signature,
+		Options:     frontendTx.Options,
+	}, nil
+}
+
+func loadTransactionsFromDB(path string) ([]*transaction.Transaction, error) {
+	db, err := leveldb.NewDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := db.Close()
+		log.LogIfError(errClose)
+	}()
+
+	txs := make([]*transaction.Transaction, 0)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		tx := &transaction.Transaction{}
+		errUnmarshal := marshalizer.Unmarshal(tx, value)
+		if errUnmarshal != nil {
+			log.Debug("skipped database entry", "key", key, "error", errUnmarshal)
+			return true
+		}
+
+		txs = append(txs, tx)
+
+		return uint(len(txs)) < argsConfig.maxTransactions
+	})
+
+	log.Info("loaded transactions from database", "path", path, "num transactions", len(txs))
+
+	return txs, nil
+}
+
+func replayFees(
+	validator gasScheduleValidatorHandler,
+	enableEpochs config.EnableEpochs,
+	txs []*transaction.Transaction,
+	epoch uint32,
+	oldFileName string,
+	newFileName string,
+) (*gasScheduleValidator.FeeReplayReport, error) {
+	economicsConfig, err := common.LoadEconomicsConfig(argsConfig.economicsConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	replayer, err := gasScheduleValidator.NewFeeReplayer(gasScheduleValidator.ArgsFeeReplayer{
+		Economics:              economicsConfig,
+		EnableEpochs:           enableEpochs,
+		AddressPubkeyConverter: addressPubkeyConverter,
+		Marshalizer:            marshalizer,
+		Hasher:                 hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	oldVersion, err := createGasScheduleVersion(validator, oldFileName)
+	if err != nil {
+		return nil, err
+	}
+	newVersion, err := createGasScheduleVersion(validator, newFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return replayer.Replay(txs, epoch, oldVersion, newVersion)
+}
+
+func createGasScheduleVersion(validator gasScheduleValidatorHandler, fileName string) (*gasScheduleValidator.GasScheduleVersion, error) {
+	gasSchedule, err := validator.GetGasSchedule(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gasScheduleValidator.GasScheduleVersion{
+		FileName:    fileName,
+		GasSchedule: gasSchedule,
+	}, nil
+}
+
+func displayValidationReport(report *gasScheduleValidator.ValidationReport) {
+	header := []string{"version", "epochs", "arwen versions", "errors", "warnings"}
+	lines := make([]*display.LineData, 0, len(report.Versions))
+	for _, version := range report.Versions {
+		lines = append(lines, display.NewLineData(false, []string{
+			version.FileName,
+			epochsInterval(version.StartEpoch, version.EndEpoch),
+			fmt.Sprintf("%v", version.ArwenVersions),
+			fmt.Sprintf("%d", len(version.Errors)),
+			fmt.Sprintf("%d", len(version.Warnings)),
+		}))
+	}
+	printTable("Gas schedule versions", header, lines)
+
+	header = []string{"version", "severity", "component", "section", "key", "message"}
+	lines = make([]*display.LineData, 0)
+	for _, version := range report.Versions {
+		lines = appendIssueLines(lines, version.FileName, "error", version.Errors)
+		lines = appendIssueLines(lines, version.FileName, "warning", version.Warnings)
+	}
+	if len(lines) > 0 {
+		printTable("Gas schedule issues", header, lines)
+	}
+}
+
+func appendIssueLines(lines []*display.LineData, fileName string, severity string, issues []*gasScheduleValidator.Issue) []*display.LineData {
+	for _, issue := range issues {
+		lines = append(lines, display.NewLineData(false, []string{
+			fileName,
+			severity,
+			issue.Component,
+			issue.Section,
+			issue.Key,
+			issue.Message,
+		}))
+	}
+
+	return lines
+}
+
+func epochsInterval(startEpoch uint32, endEpoch uint32) string {
+	if endEpoch == math.MaxUint32 {
+		return fmt.Sprintf("%d - ...", startEpoch)
+	}
+
+	return fmt.Sprintf("%d - %d", startEpoch, endEpoch)
+}
+
+func displayDiff(diff *gasScheduleValidator.ScheduleDiff) {
+	header := []string{"change", "section", "key", "old value", "new value", "relative change"}
+	lines := make([]*display.LineData, 0, len(diff.Added)+len(diff.Removed)+len(diff.Changed))
+	lines = appendDiffLines(lines, "changed", diff.Changed)
+	lines = appendDiffLines(lines, "added", diff.Added)
+	lines = appendDiffLines(lines, "removed", diff.Removed)
+
+	title := fmt.Sprintf("Gas schedule diff %s -> %s", diff.OldVersion, diff.NewVersion)
+	if len(lines) == 0 {
+		log.Info(title + ": no changes")
+		return
+	}
+
+	printTable(title, header, lines)
+}
+
+func appendDiffLines(lines []*display.LineData, change string, keyDiffs []*gasScheduleValidator.KeyDiff) []*display.LineData {
+	for _, keyDiff := range keyDiffs {
+		lines = append(lines, display.NewLineData(false, []string{
+			change,
+			keyDiff.Section,
+			keyDiff.Key,
+			fmt.Sprintf("%d", keyDiff.OldValue),
+			fmt.Sprintf("%d", keyDiff.NewValue),
+			fmt.Sprintf("%+.2f%%", keyDiff.RelativeChange*100),
+		}))
+	}
+
+	return lines
+}
+
+func displayFeeReplay(report *gasScheduleValidator.FeeReplayReport) {
+	header := []string{"hash", "category", "function", "old gas", "new gas", "old fee", "new fee", "delta"}
+	lines := make([]*display.LineData, 0, report.NumChanged)
+	for _, tx := range report.Transactions {
+		if !tx.Replayed || tx.FeeDelta.Sign() == 0 {
+			continue
+		}
+
+		lines = append(lines, display.NewLineData(false, []string{
+			tx.Hash,
+			tx.Category,
+			tx.Function,
+			fmt.Sprintf("%d", tx.OldGasUsed),
+			fmt.Sprintf("%d", tx.NewGasUsed),
+			tx.OldFee.String(),
+			tx.NewFee.String(),
+			tx.FeeDelta.String(),
+		}))
+	}
+	if len(lines) > 0 {
+		printTable("Transactions with changed fees", header, lines)
+	}
+
+	log.Info("fees replay",
+		"epoch", report.Epoch,
+		"num transactions", report.NumTransactions,
+		"num replayed", report.NumReplayed,
+		"num not replayed", report.NumNotReplayed,
+		"num changed", report.NumChanged,
+		"total old fee", report.TotalOldFee.String(),
+		"total new fee", report.TotalNewFee.String(),
+		"total delta", report.TotalFeeDelta.String(),
+	)
+	if report.Partial {
+		log.Warn("partial fees replay: the smart contract and relayed transactions are not replayed and dominate "+
+			"the transactions whose fees depend on the gas schedule",
+			"not replayed ratio", fmt.Sprintf("%.2f", report.NotReplayedRatio),
+		)
+	}
+}
+
+func printTable(title string, header []string, lines []*display.LineData) {
+	table, err := display.CreateTableString(header, lines)
+	if err != nil {
+		log.Warn("cannot display table", "title", title, "error", err)
+		return
+	}
+
+	log.Info(title + "\n" + table)
+}
+
+func saveResult(result *output) error {
+	if len(argsConfig.outputFile) == 0 {
+		return nil
+	}
+
+	buff, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.Info("saving validation result", "file", argsConfig.outputFile)
+
+	return ioutil.WriteFile(filepath.Clean(argsConfig.outputFile), buff, core.FileModeUserReadWrite)
+}
@@ -0,0 +1,362 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/display"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/factory"
+	"github.com/ElrondNetwork/elrond-go/genesis/builder"
+	"github.com/urfave/cli"
+)
+
+const conversionBase = 10
+
+type cfg struct {
+	configFile              string
+	economicsConfigFile     string
+	systemSCConfigFile      string
+	numOfShards             uint
+	numOfNodesPerShard      uint
+	numOfMetachainNodes     uint
+	shardConsensusGroupSize uint
+	metaConsensusGroupSize  uint
+	roundDuration           uint64
+	startTime               int64
+	numOfWallets            uint
+	initialBalance          string
+	numOfDelegatedNodes     uint
+	numOfDelegators         uint
+	delegationContractFile  string
+	dnsContractFile         string
+	outputFolder            string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// configFile defines a flag for the path to the main toml file
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the main configuration file, used for the public key converters and the maximum number of genesis shards",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// economicsConfigFile defines a flag for the path to the economics toml file
+	economicsConfigFile = cli.StringFlag{
+		Name:        "economics-config",
+		Usage:       "The `filepath` for the economics configuration file, used for the genesis total supply",
+		Value:       "./config/economics.toml",
+		Destination: &argsConfig.economicsConfigFile,
+	}
+	// systemSCConfigFile defines a flag for the path to the system smart contracts toml file
+	systemSCConfigFile = cli.StringFlag{
+		Name:        "systemsc-config",
+		Usage:       "The `filepath` for the system smart contracts configuration file, used for the genesis node price",
+		Value:       "./config/systemSmartContractsConfig.toml",
+		Destination: &argsConfig.systemSCConfigFile,
+	}
+	// numOfShards defines a flag for the number of shards
+	numOfShards = cli.UintFlag{
+		Name:        "num-shards",
+		Usage:       "The number of shards of the network, without the metachain",
+		Value:       2,
+		Destination: &argsConfig.numOfShards,
+	}
+	// numOfNodesPerShard defines a flag for the number of validators in each shard
+	numOfNodesPerShard = cli.UintFlag{
+		Name:        "num-nodes-per-shard",
+		Usage:       "The number of validators in each shard",
+		Value:       3,
+		Destination: &argsConfig.numOfNodesPerShard,
+	}
+	// numOfMetachainNodes defines a flag for the number of validators in the metachain
+	numOfMetachainNodes = cli.UintFlag{
+		Name:        "num-metachain-nodes",
+		Usage:       "The number of validators in the metachain",
+		Value:       3,
+		Destination: &argsConfig.numOfMetachainNodes,
+	}
+	// shardConsensusGroupSize defines a flag for the consensus group size of the shards
+	shardConsensusGroupSize = cli.UintFlag{
+		Name:        "shard-consensus-group-size",
+		Usage:       "The consensus group size of the shards",
+		Value:       3,
+		Destination: &argsConfig.shardConsensusGroupSize,
+	}
+	// metaConsensusGroupSize defines a flag for the consensus group size of the metachain
+	metaConsensusGroupSize = cli.UintFlag{
+		Name:        "meta-consensus-group-size",
+		Usage:       "The consensus group size of the metachain",
+		Value:       3,
+		Destination: &argsConfig.metaConsensusGroupSize,
+	}
+	// roundDuration defines a flag for the round duration
+	roundDuration = cli.Uint64Flag{
+		Name:        "round-duration",
+		Usage:       "The round duration in milliseconds",
+		Value:       4000,
+		Destination: &argsConfig.roundDuration,
+	}
+	// startTime defines a flag for the genesis time
+	startTime = cli.Int64Flag{
+		Name:        "start-time",
+		Usage:       "The genesis unix timestamp written in the nodes setup file",
+		Value:       0,
+		Destination: &argsConfig.startTime,
+	}
+	// numOfWallets defines a flag for the number of wallets that only hold an initial balance
+	numOfWallets = cli.UintFlag{
+		Name:        "num-wallets",
+		Usage:       "The number of generated wallets that only hold the initial balance",
+		Value:       10,
+		Destination: &argsConfig.numOfWallets,
+	}
+	// initialBalance defines a flag for the initial balance of each generated wallet
+	initialBalance = cli.StringFlag{
+		Name: "initial-balance",
+		Usage: "The initial balance, in denominated units, of each generated wallet, including the node owners and the " +
+			"delegators. The part of the genesis total supply left is given to a treasury wallet",
+		Value:       "1000000000000000000000",
+		Destination: &argsConfig.initialBalance,
+	}
+	// numOfDelegatedNodes defines a flag for the number of nodes backed by the genesis delegation contract
+	numOfDelegatedNodes = cli.UintFlag{
+		Name:        "num-delegated-nodes",
+		Usage:       "The number of validators backed by the genesis delegation contract instead of their own staking wallets",
+		Value:       0,
+		Destination: &argsConfig.numOfDelegatedNodes,
+	}
+	// numOfDelegators defines a flag for the number of delegators of the genesis delegation contract
+	numOfDelegators = cli.UintFlag{
+		Name:        "num-delegators",
+		Usage:       "The number of wallets sharing the value delegated to the genesis delegation contract",
+		Value:       0,
+		Destination: &argsConfig.numOfDelegators,
+	}
+	// delegationContractFile defines a flag for the delegation contract code
+	delegationContractFile = cli.StringFlag{
+		Name: "delegation-contract",
+		Usage: "The `filepath` of the genesis delegation contract code, written as provided in the genesis smart contracts " +
+			"file, so it should be relative to the node's working directory",
+		Value:       "./config/genesisContracts/delegation.wasm",
+		Destination: &argsConfig.delegationContractFile,
+	}
+	// dnsContractFile defines a flag for the DNS contract code
+	dnsContractFile = cli.StringFlag{
+		Name: "dns-contract",
+		Usage: "The `filepath` of the genesis DNS contract code, written as provided in the genesis smart contracts file, " +
+			"so it should be relative to the node's working directory. Leave empty to skip the DNS contract",
+		Value:       "./config/genesisContracts/dns.wasm",
+		Destination: &argsConfig.dnsContractFile,
+	}
+	// outputFolder defines a flag for the folder where the keys and the configuration files are generated
+	outputFolder = cli.StringFlag{
+		Name:        "output-folder",
+		Usage:       "The `directory` where the keys, the configuration files and the consistency report are generated",
+		Value:       "./genesisOutput",
+		Destination: &argsConfig.outputFolder,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("genesisbuilder")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Genesis builder Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary generates the validator and wallet keys, the genesis, nodes setup and genesis smart contracts " +
+		"files of a custom local network. The generated files are validated with the same parsers and checkers used by " +
+		"the node, so the network boots with them and the provided economics and system smart contracts configuration"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		configFile,
+		economicsConfigFile,
+		systemSCConfigFile,
+		numOfShards,
+		numOfNodesPerShard,
+		numOfMetachainNodes,
+		shardConsensusGroupSize,
+		metaConsensusGroupSize,
+		roundDuration,
+		startTime,
+		numOfWallets,
+		initialBalance,
+		numOfDelegatedNodes,
+		numOfDelegators,
+		delegationContractFile,
+		dnsContractFile,
+		outputFolder,
+	}
+	app.Action = func(_ *cli.Context) error {
+		return build()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func build() error {
+	mainConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+	economicsConfig, err := common.LoadEconomicsConfig(argsConfig.economicsConfigFile)
+	if err != nil {
+		return err
+	}
+	systemSCConfig, err := common.LoadSystemSmartContractsConfig(argsConfig.systemSCConfigFile)
+	if err != nil {
+		return err
+	}
+
+	addressPubkeyConverter, err := factory.NewPubkeyConverter(mainConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+	validatorPubkeyConverter, err := factory.NewPubkeyConverter(mainConfig.ValidatorPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	entireSupply, err := parseBigInt("genesis total supply", economicsConfig.GlobalSettings.GenesisTotalSupply)
+	if err != nil {
+		return err
+	}
+	nodePrice, err := parseBigInt("genesis node price", systemSCConfig.StakingSystemSCConfig.GenesisNodePrice)
+	if err != nil {
+		return err
+	}
+	balance, err := parseBigInt("initial balance", argsConfig.initialBalance)
+	if err != nil {
+		return err
+	}
+
+	genesisBuilder, err := builder.NewGenesisBuilder(builder.ArgsGenesisBuilder{
+		NumOfShards:              uint32(argsConfig.numOfShards),
+		NumOfNodesPerShard:       uint32(argsConfig.numOfNodesPerShard),
+		NumOfMetachainNodes:      uint32(argsConfig.numOfMetachainNodes),
+		ShardConsensusGroupSize:  uint32(argsConfig.shardConsensusGroupSize),
+		MetaConsensusGroupSize:   uint32(argsConfig.metaConsensusGroupSize),
+		GenesisMaxNumShards:      mainConfig.GeneralSettings.GenesisMaxNumberOfShards,
+		StartTime:                argsConfig.startTime,
+		RoundDuration:            argsConfig.roundDuration,
+		NumOfWallets:             uint32(argsConfig.numOfWallets),
+		InitialBalance:           balance,
+		NumOfDelegatedNodes:      uint32(argsConfig.numOfDelegatedNodes),
+		NumOfDelegators:          uint32(argsConfig.numOfDelegators),
+		DelegationContractFile:   argsConfig.delegationContractFile,
+		DNSContractFile:          argsConfig.dnsContractFile,
+		EntireSupply:             entireSupply,
+		NodePrice:                nodePrice,
+		AddressPubkeyConverter:   addressPubkeyConverter,
+		ValidatorPubkeyConverter: validatorPubkeyConverter,
+		WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		OutputFolder:             argsConfig.outputFolder,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("generating genesis", "output folder", argsConfig.outputFolder)
+
+	report, err := genesisBuilder.Build()
+	if err != nil {
+		return err
+	}
+
+	printReport(report)
+
+	return nil
+}
+
+func parseBigInt(identifier string, value string) (*big.Int, error) {
+	result, ok := big.NewInt(0).SetString(value, conversionBase)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s: %s", identifier, value)
+	}
+
+	return result, nil
+}
+
+func printReport(report *builder.Report) {
+	lines := []*display.LineData{
+		display.NewLineData(false, []string{"shards", fmt.Sprintf("%d", report.NumOfShards)}),
+		display.NewLineData(false, []string{"staked nodes", fmt.Sprintf("%d", report.NumOfStakedNodes)}),
+		display.NewLineData(false, []string{"delegated nodes", fmt.Sprintf("%d", report.NumOfDelegatedNodes)}),
+		display.NewLineData(false, []string{"delegation contract", report.DelegationContractAddress}),
+		display.NewLineData(false, []string{"genesis smart contracts", fmt.Sprintf("%d", report.NumOfSmartContracts)}),
+		display.NewLineData(false, []string{"genesis accounts", fmt.Sprintf("%d", report.NumOfAccounts)}),
+		display.NewLineData(false, []string{"node price", report.NodePrice.String()}),
+		display.NewLineData(false, []string{"total balance", report.TotalBalance.String()}),
+		display.NewLineData(false, []string{"total staked", report.TotalStaked.String()}),
+		display.NewLineData(false, []string{"total delegated", report.TotalDelegated.String()}),
+		display.NewLineData(false, []string{"treasury balance", report.TreasuryBalance.String()}),
+		display.NewLineData(true, []string{"total supply", report.TotalSupply.String()}),
+	}
+	printTable("Genesis", []string{"Item", "Value"}, lines)
+
+	lines = make([]*display.LineData, 0, len(report.Shards))
+	for _, shard := range report.Shards {
+		lines = append(lines, display.NewLineData(false, []string{
+			shardName(shard.ShardID),
+			fmt.Sprintf("%d", shard.NumOfEligibleNodes),
+			fmt.Sprintf("%d", shard.NumOfWaitingNodes),
+			fmt.Sprintf("%d", shard.ConsensusGroupSize),
+		}))
+	}
+	printTable("Nodes", []string{"Shard", "Eligible", "Waiting", "Consensus group size"}, lines)
+
+	log.Info("generated genesis is consistent", "files", report.Files)
+}
+
+func printTable(title string, header []string, lines []*display.LineData) {
+	table, err := display.CreateTableString(header, lines)
+	if err != nil {
+		log.Warn("cannot display table", "title", title, "error", err)
+		return
+	}
+
+	log.Info(title + "\n" + table)
+}
+
+func shardName(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardID)
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/readable"
+	"github.com/ElrondNetwork/elrond-go/update/storing"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	configFile           string
+	exportFolder         string
+	readableFolder       string
+	nodesSetupFile       string
+	nodesSetupOutputFile string
+	convertBack          bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// configFile defines a flag for the path to the main toml file holding the hardfork import storage configuration
+	configFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `filepath` for the main configuration file, used for the hardfork storage configuration, the hasher, " +
+			"the marshalizer and the address public key converter",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// exportFolder defines a flag for the folder holding the hardfork export
+	exportFolder = cli.StringFlag{
+		Name:        "export-folder",
+		Usage:       "The `directory` holding the hardfork export. When converting back, a new hardfork export is created in this directory",
+		Value:       "./export",
+		Destination: &argsConfig.exportFolder,
+	}
+	// readableFolder defines a flag for the folder holding the readable export
+	readableFolder = cli.StringFlag{
+		Name:        "readable-folder",
+		Usage:       "The `directory` holding the JSON-lines files of the readable export",
+		Value:       "./readableExport",
+		Destination: &argsConfig.readableFolder,
+	}
+	// nodesSetupFile defines a flag for the nodes setup file written by the hardfork export
+	nodesSetupFile = cli.StringFlag{
+		Name:        "nodes-setup-file",
+		Usage:       "The `filepath` for the nodes setup file written by the hardfork export, used to export the validators. Leave empty to skip the validators",
+		Value:       "./export/nodesSetup.json",
+		Destination: &argsConfig.nodesSetupFile,
+	}
+	// nodesSetupOutputFile defines a flag for the nodes setup file written when converting back
+	nodesSetupOutputFile = cli.StringFlag{
+		Name:        "nodes-setup-output-file",
+		Usage:       "The `filepath` for the nodes setup file rebuilt from the readable validators when converting back. Leave empty to skip the validators",
+		Value:       "./export/nodesSetup.json",
+		Destination: &argsConfig.nodesSetupOutputFile,
+	}
+	// convertBack defines a flag that switches the tool to converting a readable export back to a hardfork export
+	convertBack = cli.BoolFlag{
+		Name:        "convert-back",
+		Usage:       "If set, the readable export is converted back to a hardfork export that can be imported by a test network",
+		Destination: &argsConfig.convertBack,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("hardforkreadable")
+
+	errExportFolderExists = errors.New("the export folder already exists")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Hardfork readable export Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary converts a hardfork export to a documented set of JSON-lines files holding the accounts with " +
+		"their decoded balances, ESDT balances and data tries, the validators and the pending transactions. The files can " +
+		"be edited and converted back to a hardfork export, so the edited state can be imported in test networks"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		configFile,
+		exportFolder,
+		readableFolder,
+		nodesSetupFile,
+		nodesSetupOutputFile,
+		convertBack,
+	}
+	app.Action = func(_ *cli.Context) error {
+		if argsConfig.convertBack {
+			return convert()
+		}
+
+		return export()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func export() error {
+	mainConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalizerFactory.NewMarshalizer(mainConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	addressPubKeyConverter, err := factory.NewPubkeyConverter(mainConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	hs, err := createHardforkStorer(mainConfig.Hardfork, marshalizer)
+	if err != nil {
+		return err
+	}
+	defer closeHardforkStorer(hs)
+
+	exporter, err := readable.NewReadableExporter(readable.ArgsReadableExporter{
+		HardforkStorer:         hs,
+		Marshalizer:            marshalizer,
+		AddressPubKeyConverter: addressPubKeyConverter,
+		NodesSetupFile:         argsConfig.nodesSetupFile,
+		OutputFolder:           argsConfig.readableFolder,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("exporting to readable format", "export folder", argsConfig.exportFolder, "readable folder", argsConfig.readableFolder)
+
+	return exporter.Export()
+}
+
+func convert() error {
+	_, err := os.Stat(argsConfig.exportFolder)
+	if err == nil {
+		return fmt.Errorf("%w: %s", errExportFolderExists, argsConfig.exportFolder)
+	}
+
+	mainConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalizerFactory.NewMarshalizer(mainConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(mainConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	addressPubKeyConverter, err := factory.NewPubkeyConverter(mainConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	hs, err := createHardforkStorer(mainConfig.Hardfork, marshalizer)
+	if err != nil {
+		return err
+	}
+	defer closeHardforkStorer(hs)
+
+	converter, err := readable.NewReadableConverter(readable.ArgsReadableConverter{
+		HardforkStorer:         hs,
+		Marshalizer:            marshalizer,
+		Hasher:                 hasher,
+		AddressPubKeyConverter: addressPubKeyConverter,
+		InputFolder:            argsConfig.readableFolder,
+		NodesSetupOutputFile:   argsConfig.nodesSetupOutputFile,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("converting back to hardfork format", "readable folder", argsConfig.readableFolder, "export folder", argsConfig.exportFolder)
+
+	return converter.Convert()
+}
+
+func createHardforkStorer(hardforkConfig config.HardforkConfig, marshalizer marshal.Marshalizer) (update.HardforkStorer, error) {
+	keysStorer, err := createStorer(hardforkConfig.ImportKeysStorageConfig, argsConfig.exportFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys storer", err)
+	}
+	keysVals, err := createStorer(hardforkConfig.ImportStateStorageConfig, argsConfig.exportFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys-values storer", err)
+	}
+
+	return storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysVals,
+		Marshalizer: marshalizer,
+	})
+}
+
+func createStorer(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path.Join(filepath.Clean(folder), storageConfig.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(storageConfig.Bloom),
+	)
+}
+
+func closeHardforkStorer(hs update.HardforkStorer) {
+	if check.IfNil(hs) {
+		return
+	}
+
+	err := hs.Close()
+	if err != nil {
+		log.Warn("cannot close the hardfork storer", "error", err)
+	}
+}
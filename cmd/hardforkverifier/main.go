@@ -0,0 +1,364 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/display"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/storing"
+	"github.com/ElrondNetwork/elrond-go/update/verifier"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	configFile           string
+	exportFolder         string
+	validatorKeyPemFile  string
+	validatorKeyIndex    int
+	outputFile           string
+	otherValidatorsFiles cli.StringSlice
+}
+
+// reportSignerHandler defines the report signer operations used by the tool
+type reportSignerHandler interface {
+	Sign(report *verifier.ExportReport) (*verifier.SignedExportReport, error)
+	Verify(signedReport *verifier.SignedExportReport) error
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// configFile defines a flag for the path to the main toml file holding the hardfork import storage configuration
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the main configuration file, used for the hardfork storage configuration, the hasher and the marshalizer",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// exportFolder defines a flag for the folder holding the hardfork export
+	exportFolder = cli.StringFlag{
+		Name:        "export-folder",
+		Usage:       "The `directory` holding the hardfork export to be verified",
+		Value:       "./export",
+		Destination: &argsConfig.exportFolder,
+	}
+	// validatorKeyPemFile defines a flag for the path to the validator key used to sign the report
+	validatorKeyPemFile = cli.StringFlag{
+		Name:        "validator-key-pem-file",
+		Usage:       "The `filepath` for the PEM file which contains the validator secret key used to sign the report",
+		Value:       "./config/validatorKey.pem",
+		Destination: &argsConfig.validatorKeyPemFile,
+	}
+	// validatorKeyIndex defines a flag that specifies the 0-th based index of the private key to be used from the
+	// validator key PEM file
+	validatorKeyIndex = cli.IntFlag{
+		Name:        "sk-index",
+		Usage:       "The index in the PEM file of the validator secret key",
+		Value:       0,
+		Destination: &argsConfig.validatorKeyIndex,
+	}
+	// outputFile defines a flag for the json file the signed report is written to
+	outputFile = cli.StringFlag{
+		Name:        "output-file",
+		Usage:       "The `filepath` for the json file the signed verification report is written to",
+		Value:       "./hardforkExportReport.json",
+		Destination: &argsConfig.outputFile,
+	}
+	// otherValidatorsReports defines a flag for the signed reports produced by other validators
+	otherValidatorsReports = cli.StringSliceFlag{
+		Name:  "compare-with",
+		Usage: "The `filepath` for a signed report produced by another validator, to be checked and compared with the local report. Can be provided multiple times",
+		Value: &argsConfig.otherValidatorsFiles,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("hardforkverifier")
+
+	errInvalidExport     = errors.New("hardfork export verification failed")
+	errReportsMismatched = errors.New("other validators reports do not match the local report")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Hardfork export verifier Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary re-imports a hardfork export in memory, checks the recomputed root hashes against the " +
+		"exported ones and the epoch start metaBlock, checks that all pending miniBlocks and transactions were exported " +
+		"and produces a signed report that can be compared between validators"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		configFile,
+		exportFolder,
+		validatorKeyPemFile,
+		validatorKeyIndex,
+		outputFile,
+		otherValidatorsReports,
+	}
+	app.Action = func(_ *cli.Context) error {
+		return verify()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func verify() error {
+	mainConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalizerFactory.NewMarshalizer(mainConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(mainConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	reportSigner, err := createReportSigner(hasher)
+	if err != nil {
+		return err
+	}
+
+	hs, err := createHardforkStorer(mainConfig.Hardfork, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	exportVerifier, err := verifier.NewExportVerifier(verifier.ArgsExportVerifier{
+		HardforkStorer: hs,
+		Marshalizer:    marshalizer,
+		Hasher:         hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := exportVerifier.Verify()
+	if err != nil {
+		return err
+	}
+	displayReport(report)
+
+	signedReport, err := reportSigner.Sign(report)
+	if err != nil {
+		return err
+	}
+
+	err = saveSignedReport(signedReport)
+	if err != nil {
+		return err
+	}
+
+	if !report.IsValid() {
+		return errInvalidExport
+	}
+
+	return compareWithOtherValidators(reportSigner, signedReport)
+}
+
+func createReportSigner(hasher hashing.Hasher) (reportSignerHandler, error) {
+	encodedSk, pkString, err := core.LoadSkPkFromPemFile(argsConfig.validatorKeyPemFile, argsConfig.validatorKeyIndex)
+	if err != nil {
+		return nil, err
+	}
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, fmt.Errorf("%w for encoded secret key", err)
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, err := keyGen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("signing the report", "public key", pkString)
+
+	return verifier.NewReportSigner(verifier.ArgsReportSigner{
+		Hasher:       hasher,
+		SingleSigner: &mclSig.BlsSingleSigner{},
+		KeyGenerator: keyGen,
+		PrivateKey:   privateKey,
+	})
+}
+
+func createHardforkStorer(hardforkConfig config.HardforkConfig, marshalizer marshal.Marshalizer) (update.HardforkStorer, error) {
+	keysStorer, err := createStorer(hardforkConfig.ImportKeysStorageConfig, argsConfig.exportFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys storer", err)
+	}
+	keysVals, err := createStorer(hardforkConfig.ImportStateStorageConfig, argsConfig.exportFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys-values storer", err)
+	}
+
+	return storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysVals,
+		Marshalizer: marshalizer,
+	})
+}
+
+func createStorer(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(storageConfig.Bloom),
+	)
+}
+
+func compareWithOtherValidators(reportSigner reportSignerHandler, localReport *verifier.SignedExportReport) error {
+	if len(argsConfig.otherValidatorsFiles) == 0 {
+		return nil
+	}
+
+	header := []string{"file", "public key", "report hash", "signature", "matches local report"}
+	lines := make([]*display.LineData, 0, len(argsConfig.otherValidatorsFiles))
+	numMismatches := 0
+	for _, file := range argsConfig.otherValidatorsFiles {
+		otherReport := &verifier.SignedExportReport{}
+		err := core.LoadJsonFile(otherReport, file)
+		if err != nil {
+			return err
+		}
+
+		signatureStatus := "valid"
+		err = reportSigner.Verify(otherReport)
+		if err != nil {
+			signatureStatus = err.Error()
+			numMismatches++
+		}
+
+		matches := err == nil && otherReport.ReportHash == localReport.ReportHash
+		if err == nil && !matches {
+			numMismatches++
+		}
+
+		lines = append(lines, display.NewLineData(false, []string{
+			file,
+			otherReport.PublicKey,
+			otherReport.ReportHash,
+			signatureStatus,
+			fmt.Sprintf("%v", matches),
+		}))
+	}
+	printTable("Other validators reports", header, lines)
+
+	if numMismatches > 0 {
+		return fmt.Errorf("%w: %d out of %d", errReportsMismatched, numMismatches, len(argsConfig.otherValidatorsFiles))
+	}
+
+	return nil
+}
+
+func displayReport(report *verifier.ExportReport) {
+	header := []string{"shard", "exported root hash", "recomputed root hash", "epoch start root hash"}
+	lines := make([]*display.LineData, 0, len(report.Shards))
+	for _, shard := range report.Shards {
+		lines = append(lines, display.NewLineData(false, []string{
+			shardName(shard.ShardID),
+			shard.ExportedRootHash,
+			shard.RecomputedRootHash,
+			shard.EpochStartRootHash,
+		}))
+	}
+	printTable("Accounts tries", header, lines)
+
+	for _, issue := range report.Issues {
+		log.Warn("export issue", "description", issue)
+	}
+
+	log.Info("hardfork export verified",
+		"chain ID", report.ChainID,
+		"epoch", report.Epoch,
+		"round", report.Round,
+		"nonce", report.Nonce,
+		"epoch start metaBlock hash", report.EpochStartMetaBlockHash,
+		"num data tries", report.NumDataTries,
+		"num unFinished metaBlocks", report.NumUnFinishedMetaBlocks,
+		"num pending miniBlocks", report.NumPendingMiniBlocks,
+		"num miniBlocks", report.NumMiniBlocks,
+		"num transactions", report.NumTransactions,
+		"num issues", len(report.Issues),
+	)
+}
+
+func shardName(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardID)
+}
+
+func printTable(title string, header []string, lines []*display.LineData) {
+	table, err := display.CreateTableString(header, lines)
+	if err != nil {
+		log.Warn("cannot display table", "title", title, "error", err)
+		return
+	}
+
+	log.Info(title + "\n" + table)
+}
+
+func saveSignedReport(signedReport *verifier.SignedExportReport) error {
+	buff, err := json.MarshalIndent(signedReport, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.Info("saving signed report", "file", argsConfig.outputFile, "report hash", signedReport.ReportHash)
+
+	return ioutil.WriteFile(filepath.Clean(argsConfig.outputFile), buff, core.FileModeUserReadWrite)
+}
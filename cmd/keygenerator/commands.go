@@ -0,0 +1,433 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/mnemonic"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/urfave/cli"
+)
+
+const defaultNumShards = 3
+
+const (
+	walletKeystoreFilename    = "walletKey.json"
+	validatorKeystoreFilename = "validatorKey.json"
+)
+
+// elrondSignedMessagePrefix is prepended to the messages signed with a wallet key, so that the signature can not be
+// replayed as a transaction signature
+const elrondSignedMessagePrefix = "\x17Elrond Signed Message:\n"
+
+// keyTypeHandler groups the components used to handle the keys of a certain type
+type keyTypeHandler struct {
+	keyGenerator    crypto.KeyGenerator
+	singleSigner    crypto.SingleSigner
+	pubKeyConverter core.PubkeyConverter
+	isWallet        bool
+}
+
+var (
+	commandKeyType = cli.StringFlag{
+		Name:  "key-type",
+		Usage: fmt.Sprintf("The type of the key. Available options: %s, %s", validatorType, walletType),
+		Value: validatorType,
+	}
+	commandKeyFile = cli.StringFlag{
+		Name:  "key-file",
+		Usage: "The `filepath` of the key, either a PEM file or a password encrypted JSON key file",
+	}
+	commandKeyIndex = cli.IntFlag{
+		Name:  "index",
+		Usage: "The 0-based index of the key in the PEM file",
+	}
+	commandPasswordFile = cli.StringFlag{
+		Name:  "password-file",
+		Usage: "The `filepath` of the file holding the password of the JSON key file",
+	}
+	commandOutputFile = cli.StringFlag{
+		Name:  "output-file",
+		Usage: "The `filepath` of the generated file. The format is given by the extension: .json for a password encrypted key file, PEM otherwise",
+	}
+	commandMessage = cli.StringFlag{
+		Name:  "message",
+		Usage: "The message to be signed or verified",
+	}
+
+	commands = []cli.Command{
+		{
+			Name:  "new-keystore",
+			Usage: "generates a new key and saves it in a password encrypted JSON key file",
+			Flags: []cli.Flag{
+				commandKeyType,
+				commandPasswordFile,
+				cli.StringFlag{
+					Name:  commandOutputFile.Name,
+					Usage: "The `filepath` of the generated JSON key file. Defaults to ./validatorKey.json or ./walletKey.json, depending on the key type",
+				},
+			},
+			Action: newKeystore,
+		},
+		{
+			Name:  "derive",
+			Usage: "derives a wallet key from a BIP39 mnemonic using the m/44'/508'/account'/0'/index' path",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "mnemonic-file",
+					Usage: "The `filepath` of the file holding the mnemonic words",
+				},
+				cli.StringFlag{
+					Name:  "passphrase-file",
+					Usage: "The `filepath` of the file holding the optional BIP39 passphrase",
+				},
+				cli.UintFlag{
+					Name:  "account",
+					Usage: "The account index of the derivation path",
+				},
+				cli.UintFlag{
+					Name:  "address-index",
+					Usage: "The address index of the derivation path",
+				},
+				commandPasswordFile,
+				cli.StringFlag{
+					Name:  commandOutputFile.Name,
+					Usage: commandOutputFile.Usage,
+					Value: "./walletKey.pem",
+				},
+			},
+			Action: derive,
+		},
+		{
+			Name:  "show",
+			Usage: "shows the public key, the bech32 address and the shard of a key",
+			Flags: []cli.Flag{
+				commandKeyType,
+				commandKeyFile,
+				commandKeyIndex,
+				commandPasswordFile,
+				cli.UintFlag{
+					Name:  "num-shards",
+					Usage: "The number of shards used to compute the shard of a wallet address",
+					Value: defaultNumShards,
+				},
+			},
+			Action: show,
+		},
+		{
+			Name:  "convert",
+			Usage: "converts a PEM key to a password encrypted JSON key file or the other way around",
+			Flags: []cli.Flag{
+				commandKeyType,
+				commandKeyFile,
+				commandKeyIndex,
+				commandPasswordFile,
+				commandOutputFile,
+			},
+			Action: convert,
+		},
+		{
+			Name:  "sign",
+			Usage: "signs a message and prints the hex encoded signature, wallet keys signing it as an Elrond signed message",
+			Flags: []cli.Flag{
+				commandKeyType,
+				commandKeyFile,
+				commandKeyIndex,
+				commandPasswordFile,
+				commandMessage,
+			},
+			Action: sign,
+		},
+		{
+			Name:  "verify",
+			Usage: "verifies the signature of a message",
+			Flags: []cli.Flag{
+				commandKeyType,
+				commandMessage,
+				cli.StringFlag{
+					Name:  "public-key",
+					Usage: "The public key, bech32 encoded for wallet keys and hex encoded for validator keys",
+				},
+				cli.StringFlag{
+					Name:  "signature",
+					Usage: "The hex encoded signature",
+				},
+			},
+			Action: verify,
+		},
+	}
+)
+
+func getKeyTypeHandler(keyType string) (*keyTypeHandler, error) {
+	switch keyType {
+	case validatorType:
+		return &keyTypeHandler{
+			keyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+			singleSigner:    &mclSig.BlsSingleSigner{},
+			pubKeyConverter: validatorPubKeyConverter,
+		}, nil
+	case walletType:
+		return &keyTypeHandler{
+			keyGenerator:    signing.NewKeyGenerator(ed25519.NewEd25519()),
+			singleSigner:    &singlesig.Ed25519Signer{},
+			pubKeyConverter: walletPubKeyConverter,
+			isWallet:        true,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown key type %s", keyType)
+	}
+}
+
+func newKeystore(ctx *cli.Context) error {
+	handler, err := getKeyTypeHandler(ctx.String(commandKeyType.Name))
+	if err != nil {
+		return err
+	}
+
+	filename := ctx.String(commandOutputFile.Name)
+	if len(filename) == 0 {
+		filename = validatorKeystoreFilename
+		if handler.isWallet {
+			filename = walletKeystoreFilename
+		}
+	}
+	if !keystore.IsKeyFile(filename) {
+		return fmt.Errorf("the output file %s should have the .json extension", filename)
+	}
+
+	sk, _ := handler.keyGenerator.GeneratePair()
+
+	return saveKey(sk, handler, filename, ctx.String(commandPasswordFile.Name))
+}
+
+func derive(ctx *cli.Context) error {
+	mnemonicBytes, err := ioutil.ReadFile(ctx.String("mnemonic-file"))
+	if err != nil {
+		return err
+	}
+
+	passphrase := ""
+	passphraseFile := ctx.String("passphrase-file")
+	if len(passphraseFile) > 0 {
+		passphrase, err = keystore.LoadPassword(passphraseFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	account := uint32(ctx.Uint("account"))
+	addressIndex := uint32(ctx.Uint("address-index"))
+	skBytes, err := mnemonic.DeriveWalletSecretKey(string(mnemonicBytes), passphrase, account, addressIndex)
+	if err != nil {
+		return err
+	}
+
+	handler, err := getKeyTypeHandler(walletType)
+	if err != nil {
+		return err
+	}
+	sk, err := handler.keyGenerator.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return err
+	}
+
+	log.Info("derived wallet key", "path", mnemonic.DerivationPath(account, addressIndex))
+
+	return saveKey(sk, handler, ctx.String(commandOutputFile.Name), ctx.String(commandPasswordFile.Name))
+}
+
+func show(ctx *cli.Context) error {
+	handler, sk, err := loadKeyFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	if !handler.isWallet {
+		log.Info("validator key",
+			"public key", handler.pubKeyConverter.Encode(pkBytes),
+			"shard", "assigned by the nodes coordinator",
+		)
+		return nil
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(uint32(ctx.Uint("num-shards")), 0)
+	if err != nil {
+		return err
+	}
+
+	log.Info("wallet key",
+		"public key", hex.EncodeToString(pkBytes),
+		"bech32 address", handler.pubKeyConverter.Encode(pkBytes),
+		"shard", shardCoordinator.ComputeId(pkBytes),
+	)
+
+	return nil
+}
+
+func convert(ctx *cli.Context) error {
+	handler, sk, err := loadKeyFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return saveKey(sk, handler, ctx.String(commandOutputFile.Name), ctx.String(commandPasswordFile.Name))
+}
+
+func sign(ctx *cli.Context) error {
+	handler, sk, err := loadKeyFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	signature, err := handler.singleSigner.Sign(sk, handler.signableMessage(ctx.String(commandMessage.Name)))
+	if err != nil {
+		return err
+	}
+
+	log.Info("signed message", "signature", hex.EncodeToString(signature))
+
+	return nil
+}
+
+func verify(ctx *cli.Context) error {
+	handler, err := getKeyTypeHandler(ctx.String(commandKeyType.Name))
+	if err != nil {
+		return err
+	}
+
+	pkBytes, err := handler.pubKeyConverter.Decode(ctx.String("public-key"))
+	if err != nil {
+		return err
+	}
+	pk, err := handler.keyGenerator.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return err
+	}
+	signature, err := hex.DecodeString(ctx.String("signature"))
+	if err != nil {
+		return err
+	}
+
+	err = handler.singleSigner.Verify(pk, handler.signableMessage(ctx.String(commandMessage.Name)), signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	log.Info("valid signature")
+
+	return nil
+}
+
+// signableMessage returns the bytes signed for the provided message. Wallet keys sign the keccak hash of the prefixed
+// message, as the Elrond wallets do
+func (handler *keyTypeHandler) signableMessage(message string) []byte {
+	if !handler.isWallet {
+		return []byte(message)
+	}
+
+	payload := fmt.Sprintf("%s%d%s", elrondSignedMessagePrefix, len(message), message)
+
+	return keccak.NewKeccak().Compute(payload)
+}
+
+func loadKeyFromContext(ctx *cli.Context) (*keyTypeHandler, crypto.PrivateKey, error) {
+	handler, err := getKeyTypeHandler(ctx.String(commandKeyType.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sk, err := keystore.LoadPrivateKey(
+		handler.keyGenerator,
+		handler.pubKeyConverter,
+		ctx.String(commandKeyFile.Name),
+		ctx.Int(commandKeyIndex.Name),
+		ctx.String(commandPasswordFile.Name),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return handler, sk, nil
+}
+
+// saveKey writes the secret key as a password encrypted JSON key file if the output file has the .json extension,
+// otherwise as a PEM file
+func saveKey(sk crypto.PrivateKey, handler *keyTypeHandler, filename string, passwordFile string) error {
+	skBytes, err := sk.ToByteArray()
+	if err != nil {
+		return err
+	}
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	if !keystore.IsKeyFile(filename) {
+		buff := bytes.NewBuffer(make([]byte, 0))
+		err = writeKeyToStream(buff, key{skBytes: skBytes, pkBytes: pkBytes}, handler.pubKeyConverter)
+		if err != nil {
+			return err
+		}
+
+		log.Info("saving PEM file", "file", filename, "public key", handler.pubKeyConverter.Encode(pkBytes))
+		return writeNewFile(filename, buff.Bytes())
+	}
+
+	if len(passwordFile) == 0 {
+		return keystore.ErrMissingPasswordFile
+	}
+	password, err := keystore.LoadPassword(passwordFile)
+	if err != nil {
+		return err
+	}
+
+	address := hex.EncodeToString(pkBytes)
+	bech32 := ""
+	if handler.isWallet {
+		bech32 = handler.pubKeyConverter.Encode(pkBytes)
+	}
+	keyFile, err := keystore.Encrypt(skBytes, address, bech32, password)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stat(filename)
+	if err == nil {
+		return fmt.Errorf("file %s already exists", filename)
+	}
+
+	log.Info("saving JSON key file", "file", filename, "public key", handler.pubKeyConverter.Encode(pkBytes))
+	return keystore.SaveKeyFile(filename, keyFile)
+}
+
+func writeNewFile(filename string, content []byte) error {
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_EXCL|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(content)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
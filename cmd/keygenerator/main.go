@@ -46,11 +46,14 @@ var (
 	fileGenHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
 USAGE:
-   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .Commands}} [command [command options]]{{end}}
    {{if len .Authors}}
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -106,7 +109,9 @@ func main() {
 	cli.AppHelpTemplate = fileGenHelpTemplate
 	app.Name = "Key generation Tool"
 	app.Version = "v1.0.0"
-	app.Usage = "This binary will generate a validatorKey.pem and walletKey.pem, each containing private key(s)"
+	app.Usage = "This binary will generate a validatorKey.pem and walletKey.pem, each containing private key(s). " +
+		"The commands handle password encrypted JSON key files, derive wallet keys from a mnemonic, inspect, " +
+		"convert and use the keys to sign and verify messages"
 	app.Authors = []cli.Author{
 		{
 			Name:  "The Elrond Team",
@@ -119,6 +124,7 @@ func main() {
 		consoleOut,
 		noSplit,
 	}
+	app.Commands = commands
 
 	app.Action = func(_ *cli.Context) error {
 		return process()
@@ -126,7 +132,7 @@ func main() {
 
 	err := app.Run(os.Args)
 	if err != nil {
-		log.Error("error generating files", "error", err)
+		log.Error("error running the key tool", "error", err)
 
 		os.Exit(1)
 	}
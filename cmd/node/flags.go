@@ -195,6 +195,31 @@ var (
 		Value: "./config/validatorKey.pem",
 	}
 
+	// validatorKeystoreFile defines a flag for the path to the password encrypted validator key used in block signing
+	validatorKeystoreFile = cli.StringFlag{
+		Name: "validator-keystore-file",
+		Usage: "The `filepath` for the JSON key file which contains the password encrypted validator secret key. " +
+			"Used instead of the validator key PEM file when a password file is provided.",
+		Value: "./config/validatorKey.json",
+	}
+
+	// validatorKeyPasswordFile defines a flag for the path to the file holding the password of the validator key file
+	validatorKeyPasswordFile = cli.StringFlag{
+		Name: "validator-key-password-file",
+		Usage: "The `filepath` for the file which contains the password of the validator key file. If provided, " +
+			"the validator key is loaded from the validator key file instead of the validator key PEM file.",
+		Value: "",
+	}
+
+	// allValidatorKeysPemFile defines a flag for the path to the file that hold all validator keys used in block signing
+	// managed by the current node
+	allValidatorKeysPemFile = cli.StringFlag{
+		Name: "all-validator-keys-pem-file",
+		Usage: "The `filepath` for the PEM file which contains all the secret keys managed by the current node, " +
+			"besides the validator key.",
+		Value: "./config/allValidatorsKeys.pem",
+	}
+
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -328,6 +353,9 @@ func getFlags() []cli.Flag {
 		gasScheduleConfigurationDirectory,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		validatorKeystoreFile,
+		validatorKeyPasswordFile,
+		allValidatorKeysPemFile,
 		port,
 		profileMode,
 		useHealthService,
@@ -388,6 +416,9 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	cfgs.ConfigurationPathsHolder.GasScheduleDirectoryName = ctx.GlobalString(gasScheduleConfigurationDirectory.Name)
 	cfgs.ConfigurationPathsHolder.SmartContracts = ctx.GlobalString(smartContractsFile.Name)
 	cfgs.ConfigurationPathsHolder.ValidatorKey = ctx.GlobalString(validatorKeyPemFile.Name)
+	cfgs.ConfigurationPathsHolder.ValidatorKeystore = ctx.GlobalString(validatorKeystoreFile.Name)
+	cfgs.ConfigurationPathsHolder.ValidatorKeyPassword = ctx.GlobalString(validatorKeyPasswordFile.Name)
+	cfgs.ConfigurationPathsHolder.AllValidatorKeys = ctx.GlobalString(allValidatorKeysPemFile.Name)
 
 	if ctx.IsSet(startInEpoch.Name) {
 		log.Debug("start in epoch is enabled")
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/remote"
+	"github.com/urfave/cli"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+type cfg struct {
+	listenAddress          string
+	keysPemFiles           cli.StringSlice
+	certificateFile        string
+	keyFile                string
+	clientCACertificate    string
+	slashingProtectionFile string
+	logLevel               string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// listenAddress defines a flag for the address the remote signer listens on
+	listenAddress = cli.StringFlag{
+		Name:        "listen-address",
+		Usage:       "The `address` the remote signer listens on for the signing requests",
+		Value:       "127.0.0.1:9443",
+		Destination: &argsConfig.listenAddress,
+	}
+	// keysPemFiles defines a flag for the PEM files holding the validator keys
+	keysPemFiles = cli.StringSliceFlag{
+		Name:  "keys-pem-file",
+		Usage: "The `filepath` for a PEM file holding validator secret keys. Can be provided multiple times",
+		Value: &argsConfig.keysPemFiles,
+	}
+	// certificateFile defines a flag for the TLS certificate presented by the remote signer
+	certificateFile = cli.StringFlag{
+		Name:        "tls-certificate",
+		Usage:       "The `filepath` for the TLS certificate presented by the remote signer",
+		Value:       "./remoteSigner.crt",
+		Destination: &argsConfig.certificateFile,
+	}
+	// keyFile defines a flag for the key of the TLS certificate presented by the remote signer
+	keyFile = cli.StringFlag{
+		Name:        "tls-key",
+		Usage:       "The `filepath` for the key of the TLS certificate presented by the remote signer",
+		Value:       "./remoteSigner.key",
+		Destination: &argsConfig.keyFile,
+	}
+	// clientCACertificate defines a flag for the CA certificate the nodes certificates should be issued by
+	clientCACertificate = cli.StringFlag{
+		Name:        "client-ca-certificate",
+		Usage:       "The `filepath` for the CA certificate the nodes certificates should be issued by",
+		Value:       "./ca.crt",
+		Destination: &argsConfig.clientCACertificate,
+	}
+	// slashingProtectionFile defines a flag for the file holding the last signed round of each key
+	slashingProtectionFile = cli.StringFlag{
+		Name:        "slashing-protection-file",
+		Usage:       "The `filepath` for the json file holding the last signed round of each key, used to refuse the requests that could lead to slashing",
+		Value:       "./slashingProtection.json",
+		Destination: &argsConfig.slashingProtectionFile,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example, if set to *:INFO the logs for all packages will have the INFO level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("remotesigner")
+
+	errNoKeysPemFile = errors.New("no keys PEM file provided")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Remote Signer"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary holds validator keys and signs the requests of the nodes using them over mutually " +
+		"authenticated TLS connections, refusing to sign two different blocks in the same round with the same key"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		listenAddress,
+		keysPemFiles,
+		certificateFile,
+		keyFile,
+		clientCACertificate,
+		slashingProtectionFile,
+		logLevel,
+	}
+	app.Action = func(_ *cli.Context) error {
+		return startRemoteSigner()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startRemoteSigner() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	privateKeys, err := loadPrivateKeys()
+	if err != nil {
+		return err
+	}
+
+	slashingProtector, err := remote.NewSlashingProtector(argsConfig.slashingProtectionFile)
+	if err != nil {
+		return err
+	}
+
+	handler, err := remote.NewSigningHandler(remote.ArgsSigningHandler{
+		PrivateKeys:       privateKeys,
+		SingleSigner:      &mclSig.BlsSingleSigner{},
+		SlashingProtector: slashingProtector,
+	})
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := remote.NewServerTLSConfig(remote.ArgsTLSConfig{
+		CertificateFile:   argsConfig.certificateFile,
+		KeyFile:           argsConfig.keyFile,
+		CACertificateFile: argsConfig.clientCACertificate,
+	})
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:      argsConfig.listenAddress,
+		Handler:   handler,
+		TLSConfig: tlsConfig,
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		log.Info("remote signer started", "address", argsConfig.listenAddress, "num keys", len(privateKeys))
+		serverErr <- server.ListenAndServeTLS("", "")
+	}()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err = <-serverErr:
+		return err
+	case <-sigs:
+		log.Info("terminating remote signer...")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return server.Shutdown(ctx)
+}
+
+func loadPrivateKeys() (map[string]crypto.PrivateKey, error) {
+	if len(argsConfig.keysPemFiles) == 0 {
+		return nil, errNoKeysPemFile
+	}
+
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKeys := make(map[string]crypto.PrivateKey)
+	for _, pemFile := range argsConfig.keysPemFiles {
+		privateKeysBytes, _, err := keysManagement.LoadAllKeysFromPemFile(pemFile)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, skBytes := range privateKeysBytes {
+			privateKey, errKey := keyGenerator.PrivateKeyFromByteArray(skBytes)
+			if errKey != nil {
+				return nil, fmt.Errorf("%w in file %s", errKey, pemFile)
+			}
+
+			pkBytes, errKey := privateKey.GeneratePublic().ToByteArray()
+			if errKey != nil {
+				return nil, errKey
+			}
+
+			privateKeys[string(pkBytes)] = privateKey
+		}
+	}
+
+	return privateKeys, nil
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/display"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+	"github.com/ElrondNetwork/elrond-go/epochStart/rewardsSimulator"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+const (
+	addressPubkeyLen  = 32
+	reportCacheSize   = 10
+	batchDelaySeconds = 2
+	maxBatchSize      = 100
+	maxOpenFiles      = 10
+)
+
+type cfg struct {
+	economicsConfigFile string
+	nodesSetupFile      string
+	reportFile          string
+	dbPath              string
+	epoch               uint
+	outputFile          string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// economicsConfigFile defines a flag for the path to the economics toml file holding the alternative parameters
+	economicsConfigFile = cli.StringFlag{
+		Name:        "economics-config",
+		Usage:       "The `filepath` for the toml file holding the alternative economics parameters",
+		Value:       "./config/economics.toml",
+		Destination: &argsConfig.economicsConfigFile,
+	}
+	// nodesSetupFile defines a flag for the path to the nodes setup file holding the round duration and consensus sizes
+	nodesSetupFile = cli.StringFlag{
+		Name:        "nodes-setup-file",
+		Usage:       "The `filepath` for the nodes setup json file, used for the round duration and the consensus group sizes",
+		Value:       "./config/nodesSetup.json",
+		Destination: &argsConfig.nodesSetupFile,
+	}
+	// reportFile defines a flag for the path to a json file holding the economics report of the simulated epoch
+	reportFile = cli.StringFlag{
+		Name:        "report-file",
+		Usage:       "The `filepath` for a json file holding the economics report of the simulated epoch, as saved by a metachain node",
+		Destination: &argsConfig.reportFile,
+	}
+	// dbPath defines a flag for the path to the epoch economics database of a metachain node
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The `directory` of the EpochEconomics database of a metachain node. Used when no report file is provided",
+		Destination: &argsConfig.dbPath,
+	}
+	// epoch defines a flag for the epoch to be simulated when the report is read from the database
+	epoch = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "The epoch to be simulated when the economics report is read from the database",
+		Destination: &argsConfig.epoch,
+	}
+	// outputFile defines a flag for the json file the full simulation result is written to
+	outputFile = cli.StringFlag{
+		Name:        "output-file",
+		Usage:       "The `filepath` for the json file the full simulation result is written to. Optional",
+		Destination: &argsConfig.outputFile,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("rewardsimulator")
+
+	addressPubkeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(addressPubkeyLen, log)
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Rewards simulator Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary replays the end of epoch economics and rewards of a historical epoch with alternative " +
+		"economics parameters and displays the per-shard and per-node rewards deltas"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		economicsConfigFile,
+		nodesSetupFile,
+		reportFile,
+		dbPath,
+		epoch,
+		outputFile,
+	}
+	app.Action = func(_ *cli.Context) error {
+		return simulate()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func simulate() error {
+	economicsConfig, err := common.LoadEconomicsConfig(argsConfig.economicsConfigFile)
+	if err != nil {
+		return err
+	}
+
+	nodesSetup := &sharding.NodesSetup{}
+	err = core.LoadJsonFile(nodesSetup, argsConfig.nodesSetupFile)
+	if err != nil {
+		return err
+	}
+
+	report, err := loadReport()
+	if err != nil {
+		return err
+	}
+
+	simulator, err := rewardsSimulator.NewRewardsSimulator(rewardsSimulator.ArgsRewardsSimulator{
+		Economics:               economicsConfig,
+		AddressPubkeyConverter:  addressPubkeyConverter,
+		RoundDuration:           time.Duration(nodesSetup.RoundDuration) * time.Millisecond,
+		ShardConsensusGroupSize: nodesSetup.ConsensusGroupSize,
+		MetaConsensusGroupSize:  nodesSetup.MetaChainConsensusGroupSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := simulator.Simulate(report)
+	if err != nil {
+		return err
+	}
+
+	displayResult(result)
+
+	return saveResult(result)
+}
+
+func loadReport() (*economicsReport.EpochReport, error) {
+	if len(argsConfig.reportFile) > 0 {
+		report := &economicsReport.EpochReport{}
+		err := core.LoadJsonFile(report, argsConfig.reportFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return report, nil
+	}
+
+	if len(argsConfig.dbPath) == 0 {
+		return nil, errors.New("either a report file or a database path should be provided")
+	}
+
+	db, err := leveldb.NewDB(argsConfig.dbPath, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := lrucache.NewCache(reportCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	storer, err := storageUnit.NewStorageUnit(cache, db)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := storer.Close()
+		log.LogIfError(errClose)
+	}()
+
+	reportStorer, err := economicsReport.NewReportStorer(storer)
+	if err != nil {
+		return nil, err
+	}
+
+	return reportStorer.GetEpochReport(uint32(argsConfig.epoch))
+}
+
+func displayResult(result *rewardsSimulator.SimulationResult) {
+	totals := result.Totals
+	header := []string{"identifier", "actual", "simulated", "delta"}
+	lines := []*display.LineData{
+		display.NewLineData(false, []string{"inflation rate",
+			fmt.Sprintf("%.6f", totals.ActualInflationRate), fmt.Sprintf("%.6f", totals.SimulatedInflationRate), ""}),
+		newDeltaLine("newly minted", totals.NewlyMinted),
+		newDeltaLine("total to distribute", totals.TotalToDistribute),
+		newDeltaLine("rewards per block", totals.RewardsPerBlock),
+		newDeltaLine("base rewards", totals.BaseRewards),
+		newDeltaLine("top-up rewards", totals.TopUpRewards),
+		newDeltaLine("leader fees", totals.LeaderFees),
+		newDeltaLine("protocol sustainability", totals.ProtocolSustainabilityRewards),
+	}
+	printTable(fmt.Sprintf("Economics of epoch %d", result.Epoch), header, lines)
+
+	header = []string{"shard", "actual total", "simulated total", "delta", "base delta", "top-up delta"}
+	lines = make([]*display.LineData, 0, len(result.Shards))
+	for _, shard := range result.Shards {
+		lines = append(lines, display.NewLineData(false, []string{
+			shardName(shard.ShardID),
+			shard.Total.Actual.String(),
+			shard.Total.Simulated.String(),
+			shard.Total.Delta.String(),
+			shard.BaseRewards.Delta.String(),
+			shard.TopUpRewards.Delta.String(),
+		}))
+	}
+	printTable("Rewards per shard", header, lines)
+
+	header = []string{"node", "shard", "reward address", "actual total", "simulated total", "delta"}
+	lines = make([]*display.LineData, 0, len(result.Nodes))
+	for _, node := range result.Nodes {
+		lines = append(lines, display.NewLineData(false, []string{
+			hex.EncodeToString(node.PublicKey),
+			shardName(node.ShardID),
+			addressPubkeyConverter.Encode(node.RewardAddress),
+			node.Total.Actual.String(),
+			node.Total.Simulated.String(),
+			node.Total.Delta.String(),
+		}))
+	}
+	printTable("Rewards per node", header, lines)
+}
+
+func newDeltaLine(identifier string, value *rewardsSimulator.ValueDelta) *display.LineData {
+	return display.NewLineData(false, []string{identifier, value.Actual.String(), value.Simulated.String(), value.Delta.String()})
+}
+
+func printTable(title string, header []string, lines []*display.LineData) {
+	table, err := display.CreateTableString(header, lines)
+	if err != nil {
+		log.Warn("cannot display table", "title", title, "error", err)
+		return
+	}
+
+	log.Info(title + "\n" + table)
+}
+
+func shardName(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardID)
+}
+
+func saveResult(result *rewardsSimulator.SimulationResult) error {
+	if len(argsConfig.outputFile) == 0 {
+		return nil
+	}
+
+	buff, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.Info("saving simulation result", "file", argsConfig.outputFile)
+
+	return ioutil.WriteFile(argsConfig.outputFile, buff, core.FileModeUserReadWrite)
+}
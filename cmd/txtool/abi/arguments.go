@@ -0,0 +1,131 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// ArgumentType defines how a provided argument is converted into the bytes expected by the system smart contract
+type ArgumentType string
+
+const (
+	// BigUint is a positive decimal number encoded as big endian bytes
+	BigUint ArgumentType = "biguint"
+	// DecimalString is a positive decimal number passed as its string representation
+	DecimalString ArgumentType = "decimal"
+	// Address is a bech32 address passed as its raw bytes
+	Address ArgumentType = "address"
+	// BLSKey is a hex encoded validator public key
+	BLSKey ArgumentType = "blsKey"
+	// BLSSignature is a hex encoded signature generated with a validator private key
+	BLSSignature ArgumentType = "blsSignature"
+	// Bool is a boolean passed as the "true" or "false" strings
+	Bool ArgumentType = "bool"
+	// String is a string passed as its raw bytes
+	String ArgumentType = "string"
+)
+
+const blsKeyLength = 96
+
+// Argument describes a single argument of a system smart contract function
+type Argument struct {
+	Name string
+	Type ArgumentType
+	// Length, if not 0, is the exact length of the encoded argument
+	Length int
+	// Values, if not empty, contains the only accepted values for the argument
+	Values []string
+}
+
+// String returns the human readable argument signature
+func (a Argument) String() string {
+	if len(a.Values) > 0 {
+		return fmt.Sprintf("%s:%s(%s)", a.Name, a.Type, strings.Join(a.Values, "|"))
+	}
+
+	return fmt.Sprintf("%s:%s", a.Name, a.Type)
+}
+
+func (a Argument) encode(value string, addressConverter core.PubkeyConverter) ([]byte, error) {
+	if len(a.Values) > 0 && !contains(a.Values, value) {
+		return nil, fmt.Errorf("%w %s: %s is not one of %s", ErrInvalidArgument, a.Name, value, strings.Join(a.Values, ", "))
+	}
+
+	buff, err := a.encodeValue(value, addressConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %s", ErrInvalidArgument, a.Name, err.Error())
+	}
+	if a.Length > 0 && len(buff) != a.Length {
+		return nil, fmt.Errorf("%w %s: expected length %d, got %d", ErrInvalidArgument, a.Name, a.Length, len(buff))
+	}
+
+	return buff, nil
+}
+
+func (a Argument) encodeValue(value string, addressConverter core.PubkeyConverter) ([]byte, error) {
+	switch a.Type {
+	case BigUint:
+		number, ok := big.NewInt(0).SetString(value, 10)
+		if !ok || number.Sign() < 0 {
+			return nil, fmt.Errorf("%s is not a positive decimal number", value)
+		}
+		if number.Sign() == 0 {
+			return []byte{0}, nil
+		}
+
+		return number.Bytes(), nil
+	case DecimalString:
+		number, ok := big.NewInt(0).SetString(value, 10)
+		if !ok || number.Sign() < 0 {
+			return nil, fmt.Errorf("%s is not a positive decimal number", value)
+		}
+
+		return []byte(number.String()), nil
+	case Address:
+		return addressConverter.Decode(value)
+	case BLSKey:
+		buff, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(buff) != blsKeyLength {
+			return nil, fmt.Errorf("expected a %d bytes key, got %d bytes", blsKeyLength, len(buff))
+		}
+
+		return buff, nil
+	case BLSSignature:
+		buff, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(buff) == 0 {
+			return nil, fmt.Errorf("empty signature")
+		}
+
+		return buff, nil
+	case Bool:
+		if value != "true" && value != "false" {
+			return nil, fmt.Errorf("%s is not true or false", value)
+		}
+
+		return []byte(value), nil
+	case String:
+		return []byte(value), nil
+	default:
+		return nil, fmt.Errorf("unknown argument type %s", a.Type)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const argumentsSeparator = "@"
+
+type encoder struct {
+	addressConverter core.PubkeyConverter
+}
+
+// NewEncoder creates an encoder of system smart contract calls
+func NewEncoder(addressConverter core.PubkeyConverter) (*encoder, error) {
+	if check.IfNil(addressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+
+	return &encoder{
+		addressConverter: addressConverter,
+	}, nil
+}
+
+// EncodeCallData checks the provided arguments against the function signature and returns the transaction data
+// field as function@hexArg1@hexArg2...
+func (e *encoder) EncodeCallData(function *Function, args []string) (string, error) {
+	if function == nil {
+		return "", ErrUnknownFunction
+	}
+
+	numRepeated, err := function.numRepeated(len(args))
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{function.Name}
+	if function.PrefixCount && numRepeated > 0 {
+		parts = append(parts, hex.EncodeToString(big.NewInt(int64(numRepeated)).Bytes()))
+	}
+
+	for i, value := range args {
+		argument := function.argumentAt(i)
+		buff, errEncode := argument.encode(value, e.addressConverter)
+		if errEncode != nil {
+			return "", errEncode
+		}
+
+		parts = append(parts, hex.EncodeToString(buff))
+	}
+
+	return strings.Join(parts, argumentsSeparator), nil
+}
+
+// ContractAddress returns the address the function call should be sent to. The provided address is mandatory
+// for the contracts without a fixed address and forbidden for the others
+func (e *encoder) ContractAddress(contract *Contract, address string) ([]byte, error) {
+	if contract == nil {
+		return nil, ErrUnknownContract
+	}
+	if len(contract.Address) > 0 {
+		if len(address) > 0 {
+			return nil, fmt.Errorf("%w: contract %s has a fixed address", ErrInvalidArgument, contract.Name)
+		}
+
+		return contract.Address, nil
+	}
+	if len(address) == 0 {
+		return nil, fmt.Errorf("%w for contract %s", ErrMissingContractAddress, contract.Name)
+	}
+
+	return e.addressConverter.Decode(address)
+}
+
+// CheckValue returns an error if a value is transferred to a function that is not payable
+func (e *encoder) CheckValue(function *Function, value *big.Int) error {
+	if function == nil {
+		return ErrUnknownFunction
+	}
+	if !function.Payable && value != nil && value.Sign() != 0 {
+		return fmt.Errorf("%w: function %s is not payable", ErrInvalidArgument, function.Name)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *encoder) IsInterfaceNil() bool {
+	return e == nil
+}
+
+func (f *Function) numRepeated(numArgs int) (int, error) {
+	numFixed := len(f.Arguments)
+	numRemaining := numArgs - numFixed
+	if numRemaining < 0 {
+		return 0, fmt.Errorf("%w for %s: expected at least %d, got %d", ErrInvalidNumberOfArguments, f.Name, numFixed, numArgs)
+	}
+	if len(f.Repeated) == 0 {
+		if numRemaining != 0 {
+			return 0, fmt.Errorf("%w for %s: expected %d, got %d", ErrInvalidNumberOfArguments, f.Name, numFixed, numArgs)
+		}
+
+		return 0, nil
+	}
+	if numRemaining%len(f.Repeated) != 0 {
+		return 0, fmt.Errorf("%w for %s: the repeated arguments should be provided in groups of %d",
+			ErrInvalidNumberOfArguments, f.Name, len(f.Repeated))
+	}
+
+	numRepeated := numRemaining / len(f.Repeated)
+	if numRepeated < f.MinRepeated {
+		return 0, fmt.Errorf("%w for %s: expected at least %d repeated groups, got %d",
+			ErrInvalidNumberOfArguments, f.Name, f.MinRepeated, numRepeated)
+	}
+	if f.MaxRepeated > 0 && numRepeated > f.MaxRepeated {
+		return 0, fmt.Errorf("%w for %s: expected at most %d repeated groups, got %d",
+			ErrInvalidNumberOfArguments, f.Name, f.MaxRepeated, numRepeated)
+	}
+
+	return numRepeated, nil
+}
+
+func (f *Function) argumentAt(index int) Argument {
+	if index < len(f.Arguments) {
+		return f.Arguments[index]
+	}
+
+	return f.Repeated[(index-len(f.Arguments))%len(f.Repeated)]
+}
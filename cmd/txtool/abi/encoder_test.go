@@ -0,0 +1,201 @@
+package abi_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/txtool/abi"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+var testBLSKey = strings.Repeat("ab", 96)
+
+func createEncoder(t *testing.T) abi.Encoder {
+	converter, err := pubkeyConverter.NewBech32PubkeyConverter(32, logger.GetOrCreate("abi_test"))
+	require.Nil(t, err)
+
+	encoder, err := abi.NewEncoder(converter)
+	require.Nil(t, err)
+
+	return encoder
+}
+
+func getFunction(t *testing.T, contractName string, functionName string) *abi.Function {
+	_, function, err := abi.GetFunction(contractName, functionName)
+	require.Nil(t, err)
+
+	return function
+}
+
+func TestNewEncoder(t *testing.T) {
+	t.Parallel()
+
+	encoder, err := abi.NewEncoder(nil)
+	assert.Nil(t, encoder)
+	assert.Equal(t, abi.ErrNilAddressConverter, err)
+}
+
+func TestGetFunction(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := abi.GetFunction("esdt", "issue")
+	assert.True(t, errors.Is(err, abi.ErrUnknownContract))
+
+	_, _, err = abi.GetFunction("validator", "delegate")
+	assert.True(t, errors.Is(err, abi.ErrUnknownFunction))
+
+	contract, function, err := abi.GetFunction("validator", "stake")
+	assert.Nil(t, err)
+	assert.Equal(t, vm.ValidatorSCAddress, contract.Address)
+	assert.Equal(t, "stake", function.Name)
+	assert.Equal(t, "stake [blsKey:blsKey signature:blsSignature]...", function.Signature())
+}
+
+func TestEncoder_EncodeCallData(t *testing.T) {
+	t.Parallel()
+
+	encoder := createEncoder(t)
+
+	t.Run("stake without keys should only top up", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := encoder.EncodeCallData(getFunction(t, "validator", "stake"), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "stake", data)
+	})
+	t.Run("stake should prefix the number of nodes", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := encoder.EncodeCallData(getFunction(t, "validator", "stake"), []string{testBLSKey, "0102", testBLSKey, "0304"})
+		assert.Nil(t, err)
+		assert.Equal(t, "stake@02@"+testBLSKey+"@0102@"+testBLSKey+"@0304", data)
+	})
+	t.Run("stake with an incomplete group should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := encoder.EncodeCallData(getFunction(t, "validator", "stake"), []string{testBLSKey})
+		assert.True(t, errors.Is(err, abi.ErrInvalidNumberOfArguments))
+	})
+	t.Run("invalid bls key should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := encoder.EncodeCallData(getFunction(t, "validator", "unStake"), []string{"abcd"})
+		assert.True(t, errors.Is(err, abi.ErrInvalidArgument))
+	})
+	t.Run("missing repeated arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := encoder.EncodeCallData(getFunction(t, "validator", "unBond"), nil)
+		assert.True(t, errors.Is(err, abi.ErrInvalidNumberOfArguments))
+	})
+	t.Run("too many repeated arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := encoder.EncodeCallData(getFunction(t, "validator", "unBondTokens"), []string{"1", "2"})
+		assert.True(t, errors.Is(err, abi.ErrInvalidNumberOfArguments))
+	})
+	t.Run("too many fixed arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := encoder.EncodeCallData(getFunction(t, "validator", "claim"), []string{"1"})
+		assert.True(t, errors.Is(err, abi.ErrInvalidNumberOfArguments))
+	})
+	t.Run("big unsigned integers should be big endian encoded", func(t *testing.T) {
+		t.Parallel()
+
+		function := getFunction(t, "delegationManager", "createNewDelegationContract")
+		data, err := encoder.EncodeCallData(function, []string{"0", "1000"})
+		assert.Nil(t, err)
+		assert.Equal(t, "createNewDelegationContract@00@03e8", data)
+
+		_, err = encoder.EncodeCallData(function, []string{"-1", "1000"})
+		assert.True(t, errors.Is(err, abi.ErrInvalidArgument))
+	})
+	t.Run("addresses should be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := encoder.EncodeCallData(getFunction(t, "validator", "changeRewardAddress"), []string{testAddress})
+		assert.Nil(t, err)
+		assert.Equal(t, "changeRewardAddress@0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1", data)
+
+		_, err = encoder.EncodeCallData(getFunction(t, "validator", "changeRewardAddress"), []string{"erd1"})
+		assert.True(t, errors.Is(err, abi.ErrInvalidArgument))
+	})
+	t.Run("booleans should be passed as strings", func(t *testing.T) {
+		t.Parallel()
+
+		function := getFunction(t, "delegation", "setAutomaticActivation")
+		data, err := encoder.EncodeCallData(function, []string{"true"})
+		assert.Nil(t, err)
+		assert.Equal(t, "setAutomaticActivation@"+hex.EncodeToString([]byte("true")), data)
+
+		_, err = encoder.EncodeCallData(function, []string{"yes"})
+		assert.True(t, errors.Is(err, abi.ErrInvalidArgument))
+	})
+	t.Run("governance nonces should be passed as decimal strings", func(t *testing.T) {
+		t.Parallel()
+
+		commit := strings.Repeat("a", 40)
+		data, err := encoder.EncodeCallData(getFunction(t, "governance", "proposal"), []string{commit, "10", "20"})
+		assert.Nil(t, err)
+		expected := "proposal@" + hex.EncodeToString([]byte(commit)) + "@" +
+			hex.EncodeToString([]byte("10")) + "@" + hex.EncodeToString([]byte("20"))
+		assert.Equal(t, expected, data)
+
+		_, err = encoder.EncodeCallData(getFunction(t, "governance", "proposal"), []string{"short", "10", "20"})
+		assert.True(t, errors.Is(err, abi.ErrInvalidArgument))
+	})
+	t.Run("vote options should be checked", func(t *testing.T) {
+		t.Parallel()
+
+		commit := strings.Repeat("a", 40)
+		data, err := encoder.EncodeCallData(getFunction(t, "governance", "vote"), []string{commit, "veto"})
+		assert.Nil(t, err)
+		assert.Equal(t, "vote@"+hex.EncodeToString([]byte(commit))+"@"+hex.EncodeToString([]byte("veto")), data)
+
+		_, err = encoder.EncodeCallData(getFunction(t, "governance", "vote"), []string{commit, "maybe"})
+		assert.True(t, errors.Is(err, abi.ErrInvalidArgument))
+	})
+}
+
+func TestEncoder_ContractAddress(t *testing.T) {
+	t.Parallel()
+
+	encoder := createEncoder(t)
+	validator, _, _ := abi.GetFunction("validator", "stake")
+	delegation, _, _ := abi.GetFunction("delegation", "delegate")
+
+	address, err := encoder.ContractAddress(validator, "")
+	assert.Nil(t, err)
+	assert.Equal(t, vm.ValidatorSCAddress, address)
+
+	_, err = encoder.ContractAddress(validator, testAddress)
+	assert.True(t, errors.Is(err, abi.ErrInvalidArgument))
+
+	_, err = encoder.ContractAddress(delegation, "")
+	assert.True(t, errors.Is(err, abi.ErrMissingContractAddress))
+
+	address, err = encoder.ContractAddress(delegation, testAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, 32, len(address))
+}
+
+func TestEncoder_CheckValue(t *testing.T) {
+	t.Parallel()
+
+	encoder := createEncoder(t)
+
+	assert.Nil(t, encoder.CheckValue(getFunction(t, "delegation", "delegate"), big.NewInt(10)))
+	assert.Nil(t, encoder.CheckValue(getFunction(t, "delegation", "withdraw"), big.NewInt(0)))
+	err := encoder.CheckValue(getFunction(t, "delegation", "withdraw"), big.NewInt(10))
+	assert.True(t, errors.Is(err, abi.ErrInvalidArgument))
+}
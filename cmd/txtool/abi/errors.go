@@ -0,0 +1,21 @@
+package abi
+
+import "errors"
+
+// ErrUnknownContract signals that the provided system smart contract name is not known
+var ErrUnknownContract = errors.New("unknown system smart contract")
+
+// ErrUnknownFunction signals that the provided function is not exposed by the system smart contract
+var ErrUnknownFunction = errors.New("unknown function")
+
+// ErrInvalidNumberOfArguments signals that the number of the provided arguments does not match the function signature
+var ErrInvalidNumberOfArguments = errors.New("invalid number of arguments")
+
+// ErrInvalidArgument signals that an argument could not be encoded with respect to its type
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrMissingContractAddress signals that the contract address was not provided for a contract without a fixed address
+var ErrMissingContractAddress = errors.New("missing contract address")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
@@ -0,0 +1,181 @@
+package abi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+// Contract describes a system smart contract and the functions that can be called by an account
+type Contract struct {
+	Name        string
+	Description string
+	// Address is nil for the contracts deployed on custom addresses, like the delegation contracts
+	Address   []byte
+	Functions []*Function
+}
+
+// Function describes a system smart contract function. The call arguments are the Arguments followed by the
+// Repeated group, provided between MinRepeated and MaxRepeated (0 meaning unbounded) times
+type Function struct {
+	Name        string
+	Description string
+	Payable     bool
+	Arguments   []Argument
+	Repeated    []Argument
+	MinRepeated int
+	MaxRepeated int
+	// PrefixCount, if set, adds the number of repeated groups as the first argument when there is at least one group
+	PrefixCount bool
+}
+
+var commitHash = Argument{Name: "commitHash", Type: String, Length: 40}
+var voteOption = Argument{Name: "vote", Type: String, Values: []string{"yes", "no", "veto"}}
+var blsKey = Argument{Name: "blsKey", Type: BLSKey}
+var blsKeyWithSignature = []Argument{blsKey, {Name: "signature", Type: BLSSignature}}
+
+var contracts = []*Contract{
+	{
+		Name:        "validator",
+		Description: "stakes and manages the nodes of an account",
+		Address:     vm.ValidatorSCAddress,
+		Functions: []*Function{
+			{
+				Name:        "stake",
+				Description: "stakes the value and registers the provided keys, each signature being the sender address signed with the key",
+				Payable:     true,
+				Repeated:    blsKeyWithSignature,
+				PrefixCount: true,
+			},
+			{Name: "unStake", Description: "unstakes the provided nodes", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "unStakeNodes", Description: "unstakes the provided nodes, keeping the tokens staked", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "unStakeTokens", Description: "unstakes the provided value", Arguments: []Argument{{Name: "value", Type: BigUint}}},
+			{Name: "unBond", Description: "unbonds the provided nodes", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "unBondNodes", Description: "unbonds the provided nodes, keeping the tokens", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "unBondTokens", Description: "unbonds the provided value or all the unbondable tokens", Repeated: []Argument{{Name: "value", Type: BigUint}}, MaxRepeated: 1},
+			{Name: "reStakeUnStakedNodes", Description: "stakes again the provided unstaked nodes", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "unJail", Description: "unjails the provided nodes, the value being the unjail price for each node", Payable: true, Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "changeRewardAddress", Description: "changes the address receiving the rewards", Arguments: []Argument{{Name: "rewardAddress", Type: Address}}},
+			{Name: "claim", Description: "claims the unbonded tokens"},
+		},
+	},
+	{
+		Name:        "delegationManager",
+		Description: "creates delegation contracts",
+		Address:     vm.DelegationManagerSCAddress,
+		Functions: []*Function{
+			{
+				Name:        "createNewDelegationContract",
+				Description: "creates a new delegation contract, the value being the initial delegation of the owner",
+				Payable:     true,
+				Arguments:   []Argument{{Name: "totalDelegationCap", Type: BigUint}, {Name: "serviceFee", Type: BigUint}},
+			},
+			{
+				Name:        "makeNewContractFromValidatorData",
+				Description: "turns the staked nodes of the sender into a new delegation contract",
+				Arguments:   []Argument{{Name: "totalDelegationCap", Type: BigUint}, {Name: "serviceFee", Type: BigUint}},
+			},
+			{
+				Name:        "mergeValidatorToDelegationSameOwner",
+				Description: "moves the staked nodes of the sender into its own delegation contract",
+				Arguments:   []Argument{{Name: "delegationContract", Type: Address}},
+			},
+			{
+				Name:        "mergeValidatorToDelegationWithWhitelist",
+				Description: "moves the staked nodes of the sender into a delegation contract that whitelisted it",
+				Arguments:   []Argument{{Name: "delegationContract", Type: Address}},
+			},
+		},
+	},
+	{
+		Name:        "delegation",
+		Description: "a delegation contract, deployed on the address provided with the contract address flag",
+		Functions: []*Function{
+			{Name: "delegate", Description: "delegates the value", Payable: true},
+			{Name: "unDelegate", Description: "undelegates the provided value", Arguments: []Argument{{Name: "value", Type: BigUint}}},
+			{Name: "withdraw", Description: "withdraws the undelegated value"},
+			{Name: "claimRewards", Description: "claims the delegation rewards"},
+			{Name: "reDelegateRewards", Description: "delegates the rewards"},
+			{
+				Name:        "addNodes",
+				Description: "adds the provided keys, each signature being the delegation contract address signed with the key",
+				Repeated:    blsKeyWithSignature,
+				MinRepeated: 1,
+			},
+			{Name: "removeNodes", Description: "removes the provided nodes", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "stakeNodes", Description: "stakes the provided nodes", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "unStakeNodes", Description: "unstakes the provided nodes", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "unBondNodes", Description: "unbonds the provided nodes", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "reStakeUnStakedNodes", Description: "stakes again the provided unstaked nodes", Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "unJailNodes", Description: "unjails the provided nodes, the value being the unjail price for each node", Payable: true, Repeated: []Argument{blsKey}, MinRepeated: 1},
+			{Name: "changeServiceFee", Description: "changes the service fee, in hundredths of percent", Arguments: []Argument{{Name: "serviceFee", Type: BigUint}}},
+			{Name: "modifyTotalDelegationCap", Description: "changes the delegation cap, 0 meaning uncapped", Arguments: []Argument{{Name: "totalDelegationCap", Type: BigUint}}},
+			{Name: "setAutomaticActivation", Description: "enables or disables the automatic staking of the nodes", Arguments: []Argument{{Name: "enabled", Type: Bool}}},
+			{Name: "setCheckCapOnReDelegateRewards", Description: "enables or disables the delegation cap check when redelegating", Arguments: []Argument{{Name: "enabled", Type: Bool}}},
+			{
+				Name:        "setMetaData",
+				Description: "sets the name, the website and the identifier of the staking provider",
+				Arguments:   []Argument{{Name: "name", Type: String}, {Name: "website", Type: String}, {Name: "identifier", Type: String}},
+			},
+			{Name: "whitelistForMerge", Description: "allows the provided address to merge its nodes into the contract", Arguments: []Argument{{Name: "address", Type: Address}}},
+		},
+	},
+	{
+		Name:        "governance",
+		Description: "creates and votes the protocol proposals",
+		Address:     vm.GovernanceSCAddress,
+		Functions: []*Function{
+			{
+				Name:        "proposal",
+				Description: "creates a proposal, the value being the proposal cost",
+				Payable:     true,
+				Arguments:   []Argument{commitHash, {Name: "startVoteNonce", Type: DecimalString}, {Name: "endVoteNonce", Type: DecimalString}},
+			},
+			{Name: "vote", Description: "votes a proposal with the staked value", Arguments: []Argument{commitHash, voteOption}},
+			{Name: "voteWithFunds", Description: "votes a proposal with the locked value", Payable: true, Arguments: []Argument{commitHash, voteOption}},
+			{Name: "claimFunds", Description: "claims the value locked when voting a proposal", Arguments: []Argument{commitHash}},
+		},
+	},
+}
+
+// Contracts returns the known system smart contracts
+func Contracts() []*Contract {
+	return contracts
+}
+
+// GetFunction returns the function of the provided system smart contract
+func GetFunction(contractName string, functionName string) (*Contract, *Function, error) {
+	for _, contract := range contracts {
+		if contract.Name != contractName {
+			continue
+		}
+
+		for _, function := range contract.Functions {
+			if function.Name == functionName {
+				return contract, function, nil
+			}
+		}
+
+		return nil, nil, fmt.Errorf("%w %s for contract %s", ErrUnknownFunction, functionName, contractName)
+	}
+
+	return nil, nil, fmt.Errorf("%w %s", ErrUnknownContract, contractName)
+}
+
+// Signature returns the human readable function signature
+func (f *Function) Signature() string {
+	parts := []string{f.Name}
+	for _, arg := range f.Arguments {
+		parts = append(parts, arg.String())
+	}
+	if len(f.Repeated) > 0 {
+		repeated := make([]string, 0, len(f.Repeated))
+		for _, arg := range f.Repeated {
+			repeated = append(repeated, arg.String())
+		}
+		parts = append(parts, "["+strings.Join(repeated, " ")+"]...")
+	}
+
+	return strings.Join(parts, " ")
+}
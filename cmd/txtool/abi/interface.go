@@ -0,0 +1,11 @@
+package abi
+
+import "math/big"
+
+// Encoder encodes the system smart contract calls
+type Encoder interface {
+	EncodeCallData(function *Function, args []string) (string, error)
+	ContractAddress(contract *Contract, address string) ([]byte, error)
+	CheckValue(function *Function, value *big.Int) error
+	IsInterfaceNil() bool
+}
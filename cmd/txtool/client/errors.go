@@ -0,0 +1,18 @@
+package client
+
+import "errors"
+
+// ErrEmptyURL signals that an empty node URL has been provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNodeResponse signals that the node returned an error
+var ErrNodeResponse = errors.New("node error")
+
+// ErrCostNotComputed signals that the node could not compute the gas needed by a transaction
+var ErrCostNotComputed = errors.New("transaction cost could not be computed")
@@ -0,0 +1,12 @@
+package client
+
+import "github.com/ElrondNetwork/elrond-go-core/data/transaction"
+
+// NodeClient defines the node REST API calls needed to create and broadcast transactions
+type NodeClient interface {
+	GetNetworkConfig() (*NetworkConfig, error)
+	GetAccountNonce(address string) (uint64, error)
+	ComputeTransactionCost(tx *transaction.FrontendTransaction) (uint64, error)
+	SendTransaction(tx *transaction.FrontendTransaction) (string, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+const (
+	networkConfigEndpoint   = "/network/config"
+	accountEndpoint         = "/address/%s"
+	transactionCostEndpoint = "/transaction/cost"
+	sendTransactionEndpoint = "/transaction/send"
+)
+
+// ArgsNodeClient represents the argument for the node REST API client
+type ArgsNodeClient struct {
+	URL            string
+	RequestTimeout time.Duration
+}
+
+// NetworkConfig holds the network parameters needed to create a transaction
+type NetworkConfig struct {
+	ChainID               string `json:"erd_chain_id"`
+	MinGasPrice           uint64 `json:"erd_min_gas_price"`
+	MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
+}
+
+type genericResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+type nodeClient struct {
+	url            string
+	httpClient     *http.Client
+	requestTimeout time.Duration
+}
+
+// NewNodeClient creates a client of the node REST API
+func NewNodeClient(args ArgsNodeClient) (*nodeClient, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &nodeClient{
+		url:            strings.TrimSuffix(args.URL, "/"),
+		httpClient:     &http.Client{},
+		requestTimeout: args.RequestTimeout,
+	}, nil
+}
+
+// GetNetworkConfig returns the chain ID, the minimum gas price and the minimum transaction version of the network
+func (nc *nodeClient) GetNetworkConfig() (*NetworkConfig, error) {
+	response := &struct {
+		Config NetworkConfig `json:"config"`
+	}{}
+	err := nc.doRequest(http.MethodGet, networkConfigEndpoint, nil, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Config, nil
+}
+
+// GetAccountNonce returns the current nonce of the provided bech32 address
+func (nc *nodeClient) GetAccountNonce(address string) (uint64, error) {
+	response := &struct {
+		Account api.AccountResponse `json:"account"`
+	}{}
+	err := nc.doRequest(http.MethodGet, fmt.Sprintf(accountEndpoint, address), nil, response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Account.Nonce, nil
+}
+
+// ComputeTransactionCost returns the gas units the node estimates the transaction will consume
+func (nc *nodeClient) ComputeTransactionCost(tx *transaction.FrontendTransaction) (uint64, error) {
+	if tx == nil {
+		return 0, ErrNilTransaction
+	}
+
+	response := &transaction.CostResponse{}
+	err := nc.doRequest(http.MethodPost, transactionCostEndpoint, tx, response)
+	if err != nil {
+		return 0, err
+	}
+	if response.GasUnits == 0 {
+		return 0, fmt.Errorf("%w: %s", ErrCostNotComputed, response.ReturnMessage)
+	}
+
+	return response.GasUnits, nil
+}
+
+// SendTransaction broadcasts the signed transaction and returns its hash
+func (nc *nodeClient) SendTransaction(tx *transaction.FrontendTransaction) (string, error) {
+	if tx == nil {
+		return "", ErrNilTransaction
+	}
+
+	response := &struct {
+		TxHash string `json:"txHash"`
+	}{}
+	err := nc.doRequest(http.MethodPost, sendTransactionEndpoint, tx, response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.TxHash, nil
+}
+
+func (nc *nodeClient) doRequest(method string, endpoint string, body interface{}, data interface{}) error {
+	var requestBody []byte
+	if body != nil {
+		var err error
+		requestBody, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), nc.requestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, method, nc.url+endpoint, bytes.NewReader(requestBody))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := nc.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNodeResponse, err.Error())
+	}
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+
+	responseBytes, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return err
+	}
+
+	response := &genericResponse{}
+	err = json.Unmarshal(responseBytes, response)
+	if err != nil {
+		return fmt.Errorf("%w: status %s, %s", ErrNodeResponse, httpResponse.Status, err.Error())
+	}
+	if len(response.Error) > 0 || httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %s, %s", ErrNodeResponse, httpResponse.Status, response.Error)
+	}
+
+	return json.Unmarshal(response.Data, data)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nc *nodeClient) IsInterfaceNil() bool {
+	return nc == nil
+}
@@ -0,0 +1,156 @@
+package client_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/cmd/txtool/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeResponse(w http.ResponseWriter, status int, data interface{}, errMessage string) {
+	w.WriteHeader(status)
+	buff, _ := json.Marshal(map[string]interface{}{
+		"data":  data,
+		"error": errMessage,
+		"code":  "successful",
+	})
+	_, _ = w.Write(buff)
+}
+
+func createClient(t *testing.T, handler http.HandlerFunc) client.NodeClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	nodeClient, err := client.NewNodeClient(client.ArgsNodeClient{
+		URL:            server.URL + "/",
+		RequestTimeout: time.Second,
+	})
+	require.Nil(t, err)
+
+	return nodeClient
+}
+
+func TestNewNodeClient(t *testing.T) {
+	t.Parallel()
+
+	nodeClient, err := client.NewNodeClient(client.ArgsNodeClient{RequestTimeout: time.Second})
+	assert.Nil(t, nodeClient)
+	assert.Equal(t, client.ErrEmptyURL, err)
+
+	nodeClient, err = client.NewNodeClient(client.ArgsNodeClient{URL: "http://localhost"})
+	assert.Nil(t, nodeClient)
+	assert.Equal(t, client.ErrInvalidRequestTimeout, err)
+}
+
+func TestNodeClient_GetNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	nodeClient := createClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/network/config", r.URL.Path)
+		writeResponse(w, http.StatusOK, map[string]interface{}{
+			"config": map[string]interface{}{
+				"erd_chain_id":                "T",
+				"erd_min_gas_price":           1000000000,
+				"erd_min_transaction_version": 1,
+			},
+		}, "")
+	})
+
+	config, err := nodeClient.GetNetworkConfig()
+	require.Nil(t, err)
+	assert.Equal(t, "T", config.ChainID)
+	assert.Equal(t, uint64(1000000000), config.MinGasPrice)
+	assert.Equal(t, uint32(1), config.MinTransactionVersion)
+}
+
+func TestNodeClient_GetAccountNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("node error should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeClient := createClient(t, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusInternalServerError, nil, "could not get account")
+		})
+
+		_, err := nodeClient.GetAccountNonce("erd1")
+		assert.True(t, errors.Is(err, client.ErrNodeResponse))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nodeClient := createClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/address/erd1", r.URL.Path)
+			writeResponse(w, http.StatusOK, map[string]interface{}{
+				"account": map[string]interface{}{"nonce": 37},
+			}, "")
+		})
+
+		nonce, err := nodeClient.GetAccountNonce("erd1")
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(37), nonce)
+	})
+}
+
+func TestNodeClient_ComputeTransactionCost(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeClient := createClient(t, func(w http.ResponseWriter, r *http.Request) {})
+		_, err := nodeClient.ComputeTransactionCost(nil)
+		assert.Equal(t, client.ErrNilTransaction, err)
+	})
+	t.Run("failed simulation should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeClient := createClient(t, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusOK, transaction.CostResponse{ReturnMessage: "insufficient funds"}, "")
+		})
+
+		_, err := nodeClient.ComputeTransactionCost(&transaction.FrontendTransaction{})
+		assert.True(t, errors.Is(err, client.ErrCostNotComputed))
+		assert.Contains(t, err.Error(), "insufficient funds")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nodeClient := createClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/transaction/cost", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			body, _ := ioutil.ReadAll(r.Body)
+			tx := &transaction.FrontendTransaction{}
+			_ = json.Unmarshal(body, tx)
+			assert.Equal(t, []byte("claim"), tx.Data)
+
+			writeResponse(w, http.StatusOK, transaction.CostResponse{GasUnits: 6000000}, "")
+		})
+
+		gas, err := nodeClient.ComputeTransactionCost(&transaction.FrontendTransaction{Data: []byte("claim")})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(6000000), gas)
+	})
+}
+
+func TestNodeClient_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	nodeClient := createClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/transaction/send", r.URL.Path)
+		writeResponse(w, http.StatusOK, map[string]interface{}{"txHash": "aabb"}, "")
+	})
+
+	hash, err := nodeClient.SendTransaction(&transaction.FrontendTransaction{Signature: "01"})
+	assert.Nil(t, err)
+	assert.Equal(t, "aabb", hash)
+}
@@ -0,0 +1,548 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/cmd/txtool/abi"
+	"github.com/ElrondNetwork/elrond-go/cmd/txtool/client"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultRequestTimeout = 10 * time.Second
+	defaultGasPrice       = 1000000000
+	defaultVersion        = 1
+)
+
+var (
+	errMissingNodeURL = errors.New("missing node URL")
+	errSenderMismatch = errors.New("the transaction sender does not match the signing key")
+	errNotSigned      = errors.New("the transaction is not signed")
+
+	flagContract = cli.StringFlag{
+		Name:  "contract",
+		Usage: "The system smart contract name, as listed by the functions command",
+	}
+	flagFunction = cli.StringFlag{
+		Name:  "function",
+		Usage: "The system smart contract function",
+	}
+	flagContractAddress = cli.StringFlag{
+		Name:  "contract-address",
+		Usage: "The bech32 address of the contract, mandatory for the delegation contracts",
+	}
+	flagSender = cli.StringFlag{
+		Name:  "sender",
+		Usage: "The bech32 address of the sender",
+	}
+	flagValue = cli.StringFlag{
+		Name:  "value",
+		Usage: "The transferred value, in the smallest denomination",
+		Value: "0",
+	}
+	flagNonce = cli.Uint64Flag{
+		Name:  "nonce",
+		Usage: "The sender nonce. If not set, it is fetched from the node",
+	}
+	flagGasLimit = cli.Uint64Flag{
+		Name:  "gas-limit",
+		Usage: "The gas limit. If not set, it is computed by the node",
+	}
+	flagGasPrice = cli.Uint64Flag{
+		Name:  "gas-price",
+		Usage: fmt.Sprintf("The gas price. If not set, the minimum gas price of the network is used or %d when offline", defaultGasPrice),
+	}
+	flagChainID = cli.StringFlag{
+		Name:  "chain-id",
+		Usage: "The chain ID. If not set, it is fetched from the node",
+	}
+	flagVersion = cli.UintFlag{
+		Name:  "version",
+		Usage: fmt.Sprintf("The transaction version. If not set, the minimum transaction version of the network is used or %d when offline", defaultVersion),
+	}
+	flagNodeURL = cli.StringFlag{
+		Name:  "node-url",
+		Usage: "The REST API URL of a node, for example http://127.0.0.1:8080",
+	}
+	flagKeyFile = cli.StringFlag{
+		Name:  "key-file",
+		Usage: "The `filepath` of the wallet key, either a PEM file or a password encrypted JSON key file",
+	}
+	flagKeyIndex = cli.IntFlag{
+		Name:  "index",
+		Usage: "The 0-based index of the key in the PEM file",
+	}
+	flagPasswordFile = cli.StringFlag{
+		Name:  "password-file",
+		Usage: "The `filepath` of the file holding the password of the JSON key file",
+	}
+	flagTxFile = cli.StringFlag{
+		Name:  "tx-file",
+		Usage: "The `filepath` of the JSON transaction",
+	}
+	flagOutputFile = cli.StringFlag{
+		Name:  "output-file",
+		Usage: "The `filepath` of the generated JSON transaction. If not set, the transaction is printed on the console",
+	}
+
+	commands = []cli.Command{
+		{
+			Name:   "functions",
+			Usage:  "lists the known system smart contract functions and their arguments",
+			Action: listFunctions,
+		},
+		{
+			Name:      "encode",
+			Usage:     "prints the data field calling a system smart contract function",
+			ArgsUsage: "[function arguments...]",
+			Flags:     []cli.Flag{flagContract, flagFunction},
+			Action:    encode,
+		},
+		{
+			Name:      "create",
+			Usage:     "creates an unsigned transaction calling a system smart contract function. When all the fields are set, no node is needed",
+			ArgsUsage: "[function arguments...]",
+			Flags: []cli.Flag{
+				flagContract, flagFunction, flagContractAddress, flagSender, flagValue, flagNonce, flagGasLimit,
+				flagGasPrice, flagChainID, flagVersion, flagNodeURL, flagOutputFile,
+			},
+			Action: create,
+		},
+		{
+			Name:   "sign",
+			Usage:  "signs a transaction, without connecting to any node",
+			Flags:  []cli.Flag{flagTxFile, flagKeyFile, flagKeyIndex, flagPasswordFile, flagOutputFile},
+			Action: sign,
+		},
+		{
+			Name:   "send",
+			Usage:  "broadcasts a signed transaction",
+			Flags:  []cli.Flag{flagTxFile, flagNodeURL},
+			Action: send,
+		},
+		{
+			Name:      "call",
+			Usage:     "creates, signs and broadcasts a transaction calling a system smart contract function",
+			ArgsUsage: "[function arguments...]",
+			Flags: []cli.Flag{
+				flagContract, flagFunction, flagContractAddress, flagValue, flagNonce, flagGasLimit, flagGasPrice,
+				flagChainID, flagVersion, flagNodeURL, flagKeyFile, flagKeyIndex, flagPasswordFile,
+			},
+			Action: call,
+		},
+		{
+			Name:  "bls-sign",
+			Usage: "signs an address with each key of a validator PEM file and prints the key and signature pairs expected by stake and addNodes",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "validator-key-file",
+					Usage: "The `filepath` of the validator keys PEM file",
+					Value: "./validatorKey.pem",
+				},
+				cli.StringFlag{
+					Name:  "address",
+					Usage: "The bech32 address to be signed: the sender for stake, the delegation contract for addNodes",
+				},
+			},
+			Action: blsSign,
+		},
+	}
+)
+
+func listFunctions(_ *cli.Context) error {
+	for _, contract := range abi.Contracts() {
+		address := "provided with --contract-address"
+		if len(contract.Address) > 0 {
+			address = addressConverter.Encode(contract.Address)
+		}
+		fmt.Printf("%s (%s): %s\n", contract.Name, address, contract.Description)
+
+		for _, function := range contract.Functions {
+			payable := ""
+			if function.Payable {
+				payable = " [payable]"
+			}
+			fmt.Printf("   %s%s\n      %s\n", function.Signature(), payable, function.Description)
+		}
+	}
+
+	return nil
+}
+
+func encode(ctx *cli.Context) error {
+	_, function, err := abi.GetFunction(ctx.String(flagContract.Name), ctx.String(flagFunction.Name))
+	if err != nil {
+		return err
+	}
+
+	encoder, err := abi.NewEncoder(addressConverter)
+	if err != nil {
+		return err
+	}
+
+	data, err := encoder.EncodeCallData(function, ctx.Args())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(data)
+
+	return nil
+}
+
+func create(ctx *cli.Context) error {
+	tx, err := createTransaction(ctx, ctx.String(flagSender.Name))
+	if err != nil {
+		return err
+	}
+
+	return outputTransaction(tx, ctx.String(flagOutputFile.Name))
+}
+
+func sign(ctx *cli.Context) error {
+	tx, err := loadTransaction(ctx.String(flagTxFile.Name))
+	if err != nil {
+		return err
+	}
+
+	sk, err := loadWalletKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Info("signing transaction",
+		"sender", tx.Sender,
+		"receiver", tx.Receiver,
+		"value", tx.Value,
+		"data", string(tx.Data),
+		"nonce", tx.Nonce,
+		"gas limit", tx.GasLimit,
+		"gas price", tx.GasPrice,
+		"chain ID", tx.ChainID,
+	)
+
+	err = signTransaction(tx, sk)
+	if err != nil {
+		return err
+	}
+
+	return outputTransaction(tx, ctx.String(flagOutputFile.Name))
+}
+
+func send(ctx *cli.Context) error {
+	tx, err := loadTransaction(ctx.String(flagTxFile.Name))
+	if err != nil {
+		return err
+	}
+	if len(tx.Signature) == 0 {
+		return errNotSigned
+	}
+
+	return sendTransaction(ctx.String(flagNodeURL.Name), tx)
+}
+
+func call(ctx *cli.Context) error {
+	sk, err := loadWalletKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	tx, err := createTransaction(ctx, addressConverter.Encode(pkBytes))
+	if err != nil {
+		return err
+	}
+
+	err = signTransaction(tx, sk)
+	if err != nil {
+		return err
+	}
+
+	return sendTransaction(ctx.String(flagNodeURL.Name), tx)
+}
+
+func blsSign(ctx *cli.Context) error {
+	address, err := addressConverter.Decode(ctx.String("address"))
+	if err != nil {
+		return err
+	}
+
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	signer := &mclSig.BlsSingleSigner{}
+	filename := ctx.String("validator-key-file")
+	args := make([]string, 0)
+	for index := 0; ; index++ {
+		skBytes, _, errLoad := core.LoadSkPkFromPemFile(filename, index)
+		if errLoad != nil {
+			if index == 0 {
+				return errLoad
+			}
+			break
+		}
+
+		sk, pkBytes, errDecode := keystore.DecodePrivateKey(keyGenerator, skBytes)
+		if errDecode != nil {
+			return errDecode
+		}
+
+		signature, errSign := signer.Sign(sk, address)
+		if errSign != nil {
+			return errSign
+		}
+
+		args = append(args, hex.EncodeToString(pkBytes), hex.EncodeToString(signature))
+	}
+
+	fmt.Println(strings.Join(args, " "))
+
+	return nil
+}
+
+// createTransaction builds the unsigned transaction, fetching from the node only the fields that were not provided
+func createTransaction(ctx *cli.Context, sender string) (*transaction.FrontendTransaction, error) {
+	contract, function, err := abi.GetFunction(ctx.String(flagContract.Name), ctx.String(flagFunction.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, err := abi.NewEncoder(addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := encoder.EncodeCallData(function, ctx.Args())
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := encoder.ContractAddress(contract, ctx.String(flagContractAddress.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = addressConverter.Decode(sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w for sender %s", err, sender)
+	}
+
+	value, ok := big.NewInt(0).SetString(ctx.String(flagValue.Name), 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("%w: value %s", abi.ErrInvalidArgument, ctx.String(flagValue.Name))
+	}
+	err = encoder.CheckValue(function, value)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:    ctx.Uint64(flagNonce.Name),
+		Value:    value.String(),
+		Receiver: addressConverter.Encode(receiver),
+		Sender:   sender,
+		GasPrice: ctx.Uint64(flagGasPrice.Name),
+		GasLimit: ctx.Uint64(flagGasLimit.Name),
+		Data:     []byte(data),
+		ChainID:  ctx.String(flagChainID.Name),
+		Version:  uint32(ctx.Uint(flagVersion.Name)),
+	}
+
+	isOffline := ctx.IsSet(flagNonce.Name) && ctx.IsSet(flagGasLimit.Name) && ctx.IsSet(flagChainID.Name)
+	if isOffline {
+		if !ctx.IsSet(flagGasPrice.Name) {
+			tx.GasPrice = defaultGasPrice
+		}
+		if !ctx.IsSet(flagVersion.Name) {
+			tx.Version = defaultVersion
+		}
+
+		return tx, nil
+	}
+
+	if len(ctx.String(flagNodeURL.Name)) == 0 {
+		return nil, fmt.Errorf("%w: the nonce, the gas limit and the chain ID should all be set to create the transaction offline",
+			errMissingNodeURL)
+	}
+
+	nodeClient, err := createNodeClient(ctx.String(flagNodeURL.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	err = fillTransactionFromNode(ctx, nodeClient, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func fillTransactionFromNode(ctx *cli.Context, nodeClient client.NodeClient, tx *transaction.FrontendTransaction) error {
+	networkConfig, err := nodeClient.GetNetworkConfig()
+	if err != nil {
+		return err
+	}
+	if !ctx.IsSet(flagChainID.Name) {
+		tx.ChainID = networkConfig.ChainID
+	}
+	if !ctx.IsSet(flagGasPrice.Name) {
+		tx.GasPrice = networkConfig.MinGasPrice
+	}
+	if !ctx.IsSet(flagVersion.Name) {
+		tx.Version = networkConfig.MinTransactionVersion
+	}
+	if !ctx.IsSet(flagNonce.Name) {
+		tx.Nonce, err = nodeClient.GetAccountNonce(tx.Sender)
+		if err != nil {
+			return err
+		}
+	}
+	if !ctx.IsSet(flagGasLimit.Name) {
+		tx.GasLimit, err = nodeClient.ComputeTransactionCost(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func signTransaction(tx *transaction.FrontendTransaction, sk crypto.PrivateKey) error {
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+	if addressConverter.Encode(pkBytes) != tx.Sender {
+		return fmt.Errorf("%w: sender %s", errSenderMismatch, tx.Sender)
+	}
+
+	receiver, err := addressConverter.Decode(tx.Receiver)
+	if err != nil {
+		return fmt.Errorf("%w for receiver %s", err, tx.Receiver)
+	}
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return fmt.Errorf("%w: value %s", abi.ErrInvalidArgument, tx.Value)
+	}
+
+	unsignedTx := &transaction.Transaction{
+		Nonce:       tx.Nonce,
+		Value:       value,
+		RcvAddr:     receiver,
+		RcvUserName: tx.ReceiverUsername,
+		SndAddr:     pkBytes,
+		SndUserName: tx.SenderUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     []byte(tx.ChainID),
+		Version:     tx.Version,
+		Options:     tx.Options,
+	}
+	dataToSign, err := unsignedTx.GetDataForSigning(addressConverter, &marshal.JsonMarshalizer{})
+	if err != nil {
+		return err
+	}
+
+	signature, err := (&singlesig.Ed25519Signer{}).Sign(sk, dataToSign)
+	if err != nil {
+		return err
+	}
+
+	tx.Signature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+func sendTransaction(nodeURL string, tx *transaction.FrontendTransaction) error {
+	nodeClient, err := createNodeClient(nodeURL)
+	if err != nil {
+		return err
+	}
+
+	txHash, err := nodeClient.SendTransaction(tx)
+	if err != nil {
+		return err
+	}
+
+	log.Info("transaction sent", "hash", txHash)
+
+	return nil
+}
+
+func createNodeClient(nodeURL string) (client.NodeClient, error) {
+	if len(nodeURL) == 0 {
+		return nil, errMissingNodeURL
+	}
+
+	return client.NewNodeClient(client.ArgsNodeClient{
+		URL:            nodeURL,
+		RequestTimeout: defaultRequestTimeout,
+	})
+}
+
+func loadTransaction(filename string) (*transaction.FrontendTransaction, error) {
+	buff, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.FrontendTransaction{}
+	err = json.Unmarshal(buff, tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the transaction from %s", err, filename)
+	}
+
+	return tx, nil
+}
+
+func outputTransaction(tx *transaction.FrontendTransaction, filename string) error {
+	buff, err := json.MarshalIndent(tx, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if len(filename) == 0 {
+		fmt.Println(string(buff))
+		return nil
+	}
+
+	err = ioutil.WriteFile(filename, buff, core.FileModeReadWrite)
+	if err != nil {
+		return err
+	}
+
+	log.Info("transaction saved", "file", filename)
+
+	return nil
+}
+
+func loadWalletKey(ctx *cli.Context) (crypto.PrivateKey, error) {
+	return keystore.LoadPrivateKey(
+		signing.NewKeyGenerator(ed25519.NewEd25519()),
+		addressConverter,
+		ctx.String(flagKeyFile.Name),
+		ctx.Int(flagKeyIndex.Name),
+		ctx.String(flagPasswordFile.Name),
+	)
+}
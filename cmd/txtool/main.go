@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/urfave/cli"
+)
+
+const addressLen = 32
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .Commands}} [command [command options]]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	log = logger.GetOrCreate("txtool")
+
+	addressConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(addressLen, log)
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Transaction Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary creates, signs and broadcasts the transactions calling the system smart contracts. " +
+		"The function arguments are checked and encoded against the known system smart contract signatures, the gas " +
+		"limit is computed by the node and the transactions can be signed offline, on a cold wallet"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Commands = commands
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error running the transaction tool", "error", err)
+
+		os.Exit(1)
+	}
+}
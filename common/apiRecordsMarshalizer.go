@@ -0,0 +1,11 @@
+package common
+
+import "github.com/ElrondNetwork/elrond-go-core/marshal"
+
+// NewApiRecordsMarshalizer returns the marshalizer used for the records that a node builds only in order to serve
+// them through its API (the ESDT holders index, the ESDT supply history and the economics reports). Those records
+// are never hashed, signed or exchanged with other nodes, so, unlike the consensus data, they do not need a proto
+// definition and are kept as JSON, which holds their big.Int and float values as they are
+func NewApiRecordsMarshalizer() marshal.Marshalizer {
+	return &marshal.JsonMarshalizer{}
+}
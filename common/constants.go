@@ -524,6 +524,19 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricHardforkEpoch is the metric that holds the epoch of the hardfork voted in a passed governance proposal
+const MetricHardforkEpoch = "erd_hardfork_epoch"
+
+// MetricHardforkEpochsRemaining is the metric that counts down the epochs remaining until the governance hardfork
+const MetricHardforkEpochsRemaining = "erd_hardfork_epochs_remaining"
+
+// MetricHardforkSoftwareVersion is the metric that holds the software version required by the governance hardfork
+const MetricHardforkSoftwareVersion = "erd_hardfork_software_version"
+
+// MetricHardforkSoftwareVersionMatches is the metric that tells if the node runs the software version required by the
+// governance hardfork
+const MetricHardforkSoftwareVersionMatches = "erd_hardfork_software_version_matches"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -46,3 +47,9 @@ func AssignShardForPubKeyWhenNotSpecified(pubKey []byte, numShards uint32) uint3
 
 	return randomShardID
 }
+
+// GetMetricForManagedKey returns the name of the metric that holds, for a key hosted by the node besides its main key,
+// the value the provided metric holds for the main key
+func GetMetricForManagedKey(metric string, pkBytes []byte) string {
+	return metric + "_" + hex.EncodeToString(pkBytes)
+}
@@ -328,3 +328,9 @@ func TestShardAssignment(t *testing.T) {
 		fmt.Printf("Shard %d:\n\t\t%d accounts\n", sh, cnt)
 	}
 }
+
+func TestGetMetricForManagedKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, common.MetricConsensusState+"_706b", common.GetMetricForManagedKey(common.MetricConsensusState, []byte("pk")))
+}
@@ -6,3 +6,426 @@ type GetProofResponse struct {
 	Value    []byte
 	RootHash string
 }
+
+// GovernanceConfigResponse holds the thresholds used by the governance system smart contract when closing a proposal
+type GovernanceConfigResponse struct {
+	MinQuorum        string `json:"minQuorum"`
+	MinPassThreshold string `json:"minPassThreshold"`
+	MinVetoThreshold string `json:"minVetoThreshold"`
+	ProposalFee      string `json:"proposalFee"`
+}
+
+// GovernanceProposalResponse holds the decoded state of a governance proposal
+type GovernanceProposalResponse struct {
+	Reference            string   `json:"reference"`
+	Type                 string   `json:"type"`
+	Issuer               string   `json:"issuer"`
+	CommitHash           string   `json:"commitHash"`
+	StartVoteNonce       uint64   `json:"startVoteNonce"`
+	EndVoteNonce         uint64   `json:"endVoteNonce"`
+	Yes                  string   `json:"yes"`
+	No                   string   `json:"no"`
+	Veto                 string   `json:"veto"`
+	Status               string   `json:"status"`
+	Passed               bool     `json:"passed"`
+	Closed               bool     `json:"closed"`
+	QuorumReached        bool     `json:"quorumReached"`
+	PassThresholdReached bool     `json:"passThresholdReached"`
+	VetoThresholdReached bool     `json:"vetoThresholdReached"`
+	EpochToHardFork      uint32   `json:"epochToHardFork,omitempty"`
+	NewSoftwareVersion   string   `json:"newSoftwareVersion,omitempty"`
+	Voters               []string `json:"voters"`
+}
+
+// GovernanceProposalsResponse holds all the governance proposals together with the thresholds they are checked against
+type GovernanceProposalsResponse struct {
+	Config       *GovernanceConfigResponse     `json:"config"`
+	CurrentNonce uint64                        `json:"currentNonce"`
+	Proposals    []*GovernanceProposalResponse `json:"proposals"`
+}
+
+// GovernanceVoteResponse holds a single vote cast on a governance proposal
+type GovernanceVoteResponse struct {
+	Value       string `json:"value"`
+	Power       string `json:"power"`
+	Balance     string `json:"balance"`
+	DelegatedTo string `json:"delegatedTo,omitempty"`
+}
+
+// GovernanceVoteSetResponse holds all the votes cast by a voter on a governance proposal
+type GovernanceVoteSetResponse struct {
+	Proposal    string                    `json:"proposal"`
+	Voter       string                    `json:"voter"`
+	WithFunds   bool                      `json:"withFunds"`
+	UsedPower   string                    `json:"usedPower"`
+	UsedBalance string                    `json:"usedBalance"`
+	TotalYes    string                    `json:"totalYes"`
+	TotalNo     string                    `json:"totalNo"`
+	TotalVeto   string                    `json:"totalVeto"`
+	Votes       []*GovernanceVoteResponse `json:"votes"`
+}
+
+// GovernanceVotesResponse holds the votes cast by an address and the votes delegation contracts cast on its behalf
+type GovernanceVotesResponse struct {
+	Address        string                       `json:"address"`
+	Votes          []*GovernanceVoteSetResponse `json:"votes"`
+	DelegatedVotes []*GovernanceVoteSetResponse `json:"delegatedVotes"`
+}
+
+// DelegationContractConfigResponse holds the configuration of a delegation contract
+type DelegationContractConfigResponse struct {
+	Owner                       string `json:"owner"`
+	ServiceFee                  uint64 `json:"serviceFee"`
+	MaxServiceFee               uint64 `json:"maxServiceFee"`
+	MaxDelegationCap            string `json:"maxDelegationCap"`
+	InitialOwnerFunds           string `json:"initialOwnerFunds"`
+	AutomaticActivation         bool   `json:"automaticActivation"`
+	WithDelegationCap           bool   `json:"withDelegationCap"`
+	ChangeableServiceFee        bool   `json:"changeableServiceFee"`
+	CheckCapOnReDelegateRewards bool   `json:"checkCapOnReDelegateRewards"`
+	CreatedNonce                uint64 `json:"createdNonce"`
+	UnBondPeriodInEpochs        uint32 `json:"unBondPeriodInEpochs"`
+}
+
+// DelegationContractMetaDataResponse holds the metadata set by the owner of a delegation contract
+type DelegationContractMetaDataResponse struct {
+	Name       string `json:"name"`
+	Website    string `json:"website"`
+	Identifier string `json:"identifier"`
+}
+
+// DelegationNodeResponse holds the state of a node managed by a delegation contract
+type DelegationNodeResponse struct {
+	BLSKey string `json:"blsKey"`
+	Status string `json:"status"`
+}
+
+// DelegationServiceFeeResponse holds the service fee of a delegation contract starting with an epoch
+type DelegationServiceFeeResponse struct {
+	Epoch      uint32 `json:"epoch"`
+	ServiceFee uint64 `json:"serviceFee"`
+}
+
+// DelegationRewardResponse holds the rewards a delegation contract received in an epoch
+type DelegationRewardResponse struct {
+	Epoch               uint32 `json:"epoch"`
+	RewardsToDistribute string `json:"rewardsToDistribute"`
+	TotalActive         string `json:"totalActive"`
+	ServiceFee          uint64 `json:"serviceFee"`
+}
+
+// DelegationUnDelegatedFundResponse holds an undelegated fund of a delegator
+type DelegationUnDelegatedFundResponse struct {
+	Value           string `json:"value"`
+	Epoch           uint32 `json:"epoch"`
+	RemainingEpochs uint32 `json:"remainingEpochs"`
+}
+
+// DelegationDelegatorResponse holds the position of a delegator in a delegation contract
+type DelegationDelegatorResponse struct {
+	Address               string                               `json:"address"`
+	ActiveStake           string                               `json:"activeStake"`
+	UnStakedStake         string                               `json:"unStakedStake"`
+	UnBondableStake       string                               `json:"unBondableStake"`
+	UnDelegatedList       []*DelegationUnDelegatedFundResponse `json:"unDelegatedList"`
+	ClaimableRewards      string                               `json:"claimableRewards"`
+	TotalCumulatedRewards string                               `json:"totalCumulatedRewards"`
+	RewardsCheckpoint     uint32                               `json:"rewardsCheckpoint"`
+}
+
+// DelegationContractResponse holds the decoded state of a delegation contract, read from a single state snapshot
+type DelegationContractResponse struct {
+	Address           string                              `json:"address"`
+	BlockNonce        uint64                              `json:"blockNonce"`
+	RootHash          string                              `json:"rootHash"`
+	CurrentEpoch      uint32                              `json:"currentEpoch"`
+	Config            *DelegationContractConfigResponse   `json:"config"`
+	MetaData          *DelegationContractMetaDataResponse `json:"metaData"`
+	TotalActive       string                              `json:"totalActive"`
+	TotalUnStaked     string                              `json:"totalUnStaked"`
+	NumUsers          uint64                              `json:"numUsers"`
+	Nodes             []*DelegationNodeResponse           `json:"nodes"`
+	ServiceFeeHistory []*DelegationServiceFeeResponse     `json:"serviceFeeHistory"`
+	Rewards           []*DelegationRewardResponse         `json:"rewards"`
+	Delegators        []*DelegationDelegatorResponse      `json:"delegators"`
+}
+
+// ESDTRolesResponse holds the special roles granted to an address for an ESDT token
+type ESDTRolesResponse struct {
+	Address string   `json:"address"`
+	Roles   []string `json:"roles"`
+}
+
+// ESDTTokenResponse holds the decoded ESDT system smart contract entry of a token and its role holders
+type ESDTTokenResponse struct {
+	Identifier               string               `json:"identifier"`
+	Name                     string               `json:"name"`
+	Ticker                   string               `json:"ticker"`
+	Type                     string               `json:"type"`
+	Owner                    string               `json:"owner"`
+	Decimals                 uint32               `json:"decimals"`
+	MintedValue              string               `json:"mintedValue"`
+	BurntValue               string               `json:"burntValue"`
+	NumWiped                 uint32               `json:"numWiped"`
+	IsPaused                 bool                 `json:"isPaused"`
+	CanUpgrade               bool                 `json:"canUpgrade"`
+	CanMint                  bool                 `json:"canMint"`
+	CanBurn                  bool                 `json:"canBurn"`
+	CanPause                 bool                 `json:"canPause"`
+	CanFreeze                bool                 `json:"canFreeze"`
+	CanWipe                  bool                 `json:"canWipe"`
+	CanChangeOwner           bool                 `json:"canChangeOwner"`
+	CanAddSpecialRoles       bool                 `json:"canAddSpecialRoles"`
+	CanTransferNFTCreateRole bool                 `json:"canTransferNFTCreateRole"`
+	CanCreateMultiShard      bool                 `json:"canCreateMultiShard"`
+	NFTCreateStopped         bool                 `json:"nftCreateStopped"`
+	NFTCreateRoleOwner       string               `json:"nftCreateRoleOwner,omitempty"`
+	Roles                    []*ESDTRolesResponse `json:"roles"`
+}
+
+// ESDTHolderResponse holds the balance of an ESDT token holder
+type ESDTHolderResponse struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// ESDTHoldersResponse holds a page of the holders of an ESDT token from the self shard
+type ESDTHoldersResponse struct {
+	Token      string                `json:"token"`
+	NumHolders uint32                `json:"numHolders"`
+	Offset     uint32                `json:"offset"`
+	Limit      uint32                `json:"limit"`
+	Holders    []*ESDTHolderResponse `json:"holders"`
+}
+
+// ESDTSupplyHistoryFilter holds the inclusive epoch and timestamp ranges used when fetching the supply history of a token
+// and the page of the matching events to be returned
+type ESDTSupplyHistoryFilter struct {
+	StartEpoch     uint32
+	EndEpoch       uint32
+	StartTimestamp uint64
+	EndTimestamp   uint64
+	Offset         uint32
+	Limit          uint32
+}
+
+// ESDTSupplySnapshotResponse holds the supply of an ESDT token and its cumulative breakdown at the end of an epoch
+type ESDTSupplySnapshotResponse struct {
+	Epoch          uint32 `json:"epoch"`
+	Supply         string `json:"supply"`
+	Minted         string `json:"minted"`
+	Burned         string `json:"burned"`
+	FirstTimestamp uint64 `json:"firstTimestamp"`
+	LastTimestamp  uint64 `json:"lastTimestamp"`
+	LastBlockNonce uint64 `json:"lastBlockNonce"`
+	NumEvents      uint32 `json:"numEvents"`
+}
+
+// ESDTSupplyEventResponse holds a supply changing event of an ESDT token. The amount is empty for the wipe events
+type ESDTSupplyEventResponse struct {
+	TxHash     string `json:"txHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+	Epoch      uint32 `json:"epoch"`
+	Timestamp  uint64 `json:"timestamp"`
+	Identifier string `json:"identifier"`
+	Amount     string `json:"amount,omitempty"`
+}
+
+// ESDTSupplyHistoryResponse holds the supply of an ESDT token, its minted and burned breakdown, the per-epoch
+// snapshots matching the requested ranges and a page of the supply changing events matching the same ranges
+type ESDTSupplyHistoryResponse struct {
+	Token     string                        `json:"token"`
+	Supply    string                        `json:"supply"`
+	Minted    string                        `json:"minted"`
+	Burned    string                        `json:"burned"`
+	Snapshots []*ESDTSupplySnapshotResponse `json:"snapshots"`
+	NumEvents uint32                        `json:"numEvents"`
+	Offset    uint32                        `json:"offset"`
+	Limit     uint32                        `json:"limit"`
+	Events    []*ESDTSupplyEventResponse    `json:"events"`
+}
+
+// ShardEconomicsResponse holds the rewards breakdown of a shard for an epoch
+type ShardEconomicsResponse struct {
+	ShardID                    uint32 `json:"shardID"`
+	NumBlocks                  uint64 `json:"numBlocks"`
+	NumEligibleNodes           uint32 `json:"numEligibleNodes"`
+	BaseRewardsPerBlockPerNode string `json:"baseRewardsPerBlockPerNode"`
+	BaseRewards                string `json:"baseRewards"`
+	TopUpRewards               string `json:"topUpRewards"`
+	LeaderFees                 string `json:"leaderFees"`
+}
+
+// NodeEconomicsResponse holds the rewards computed for a node in an epoch
+type NodeEconomicsResponse struct {
+	PublicKey                  string `json:"publicKey"`
+	ShardID                    uint32 `json:"shardID"`
+	RewardAddress              string `json:"rewardAddress"`
+	NumSelectedInSuccessBlocks uint32 `json:"numSelectedInSuccessBlocks"`
+	LeaderSuccess              uint32 `json:"leaderSuccess"`
+	ValidatorSuccess           uint32 `json:"validatorSuccess"`
+	TopUpStake                 string `json:"topUpStake"`
+	BaseReward                 string `json:"baseReward"`
+	TopUpReward                string `json:"topUpReward"`
+	LeaderFees                 string `json:"leaderFees"`
+	Distributed                bool   `json:"distributed"`
+}
+
+// RewardAddressEconomicsResponse holds the rewards sent to a reward address in an epoch
+type RewardAddressEconomicsResponse struct {
+	Address      string `json:"address"`
+	NumNodes     uint32 `json:"numNodes"`
+	BaseRewards  string `json:"baseRewards"`
+	TopUpRewards string `json:"topUpRewards"`
+	LeaderFees   string `json:"leaderFees"`
+	Total        string `json:"total"`
+}
+
+// EpochEconomicsResponse holds the economics and rewards breakdown computed by the metachain at the start of an epoch
+type EpochEconomicsResponse struct {
+	Epoch                         uint32                            `json:"epoch"`
+	MetaBlockRound                uint64                            `json:"metaBlockRound"`
+	MetaBlockNonce                uint64                            `json:"metaBlockNonce"`
+	PrevEpochStartRound           uint64                            `json:"prevEpochStartRound"`
+	RewardsCreatorVersion         uint32                            `json:"rewardsCreatorVersion"`
+	InflationRate                 float64                           `json:"inflationRate"`
+	TotalSupply                   string                            `json:"totalSupply"`
+	NewlyMinted                   string                            `json:"newlyMinted"`
+	AccumulatedFees               string                            `json:"accumulatedFees"`
+	DeveloperFees                 string                            `json:"developerFees"`
+	LeaderFees                    string                            `json:"leaderFees"`
+	TotalToDistribute             string                            `json:"totalToDistribute"`
+	RewardsPerBlock               string                            `json:"rewardsPerBlock"`
+	RewardsForBlocks              string                            `json:"rewardsForBlocks"`
+	BaseRewards                   string                            `json:"baseRewards"`
+	TopUpRewards                  string                            `json:"topUpRewards"`
+	ProtocolSustainabilityAddress string                            `json:"protocolSustainabilityAddress"`
+	ProtocolSustainabilityRewards string                            `json:"protocolSustainabilityRewards"`
+	NodePrice                     string                            `json:"nodePrice"`
+	NumBlocks                     uint64                            `json:"numBlocks"`
+	Shards                        []*ShardEconomicsResponse         `json:"shards"`
+	Nodes                         []*NodeEconomicsResponse          `json:"nodes"`
+	RewardAddresses               []*RewardAddressEconomicsResponse `json:"rewardAddresses"`
+}
+
+// ValidatorEpochRewardsResponse holds the rewards of a validator for one epoch
+type ValidatorEpochRewardsResponse struct {
+	Epoch         uint32 `json:"epoch"`
+	ShardID       uint32 `json:"shardID"`
+	RewardAddress string `json:"rewardAddress"`
+	BaseReward    string `json:"baseReward"`
+	TopUpReward   string `json:"topUpReward"`
+	LeaderFees    string `json:"leaderFees"`
+	Total         string `json:"total"`
+	Distributed   bool   `json:"distributed"`
+}
+
+// ValidatorRewardsResponse holds the per-epoch rewards history of a validator
+type ValidatorRewardsResponse struct {
+	PublicKey string                           `json:"publicKey"`
+	Epochs    []*ValidatorEpochRewardsResponse `json:"epochs"`
+}
+
+// ValidatorStakingResponse holds the decoded staking system smart contract entry of a BLS key
+type ValidatorStakingResponse struct {
+	Status        string `json:"status"`
+	RegisterNonce uint64 `json:"registerNonce"`
+	StakedNonce   uint64 `json:"stakedNonce"`
+	Staked        bool   `json:"staked"`
+	UnStakedNonce uint64 `json:"unStakedNonce"`
+	UnStakedEpoch uint32 `json:"unStakedEpoch"`
+	RewardAddress string `json:"rewardAddress"`
+	OwnerAddress  string `json:"ownerAddress"`
+	StakeValue    string `json:"stakeValue"`
+	Jailed        bool   `json:"jailed"`
+	JailedRound   uint64 `json:"jailedRound"`
+	JailedNonce   uint64 `json:"jailedNonce"`
+	UnJailedNonce uint64 `json:"unJailedNonce"`
+	NumJailed     uint32 `json:"numJailed"`
+	SlashValue    string `json:"slashValue"`
+	Waiting       bool   `json:"waiting"`
+}
+
+// ValidatorOwnerResponse holds the stake of the validator system smart contract owner of a BLS key
+type ValidatorOwnerResponse struct {
+	Address     string `json:"address"`
+	TotalStaked string `json:"totalStaked"`
+	TopUp       string `json:"topUp"`
+}
+
+// ValidatorQueuePositionResponse holds the 1-based position of a BLS key in the staking queue
+type ValidatorQueuePositionResponse struct {
+	Position uint32 `json:"position"`
+	Length   uint32 `json:"length"`
+}
+
+// ValidatorUnBondResponse holds the remaining unbond period of an unstaked BLS key. The remaining epochs are estimated
+// from the configured number of rounds per epoch
+type ValidatorUnBondResponse struct {
+	UnBondPeriod             uint64 `json:"unBondPeriod"`
+	RemainingNonces          uint64 `json:"remainingNonces"`
+	EstimatedRemainingEpochs uint32 `json:"estimatedRemainingEpochs"`
+	CanUnBond                bool   `json:"canUnBond"`
+}
+
+// ValidatorPeerResponse holds the rating and the list of a BLS key as known from the peer accounts trie
+type ValidatorPeerResponse struct {
+	ShardID        uint32  `json:"shardID"`
+	List           string  `json:"list"`
+	Rating         float32 `json:"rating"`
+	TempRating     float32 `json:"tempRating"`
+	RatingModifier float32 `json:"ratingModifier"`
+}
+
+// ValidatorShufflingResponse holds the position of a BLS key in the nodes coordinator of the current epoch together
+// with the shuffling expectations of its shard
+type ValidatorShufflingResponse struct {
+	Epoch                        uint32  `json:"epoch"`
+	ShardID                      uint32  `json:"shardID"`
+	List                         string  `json:"list"`
+	Index                        int     `json:"index"`
+	NumEligibleInShard           int     `json:"numEligibleInShard"`
+	NumWaitingInShard            int     `json:"numWaitingInShard"`
+	NodesToShufflePerShard       uint32  `json:"nodesToShufflePerShard"`
+	EstimatedEpochsUntilEligible uint32  `json:"estimatedEpochsUntilEligible,omitempty"`
+	ShuffleOutProbability        float64 `json:"shuffleOutProbability,omitempty"`
+}
+
+// ValidatorLifecycleResponse aggregates the staking, ownership, queue, unbond, rating and shuffling status of a BLS
+// key, read from a single state snapshot
+type ValidatorLifecycleResponse struct {
+	PublicKey    string                          `json:"publicKey"`
+	BlockNonce   uint64                          `json:"blockNonce"`
+	CurrentEpoch uint32                          `json:"currentEpoch"`
+	Staking      *ValidatorStakingResponse       `json:"staking"`
+	Owner        *ValidatorOwnerResponse         `json:"owner,omitempty"`
+	Queue        *ValidatorQueuePositionResponse `json:"queue,omitempty"`
+	UnBond       *ValidatorUnBondResponse        `json:"unBond,omitempty"`
+	Peer         *ValidatorPeerResponse          `json:"peer,omitempty"`
+	Shuffling    *ValidatorShufflingResponse     `json:"shuffling,omitempty"`
+}
+
+// StakingQueueFilter holds the owner filter and the page of the matching staking queue entries to be returned
+type StakingQueueFilter struct {
+	Owner  string
+	Offset uint32
+	Limit  uint32
+}
+
+// StakingQueueEntryResponse holds a BLS key from the staking queue
+type StakingQueueEntryResponse struct {
+	Position      uint32 `json:"position"`
+	BLSKey        string `json:"blsKey"`
+	OwnerAddress  string `json:"ownerAddress"`
+	RewardAddress string `json:"rewardAddress"`
+	RegisterNonce uint64 `json:"registerNonce"`
+}
+
+// StakingQueueResponse holds a page of the staking queue entries matching the requested owner
+type StakingQueueResponse struct {
+	BlockNonce   uint64                       `json:"blockNonce"`
+	CurrentEpoch uint32                       `json:"currentEpoch"`
+	Length       uint32                       `json:"length"`
+	NumMatching  uint32                       `json:"numMatching"`
+	Entries      []*StakingQueueEntryResponse `json:"entries"`
+}
@@ -22,6 +22,7 @@ type gasScheduleNotifier struct {
 	gasScheduleConfig config.GasScheduleConfig
 	currentEpoch      uint32
 	lastGasSchedule   GasScheduleMap
+	lastVersion       config.GasScheduleByEpochs
 	handlers          []core.GasScheduleSubscribeHandler
 	arwenChangeLocker common.Locker
 }
@@ -65,7 +66,8 @@ func NewGasScheduleNotifier(args ArgsNewGasScheduleNotifier) (*gasScheduleNotifi
 		return g.gasScheduleConfig.GasScheduleByEpochs[i].StartEpoch < g.gasScheduleConfig.GasScheduleByEpochs[j].StartEpoch
 	})
 	var err error
-	g.lastGasSchedule, err = common.LoadGasScheduleConfig(filepath.Join(g.configDir, args.GasScheduleConfig.GasScheduleByEpochs[0].FileName))
+	g.lastVersion = g.gasScheduleConfig.GasScheduleByEpochs[0]
+	g.lastGasSchedule, err = common.LoadGasScheduleConfig(filepath.Join(g.configDir, g.lastVersion.FileName))
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +153,7 @@ func (g *gasScheduleNotifier) changeLatestGasSchedule(epoch uint32, oldEpoch uin
 	)
 
 	g.lastGasSchedule = newGasSchedule
+	g.lastVersion = newVersion
 
 	return newGasSchedule
 }
@@ -162,6 +165,13 @@ func (g *gasScheduleNotifier) LatestGasSchedule() map[string]map[string]uint64 {
 	return g.lastGasSchedule
 }
 
+// LatestGasScheduleVersion returns the file name of the gas schedule version currently in use
+func (g *gasScheduleNotifier) LatestGasScheduleVersion() string {
+	g.mutNotifier.RLock()
+	defer g.mutNotifier.RUnlock()
+	return g.lastVersion.FileName
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (g *gasScheduleNotifier) IsInterfaceNil() bool {
 	return g == nil
@@ -157,6 +157,21 @@ func TestGasScheduleNotifier_CheckEpochShouldCall(t *testing.T) {
 	assert.Equal(t, g.LatestGasSchedule()["BaseOperationCost"]["AoTPreparePerByte"], uint64(300))
 }
 
+func TestGasScheduleNotifier_LatestGasScheduleVersionShouldFollowEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createGasScheduleNotifierArgs()
+	g, err := NewGasScheduleNotifier(args)
+	require.Nil(t, err)
+	assert.Equal(t, "gasScheduleV1.toml", g.LatestGasScheduleVersion())
+
+	g.EpochConfirmed(1, 0)
+	assert.Equal(t, "gasScheduleV1.toml", g.LatestGasScheduleVersion())
+
+	g.EpochConfirmed(2, 0)
+	assert.Equal(t, "gasScheduleV2.toml", g.LatestGasScheduleVersion())
+}
+
 func TestGasScheduleNotifier_CheckEpochInSyncShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,9 @@
 package common
 
-import "github.com/ElrondNetwork/elrond-go-core/core"
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+)
 
 // NumNodesDTO represents the DTO structure that will hold the number of nodes split by category and other
 // trie structure relevant data such as maximum number of trie levels including the roothash node and all leaves
@@ -84,3 +87,32 @@ type Locker interface {
 type MerkleProofVerifier interface {
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 }
+
+// GasScheduleNotifierAPI defines the behavior of the gas schedule notifier components that is used for api-related calls
+type GasScheduleNotifierAPI interface {
+	core.GasScheduleNotifier
+	LatestGasScheduleVersion() string
+}
+
+// ManagedPeersHolder defines the operations of an entity that holds the extra validator keys hosted by the node
+type ManagedPeersHolder interface {
+	AddManagedPeer(privateKeyBytes []byte) error
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	IsKeyRegistered(pkBytes []byte) bool
+	IsKeyManagedByCurrentNode(pkBytes []byte) bool
+	IncrementRoundsOfInactivity(pkBytes []byte, roundIndex int64)
+	ResetRoundsOfInactivity(pkBytes []byte, pid core.PeerID)
+	GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey
+	IsMultiKeyMode() bool
+	IsInterfaceNil() bool
+}
+
+// KeysSigner defines the operations of an entity able to produce the signatures of the validator keys hosted by the
+// node, without requiring the private keys to be held by the caller
+type KeysSigner interface {
+	SignBlockSignatureShare(pkBytes []byte, round int64, headerHash []byte) ([]byte, error)
+	SignBlockHeader(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error)
+	SignRandSeed(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error)
+	SignPeerID(pkBytes []byte, pid []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
@@ -39,3 +39,11 @@ const MetricP2PNumReceiverPeers = "erd_p2p_num_receiver_peers"
 // MetricP2PPeakNumReceiverPeers represents the peak number of connected peer sent messages to the current peer
 // (and have been received by the current peer) in the amount of time
 const MetricP2PPeakNumReceiverPeers = "erd_p2p_peak_num_receiver_peers"
+
+// MetricP2PEffectiveMaxNumMessagesPerPeer represents the maximum number of messages a peer of a certain class
+// can send in the amount of time, after applying the adaptive quota multipliers
+const MetricP2PEffectiveMaxNumMessagesPerPeer = "erd_p2p_effective_max_num_messages_per_peer"
+
+// MetricP2PEffectiveMaxSizePerPeer represents the maximum size of messages a peer of a certain class can send
+// in the amount of time, after applying the adaptive quota multipliers
+const MetricP2PEffectiveMaxSizePerPeer = "erd_p2p_effective_max_size_per_peer"
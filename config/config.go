@@ -71,6 +71,14 @@ type ConsensusConfig struct {
 	Type string
 }
 
+// SlashingDetectorConfig will hold the configuration of the component that detects the validators signing
+// conflicting data in the same round
+type SlashingDetectorConfig struct {
+	Enabled         bool
+	NumRoundsToKeep int64
+	EvidenceStorage StorageConfig
+}
+
 // NTPConfig will hold the configuration for NTP queries
 type NTPConfig struct {
 	Hosts               []string
@@ -97,6 +105,13 @@ type EpochStartConfig struct {
 	MinNumOfPeersToConsiderBlockValid int
 }
 
+// LocalSnapshotBootstrapConfig will hold the configuration for starting the node from a trusted copy of its storage,
+// regardless of how old it is and without asking the peers for the epoch start data
+type LocalSnapshotBootstrapConfig struct {
+	Enabled                 bool
+	EpochStartMetaBlockHash string
+}
+
 // BlockSizeThrottleConfig will hold the configuration for adaptive block size throttle
 type BlockSizeThrottleConfig struct {
 	MinSizeInBytes uint32
@@ -124,6 +139,7 @@ type Config struct {
 	SmartContractsStorage           StorageConfig
 	SmartContractsStorageForSCQuery StorageConfig
 	TrieEpochRootHashStorage        StorageConfig
+	EpochEconomicsStorage           StorageConfig
 	SmartContractsStorageSimulate   StorageConfig
 
 	BootstrapStorage StorageConfig
@@ -169,6 +185,7 @@ type Config struct {
 	ValidatorStatistics ValidatorStatisticsConfig
 	GeneralSettings     GeneralSettingsConfig
 	Consensus           ConsensusConfig
+	SlashingDetector    SlashingDetectorConfig
 	StoragePruning      StoragePruningConfig
 	LogsAndEvents       LogsAndEventsConfig
 
@@ -177,9 +194,10 @@ type Config struct {
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
 	VirtualMachine          VirtualMachineServicesConfig
 
-	Hardfork HardforkConfig
-	Debug    DebugConfig
-	Health   HealthServiceConfig
+	Hardfork               HardforkConfig
+	LocalSnapshotBootstrap LocalSnapshotBootstrapConfig
+	Debug                  DebugConfig
+	Health                 HealthServiceConfig
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
@@ -323,6 +341,26 @@ type AntifloodConfig struct {
 	WebServer                 WebServerAntifloodConfig
 	Topic                     TopicAntifloodConfig
 	TxAccumulator             TxAccumulatorConfig
+	Adaptive                  AdaptiveAntifloodConfig
+}
+
+// AdaptiveAntifloodConfig will hold the parameters used to scale the per-peer quotas based on the measured node load
+type AdaptiveAntifloodConfig struct {
+	Enabled                          bool
+	EvaluationIntervalInMilliseconds uint32
+	MaxQueueDepth                    uint32
+	MaxCpuLoadPercent                uint64
+	MaxBlockProcessingTimeInMs       uint32
+	Validators                       AdaptiveQuotaCurveConfig
+	Observers                        AdaptiveQuotaCurveConfig
+	Unknown                          AdaptiveQuotaCurveConfig
+}
+
+// AdaptiveQuotaCurveConfig defines how the quota multiplier of a peer class decreases as the node load increases
+type AdaptiveQuotaCurveConfig struct {
+	MinMultiplier float32
+	MaxMultiplier float32
+	Exponent      float32
 }
 
 // FloodPreventerConfig will hold all flood preventer parameters
@@ -387,8 +425,18 @@ type HardforkConfig struct {
 	ValidatorGracePeriodInEpochs uint32
 	EnableTrigger                bool
 	EnableTriggerFromP2P         bool
+	EnableTriggerFromGovernance  bool
 	MustImport                   bool
 	AfterHardFork                bool
+	GenesisFork                  GenesisForkConfig
+}
+
+// GenesisForkConfig holds the configuration for starting a new chain from the state of an existing chain
+type GenesisForkConfig struct {
+	SourceDBPath           string
+	SourceEpoch            uint32
+	Enabled                bool
+	StakeDifferenceAddress string
 }
 
 // LogsAndEventsConfig hold the configuration for the logs and events
@@ -400,6 +448,7 @@ type LogsAndEventsConfig struct {
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
 type DbLookupExtensionsConfig struct {
 	Enabled                            bool
+	ESDTHoldersIndexEnabled            bool
 	DbLookupMaxActivePersisters        uint32
 	MiniblocksMetadataStorageConfig    StorageConfig
 	MiniblockHashByTxHashStorageConfig StorageConfig
@@ -415,6 +464,7 @@ type DebugConfig struct {
 	Antiflood           AntifloodDebugConfig
 	ShuffleOut          ShuffleOutDebugConfig
 	EpochStart          EpochStartDebugConfig
+	ConsensusTracer     ConsensusTracerDebugConfig
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -458,6 +508,14 @@ type EpochStartDebugConfig struct {
 	ProcessDataTrieOnCommitEpoch bool
 }
 
+// ConsensusTracerDebugConfig will hold the consensus round tracer configuration
+type ConsensusTracerDebugConfig struct {
+	Enabled         bool
+	NumRoundsToKeep int
+	ExportToDisk    bool
+	ExportFolder    string
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
@@ -525,6 +583,9 @@ type ConfigurationPathsHolder struct {
 	Genesis                  string
 	SmartContracts           string
 	ValidatorKey             string
+	ValidatorKeystore        string
+	ValidatorKeyPassword     string
+	AllValidatorKeys         string
 	Epoch                    string
 }
 
@@ -2,9 +2,12 @@ package config
 
 // P2PConfig will hold all the P2P settings
 type P2PConfig struct {
-	Node                NodeConfig
-	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
-	Sharding            ShardingConfig
+	Node                 NodeConfig
+	KadDhtPeerDiscovery  KadDhtPeerDiscoveryConfig
+	StaticPeerDiscovery  StaticPeerDiscoveryConfig
+	DNSSeedPeerDiscovery DNSSeedPeerDiscoveryConfig
+	MdnsPeerDiscovery    MdnsPeerDiscoveryConfig
+	Sharding             ShardingConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -26,6 +29,30 @@ type KadDhtPeerDiscoveryConfig struct {
 	RoutingTableRefreshIntervalInSec uint32
 }
 
+// StaticPeerDiscoveryConfig will hold the static (known) peers discovery config settings
+type StaticPeerDiscoveryConfig struct {
+	Enabled                   bool
+	PeersList                 []string
+	ReconnectIntervalInSec    uint32
+	MaxReconnectIntervalInSec uint32
+}
+
+// DNSSeedPeerDiscoveryConfig will hold the DNS TXT records seeds discovery config settings
+type DNSSeedPeerDiscoveryConfig struct {
+	Enabled                   bool
+	Domains                   []string
+	ResolveIntervalInSec      uint32
+	ReconnectIntervalInSec    uint32
+	MaxReconnectIntervalInSec uint32
+}
+
+// MdnsPeerDiscoveryConfig will hold the LAN multicast DNS discovery config settings
+type MdnsPeerDiscoveryConfig struct {
+	Enabled            bool
+	ServiceTag         string
+	QueryIntervalInSec uint32
+}
+
 // ShardingConfig will hold the network sharding config settings
 type ShardingConfig struct {
 	TargetPeerCount         uint32
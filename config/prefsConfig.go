@@ -2,7 +2,8 @@ package config
 
 // Preferences will hold the configuration related to node's preferences
 type Preferences struct {
-	Preferences PreferencesConfig
+	Preferences  PreferencesConfig
+	RemoteSigner RemoteSignerConfig
 }
 
 // PreferencesConfig will hold the fields which are node specific such as the display name
@@ -14,3 +15,16 @@ type PreferencesConfig struct {
 	PreferredConnections       []string
 	FullArchive                bool
 }
+
+// RemoteSignerConfig will hold the configuration of the remote signer holding the validator key. The connection is
+// authenticated on both ends using the provided certificates
+type RemoteSignerConfig struct {
+	Enabled                      bool
+	URL                          string
+	PublicKey                    string
+	CertificateFile              string
+	KeyFile                      string
+	CACertificateFile            string
+	RequestTimeoutInMilliseconds uint32
+	SlashingProtectionFile       string
+}
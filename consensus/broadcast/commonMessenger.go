@@ -43,6 +43,7 @@ type commonMessenger struct {
 	shardCoordinator        sharding.Coordinator
 	peerSignatureHandler    crypto.PeerSignatureHandler
 	delayedBlockBroadcaster delayedBroadcaster
+	managedPeersHolder      common.ManagedPeersHolder
 }
 
 // CommonMessengerArgs holds the arguments for creating commonMessenger instance
@@ -58,6 +59,7 @@ type CommonMessengerArgs struct {
 	MaxDelayCacheSize          uint32
 	MaxValidatorDelayCacheSize uint32
 	AlarmScheduler             core.TimersScheduler
+	ManagedPeersHolder         common.ManagedPeersHolder
 }
 
 func checkCommonMessengerNilParameters(
@@ -93,13 +95,16 @@ func checkCommonMessengerNilParameters(
 	if args.MaxDelayCacheSize == 0 || args.MaxValidatorDelayCacheSize == 0 {
 		return spos.ErrInvalidCacheSize
 	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return spos.ErrNilManagedPeersHolder
+	}
 
 	return nil
 }
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
-	signature, err := cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	signature, err := cm.peerSignatureHandler.GetPeerSignature(cm.getPrivateKey(message.PubKey), message.OriginatorPid)
 	if err != nil {
 		return err
 	}
@@ -119,6 +124,22 @@ func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message)
 	return nil
 }
 
+// getPrivateKey returns the private key matching the public key of the message, which can be one of the keys hosted
+// by the node besides its main key
+func (cm *commonMessenger) getPrivateKey(pkBytes []byte) crypto.PrivateKey {
+	if !cm.managedPeersHolder.IsKeyRegistered(pkBytes) {
+		return cm.privateKey
+	}
+
+	privateKey, err := cm.managedPeersHolder.GetPrivateKey(pkBytes)
+	if err != nil {
+		log.Warn("commonMessenger.getPrivateKey", "error", err.Error())
+		return cm.privateKey
+	}
+
+	return privateKey
+}
+
 // BroadcastMiniBlocks will send on miniblocks topic the cross-shard miniblocks
 func (cm *commonMessenger) BroadcastMiniBlocks(miniBlocks map[uint32][]byte) error {
 	for k, v := range miniBlocks {
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -48,6 +49,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -76,6 +78,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -83,6 +86,48 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCommonMessenger_BroadcastConsensusMessageShouldSignWithManagedKey(t *testing.T) {
+	managedPkBytes := []byte("managed pk")
+	managedPrivateKey := &mock.PrivateKeyMock{}
+	privateKeyMock := &mock.PrivateKeyMock{}
+	var usedPrivateKey crypto.PrivateKey
+	singleSignerMock := &mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			usedPrivateKey = private
+			return []byte(""), nil
+		},
+	}
+	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
+	managedPeersHolder := &testscommon.ManagedPeersHolderStub{
+		IsKeyRegisteredCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == string(managedPkBytes)
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return managedPrivateKey, nil
+		},
+	}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+			},
+		},
+		privateKeyMock,
+		&mock.ShardCoordinatorMock{},
+		peerSigHandler,
+		managedPeersHolder,
+	)
+
+	err := cm.BroadcastConsensusMessage(&consensus.Message{PubKey: managedPkBytes})
+	assert.Nil(t, err)
+	assert.True(t, usedPrivateKey == managedPrivateKey)
+
+	err = cm.BroadcastConsensusMessage(&consensus.Message{PubKey: []byte("main pk")})
+	assert.Nil(t, err)
+	assert.True(t, usedPrivateKey == privateKeyMock)
+}
+
 func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 	err := errors.New("sign message error")
 	marshalizerMock := &mock.MarshalizerMock{}
@@ -102,6 +147,7 @@ func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -157,6 +203,7 @@ func TestSubroundEndRound_ExtractMiniBlocksAndTransactionsShouldWork(t *testing.
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	metaMiniBlocks, metaTransactions := cm.ExtractMetaMiniBlocksAndTransactions(miniBlocks, transactions)
@@ -199,6 +246,7 @@ func TestCommonMessenger_BroadcastBlockData(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	miniBlocks := map[uint32][]byte{0: []byte("mbs data1"), 1: []byte("mbs data2")}
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -18,7 +19,7 @@ type HeaderDataForValidator struct {
 
 // SignMessage will sign and return the given message
 func (cm *commonMessenger) SignMessage(message *consensus.Message) ([]byte, error) {
-	return cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	return cm.peerSignatureHandler.GetPeerSignature(cm.getPrivateKey(message.PubKey), message.OriginatorPid)
 }
 
 // ExtractMetaMiniBlocksAndTransactions -
@@ -178,6 +179,7 @@ func NewCommonMessenger(
 	privateKey crypto.PrivateKey,
 	shardCoordinator sharding.Coordinator,
 	peerSigHandler crypto.PeerSignatureHandler,
+	managedPeersHolder common.ManagedPeersHolder,
 ) (*commonMessenger, error) {
 
 	return &commonMessenger{
@@ -186,5 +188,6 @@ func NewCommonMessenger(
 		privateKey:           privateKey,
 		shardCoordinator:     shardCoordinator,
 		peerSignatureHandler: peerSigHandler,
+		managedPeersHolder:   managedPeersHolder,
 	}, nil
 }
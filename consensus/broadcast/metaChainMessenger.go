@@ -53,6 +53,7 @@ func NewMetaChainMessenger(
 		privateKey:              args.PrivateKey,
 		shardCoordinator:        args.ShardCoordinator,
 		peerSignatureHandler:    args.PeerSignatureHandler,
+		managedPeersHolder:      args.ManagedPeersHolder,
 		delayedBlockBroadcaster: dbb,
 	}
 
@@ -75,6 +75,7 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			MaxDelayCacheSize:          1,
 			MaxValidatorDelayCacheSize: 1,
 			AlarmScheduler:             alarmScheduler,
+			ManagedPeersHolder:         &testscommon.ManagedPeersHolderStub{},
 		},
 	}
 }
@@ -124,6 +125,15 @@ func TestShardChainMessenger_NewShardChainMessengerNilPeerSignatureHandlerShould
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilManagedPeersHolderShouldFail(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.ManagedPeersHolder = nil
+	scm, err := broadcast.NewShardChainMessenger(args)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilManagedPeersHolder, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerNilInterceptorsContainerShouldFail(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	args.InterceptorsContainer = nil
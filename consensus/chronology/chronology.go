@@ -40,6 +40,7 @@ type chronology struct {
 	subroundHandlers []consensus.SubroundHandler
 	mutSubrounds     sync.RWMutex
 	appStatusHandler core.AppStatusHandler
+	roundTracer      consensus.RoundTracer
 	cancelFunc       func()
 
 	watchdog core.WatchdogTimer
@@ -58,6 +59,7 @@ func NewChronology(arg ArgChronology) (*chronology, error) {
 		roundHandler:     arg.RoundHandler,
 		syncTimer:        arg.SyncTimer,
 		appStatusHandler: arg.AppStatusHandler,
+		roundTracer:      arg.RoundTracer,
 		watchdog:         arg.Watchdog,
 	}
 
@@ -83,6 +85,9 @@ func checkNewChronologyParams(arg ArgChronology) error {
 	if check.IfNil(arg.AppStatusHandler) {
 		return ErrNilAppStatusHandler
 	}
+	if check.IfNil(arg.RoundTracer) {
+		return ErrNilRoundTracer
+	}
 
 	return nil
 }
@@ -149,7 +154,11 @@ func (chr *chronology) startRound() {
 	log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 	logger.SetCorrelationSubround(sr.Name())
 
-	if !sr.DoWork(chr.roundHandler) {
+	roundIndex := chr.roundHandler.Index()
+	chr.roundTracer.StartSubround(roundIndex, sr.Name())
+	finished := sr.DoWork(chr.roundHandler)
+	chr.roundTracer.EndSubround(roundIndex, sr.Name(), finished)
+	if !finished {
 		chr.subroundId = srBeforeStartRound
 		return
 	}
@@ -182,6 +191,7 @@ func (chr *chronology) initRound() {
 
 	if hasSubroundsAndGenesisTimePassed {
 		chr.subroundId = chr.subroundHandlers[0].Current()
+		chr.roundTracer.StartRound(chr.roundHandler.Index(), chr.roundHandler.TimeStamp())
 		chr.appStatusHandler.SetUInt64Value(common.MetricCurrentRound, uint64(chr.roundHandler.Index()))
 		chr.appStatusHandler.SetUInt64Value(common.MetricCurrentRoundTimestamp, uint64(chr.roundHandler.TimeStamp().Unix()))
 	}
@@ -73,6 +73,17 @@ func TestChronology_NewChronologyNilAppStatusHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, err, chronology.ErrNilAppStatusHandler)
 }
 
+func TestChronology_NewChronologyNilRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	arg := getDefaultChronologyArg()
+	arg.RoundTracer = nil
+	chr, err := chronology.NewChronology(arg)
+
+	assert.Nil(t, chr)
+	assert.Equal(t, err, chronology.ErrNilRoundTracer)
+}
+
 func TestChronology_NewChronologyShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -182,6 +193,40 @@ func TestChronology_StartRoundShouldWork(t *testing.T) {
 	assert.Equal(t, srm.Next(), chr.SubroundId())
 }
 
+func TestChronology_StartRoundShouldTraceSubround(t *testing.T) {
+	t.Parallel()
+
+	arg := getDefaultChronologyArg()
+	roundHandlerMock := &mock.RoundHandlerMock{}
+	roundHandlerMock.UpdateRound(roundHandlerMock.TimeStamp(), roundHandlerMock.TimeStamp().Add(roundHandlerMock.TimeDuration()))
+	arg.RoundHandler = roundHandlerMock
+	startedSubround := ""
+	endedSubround := ""
+	subroundFinished := false
+	arg.RoundTracer = &mock.RoundTracerStub{
+		StartSubroundCalled: func(round int64, subroundName string) {
+			startedSubround = subroundName
+		},
+		EndSubroundCalled: func(round int64, subroundName string, finished bool) {
+			endedSubround = subroundName
+			subroundFinished = finished
+		},
+	}
+	chr, _ := chronology.NewChronology(arg)
+
+	srm := initSubroundHandlerMock()
+	srm.DoWorkCalled = func(roundHandler consensus.RoundHandler) bool {
+		return true
+	}
+	chr.AddSubround(srm)
+	chr.SetSubroundId(0)
+	chr.StartRound()
+
+	assert.Equal(t, "(TEST)", startedSubround)
+	assert.Equal(t, "(TEST)", endedSubround)
+	assert.True(t, subroundFinished)
+}
+
 func TestChronology_UpdateRoundShouldInitRound(t *testing.T) {
 	t.Parallel()
 
@@ -319,5 +364,6 @@ func getDefaultChronologyArg() chronology.ArgChronology {
 		SyncTimer:        &mock.SyncTimerMock{},
 		AppStatusHandler: statusHandlerMock.NewAppStatusHandlerMock(),
 		Watchdog:         &mock.WatchdogMock{},
+		RoundTracer:      &mock.RoundTracerStub{},
 	}
 }
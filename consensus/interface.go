@@ -138,3 +138,29 @@ type NodeRedundancyHandler interface {
 	ObserverPrivateKey() crypto.PrivateKey
 	IsInterfaceNil() bool
 }
+
+// RoundTracer records the timeline of the consensus events that happened in each round
+type RoundTracer interface {
+	StartRound(round int64, roundTimeStamp time.Time)
+	StartSubround(round int64, subroundName string)
+	EndSubround(round int64, subroundName string, finished bool)
+	SetLeader(round int64, leader []byte)
+	BlockProposed(round int64, headerHash []byte, selfProposed bool)
+	SignatureReceived(round int64, pubKey []byte, pid core.PeerID)
+	AggregatedSignatureReady(round int64)
+	BlockCommitted(round int64, nonce uint64)
+	GetRounds() []*RoundTrace
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// EquivocationDetector keeps the messages signed by the validators in each round and builds slashing evidences when
+// a validator signs conflicting data
+type EquivocationDetector interface {
+	AddSignatureShare(round int64, pubKey []byte, headerHash []byte, signatureShare []byte)
+	AddHeader(headerHash []byte, header data.HeaderHandler)
+	VerifyEvidence(evidence *SlashingEvidence) error
+	GetEvidences() []*SlashingEvidence
+	Close() error
+	IsInterfaceNil() bool
+}
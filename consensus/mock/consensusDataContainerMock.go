@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -35,6 +36,9 @@ type ConsensusCoreMock struct {
 	headerSigVerifier       consensus.HeaderSigVerifier
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
 	nodeRedundancyHandler   consensus.NodeRedundancyHandler
+	roundTracer             consensus.RoundTracer
+	managedPeersHolder      common.ManagedPeersHolder
+	keysSigner              common.KeysSigner
 }
 
 // GetAntiFloodHandler -
@@ -222,6 +226,36 @@ func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler con
 	ccm.nodeRedundancyHandler = nodeRedundancyHandler
 }
 
+// RoundTracer -
+func (ccm *ConsensusCoreMock) RoundTracer() consensus.RoundTracer {
+	return ccm.roundTracer
+}
+
+// SetRoundTracer -
+func (ccm *ConsensusCoreMock) SetRoundTracer(roundTracer consensus.RoundTracer) {
+	ccm.roundTracer = roundTracer
+}
+
+// ManagedPeersHolder -
+func (ccm *ConsensusCoreMock) ManagedPeersHolder() common.ManagedPeersHolder {
+	return ccm.managedPeersHolder
+}
+
+// SetManagedPeersHolder -
+func (ccm *ConsensusCoreMock) SetManagedPeersHolder(managedPeersHolder common.ManagedPeersHolder) {
+	ccm.managedPeersHolder = managedPeersHolder
+}
+
+// KeysSigner -
+func (ccm *ConsensusCoreMock) KeysSigner() common.KeysSigner {
+	return ccm.keysSigner
+}
+
+// SetKeysSigner -
+func (ccm *ConsensusCoreMock) SetKeysSigner(keysSigner common.KeysSigner) {
+	ccm.keysSigner = keysSigner
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	AddSignatureShareCalled func(round int64, pubKey []byte, headerHash []byte, signatureShare []byte)
+	AddHeaderCalled         func(headerHash []byte, header data.HeaderHandler)
+	VerifyEvidenceCalled    func(evidence *consensus.SlashingEvidence) error
+	GetEvidencesCalled      func() []*consensus.SlashingEvidence
+}
+
+// AddSignatureShare -
+func (stub *EquivocationDetectorStub) AddSignatureShare(round int64, pubKey []byte, headerHash []byte, signatureShare []byte) {
+	if stub.AddSignatureShareCalled != nil {
+		stub.AddSignatureShareCalled(round, pubKey, headerHash, signatureShare)
+	}
+}
+
+// AddHeader -
+func (stub *EquivocationDetectorStub) AddHeader(headerHash []byte, header data.HeaderHandler) {
+	if stub.AddHeaderCalled != nil {
+		stub.AddHeaderCalled(headerHash, header)
+	}
+}
+
+// VerifyEvidence -
+func (stub *EquivocationDetectorStub) VerifyEvidence(evidence *consensus.SlashingEvidence) error {
+	if stub.VerifyEvidenceCalled != nil {
+		return stub.VerifyEvidenceCalled(evidence)
+	}
+
+	return nil
+}
+
+// GetEvidences -
+func (stub *EquivocationDetectorStub) GetEvidences() []*consensus.SlashingEvidence {
+	if stub.GetEvidencesCalled != nil {
+		return stub.GetEvidencesCalled()
+	}
+
+	return make([]*consensus.SlashingEvidence, 0)
+}
+
+// Close -
+func (stub *EquivocationDetectorStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
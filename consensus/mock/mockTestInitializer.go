@@ -136,6 +136,9 @@ func InitConsensusCoreWithMultiSigner(multiSigner crypto.MultiSigner) *Consensus
 	headerSigVerifier := &HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &NodeRedundancyHandlerStub{}
+	roundTracer := &RoundTracerStub{}
+	managedPeersHolder := &testscommon.ManagedPeersHolderStub{}
+	keysSigner := &testscommon.KeysSignerStub{}
 
 	container := &ConsensusCoreMock{
 		blockChain:              blockChain,
@@ -159,6 +162,9 @@ func InitConsensusCoreWithMultiSigner(multiSigner crypto.MultiSigner) *Consensus
 		headerSigVerifier:       headerSigVerifier,
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
+		roundTracer:             roundTracer,
+		managedPeersHolder:      managedPeersHolder,
+		keysSigner:              keysSigner,
 	}
 
 	return container
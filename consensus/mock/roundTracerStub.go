@@ -0,0 +1,96 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// RoundTracerStub -
+type RoundTracerStub struct {
+	StartRoundCalled               func(round int64, roundTimeStamp time.Time)
+	StartSubroundCalled            func(round int64, subroundName string)
+	EndSubroundCalled              func(round int64, subroundName string, finished bool)
+	SetLeaderCalled                func(round int64, leader []byte)
+	BlockProposedCalled            func(round int64, headerHash []byte, selfProposed bool)
+	SignatureReceivedCalled        func(round int64, pubKey []byte, pid core.PeerID)
+	AggregatedSignatureReadyCalled func(round int64)
+	BlockCommittedCalled           func(round int64, nonce uint64)
+	GetRoundsCalled                func() []*consensus.RoundTrace
+}
+
+// StartRound -
+func (stub *RoundTracerStub) StartRound(round int64, roundTimeStamp time.Time) {
+	if stub.StartRoundCalled != nil {
+		stub.StartRoundCalled(round, roundTimeStamp)
+	}
+}
+
+// StartSubround -
+func (stub *RoundTracerStub) StartSubround(round int64, subroundName string) {
+	if stub.StartSubroundCalled != nil {
+		stub.StartSubroundCalled(round, subroundName)
+	}
+}
+
+// EndSubround -
+func (stub *RoundTracerStub) EndSubround(round int64, subroundName string, finished bool) {
+	if stub.EndSubroundCalled != nil {
+		stub.EndSubroundCalled(round, subroundName, finished)
+	}
+}
+
+// SetLeader -
+func (stub *RoundTracerStub) SetLeader(round int64, leader []byte) {
+	if stub.SetLeaderCalled != nil {
+		stub.SetLeaderCalled(round, leader)
+	}
+}
+
+// BlockProposed -
+func (stub *RoundTracerStub) BlockProposed(round int64, headerHash []byte, selfProposed bool) {
+	if stub.BlockProposedCalled != nil {
+		stub.BlockProposedCalled(round, headerHash, selfProposed)
+	}
+}
+
+// SignatureReceived -
+func (stub *RoundTracerStub) SignatureReceived(round int64, pubKey []byte, pid core.PeerID) {
+	if stub.SignatureReceivedCalled != nil {
+		stub.SignatureReceivedCalled(round, pubKey, pid)
+	}
+}
+
+// AggregatedSignatureReady -
+func (stub *RoundTracerStub) AggregatedSignatureReady(round int64) {
+	if stub.AggregatedSignatureReadyCalled != nil {
+		stub.AggregatedSignatureReadyCalled(round)
+	}
+}
+
+// BlockCommitted -
+func (stub *RoundTracerStub) BlockCommitted(round int64, nonce uint64) {
+	if stub.BlockCommittedCalled != nil {
+		stub.BlockCommittedCalled(round, nonce)
+	}
+}
+
+// GetRounds -
+func (stub *RoundTracerStub) GetRounds() []*consensus.RoundTrace {
+	if stub.GetRoundsCalled != nil {
+		return stub.GetRoundsCalled()
+	}
+
+	return make([]*consensus.RoundTrace, 0)
+}
+
+// Close -
+func (stub *RoundTracerStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RoundTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
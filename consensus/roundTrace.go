@@ -0,0 +1,38 @@
+package consensus
+
+// SubroundTrace holds the timing of a subround, relative to the round start
+type SubroundTrace struct {
+	Name        string `json:"name"`
+	StartOffset int64  `json:"startOffsetMs"`
+	EndOffset   int64  `json:"endOffsetMs"`
+	Finished    bool   `json:"finished"`
+}
+
+// BlockProposalTrace holds the information about the block proposed in a round
+type BlockProposalTrace struct {
+	HeaderHash   string `json:"headerHash"`
+	Offset       int64  `json:"offsetMs"`
+	SelfProposed bool   `json:"selfProposed"`
+}
+
+// SignatureTrace holds the information about a signature share received by the leader
+type SignatureTrace struct {
+	PubKey  string `json:"pubKey"`
+	PeerID  string `json:"peerID"`
+	Offset  int64  `json:"offsetMs"`
+	Latency int64  `json:"latencyMs"`
+}
+
+// RoundTrace holds all the consensus events recorded in a round
+type RoundTrace struct {
+	Round                     int64               `json:"round"`
+	RoundTimeStamp            int64               `json:"roundTimeStamp"`
+	Leader                    string              `json:"leader"`
+	Subrounds                 []*SubroundTrace    `json:"subrounds"`
+	BlockProposal             *BlockProposalTrace `json:"blockProposal,omitempty"`
+	Signatures                []*SignatureTrace   `json:"signatures"`
+	AggregatedSignatureOffset int64               `json:"aggregatedSignatureOffsetMs"`
+	HasAggregatedSignature    bool                `json:"hasAggregatedSignature"`
+	Outcome                   string              `json:"outcome"`
+	CommittedNonce            uint64              `json:"committedNonce,omitempty"`
+}
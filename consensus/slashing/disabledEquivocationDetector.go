@@ -0,0 +1,44 @@
+package slashing
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var _ consensus.EquivocationDetector = (*disabledEquivocationDetector)(nil)
+
+type disabledEquivocationDetector struct {
+}
+
+// NewDisabledEquivocationDetector returns a disabled instance of the equivocation detector
+func NewDisabledEquivocationDetector() *disabledEquivocationDetector {
+	return &disabledEquivocationDetector{}
+}
+
+// AddSignatureShare does nothing
+func (ded *disabledEquivocationDetector) AddSignatureShare(_ int64, _ []byte, _ []byte, _ []byte) {
+}
+
+// AddHeader does nothing
+func (ded *disabledEquivocationDetector) AddHeader(_ []byte, _ data.HeaderHandler) {
+}
+
+// VerifyEvidence returns nil
+func (ded *disabledEquivocationDetector) VerifyEvidence(_ *consensus.SlashingEvidence) error {
+	return nil
+}
+
+// GetEvidences returns an empty slice
+func (ded *disabledEquivocationDetector) GetEvidences() []*consensus.SlashingEvidence {
+	return make([]*consensus.SlashingEvidence, 0)
+}
+
+// Close returns nil
+func (ded *disabledEquivocationDetector) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ded *disabledEquivocationDetector) IsInterfaceNil() bool {
+	return ded == nil
+}
@@ -0,0 +1,395 @@
+package slashing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ consensus.EquivocationDetector = (*equivocationDetector)(nil)
+
+var log = logger.GetOrCreate("consensus/slashing")
+
+// ArgsEquivocationDetector holds the arguments needed to create an equivocation detector
+type ArgsEquivocationDetector struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	NodesCoordinator  sharding.NodesCoordinator
+	KeyGenerator      crypto.KeyGenerator
+	SingleSigVerifier crypto.SingleSigner
+	ShardCoordinator  sharding.Coordinator
+	SyncTimer         ntp.SyncTimer
+	Storer            storage.Storer
+	OutportHandler    outport.OutportHandler
+	NumRoundsToKeep   int64
+}
+
+type roundMessages struct {
+	signatureShares map[string]*consensus.SignedProof
+	proposals       map[string]*proposedHeader
+}
+
+type proposedHeader struct {
+	shardID uint32
+	proof   *consensus.SignedProof
+}
+
+// equivocationDetector keeps, for the last rounds, the first signature share and the first proposed header of each
+// validator. When a validator signs a different header hash in the same round, or a leader proposes a different
+// header in the same round, a verifiable slashing evidence is built, persisted and pushed to the outport
+type equivocationDetector struct {
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	nodesCoordinator  sharding.NodesCoordinator
+	keyGenerator      crypto.KeyGenerator
+	singleSigVerifier crypto.SingleSigner
+	shardCoordinator  sharding.Coordinator
+	syncTimer         ntp.SyncTimer
+	storer            storage.Storer
+	outportHandler    outport.OutportHandler
+	numRoundsToKeep   int64
+
+	mutMessages  sync.Mutex
+	messages     map[int64]*roundMessages
+	highestRound int64
+
+	mutEvidences sync.RWMutex
+	evidences    []*consensus.SlashingEvidence
+	reported     map[string]struct{}
+}
+
+// NewEquivocationDetector creates a new equivocation detector instance. The evidences already persisted in the
+// provided storer are loaded, so they are not reported again
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ed := &equivocationDetector{
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+		nodesCoordinator:  args.NodesCoordinator,
+		keyGenerator:      args.KeyGenerator,
+		singleSigVerifier: args.SingleSigVerifier,
+		shardCoordinator:  args.ShardCoordinator,
+		syncTimer:         args.SyncTimer,
+		storer:            args.Storer,
+		outportHandler:    args.OutportHandler,
+		numRoundsToKeep:   args.NumRoundsToKeep,
+		messages:          make(map[int64]*roundMessages),
+		evidences:         make([]*consensus.SlashingEvidence, 0),
+		reported:          make(map[string]struct{}),
+	}
+	ed.loadPersistedEvidences()
+
+	return ed, nil
+}
+
+func checkArgs(args ArgsEquivocationDetector) error {
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigVerifier) {
+		return ErrNilSingleSigVerifier
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.SyncTimer) {
+		return ErrNilSyncTimer
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.OutportHandler) {
+		return ErrNilOutportHandler
+	}
+	if args.NumRoundsToKeep < 1 {
+		return fmt.Errorf("%w, provided %d", ErrInvalidNumRoundsToKeep, args.NumRoundsToKeep)
+	}
+
+	return nil
+}
+
+func (ed *equivocationDetector) loadPersistedEvidences() {
+	ed.storer.RangeKeys(func(key []byte, val []byte) bool {
+		evidence := &consensus.SlashingEvidence{}
+		err := json.Unmarshal(val, evidence)
+		if err != nil {
+			log.Debug("equivocationDetector: cannot load persisted evidence", "key", key, "error", err)
+			return true
+		}
+
+		ed.evidences = append(ed.evidences, evidence)
+		ed.reported[string(evidence.Hash)] = struct{}{}
+
+		return true
+	})
+}
+
+// AddSignatureShare records the signature share sent by a validator in the provided round. If the validator already
+// signed a different header hash in the same round, a double signing evidence is built
+func (ed *equivocationDetector) AddSignatureShare(round int64, pubKey []byte, headerHash []byte, signatureShare []byte) {
+	if len(pubKey) == 0 || len(headerHash) == 0 || len(signatureShare) == 0 {
+		return
+	}
+
+	newProof := &consensus.SignedProof{
+		HeaderHash: headerHash,
+		Signature:  signatureShare,
+	}
+
+	ed.mutMessages.Lock()
+	messages, ok := ed.getRoundMessages(round)
+	if !ok {
+		ed.mutMessages.Unlock()
+		return
+	}
+
+	var evidence *consensus.SlashingEvidence
+	shardID := ed.shardCoordinator.SelfId()
+	existingProof, found := messages.signatureShares[string(pubKey)]
+	if !found {
+		messages.signatureShares[string(pubKey)] = newProof
+	} else if !bytes.Equal(existingProof.HeaderHash, headerHash) {
+		var shouldReplace bool
+		evidence, shouldReplace = ed.resolveConflict(consensus.DoubleSigningEvidence, pubKey, shardID, round, existingProof, newProof)
+		if shouldReplace {
+			messages.signatureShares[string(pubKey)] = newProof
+		}
+	}
+	ed.mutMessages.Unlock()
+
+	ed.report(evidence)
+}
+
+// AddHeader records the header proposed by the leader of the header's round. If the same leader already proposed a
+// different header in the same round, an equivocating proposer evidence is built
+func (ed *equivocationDetector) AddHeader(headerHash []byte, header data.HeaderHandler) {
+	if len(headerHash) == 0 || check.IfNil(header) || len(header.GetLeaderSignature()) == 0 {
+		return
+	}
+
+	leader, err := ed.getLeader(header)
+	if err != nil {
+		log.Trace("equivocationDetector.AddHeader: cannot compute leader",
+			"round", header.GetRound(), "shard", header.GetShardID(), "error", err)
+		return
+	}
+	headerBytes, err := ed.marshalizer.Marshal(header)
+	if err != nil {
+		return
+	}
+
+	newProposal := &proposedHeader{
+		shardID: header.GetShardID(),
+		proof: &consensus.SignedProof{
+			HeaderHash: headerHash,
+			Header:     headerBytes,
+			Signature:  header.GetLeaderSignature(),
+		},
+	}
+
+	round := int64(header.GetRound())
+	ed.mutMessages.Lock()
+	messages, ok := ed.getRoundMessages(round)
+	if !ok {
+		ed.mutMessages.Unlock()
+		return
+	}
+
+	var evidence *consensus.SlashingEvidence
+	existingProposal, found := messages.proposals[string(leader)]
+	if !found {
+		messages.proposals[string(leader)] = newProposal
+	} else if !bytes.Equal(existingProposal.proof.HeaderHash, headerHash) {
+		var shouldReplace bool
+		evidence, shouldReplace = ed.resolveConflict(consensus.EquivocatingProposerEvidence, leader, newProposal.shardID, round, existingProposal.proof, newProposal.proof)
+		if shouldReplace {
+			messages.proposals[string(leader)] = newProposal
+		}
+	}
+	ed.mutMessages.Unlock()
+
+	ed.report(evidence)
+}
+
+// getRoundMessages returns the messages stored for the provided round, removing the rounds that are too old.
+// It returns false if the round itself is too old. Should be called under mutex protection
+func (ed *equivocationDetector) getRoundMessages(round int64) (*roundMessages, bool) {
+	if round > ed.highestRound {
+		ed.highestRound = round
+		for r := range ed.messages {
+			if r <= ed.highestRound-ed.numRoundsToKeep {
+				delete(ed.messages, r)
+			}
+		}
+	}
+	if round <= ed.highestRound-ed.numRoundsToKeep {
+		return nil, false
+	}
+
+	messages, found := ed.messages[round]
+	if !found {
+		messages = &roundMessages{
+			signatureShares: make(map[string]*consensus.SignedProof),
+			proposals:       make(map[string]*proposedHeader),
+		}
+		ed.messages[round] = messages
+	}
+
+	return messages, true
+}
+
+// resolveConflict verifies the two conflicting proofs. The signatures are checked only when a conflict appears, so
+// an incorrectly signed proof stored for the validator is replaced by the new one, if the new one is correct. An
+// evidence is returned only if both proofs are correctly signed
+func (ed *equivocationDetector) resolveConflict(
+	evidenceType consensus.SlashingEvidenceType,
+	pubKey []byte,
+	shardID uint32,
+	round int64,
+	existingProof *consensus.SignedProof,
+	newProof *consensus.SignedProof,
+) (*consensus.SlashingEvidence, bool) {
+	err := ed.verifyProof(evidenceType, pubKey, shardID, uint64(round), newProof)
+	if err != nil {
+		log.Debug("equivocationDetector: conflicting message is not correctly signed",
+			"type", evidenceType, "pk", pubKey, "round", round, "hash", newProof.HeaderHash, "error", err)
+		return nil, false
+	}
+
+	err = ed.verifyProof(evidenceType, pubKey, shardID, uint64(round), existingProof)
+	if err != nil {
+		log.Debug("equivocationDetector: stored message is not correctly signed, replacing it",
+			"type", evidenceType, "pk", pubKey, "round", round, "hash", existingProof.HeaderHash, "error", err)
+		return nil, true
+	}
+
+	return ed.buildEvidence(evidenceType, pubKey, shardID, round, existingProof, newProof), false
+}
+
+func (ed *equivocationDetector) buildEvidence(
+	evidenceType consensus.SlashingEvidenceType,
+	pubKey []byte,
+	shardID uint32,
+	round int64,
+	proof1 *consensus.SignedProof,
+	proof2 *consensus.SignedProof,
+) *consensus.SlashingEvidence {
+	first, second := proof1, proof2
+	if bytes.Compare(first.HeaderHash, second.HeaderHash) > 0 {
+		first, second = second, first
+	}
+
+	evidence := &consensus.SlashingEvidence{
+		Type:    evidenceType,
+		PubKey:  pubKey,
+		ShardID: shardID,
+		Round:   uint64(round),
+		First:   first,
+		Second:  second,
+	}
+	evidence.Hash = ed.computeEvidenceHash(evidence)
+
+	return evidence
+}
+
+// computeEvidenceHash computes the identifier of the evidence, which does not depend on the detection moment
+// or on the order the conflicting messages were received in
+func (ed *equivocationDetector) computeEvidenceHash(evidence *consensus.SlashingEvidence) []byte {
+	evidenceCopy := *evidence
+	evidenceCopy.Hash = nil
+	evidenceCopy.DetectedAt = 0
+
+	buff, err := json.Marshal(&evidenceCopy)
+	if err != nil {
+		return nil
+	}
+
+	return ed.hasher.Compute(string(buff))
+}
+
+func (ed *equivocationDetector) report(evidence *consensus.SlashingEvidence) {
+	if evidence == nil {
+		return
+	}
+
+	ed.mutEvidences.Lock()
+	_, alreadyReported := ed.reported[string(evidence.Hash)]
+	if alreadyReported {
+		ed.mutEvidences.Unlock()
+		return
+	}
+	evidence.DetectedAt = ed.syncTimer.CurrentTime().Unix()
+	ed.reported[string(evidence.Hash)] = struct{}{}
+	ed.evidences = append(ed.evidences, evidence)
+	ed.mutEvidences.Unlock()
+
+	log.Warn("slashing evidence detected",
+		"type", evidence.Type,
+		"pk", hex.EncodeToString(evidence.PubKey),
+		"shard", evidence.ShardID,
+		"round", evidence.Round,
+		"first hash", evidence.First.HeaderHash,
+		"second hash", evidence.Second.HeaderHash)
+
+	buff, err := json.Marshal(evidence)
+	if err == nil {
+		err = ed.storer.Put(evidence.Hash, buff)
+	}
+	if err != nil {
+		log.Warn("equivocationDetector: cannot persist evidence", "hash", evidence.Hash, "error", err)
+	}
+
+	ed.outportHandler.SaveSlashingEvidence(evidence)
+}
+
+// GetEvidences returns all the slashing evidences detected by the node, sorted by round
+func (ed *equivocationDetector) GetEvidences() []*consensus.SlashingEvidence {
+	ed.mutEvidences.RLock()
+	evidences := make([]*consensus.SlashingEvidence, len(ed.evidences))
+	copy(evidences, ed.evidences)
+	ed.mutEvidences.RUnlock()
+
+	sort.SliceStable(evidences, func(i, j int) bool {
+		return evidences[i].Round < evidences[j].Round
+	})
+
+	return evidences
+}
+
+// Close closes the evidences storer
+func (ed *equivocationDetector) Close() error {
+	return ed.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,398 @@
+package slashing
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testValidator struct {
+	pubKey  []byte
+	privKey crypto.PrivateKey
+}
+
+func createTestValidators(keyGen crypto.KeyGenerator, numValidators int) []*testValidator {
+	validators := make([]*testValidator, numValidators)
+	for i := range validators {
+		sk, pk := keyGen.GeneratePair()
+		pkBytes, _ := pk.ToByteArray()
+		validators[i] = &testValidator{
+			pubKey:  pkBytes,
+			privKey: sk,
+		}
+	}
+
+	return validators
+}
+
+func createTestStorer() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 1000, Shards: 1})
+	storer, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+
+	return storer
+}
+
+func createMockArgs(validators []*testValidator) ArgsEquivocationDetector {
+	return ArgsEquivocationDetector{
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		Hasher:      blake2b.NewBlake2b(),
+		NodesCoordinator: &mock.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+				group := make([]sharding.Validator, 0, len(validators))
+				for i, v := range validators {
+					group = append(group, mock.NewValidator(v.pubKey, 1, uint32(i)))
+				}
+
+				return group, nil
+			},
+		},
+		KeyGenerator:      signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		SingleSigVerifier: &singlesig.BlsSingleSigner{},
+		ShardCoordinator:  mock.ShardCoordinatorMock{},
+		SyncTimer: &mock.SyncTimerMock{
+			CurrentTimeCalled: func() time.Time {
+				return time.Unix(1000, 0)
+			},
+		},
+		Storer:          createTestStorer(),
+		OutportHandler:  &testscommon.OutportStub{},
+		NumRoundsToKeep: 10,
+	}
+}
+
+func signShare(t *testing.T, validator *testValidator, headerHash []byte) []byte {
+	signer := &singlesig.BlsSingleSigner{}
+	sig, err := signer.Sign(validator.privKey, headerHash)
+	require.Nil(t, err)
+
+	return sig
+}
+
+func createSignedHeader(t *testing.T, args ArgsEquivocationDetector, leader *testValidator, round uint64, timestamp uint64) ([]byte, *block.Header) {
+	header := &block.Header{
+		Round:        round,
+		Nonce:        round,
+		TimeStamp:    timestamp,
+		PrevRandSeed: []byte("prev rand seed"),
+	}
+	buff, err := args.Marshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	signer := &singlesig.BlsSingleSigner{}
+	header.LeaderSignature, err = signer.Sign(leader.privKey, buff)
+	require.Nil(t, err)
+
+	buff, err = args.Marshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	return args.Hasher.Compute(string(buff)), header
+}
+
+func TestNewEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 1)
+
+	tests := []struct {
+		name        string
+		modify      func(args *ArgsEquivocationDetector)
+		expectedErr error
+	}{
+		{name: "nil marshalizer", modify: func(args *ArgsEquivocationDetector) { args.Marshalizer = nil }, expectedErr: ErrNilMarshalizer},
+		{name: "nil hasher", modify: func(args *ArgsEquivocationDetector) { args.Hasher = nil }, expectedErr: ErrNilHasher},
+		{name: "nil nodes coordinator", modify: func(args *ArgsEquivocationDetector) { args.NodesCoordinator = nil }, expectedErr: ErrNilNodesCoordinator},
+		{name: "nil key generator", modify: func(args *ArgsEquivocationDetector) { args.KeyGenerator = nil }, expectedErr: ErrNilKeyGenerator},
+		{name: "nil single signer", modify: func(args *ArgsEquivocationDetector) { args.SingleSigVerifier = nil }, expectedErr: ErrNilSingleSigVerifier},
+		{name: "nil shard coordinator", modify: func(args *ArgsEquivocationDetector) { args.ShardCoordinator = nil }, expectedErr: ErrNilShardCoordinator},
+		{name: "nil sync timer", modify: func(args *ArgsEquivocationDetector) { args.SyncTimer = nil }, expectedErr: ErrNilSyncTimer},
+		{name: "nil storer", modify: func(args *ArgsEquivocationDetector) { args.Storer = nil }, expectedErr: ErrNilStorer},
+		{name: "nil outport handler", modify: func(args *ArgsEquivocationDetector) { args.OutportHandler = nil }, expectedErr: ErrNilOutportHandler},
+		{name: "invalid rounds to keep", modify: func(args *ArgsEquivocationDetector) { args.NumRoundsToKeep = 0 }, expectedErr: ErrInvalidNumRoundsToKeep},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := createMockArgs(validators)
+			tt.modify(&args)
+			ed, err := NewEquivocationDetector(args)
+
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			assert.True(t, check.IfNil(ed))
+		})
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ed, err := NewEquivocationDetector(createMockArgs(validators))
+
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(ed))
+		assert.Empty(t, ed.GetEvidences())
+	})
+}
+
+func TestEquivocationDetector_AddSignatureShareSameHashShouldNotReport(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 1)
+	ed, _ := NewEquivocationDetector(createMockArgs(validators))
+
+	hash := []byte("header hash")
+	sig := signShare(t, validators[0], hash)
+	ed.AddSignatureShare(1, validators[0].pubKey, hash, sig)
+	ed.AddSignatureShare(1, validators[0].pubKey, hash, sig)
+	ed.AddSignatureShare(2, validators[0].pubKey, []byte("other hash"), signShare(t, validators[0], []byte("other hash")))
+
+	assert.Empty(t, ed.GetEvidences())
+}
+
+func TestEquivocationDetector_AddSignatureShareDoubleSigningShouldReport(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 2)
+	args := createMockArgs(validators)
+
+	mutOutport := sync.Mutex{}
+	outportEvidences := make([]*consensus.SlashingEvidence, 0)
+	args.OutportHandler = &testscommon.OutportStub{
+		SaveSlashingEvidenceCalled: func(evidence *consensus.SlashingEvidence) {
+			mutOutport.Lock()
+			outportEvidences = append(outportEvidences, evidence)
+			mutOutport.Unlock()
+		},
+	}
+	ed, _ := NewEquivocationDetector(args)
+
+	hash1 := []byte("header hash 1")
+	hash2 := []byte("header hash 2")
+	sig1 := signShare(t, validators[1], hash1)
+	sig2 := signShare(t, validators[1], hash2)
+	ed.AddSignatureShare(5, validators[1].pubKey, hash2, sig2)
+	ed.AddSignatureShare(5, validators[0].pubKey, hash1, signShare(t, validators[0], hash1))
+	ed.AddSignatureShare(5, validators[1].pubKey, hash1, sig1)
+	ed.AddSignatureShare(5, validators[1].pubKey, hash1, sig1)
+
+	evidences := ed.GetEvidences()
+	require.Equal(t, 1, len(evidences))
+	evidence := evidences[0]
+	assert.Equal(t, consensus.DoubleSigningEvidence, evidence.Type)
+	assert.Equal(t, validators[1].pubKey, evidence.PubKey)
+	assert.Equal(t, uint64(5), evidence.Round)
+	assert.Equal(t, hash1, evidence.First.HeaderHash)
+	assert.Equal(t, sig1, evidence.First.Signature)
+	assert.Equal(t, hash2, evidence.Second.HeaderHash)
+	assert.Equal(t, sig2, evidence.Second.Signature)
+	assert.Equal(t, int64(1000), evidence.DetectedAt)
+	assert.Nil(t, ed.VerifyEvidence(evidence))
+	assert.Equal(t, evidences, outportEvidences)
+
+	persisted, err := args.Storer.Get(evidence.Hash)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, persisted)
+}
+
+func TestEquivocationDetector_AddSignatureShareInvalidSignaturesShouldNotReport(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 1)
+	ed, _ := NewEquivocationDetector(createMockArgs(validators))
+
+	hash1 := []byte("header hash 1")
+	hash2 := []byte("header hash 2")
+	hash3 := []byte("header hash 3")
+	pk := validators[0].pubKey
+
+	// the stored share is invalid and gets replaced by the first valid conflicting share
+	ed.AddSignatureShare(1, pk, hash1, []byte("invalid signature"))
+	ed.AddSignatureShare(1, pk, hash2, signShare(t, validators[0], hash2))
+	assert.Empty(t, ed.GetEvidences())
+
+	// an invalid conflicting share is ignored
+	ed.AddSignatureShare(1, pk, hash3, signShare(t, validators[0], hash1))
+	assert.Empty(t, ed.GetEvidences())
+
+	ed.AddSignatureShare(1, pk, hash3, signShare(t, validators[0], hash3))
+	evidences := ed.GetEvidences()
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, hash2, evidences[0].First.HeaderHash)
+	assert.Equal(t, hash3, evidences[0].Second.HeaderHash)
+}
+
+func TestEquivocationDetector_OldRoundsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 1)
+	args := createMockArgs(validators)
+	args.NumRoundsToKeep = 2
+	ed, _ := NewEquivocationDetector(args)
+
+	hash1 := []byte("header hash 1")
+	hash2 := []byte("header hash 2")
+	pk := validators[0].pubKey
+	ed.AddSignatureShare(1, pk, hash1, signShare(t, validators[0], hash1))
+	ed.AddSignatureShare(3, pk, hash1, signShare(t, validators[0], hash1))
+	ed.AddSignatureShare(1, pk, hash2, signShare(t, validators[0], hash2))
+	assert.Empty(t, ed.GetEvidences())
+
+	ed.AddSignatureShare(3, pk, hash2, signShare(t, validators[0], hash2))
+	evidences := ed.GetEvidences()
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, uint64(3), evidences[0].Round)
+}
+
+func TestEquivocationDetector_AddHeaderEquivocatingProposerShouldReport(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 3)
+	args := createMockArgs(validators)
+	ed, _ := NewEquivocationDetector(args)
+
+	hash1, header1 := createSignedHeader(t, args, validators[0], 7, 100)
+	hash2, header2 := createSignedHeader(t, args, validators[0], 7, 101)
+	hash3, header3 := createSignedHeader(t, args, validators[0], 8, 102)
+
+	ed.AddHeader(hash1, header1)
+	ed.AddHeader(hash1, header1)
+	ed.AddHeader(hash3, header3)
+	assert.Empty(t, ed.GetEvidences())
+
+	ed.AddHeader(hash2, header2)
+	evidences := ed.GetEvidences()
+	require.Equal(t, 1, len(evidences))
+	evidence := evidences[0]
+	assert.Equal(t, consensus.EquivocatingProposerEvidence, evidence.Type)
+	assert.Equal(t, validators[0].pubKey, evidence.PubKey)
+	assert.Equal(t, uint64(7), evidence.Round)
+	assert.NotEmpty(t, evidence.First.Header)
+	assert.NotEmpty(t, evidence.Second.Header)
+	assert.Nil(t, ed.VerifyEvidence(evidence))
+}
+
+func TestEquivocationDetector_AddHeaderNotSignedByLeaderShouldNotReport(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 2)
+	args := createMockArgs(validators)
+	ed, _ := NewEquivocationDetector(args)
+
+	hash1, header1 := createSignedHeader(t, args, validators[0], 7, 100)
+	hash2, header2 := createSignedHeader(t, args, validators[1], 7, 101)
+
+	ed.AddHeader(hash1, header1)
+	ed.AddHeader(hash2, header2)
+
+	assert.Empty(t, ed.GetEvidences())
+}
+
+func TestEquivocationDetector_VerifyEvidence(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 2)
+	args := createMockArgs(validators)
+	ed, _ := NewEquivocationDetector(args)
+
+	hash1, header1 := createSignedHeader(t, args, validators[0], 7, 100)
+	hash2, header2 := createSignedHeader(t, args, validators[0], 7, 101)
+	ed.AddHeader(hash1, header1)
+	ed.AddHeader(hash2, header2)
+	require.Equal(t, 1, len(ed.GetEvidences()))
+	validEvidence := *ed.GetEvidences()[0]
+
+	t.Run("nil evidence", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, ErrNilEvidence, ed.VerifyEvidence(nil))
+	})
+	t.Run("same header hash", func(t *testing.T) {
+		t.Parallel()
+
+		evidence := validEvidence
+		evidence.Second = evidence.First
+		assert.True(t, errors.Is(ed.VerifyEvidence(&evidence), ErrInvalidEvidence))
+	})
+	t.Run("tampered content", func(t *testing.T) {
+		t.Parallel()
+
+		evidence := validEvidence
+		evidence.Round++
+		assert.True(t, errors.Is(ed.VerifyEvidence(&evidence), ErrInvalidEvidence))
+	})
+	t.Run("other public key", func(t *testing.T) {
+		t.Parallel()
+
+		evidence := validEvidence
+		evidence.PubKey = validators[1].pubKey
+		evidence.Hash = ed.computeEvidenceHash(&evidence)
+		assert.Equal(t, ErrLeaderMismatch, ed.VerifyEvidence(&evidence))
+	})
+	t.Run("unknown type", func(t *testing.T) {
+		t.Parallel()
+
+		evidence := validEvidence
+		evidence.Type = "unknown"
+		evidence.Hash = ed.computeEvidenceHash(&evidence)
+		assert.True(t, errors.Is(ed.VerifyEvidence(&evidence), ErrUnknownEvidenceType))
+	})
+}
+
+func TestEquivocationDetector_PersistedEvidencesShouldBeLoaded(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	validators := createTestValidators(keyGen, 1)
+	args := createMockArgs(validators)
+	ed, _ := NewEquivocationDetector(args)
+
+	hash1 := []byte("header hash 1")
+	hash2 := []byte("header hash 2")
+	pk := validators[0].pubKey
+	ed.AddSignatureShare(1, pk, hash1, signShare(t, validators[0], hash1))
+	ed.AddSignatureShare(1, pk, hash2, signShare(t, validators[0], hash2))
+	require.Equal(t, 1, len(ed.GetEvidences()))
+
+	numOutportCalls := 0
+	args.OutportHandler = &testscommon.OutportStub{
+		SaveSlashingEvidenceCalled: func(evidence *consensus.SlashingEvidence) {
+			numOutportCalls++
+		},
+	}
+	reloaded, _ := NewEquivocationDetector(args)
+	assert.Equal(t, ed.GetEvidences(), reloaded.GetEvidences())
+
+	// the same conflict is not reported again
+	reloaded.AddSignatureShare(1, pk, hash1, signShare(t, validators[0], hash1))
+	reloaded.AddSignatureShare(1, pk, hash2, signShare(t, validators[0], hash2))
+	assert.Equal(t, 1, len(reloaded.GetEvidences()))
+	assert.Equal(t, 0, numOutportCalls)
+}
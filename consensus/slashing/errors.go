@@ -0,0 +1,45 @@
+package slashing
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigVerifier signals that a nil single signature verifier has been provided
+var ErrNilSingleSigVerifier = errors.New("nil single signature verifier")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilSyncTimer signals that a nil sync timer has been provided
+var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrInvalidNumRoundsToKeep signals that an invalid number of rounds to keep has been provided
+var ErrInvalidNumRoundsToKeep = errors.New("invalid number of rounds to keep")
+
+// ErrNilEvidence signals that a nil slashing evidence has been provided
+var ErrNilEvidence = errors.New("nil slashing evidence")
+
+// ErrInvalidEvidence signals that the slashing evidence is malformed or does not prove a conflict
+var ErrInvalidEvidence = errors.New("invalid slashing evidence")
+
+// ErrUnknownEvidenceType signals that the type of the slashing evidence is not known
+var ErrUnknownEvidenceType = errors.New("unknown slashing evidence type")
+
+// ErrLeaderMismatch signals that the evidence public key is not the leader of the round the header was proposed in
+var ErrLeaderMismatch = errors.New("public key is not the leader of the round")
@@ -0,0 +1,137 @@
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// VerifyEvidence checks that the evidence proves a conflict: both proofs are correctly signed by the evidence public
+// key, in the evidence round, for different header hashes
+func (ed *equivocationDetector) VerifyEvidence(evidence *consensus.SlashingEvidence) error {
+	if evidence == nil {
+		return ErrNilEvidence
+	}
+	if evidence.First == nil || evidence.Second == nil {
+		return fmt.Errorf("%w: missing proof", ErrInvalidEvidence)
+	}
+	if bytes.Equal(evidence.First.HeaderHash, evidence.Second.HeaderHash) {
+		return fmt.Errorf("%w: proofs are for the same header hash", ErrInvalidEvidence)
+	}
+	if !bytes.Equal(evidence.Hash, ed.computeEvidenceHash(evidence)) {
+		return fmt.Errorf("%w: hash mismatch", ErrInvalidEvidence)
+	}
+
+	for _, proof := range []*consensus.SignedProof{evidence.First, evidence.Second} {
+		err := ed.verifyProof(evidence.Type, evidence.PubKey, evidence.ShardID, evidence.Round, proof)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ed *equivocationDetector) verifyProof(
+	evidenceType consensus.SlashingEvidenceType,
+	pubKey []byte,
+	shardID uint32,
+	round uint64,
+	proof *consensus.SignedProof,
+) error {
+	switch evidenceType {
+	case consensus.DoubleSigningEvidence:
+		return ed.verifySignatureShare(pubKey, proof)
+	case consensus.EquivocatingProposerEvidence:
+		return ed.verifyProposal(pubKey, shardID, round, proof)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownEvidenceType, evidenceType)
+	}
+}
+
+// verifySignatureShare checks the signature share, which is a BLS signature on the header hash
+func (ed *equivocationDetector) verifySignatureShare(pubKey []byte, proof *consensus.SignedProof) error {
+	publicKey, err := ed.keyGenerator.PublicKeyFromByteArray(pubKey)
+	if err != nil {
+		return err
+	}
+
+	return ed.singleSigVerifier.Verify(publicKey, proof.HeaderHash, proof.Signature)
+}
+
+// verifyProposal checks that the header matches its hash, that it was proposed in the provided round by the
+// provided leader and that it carries a valid leader signature
+func (ed *equivocationDetector) verifyProposal(pubKey []byte, shardID uint32, round uint64, proof *consensus.SignedProof) error {
+	if !bytes.Equal(ed.hasher.Compute(string(proof.Header)), proof.HeaderHash) {
+		return fmt.Errorf("%w: header does not match its hash", ErrInvalidEvidence)
+	}
+
+	header, err := ed.unmarshalHeader(shardID, proof.Header)
+	if err != nil {
+		return err
+	}
+	if header.GetRound() != round || header.GetShardID() != shardID {
+		return fmt.Errorf("%w: header is not from round %d of shard %d", ErrInvalidEvidence, round, shardID)
+	}
+	if !bytes.Equal(header.GetLeaderSignature(), proof.Signature) {
+		return fmt.Errorf("%w: signature is not the header leader signature", ErrInvalidEvidence)
+	}
+
+	leader, err := ed.getLeader(header)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(leader, pubKey) {
+		return ErrLeaderMismatch
+	}
+
+	publicKey, err := ed.keyGenerator.PublicKeyFromByteArray(pubKey)
+	if err != nil {
+		return err
+	}
+
+	headerCopy := header.Clone()
+	headerCopy.SetLeaderSignature(nil)
+	headerBytes, err := ed.marshalizer.Marshal(headerCopy)
+	if err != nil {
+		return err
+	}
+
+	return ed.singleSigVerifier.Verify(publicKey, headerBytes, proof.Signature)
+}
+
+// getLeader returns the public key of the leader of the consensus group that proposed the header
+func (ed *equivocationDetector) getLeader(header data.HeaderHandler) ([]byte, error) {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	consensusGroup, err := ed.nodesCoordinator.ComputeConsensusGroup(header.GetPrevRandSeed(), header.GetRound(), header.GetShardID(), epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusGroup) == 0 {
+		return nil, fmt.Errorf("%w: empty consensus group", ErrInvalidEvidence)
+	}
+
+	return consensusGroup[0].PubKey(), nil
+}
+
+func (ed *equivocationDetector) unmarshalHeader(shardID uint32, buff []byte) (data.HeaderHandler, error) {
+	var header data.HeaderHandler = &block.Header{}
+	if shardID == core.MetachainShardId {
+		header = &block.MetaBlock{}
+	}
+
+	err := ed.marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
@@ -0,0 +1,32 @@
+package consensus
+
+// SlashingEvidenceType defines the kind of misbehaviour proven by a slashing evidence
+type SlashingEvidenceType string
+
+const (
+	// DoubleSigningEvidence proves that a validator signed two different headers in the same round
+	DoubleSigningEvidence SlashingEvidenceType = "double signing"
+	// EquivocatingProposerEvidence proves that a leader proposed two different headers in the same round
+	EquivocatingProposerEvidence SlashingEvidenceType = "equivocating proposer"
+)
+
+// SignedProof holds one of the conflicting items signed by a validator. The header is set only for proposer
+// evidences, as the leader signature is computed on the header data
+type SignedProof struct {
+	HeaderHash []byte `json:"headerHash"`
+	Header     []byte `json:"header,omitempty"`
+	Signature  []byte `json:"signature"`
+}
+
+// SlashingEvidence holds the proofs that a validator signed conflicting data in the same round. The evidence
+// can be verified by anyone knowing the nodes configuration of the round
+type SlashingEvidence struct {
+	Hash       []byte               `json:"hash"`
+	Type       SlashingEvidenceType `json:"type"`
+	PubKey     []byte               `json:"pubKey"`
+	ShardID    uint32               `json:"shardID"`
+	Round      uint64               `json:"round"`
+	First      *SignedProof         `json:"first"`
+	Second     *SignedProof         `json:"second"`
+	DetectedAt int64                `json:"detectedAt"`
+}
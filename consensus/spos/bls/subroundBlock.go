@@ -197,6 +197,7 @@ func (sr *subroundBlock) sendBlockBodyAndHeader(
 	sr.Data = headerHash
 	sr.Body = bodyHandler
 	sr.Header = headerHandler
+	sr.RoundTracer().BlockProposed(sr.RoundHandler().Index(), headerHash, true)
 
 	return true
 }
@@ -264,6 +265,7 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 
 	sr.Data = headerHash
 	sr.Header = headerHandler
+	sr.RoundTracer().BlockProposed(sr.RoundHandler().Index(), headerHash, true)
 
 	return true
 }
@@ -288,7 +290,7 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
 	hdr.SetPrevHash(prevHash)
 
-	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	randSeed, err := sr.KeysSigner().SignRandSeed([]byte(sr.SelfPubKey()), sr.RoundHandler().Index(), prevRandSeed)
 	if err != nil {
 		return nil, err
 	}
@@ -348,6 +350,8 @@ func (sr *subroundBlock) receivedBlockBodyAndHeader(cnsDta *consensus.Message) b
 		return false
 	}
 
+	sr.RoundTracer().BlockProposed(sr.RoundHandler().Index(), sr.Data, false)
+
 	log.Debug("step 1: block body and header have been received",
 		"nonce", sr.Header.GetNonce(),
 		"hash", cnsDta.BlockHeaderHash)
@@ -441,6 +445,8 @@ func (sr *subroundBlock) receivedBlockHeader(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	sr.RoundTracer().BlockProposed(sr.RoundHandler().Index(), sr.Data, false)
+
 	log.Debug("step 1: block header has been received",
 		"nonce", sr.Header.GetNonce(),
 		"hash", cnsDta.BlockHeaderHash)
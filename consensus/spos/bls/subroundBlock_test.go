@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -703,7 +704,7 @@ func TestSubroundBlock_CreateHeaderNilCurrentHeader(t *testing.T) {
 	_ = sr.SendBlockHeader(header, marshalizedHeader)
 
 	oldRand := sr.BlockChain().GetGenesisHeader().GetRandSeed()
-	newRand, _ := sr.SingleSigner().Sign(sr.PrivateKey(), oldRand)
+	newRand, _ := sr.KeysSigner().SignRandSeed([]byte(sr.SelfPubKey()), sr.RoundHandler().Index(), oldRand)
 	expectedHeader := &block.Header{
 		Round:            uint64(sr.RoundHandler().Index()),
 		TimeStamp:        uint64(sr.RoundHandler().TimeStamp().Unix()),
@@ -719,6 +720,21 @@ func TestSubroundBlock_CreateHeaderNilCurrentHeader(t *testing.T) {
 	assert.Equal(t, expectedHeader, header)
 }
 
+func TestSubroundBlock_CreateHeaderRandSeedSignErrorShouldErr(t *testing.T) {
+	container := mock.InitConsensusCore()
+	expectedErr := errors.New("expected error")
+	container.SetKeysSigner(&testscommon.KeysSignerStub{
+		SignRandSeedCalled: func(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	})
+	sr := *initSubroundBlock(nil, container, &statusHandler.AppStatusHandlerStub{})
+
+	header, err := sr.CreateHeader()
+	assert.Nil(t, header)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestSubroundBlock_CreateHeaderNotNilCurrentHeader(t *testing.T) {
 	container := mock.InitConsensusCore()
 	sr := *initSubroundBlock(nil, container, &statusHandler.AppStatusHandlerStub{})
@@ -734,7 +750,7 @@ func TestSubroundBlock_CreateHeaderNotNilCurrentHeader(t *testing.T) {
 	_ = sr.SendBlockHeader(header, marshalizedHeader)
 
 	oldRand := sr.BlockChain().GetGenesisHeader().GetRandSeed()
-	newRand, _ := sr.SingleSigner().Sign(sr.PrivateKey(), oldRand)
+	newRand, _ := sr.KeysSigner().SignRandSeed([]byte(sr.SelfPubKey()), sr.RoundHandler().Index(), oldRand)
 
 	expectedHeader := &block.Header{
 		Round:            uint64(sr.RoundHandler().Index()),
@@ -783,7 +799,7 @@ func TestSubroundBlock_CreateHeaderMultipleMiniBlocks(t *testing.T) {
 	_ = sr.SendBlockHeader(header, marshalizedHeader)
 
 	oldRand := sr.BlockChain().GetCurrentBlockHeader().GetRandSeed()
-	newRand, _ := sr.SingleSigner().Sign(sr.PrivateKey(), oldRand)
+	newRand, _ := sr.KeysSigner().SignRandSeed([]byte(sr.SelfPubKey()), sr.RoundHandler().Index(), oldRand)
 	expectedHeader := &block.Header{
 		Round:            uint64(sr.RoundHandler().Index()),
 		TimeStamp:        uint64(sr.RoundHandler().TimeStamp().Unix()),
@@ -106,6 +106,8 @@ func (sr *subroundEndRound) receivedBlockHeaderFinalInfo(cnsDta *consensus.Messa
 		"AggregateSignature", cnsDta.AggregateSignature,
 		"LeaderSignature", cnsDta.LeaderSignature)
 
+	sr.RoundTracer().AggregatedSignatureReady(sr.RoundHandler().Index())
+
 	sr.PeerHonestyHandler().ChangeScore(
 		node,
 		spos.GetConsensusTopicID(sr.ShardCoordinator()),
@@ -184,6 +186,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 
 	sr.Header.SetPubKeysBitmap(bitmap)
 	sr.Header.SetSignature(sig)
+	sr.RoundTracer().AggregatedSignatureReady(sr.RoundHandler().Index())
 
 	// Header is complete so the leader can sign it
 	leaderSignature, err := sr.signBlockHeader()
@@ -228,6 +231,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
+	sr.RoundTracer().BlockCommitted(sr.RoundHandler().Index(), sr.Header.GetNonce())
 
 	sr.displayStatistics()
 
@@ -334,6 +338,7 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
+	sr.RoundTracer().BlockCommitted(sr.RoundHandler().Index(), header.GetNonce())
 
 	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 		err = sr.setHeaderForValidator(header)
@@ -416,7 +421,7 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, err
 	}
 
-	return sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHdr)
+	return sr.KeysSigner().SignBlockHeader([]byte(sr.SelfPubKey()), sr.RoundHandler().Index(), marshalizedHdr)
 }
 
 func (sr *subroundEndRound) updateMetricsForLeader() {
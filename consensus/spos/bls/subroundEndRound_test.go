@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -534,14 +535,14 @@ func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 
 	expectedSignature := []byte("signature")
 	container := mock.InitConsensusCore()
-	singleSigner := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	keysSigner := &testscommon.KeysSignerStub{
+		SignBlockHeaderCalled: func(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
 			var receivedHdr block.Header
-			_ = container.Marshalizer().Unmarshal(&receivedHdr, msg)
+			_ = container.Marshalizer().Unmarshal(&receivedHdr, marshalizedHeader)
 			return expectedSignature, nil
 		},
 	}
-	container.SetSingleSigner(singleSigner)
+	container.SetKeysSigner(keysSigner)
 	bm := &mock.BroadcastMessengerMock{
 		BroadcastBlockCalled: func(handler data.BodyHandler, handler2 data.HeaderHandler) error {
 			return errors.New("error")
@@ -59,7 +59,8 @@ func checkNewSubroundSignatureParams(
 	return err
 }
 
-// doSignatureJob method does the job of the subround Signature
+// doSignatureJob method does the job of the subround Signature. Each key the node uses in the current round, which
+// is the self key and the other hosted keys it currently manages that are part of the consensus group, signs the block
 func (sr *subroundSignature) doSignatureJob() bool {
 	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 		return true
@@ -68,14 +69,59 @@ func (sr *subroundSignature) doSignatureJob() bool {
 		return false
 	}
 
-	signatureShare, err := sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
+	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
+
+	for _, pk := range sr.signingKeysInConsensusGroup() {
+		// the self key signs first, so the job is retried for all the keys if its share could not be sent
+		ok := sr.doSignatureJobForKey(pk, isSelfLeader)
+		if !ok && pk == sr.SelfPubKey() {
+			return false
+		}
+	}
+
+	if isSelfLeader {
+		go sr.waitAllSignatures()
+	}
+
+	return true
+}
+
+// signingKeysInConsensusGroup returns the keys which sign in the current round: the self key first, followed by the
+// other hosted keys managed by the current node which are part of the consensus group
+func (sr *subroundSignature) signingKeysInConsensusGroup() []string {
+	signingKeys := []string{sr.SelfPubKey()}
+
+	managedPeersHolder := sr.ManagedPeersHolder()
+	if !managedPeersHolder.IsMultiKeyMode() {
+		return signingKeys
+	}
+
+	for _, pk := range sr.ConsensusGroup() {
+		if pk == sr.SelfPubKey() {
+			continue
+		}
+		if managedPeersHolder.IsKeyManagedByCurrentNode([]byte(pk)) {
+			signingKeys = append(signingKeys, pk)
+		}
+	}
+
+	return signingKeys
+}
+
+// doSignatureJobForKey creates the signature share of the provided key and, if the leader is not one of the keys of
+// the node, sends it to the leader. When the leader is hosted by the node, the share is only stored, as the messages
+// of the node's own keys are not processed when received
+func (sr *subroundSignature) doSignatureJobForKey(pk string, isSelfLeader bool) bool {
+	pkForLogs := core.GetTrimmedPk(hex.EncodeToString([]byte(pk)))
+
+	signatureShare, err := sr.createSignatureShare(pk)
 	if err != nil {
-		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
+		log.Debug("doSignatureJob.CreateSignatureShare",
+			"pk", pkForLogs,
+			"error", err.Error())
 		return false
 	}
 
-	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
-
 	if !isSelfLeader {
 		//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
 		cnsMsg := consensus.NewConsensusMessage(
@@ -83,7 +129,7 @@ func (sr *subroundSignature) doSignatureJob() bool {
 			signatureShare,
 			nil,
 			nil,
-			[]byte(sr.SelfPubKey()),
+			[]byte(pk),
 			nil,
 			int(MtSignature),
 			sr.RoundHandler().Index(),
@@ -96,25 +142,24 @@ func (sr *subroundSignature) doSignatureJob() bool {
 
 		err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 		if err != nil {
-			log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
+			log.Debug("doSignatureJob.BroadcastConsensusMessage",
+				"pk", pkForLogs,
+				"error", err.Error())
 			return false
 		}
 
-		log.Debug("step 2: signature has been sent")
+		log.Debug("step 2: signature has been sent", "pk", pkForLogs)
 	}
 
-	err = sr.SetSelfJobDone(sr.Current(), true)
+	err = sr.SetJobDone(pk, sr.Current(), true)
 	if err != nil {
-		log.Debug("doSignatureJob.SetSelfJobDone",
+		log.Debug("doSignatureJob.SetJobDone",
+			"pk", pkForLogs,
 			"subround", sr.Name(),
 			"error", err.Error())
 		return false
 	}
 
-	if isSelfLeader {
-		go sr.waitAllSignatures()
-	}
-
 	return true
 }
 
@@ -193,10 +238,34 @@ func (sr *subroundSignature) receivedSignature(cnsDta *consensus.Message) bool {
 		spos.ValidatorPeerHonestyIncreaseFactor,
 	)
 
+	sr.RoundTracer().SignatureReceived(sr.RoundHandler().Index(), cnsDta.PubKey, core.PeerID(cnsDta.OriginatorPid))
+
 	sr.appStatusHandler.SetStringValue(common.MetricConsensusRoundState, "signed")
 	return true
 }
 
+// createSignatureShare creates the signature share of the provided key and stores it in the multi signer, at the
+// index of the key in the consensus group. The share is produced by the keys signer, as the key might be one of the
+// hosted keys or might be held by a remote signer
+func (sr *subroundSignature) createSignatureShare(pk string) ([]byte, error) {
+	index, err := sr.ConsensusGroupIndex(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureShare, err := sr.KeysSigner().SignBlockSignatureShare([]byte(pk), sr.RoundHandler().Index(), sr.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().StoreSignatureShare(uint16(index), signatureShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return signatureShare, nil
+}
+
 // doSignatureConsensusCheck method checks if the consensus in the subround Signature is achieved
 func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 	if sr.RoundCanceled {
@@ -274,27 +274,41 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 
 	sr.Data = []byte("X")
 
-	multiSignerMock := mock.InitMultiSignerMock()
-
 	err := errors.New("create signature share error")
-	multiSignerMock.CreateSignatureShareCalled = func(msg []byte, bitmap []byte) ([]byte, error) {
-		return nil, err
-	}
+	container.SetKeysSigner(&testscommon.KeysSignerStub{
+		SignBlockSignatureShareCalled: func(pkBytes []byte, round int64, headerHash []byte) ([]byte, error) {
+			return nil, err
+		},
+	})
+
+	r = sr.DoSignatureJob()
+	assert.False(t, r)
 
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.StoreSignatureShareCalled = func(index uint16, sig []byte) error {
+		return err
+	}
 	container.SetMultiSigner(multiSignerMock)
+	container.SetKeysSigner(&testscommon.KeysSignerStub{
+		SignBlockSignatureShareCalled: func(pkBytes []byte, round int64, headerHash []byte) ([]byte, error) {
+			return []byte("SIG"), nil
+		},
+	})
 
 	r = sr.DoSignatureJob()
 	assert.False(t, r)
 
+	storedSignatureShare := make([]byte, 0)
 	multiSignerMock = mock.InitMultiSignerMock()
-
-	multiSignerMock.CreateSignatureShareCalled = func(msg []byte, bitmap []byte) ([]byte, error) {
-		return []byte("SIG"), nil
+	multiSignerMock.StoreSignatureShareCalled = func(index uint16, sig []byte) error {
+		storedSignatureShare = sig
+		return nil
 	}
 	container.SetMultiSigner(multiSignerMock)
 
 	r = sr.DoSignatureJob()
 	assert.True(t, r)
+	assert.Equal(t, []byte("SIG"), storedSignatureShare)
 
 	_ = sr.SetJobDone(sr.SelfPubKey(), bls.SrSignature, false)
 	sr.RoundCanceled = false
@@ -304,6 +318,83 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobWithTwoHostedKeysInConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	createContainer := func(hostedKeys map[string]struct{}) (*mock.ConsensusCoreMock, map[uint16][]byte, *[]string) {
+		container := mock.InitConsensusCore()
+		container.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+				_, found := hostedKeys[string(pkBytes)]
+				return found
+			},
+		})
+		container.SetKeysSigner(&testscommon.KeysSignerStub{
+			SignBlockSignatureShareCalled: func(pkBytes []byte, round int64, headerHash []byte) ([]byte, error) {
+				return append([]byte("SIG_"), pkBytes...), nil
+			},
+		})
+
+		storedShares := make(map[uint16][]byte)
+		multiSignerMock := mock.InitMultiSignerMock()
+		multiSignerMock.StoreSignatureShareCalled = func(index uint16, sig []byte) error {
+			storedShares[index] = sig
+			return nil
+		}
+		container.SetMultiSigner(multiSignerMock)
+
+		broadcastKeys := make([]string, 0)
+		container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+			BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+				assert.Equal(t, append([]byte("SIG_"), message.PubKey...), message.SignatureShare)
+				broadcastKeys = append(broadcastKeys, string(message.PubKey))
+				return nil
+			},
+		})
+
+		return container, storedShares, &broadcastKeys
+	}
+
+	t.Run("hosted keys not leader should sign and broadcast with both keys", func(t *testing.T) {
+		t.Parallel()
+
+		container, storedShares, broadcastKeys := createContainer(map[string]struct{}{"C": {}, "E": {}})
+		sr := *initSubroundSignatureWithContainer(container)
+		sr.SetSelfPubKey("C")
+
+		r := sr.DoSignatureJob()
+		assert.True(t, r)
+
+		assert.Equal(t, []string{"C", "E"}, *broadcastKeys)
+		assert.Equal(t, map[uint16][]byte{2: []byte("SIG_C"), 4: []byte("SIG_E")}, storedShares)
+		for _, pk := range sr.ConsensusGroup() {
+			isJobDone, _ := sr.JobDone(pk, bls.SrSignature)
+			assert.Equal(t, pk == "C" || pk == "E", isJobDone, pk)
+		}
+	})
+	t.Run("hosted leader key should store the shares of both keys without broadcasting", func(t *testing.T) {
+		t.Parallel()
+
+		container, storedShares, broadcastKeys := createContainer(map[string]struct{}{"A": {}, "E": {}})
+		sr := *initSubroundSignatureWithContainer(container)
+		sr.SetSelfPubKey("A")
+		sr.WaitingAllSignaturesTimeOut = true
+
+		r := sr.DoSignatureJob()
+		assert.True(t, r)
+
+		assert.Empty(t, *broadcastKeys)
+		assert.Equal(t, map[uint16][]byte{0: []byte("SIG_A"), 4: []byte("SIG_E")}, storedShares)
+		for _, pk := range sr.ConsensusGroup() {
+			isJobDone, _ := sr.JobDone(pk, bls.SrSignature)
+			assert.Equal(t, pk == "A" || pk == "E", isJobDone, pk)
+		}
+	})
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
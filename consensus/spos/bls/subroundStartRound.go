@@ -23,6 +23,7 @@ type subroundStartRound struct {
 	processingThresholdPercentage int
 	executeStoredMessages         func()
 	resetConsensusMessages        func()
+	mainPubKey                    string
 
 	outportHandler outport.OutportHandler
 }
@@ -47,6 +48,7 @@ func NewSubroundStartRound(
 		processingThresholdPercentage: processingThresholdPercentage,
 		executeStoredMessages:         executeStoredMessages,
 		resetConsensusMessages:        resetConsensusMessages,
+		mainPubKey:                    baseSubround.SelfPubKey(),
 		outportHandler:                disabled.NewDisabledOutport(),
 		outportMutex:                  sync.RWMutex{},
 	}
@@ -133,15 +135,14 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		return false
 	}
 
+	canUseMainKey := true
 	if sr.NodeRedundancyHandler().IsRedundancyNode() {
 		sr.NodeRedundancyHandler().AdjustInactivityIfNeeded(
-			sr.SelfPubKey(),
+			sr.mainPubKey,
 			sr.ConsensusGroup(),
 			sr.RoundHandler().Index(),
 		)
-		if sr.NodeRedundancyHandler().IsMainMachineActive() {
-			return false
-		}
+		canUseMainKey = !sr.NodeRedundancyHandler().IsMainMachineActive()
 	}
 
 	leader, err := sr.GetLeader()
@@ -153,13 +154,21 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		return false
 	}
 
+	if !sr.selectSelfPubKey(leader, canUseMainKey) {
+		return false
+	}
+
+	sr.RoundTracer().SetLeader(sr.RoundHandler().Index(), []byte(leader))
+	sr.updateManagedKeysMetrics(leader)
+
 	msg := ""
 	if leader == sr.SelfPubKey() {
-		sr.AppStatusHandler().Increment(common.MetricCountLeader)
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusRoundState, "proposed")
-		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "proposer")
 		msg = " (my turn)"
 	}
+	if canUseMainKey {
+		sr.updateMainKeyMetrics(leader)
+	}
 
 	log.Debug("step 0: preparing the round",
 		"leader", core.GetTrimmedPk(hex.EncodeToString([]byte(leader))),
@@ -172,12 +181,6 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	selfIndex, err := sr.SelfConsensusGroupIndex()
 	if err != nil {
 		log.Debug("not in consensus group")
-		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "not in consensus group")
-	} else {
-		if leader != sr.SelfPubKey() {
-			sr.AppStatusHandler().Increment(common.MetricCountConsensus)
-		}
-		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "participant")
 	}
 
 	err = sr.MultiSigner().Reset(pubKeys, uint16(selfIndex))
@@ -209,6 +212,84 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	return true
 }
 
+// selectSelfPubKey sets the key the node uses in the current round. Besides its main key, the node can host other
+// keys, from which it uses the ones it currently manages, as decided by the per key redundancy. The leader key is
+// preferred, so the node proposes whenever it hosts the leader key, then the main key and then the first hosted key
+// found in the consensus group. The self key drives the round, while the other hosted keys that are part of the
+// consensus group sign the block as well. It returns false if the node should not participate in the current round
+func (sr *subroundStartRound) selectSelfPubKey(leader string, canUseMainKey bool) bool {
+	sr.SetSelfPubKey(sr.mainPubKey)
+
+	managedPeersHolder := sr.ManagedPeersHolder()
+	if !managedPeersHolder.IsMultiKeyMode() {
+		return canUseMainKey
+	}
+
+	roundIndex := sr.RoundHandler().Index()
+	hostedKeys := make([]string, 0)
+	for _, pk := range sr.ConsensusGroup() {
+		managedPeersHolder.IncrementRoundsOfInactivity([]byte(pk), roundIndex)
+		if managedPeersHolder.IsKeyManagedByCurrentNode([]byte(pk)) {
+			hostedKeys = append(hostedKeys, pk)
+		}
+	}
+
+	isMainKeyInConsensusGroup := canUseMainKey && sr.IsNodeInConsensusGroup(sr.mainPubKey)
+	isMainKeyLeader := isMainKeyInConsensusGroup && leader == sr.mainPubKey
+	if len(hostedKeys) == 0 || isMainKeyLeader {
+		return canUseMainKey
+	}
+	if hostedKeys[0] != leader && isMainKeyInConsensusGroup {
+		return true
+	}
+
+	// the consensus group starts with the leader, so the first hosted key is the leader key, if hosted
+	sr.SetSelfPubKey(hostedKeys[0])
+	log.Debug("using hosted key in the current round",
+		"round", roundIndex,
+		"key", core.GetTrimmedPk(hex.EncodeToString([]byte(hostedKeys[0]))),
+		"num hosted keys in consensus group", len(hostedKeys),
+	)
+
+	return true
+}
+
+func (sr *subroundStartRound) updateMainKeyMetrics(leader string) {
+	if leader == sr.mainPubKey {
+		sr.AppStatusHandler().Increment(common.MetricCountLeader)
+		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "proposer")
+	}
+
+	if !sr.IsNodeInConsensusGroup(sr.mainPubKey) {
+		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "not in consensus group")
+		return
+	}
+
+	if leader != sr.mainPubKey {
+		sr.AppStatusHandler().Increment(common.MetricCountConsensus)
+	}
+	sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "participant")
+}
+
+// updateManagedKeysMetrics sets the consensus metrics of each key the node currently manages besides its main key
+func (sr *subroundStartRound) updateManagedKeysMetrics(leader string) {
+	managedKeys := sr.ManagedPeersHolder().GetManagedKeysByCurrentNode()
+	for pk := range managedKeys {
+		pkBytes := []byte(pk)
+		consensusState := "not in consensus group"
+		switch {
+		case pk == leader && pk == sr.SelfPubKey():
+			sr.AppStatusHandler().Increment(common.GetMetricForManagedKey(common.MetricCountLeader, pkBytes))
+			consensusState = "proposer"
+		case pk != leader && sr.IsNodeInConsensusGroup(pk):
+			sr.AppStatusHandler().Increment(common.GetMetricForManagedKey(common.MetricCountConsensus, pkBytes))
+			consensusState = "participant"
+		}
+
+		sr.AppStatusHandler().SetStringValue(common.GetMetricForManagedKey(common.MetricConsensusState, pkBytes), consensusState)
+	}
+}
+
 func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 	sr.outportMutex.RLock()
 	defer sr.outportMutex.RUnlock()
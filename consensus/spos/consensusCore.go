@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -35,6 +36,9 @@ type ConsensusCore struct {
 	headerSigVerifier             consensus.HeaderSigVerifier
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
 	nodeRedundancyHandler         consensus.NodeRedundancyHandler
+	roundTracer                   consensus.RoundTracer
+	managedPeersHolder            common.ManagedPeersHolder
+	keysSigner                    common.KeysSigner
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -59,6 +63,9 @@ type ConsensusCoreArgs struct {
 	HeaderSigVerifier             consensus.HeaderSigVerifier
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
 	NodeRedundancyHandler         consensus.NodeRedundancyHandler
+	RoundTracer                   consensus.RoundTracer
+	ManagedPeersHolder            common.ManagedPeersHolder
+	KeysSigner                    common.KeysSigner
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -86,6 +93,9 @@ func NewConsensusCore(
 		headerSigVerifier:             args.HeaderSigVerifier,
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
 		nodeRedundancyHandler:         args.NodeRedundancyHandler,
+		roundTracer:                   args.RoundTracer,
+		managedPeersHolder:            args.ManagedPeersHolder,
+		keysSigner:                    args.KeysSigner,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -196,6 +206,21 @@ func (cc *ConsensusCore) NodeRedundancyHandler() consensus.NodeRedundancyHandler
 	return cc.nodeRedundancyHandler
 }
 
+// RoundTracer will return the round tracer which will be used in subrounds
+func (cc *ConsensusCore) RoundTracer() consensus.RoundTracer {
+	return cc.roundTracer
+}
+
+// ManagedPeersHolder will return the holder of the extra keys hosted by the node
+func (cc *ConsensusCore) ManagedPeersHolder() common.ManagedPeersHolder {
+	return cc.managedPeersHolder
+}
+
+// KeysSigner will return the signer used to produce the signatures of the keys hosted by the node
+func (cc *ConsensusCore) KeysSigner() common.KeysSigner {
+	return cc.keysSigner
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
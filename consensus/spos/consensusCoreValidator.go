@@ -64,6 +64,15 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.NodeRedundancyHandler()) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(container.RoundTracer()) {
+		return ErrNilRoundTracer
+	}
+	if check.IfNil(container.ManagedPeersHolder()) {
+		return ErrNilManagedPeersHolder
+	}
+	if check.IfNil(container.KeysSigner()) {
+		return ErrNilKeysSigner
+	}
 
 	return nil
 }
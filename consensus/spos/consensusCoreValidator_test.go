@@ -29,6 +29,9 @@ func initConsensusDataContainer() *ConsensusCore {
 	headerSigVerifier := &mock.HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &mock.NodeRedundancyHandlerStub{}
+	roundTracer := &mock.RoundTracerStub{}
+	managedPeersHolder := &testscommon.ManagedPeersHolderStub{}
+	keysSigner := &testscommon.KeysSignerStub{}
 
 	return &ConsensusCore{
 		blockChain:              blockChain,
@@ -50,6 +53,9 @@ func initConsensusDataContainer() *ConsensusCore {
 		headerSigVerifier:       headerSigVerifier,
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
+		roundTracer:             roundTracer,
+		managedPeersHolder:      managedPeersHolder,
+		keysSigner:              keysSigner,
 	}
 }
 
@@ -229,6 +235,39 @@ func TestConsensusContainerValidator_ValidateNilNodeRedundancyHandlerShouldFail(
 	assert.Equal(t, ErrNilNodeRedundancyHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.roundTracer = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilRoundTracer, err)
+}
+
+func TestConsensusContainerValidator_ValidateNilManagedPeersHolderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.managedPeersHolder = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilManagedPeersHolder, err)
+}
+
+func TestConsensusContainerValidator_ValidateNilKeysSignerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.keysSigner = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilKeysSigner, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
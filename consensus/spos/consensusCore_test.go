@@ -32,6 +32,9 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		HeaderSigVerifier:             consensusCoreMock.HeaderSigVerifier(),
 		FallbackHeaderValidator:       consensusCoreMock.FallbackHeaderValidator(),
 		NodeRedundancyHandler:         consensusCoreMock.NodeRedundancyHandler(),
+		RoundTracer:                   consensusCoreMock.RoundTracer(),
+		ManagedPeersHolder:            consensusCoreMock.ManagedPeersHolder(),
+		KeysSigner:                    consensusCoreMock.KeysSigner(),
 	}
 	return args
 }
@@ -300,6 +303,34 @@ func TestConsensusCore_WithNilNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestConsensusCore_WithNilRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.RoundTracer = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
+func TestConsensusCore_WithNilManagedPeersHolderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.ManagedPeersHolder = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilManagedPeersHolder, err)
+}
+
 func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -311,3 +342,17 @@ func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	assert.NotNil(t, consensusCore)
 	assert.Nil(t, err)
 }
+
+func TestConsensusCore_WithNilKeysSignerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.KeysSigner = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilKeysSigner, err)
+}
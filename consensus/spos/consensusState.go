@@ -107,7 +107,7 @@ func (cns *ConsensusState) IsNodeLeaderInCurrentRound(node string) bool {
 
 // IsSelfLeaderInCurrentRound method checks if the current node is leader in the current round
 func (cns *ConsensusState) IsSelfLeaderInCurrentRound() bool {
-	return cns.IsNodeLeaderInCurrentRound(cns.selfPubKey)
+	return cns.IsNodeLeaderInCurrentRound(cns.SelfPubKey())
 }
 
 // GetLeader method gets the leader of the current round
@@ -183,7 +183,7 @@ func (cns *ConsensusState) IsJobDone(node string, currentSubroundId int) bool {
 
 // IsSelfJobDone method returns true if self job for the current subround is done and false otherwise
 func (cns *ConsensusState) IsSelfJobDone(currentSubroundId int) bool {
-	return cns.IsJobDone(cns.selfPubKey, currentSubroundId)
+	return cns.IsJobDone(cns.SelfPubKey(), currentSubroundId)
 }
 
 // IsSubroundFinished method returns true if the current subround is finished and false otherwise
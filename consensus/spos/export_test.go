@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -90,6 +91,11 @@ func (wrk *Worker) SetNodeRedundancyHandler(nodeRedundancyHandler consensus.Node
 	wrk.nodeRedundancyHandler = nodeRedundancyHandler
 }
 
+// SetManagedPeersHolder -
+func (wrk *Worker) SetManagedPeersHolder(managedPeersHolder common.ManagedPeersHolder) {
+	wrk.managedPeersHolder = managedPeersHolder
+}
+
 // SetRoundHandler -
 func (wrk *Worker) SetRoundHandler(roundHandler consensus.RoundHandler) {
 	wrk.roundHandler = roundHandler
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -57,6 +58,12 @@ type ConsensusCoreHandler interface {
 	FallbackHeaderValidator() consensus.FallbackHeaderValidator
 	// NodeRedundancyHandler returns the node redundancy handler which will be used in subrounds
 	NodeRedundancyHandler() consensus.NodeRedundancyHandler
+	// RoundTracer returns the round tracer which will be used in subrounds
+	RoundTracer() consensus.RoundTracer
+	// ManagedPeersHolder returns the holder of the extra keys hosted by the node
+	ManagedPeersHolder() common.ManagedPeersHolder
+	// KeysSigner returns the signer used to produce the signatures of the keys hosted by the node
+	KeysSigner() common.KeysSigner
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
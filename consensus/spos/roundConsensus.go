@@ -11,6 +11,7 @@ type roundConsensus struct {
 	consensusGroup       []string
 	consensusGroupSize   int
 	selfPubKey           string
+	mutSelfPubKey        sync.RWMutex
 	validatorRoundStates map[string]*roundState
 	mut                  sync.RWMutex
 }
@@ -46,7 +47,7 @@ func (rcns *roundConsensus) ConsensusGroupIndex(pubKey string) (int, error) {
 
 // SelfConsensusGroupIndex returns the index of self public key in current consensus group
 func (rcns *roundConsensus) SelfConsensusGroupIndex() (int, error) {
-	return rcns.ConsensusGroupIndex(rcns.selfPubKey)
+	return rcns.ConsensusGroupIndex(rcns.SelfPubKey())
 }
 
 // SetEligibleList sets the eligible list ID's
@@ -88,12 +89,18 @@ func (rcns *roundConsensus) SetConsensusGroupSize(consensusGroudpSize int) {
 
 // SelfPubKey returns selfPubKey ID
 func (rcns *roundConsensus) SelfPubKey() string {
+	rcns.mutSelfPubKey.RLock()
+	defer rcns.mutSelfPubKey.RUnlock()
+
 	return rcns.selfPubKey
 }
 
-// SetSelfPubKey sets selfPubKey ID
+// SetSelfPubKey sets selfPubKey ID. When the node hosts more than one key, it is set at the start of each round
+// to the hosted key that participates in that round
 func (rcns *roundConsensus) SetSelfPubKey(selfPubKey string) {
+	rcns.mutSelfPubKey.Lock()
 	rcns.selfPubKey = selfPubKey
+	rcns.mutSelfPubKey.Unlock()
 }
 
 // JobDone returns the state of the action done, by the node represented by the key parameter,
@@ -133,12 +140,12 @@ func (rcns *roundConsensus) SetJobDone(key string, subroundId int, value bool) e
 
 // SelfJobDone returns the self state of the action done in subround given by the subroundId parameter
 func (rcns *roundConsensus) SelfJobDone(subroundId int) (bool, error) {
-	return rcns.JobDone(rcns.selfPubKey, subroundId)
+	return rcns.JobDone(rcns.SelfPubKey(), subroundId)
 }
 
 // SetSelfJobDone set the self state of the action done in subround given by the subroundId parameter
 func (rcns *roundConsensus) SetSelfJobDone(subroundId int, value bool) error {
-	return rcns.SetJobDone(rcns.selfPubKey, subroundId, value)
+	return rcns.SetJobDone(rcns.SelfPubKey(), subroundId, value)
 }
 
 // IsNodeInConsensusGroup method checks if the node is part of consensus group of the current round
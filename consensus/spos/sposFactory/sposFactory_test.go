@@ -148,6 +148,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	assert.Nil(t, err)
@@ -180,6 +181,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	assert.Nil(t, err)
@@ -203,6 +205,7 @@ func TestGetBroadcastMessenger_NilShardCoordinatorShouldErr(t *testing.T) {
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	assert.Nil(t, bm)
@@ -230,6 +233,7 @@ func TestGetBroadcastMessenger_InvalidShardIdShouldErr(t *testing.T) {
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
+		&testscommon.ManagedPeersHolderStub{},
 	)
 
 	assert.Nil(t, bm)
@@ -71,6 +71,9 @@ type Worker struct {
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	equivocationDetector      consensus.EquivocationDetector
+	managedPeersHolder        common.ManagedPeersHolder
+	mainPubKey                string
 	closer                    core.SafeCloser
 }
 
@@ -99,6 +102,8 @@ type WorkerArgs struct {
 	PublicKeySize            int
 	AppStatusHandler         core.AppStatusHandler
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
+	EquivocationDetector     consensus.EquivocationDetector
+	ManagedPeersHolder       common.ManagedPeersHolder
 }
 
 // NewWorker creates a new Worker object
@@ -144,6 +149,9 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
 		nodeRedundancyHandler:    args.NodeRedundancyHandler,
+		equivocationDetector:     args.EquivocationDetector,
+		managedPeersHolder:       args.ManagedPeersHolder,
+		mainPubKey:               args.ConsensusState.SelfPubKey(),
 		closer:                   closing.NewSafeChanCloser(),
 	}
 
@@ -239,6 +247,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.NodeRedundancyHandler) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return ErrNilManagedPeersHolder
+	}
 
 	return nil
 }
@@ -352,11 +366,12 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 
 	if wrk.nodeRedundancyHandler.IsRedundancyNode() {
 		wrk.nodeRedundancyHandler.ResetInactivityIfNeeded(
-			wrk.consensusState.SelfPubKey(),
+			wrk.mainPubKey,
 			string(cnsMsg.PubKey),
 			message.Peer(),
 		)
 	}
+	wrk.managedPeersHolder.ResetRoundsOfInactivity(cnsMsg.PubKey, message.Peer())
 
 	msgType := consensus.MessageType(cnsMsg.MsgType)
 
@@ -470,6 +485,8 @@ func (wrk *Worker) doJobOnMessageWithHeader(cnsMsg *consensus.Message) error {
 }
 
 func (wrk *Worker) doJobOnMessageWithSignature(cnsMsg *consensus.Message) {
+	wrk.equivocationDetector.AddSignatureShare(cnsMsg.RoundIndex, cnsMsg.PubKey, cnsMsg.BlockHeaderHash, cnsMsg.SignatureShare)
+
 	wrk.mutDisplayHashConsensusMessage.Lock()
 	defer wrk.mutDisplayHashConsensusMessage.Unlock()
 
@@ -507,6 +524,9 @@ func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
 	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
 		return ErrMessageFromItself
 	}
+	if wrk.managedPeersHolder.IsKeyManagedByCurrentNode(cnsDta.PubKey) {
+		return ErrMessageFromItself
+	}
 
 	if wrk.consensusState.RoundCanceled && wrk.consensusState.RoundIndex == cnsDta.RoundIndex {
 		return ErrRoundCanceled
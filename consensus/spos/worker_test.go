@@ -100,6 +100,8 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		PublicKeySize:            PublicKeySize,
 		AppStatusHandler:         appStatusHandler,
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
+		EquivocationDetector:     &mock.EquivocationDetectorStub{},
+		ManagedPeersHolder:       &testscommon.ManagedPeersHolderStub{},
 	}
 
 	return workerArgs
@@ -354,6 +356,27 @@ func TestWorker_NewWorkerNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestWorker_NewWorkerManagedPeersHolderShouldFail(t *testing.T) {
+	t.Parallel()
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	workerArgs.ManagedPeersHolder = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilManagedPeersHolder, err)
+}
+
+func TestWorker_NewWorkerEquivocationDetectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -539,6 +562,24 @@ func TestWorker_ProcessReceivedMessageRedundancyNodeShouldResetInactivityIfNeede
 	assert.True(t, wasCalled)
 }
 
+func TestWorker_ProcessReceivedMessageShouldResetInactivityOfManagedKey(t *testing.T) {
+	t.Parallel()
+	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
+	var resetPubKey []byte
+	var resetPid core.PeerID
+	wrk.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+		ResetRoundsOfInactivityCalled: func(pkBytes []byte, pid core.PeerID) {
+			resetPubKey = pkBytes
+			resetPid = pid
+		},
+	})
+	buff, _ := wrk.Marshalizer().Marshal(&consensus.Message{PubKey: []byte("hosted key")})
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+
+	assert.Equal(t, []byte("hosted key"), resetPubKey)
+	assert.Equal(t, currentPid, resetPid)
+}
+
 func TestWorker_ProcessReceivedMessageNodeNotInEligibleListShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
@@ -1055,6 +1096,54 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWorker_ProcessReceivedMessageWithSignatureShouldFeedTheEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	var receivedPubKey, receivedHash, receivedShare []byte
+	receivedRound := int64(-1)
+	workerArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		AddSignatureShareCalled: func(round int64, pubKey []byte, headerHash []byte, signatureShare []byte) {
+			receivedRound = round
+			receivedPubKey = pubKey
+			receivedHash = headerHash
+			receivedShare = signatureShare
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	hdrHash := make([]byte, workerArgs.Hasher.Size())
+	hdrHash[0] = 1
+	pubKey := []byte(wrk.ConsensusState().ConsensusGroup()[1])
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		signature,
+		nil,
+		nil,
+		pubKey,
+		signature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err := wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), receivedRound)
+	assert.Equal(t, pubKey, receivedPubKey)
+	assert.Equal(t, hdrHash, receivedHash)
+	assert.Equal(t, signature, receivedShare)
+}
+
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
@@ -1077,6 +1166,23 @@ func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	assert.Equal(t, spos.ErrMessageFromItself, err)
 }
 
+func TestWorker_CheckSelfStateManagedKeyShouldErrMessageFromItself(t *testing.T) {
+	t.Parallel()
+	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
+	wrk.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == "hosted key"
+		},
+	})
+	cnsMsg := &consensus.Message{PubKey: []byte("hosted key")}
+	err := wrk.CheckSelfState(cnsMsg)
+	assert.Equal(t, spos.ErrMessageFromItself, err)
+
+	cnsMsg.PubKey = []byte("other key")
+	err = wrk.CheckSelfState(cnsMsg)
+	assert.Nil(t, err)
+}
+
 func TestWorker_CheckSelfStateShouldErrRoundCanceled(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
@@ -0,0 +1,23 @@
+package tracer
+
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
+func cloneRoundTrace(rt *consensus.RoundTrace) *consensus.RoundTrace {
+	cloned := *rt
+	cloned.Subrounds = make([]*consensus.SubroundTrace, 0, len(rt.Subrounds))
+	for _, sr := range rt.Subrounds {
+		srCopy := *sr
+		cloned.Subrounds = append(cloned.Subrounds, &srCopy)
+	}
+	cloned.Signatures = make([]*consensus.SignatureTrace, 0, len(rt.Signatures))
+	for _, sig := range rt.Signatures {
+		sigCopy := *sig
+		cloned.Signatures = append(cloned.Signatures, &sigCopy)
+	}
+	if rt.BlockProposal != nil {
+		proposalCopy := *rt.BlockProposal
+		cloned.BlockProposal = &proposalCopy
+	}
+
+	return &cloned
+}
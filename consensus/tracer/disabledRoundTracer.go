@@ -0,0 +1,65 @@
+package tracer
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var _ consensus.RoundTracer = (*disabledRoundTracer)(nil)
+
+type disabledRoundTracer struct {
+}
+
+// NewDisabledRoundTracer returns a disabled instance of the round tracer
+func NewDisabledRoundTracer() *disabledRoundTracer {
+	return &disabledRoundTracer{}
+}
+
+// StartRound does nothing
+func (drt *disabledRoundTracer) StartRound(_ int64, _ time.Time) {
+}
+
+// StartSubround does nothing
+func (drt *disabledRoundTracer) StartSubround(_ int64, _ string) {
+}
+
+// EndSubround does nothing
+func (drt *disabledRoundTracer) EndSubround(_ int64, _ string, _ bool) {
+}
+
+// SetLeader does nothing
+func (drt *disabledRoundTracer) SetLeader(_ int64, _ []byte) {
+}
+
+// BlockProposed does nothing
+func (drt *disabledRoundTracer) BlockProposed(_ int64, _ []byte, _ bool) {
+}
+
+// SignatureReceived does nothing
+func (drt *disabledRoundTracer) SignatureReceived(_ int64, _ []byte, _ core.PeerID) {
+}
+
+// AggregatedSignatureReady does nothing
+func (drt *disabledRoundTracer) AggregatedSignatureReady(_ int64) {
+}
+
+// BlockCommitted does nothing
+func (drt *disabledRoundTracer) BlockCommitted(_ int64, _ uint64) {
+}
+
+// GetRounds returns an empty slice
+func (drt *disabledRoundTracer) GetRounds() []*consensus.RoundTrace {
+	return make([]*consensus.RoundTrace, 0)
+}
+
+// Close does nothing
+func (drt *disabledRoundTracer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drt *disabledRoundTracer) IsInterfaceNil() bool {
+	return drt == nil
+}
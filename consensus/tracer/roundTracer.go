@@ -0,0 +1,292 @@
+package tracer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+var _ consensus.RoundTracer = (*roundTracer)(nil)
+
+var log = logger.GetOrCreate("consensus/tracer")
+
+const (
+	// OutcomeCommitted is the outcome of a round in which a block was committed
+	OutcomeCommitted = "committed"
+	// OutcomeNoBlock is the outcome of a round that ended without any block being committed
+	OutcomeNoBlock = "no block"
+	// OutcomeTimedOutPrefix prefixes the outcome of a round in which a subround did not finish in time
+	OutcomeTimedOutPrefix = "timed out in "
+)
+
+// ArgsRoundTracer holds the arguments needed to create a round tracer
+type ArgsRoundTracer struct {
+	SyncTimer       ntp.SyncTimer
+	NumRoundsToKeep int
+	ExportWriter    io.Writer
+}
+
+// roundTracer records, for each consensus round, the timeline of the subrounds and of the consensus messages.
+// It keeps the last rounds in memory and optionally writes each finished round as a JSON line in the export writer
+type roundTracer struct {
+	syncTimer       ntp.SyncTimer
+	numRoundsToKeep int
+	exportWriter    io.Writer
+
+	mutRounds      sync.RWMutex
+	rounds         []*consensus.RoundTrace
+	currentRound   *consensus.RoundTrace
+	roundStartTime time.Time
+	proposalTime   time.Time
+}
+
+// NewRoundTracer creates a new round tracer instance
+func NewRoundTracer(args ArgsRoundTracer) (*roundTracer, error) {
+	if check.IfNil(args.SyncTimer) {
+		return nil, ErrNilSyncTimer
+	}
+	if args.NumRoundsToKeep < 1 {
+		return nil, fmt.Errorf("%w, provided %d", ErrInvalidNumRoundsToKeep, args.NumRoundsToKeep)
+	}
+
+	return &roundTracer{
+		syncTimer:       args.SyncTimer,
+		numRoundsToKeep: args.NumRoundsToKeep,
+		exportWriter:    args.ExportWriter,
+		rounds:          make([]*consensus.RoundTrace, 0, args.NumRoundsToKeep),
+	}, nil
+}
+
+// StartRound closes the previous round trace and opens a new one
+func (rt *roundTracer) StartRound(round int64, roundTimeStamp time.Time) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	if rt.currentRound != nil && rt.currentRound.Round == round {
+		return
+	}
+
+	rt.finishCurrentRound()
+
+	rt.roundStartTime = roundTimeStamp
+	rt.proposalTime = time.Time{}
+	rt.currentRound = &consensus.RoundTrace{
+		Round:          round,
+		RoundTimeStamp: roundTimeStamp.Unix(),
+		Subrounds:      make([]*consensus.SubroundTrace, 0),
+		Signatures:     make([]*consensus.SignatureTrace, 0),
+	}
+	rt.rounds = append(rt.rounds, rt.currentRound)
+	if len(rt.rounds) > rt.numRoundsToKeep {
+		rt.rounds = rt.rounds[len(rt.rounds)-rt.numRoundsToKeep:]
+	}
+}
+
+func (rt *roundTracer) finishCurrentRound() {
+	if rt.currentRound == nil {
+		return
+	}
+	if len(rt.currentRound.Outcome) == 0 {
+		rt.currentRound.Outcome = OutcomeNoBlock
+	}
+
+	rt.export(rt.currentRound)
+}
+
+func (rt *roundTracer) export(trace *consensus.RoundTrace) {
+	if rt.exportWriter == nil {
+		return
+	}
+
+	buff, err := json.Marshal(trace)
+	if err != nil {
+		log.Warn("roundTracer.export marshal", "round", trace.Round, "error", err)
+		return
+	}
+
+	_, err = rt.exportWriter.Write(append(buff, '\n'))
+	if err != nil {
+		log.Warn("roundTracer.export write", "round", trace.Round, "error", err)
+	}
+}
+
+// StartSubround records the start of a subround
+func (rt *roundTracer) StartSubround(round int64, subroundName string) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getRound(round)
+	if trace == nil {
+		return
+	}
+
+	trace.Subrounds = append(trace.Subrounds, &consensus.SubroundTrace{
+		Name:        subroundName,
+		StartOffset: rt.offset(),
+	})
+}
+
+// EndSubround records the end of a subround. A subround that did not finish sets the round outcome
+func (rt *roundTracer) EndSubround(round int64, subroundName string, finished bool) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getRound(round)
+	if trace == nil {
+		return
+	}
+
+	for i := len(trace.Subrounds) - 1; i >= 0; i-- {
+		sr := trace.Subrounds[i]
+		if sr.Name != subroundName {
+			continue
+		}
+
+		sr.EndOffset = rt.offset()
+		sr.Finished = finished
+		break
+	}
+
+	if !finished && len(trace.Outcome) == 0 {
+		trace.Outcome = OutcomeTimedOutPrefix + subroundName
+	}
+}
+
+// SetLeader records the leader of the round
+func (rt *roundTracer) SetLeader(round int64, leader []byte) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getRound(round)
+	if trace == nil {
+		return
+	}
+
+	trace.Leader = hex.EncodeToString(leader)
+}
+
+// BlockProposed records the moment the block proposal was sent (if self proposed) or received
+func (rt *roundTracer) BlockProposed(round int64, headerHash []byte, selfProposed bool) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getRound(round)
+	if trace == nil || trace.BlockProposal != nil {
+		return
+	}
+
+	rt.proposalTime = rt.syncTimer.CurrentTime()
+	trace.BlockProposal = &consensus.BlockProposalTrace{
+		HeaderHash:   hex.EncodeToString(headerHash),
+		Offset:       rt.offset(),
+		SelfProposed: selfProposed,
+	}
+}
+
+// SignatureReceived records a valid signature share received from a consensus group member. The latency is computed
+// from the moment the block was proposed
+func (rt *roundTracer) SignatureReceived(round int64, pubKey []byte, pid core.PeerID) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getRound(round)
+	if trace == nil {
+		return
+	}
+
+	offset := rt.offset()
+	latency := offset
+	if !rt.proposalTime.IsZero() {
+		latency = rt.syncTimer.CurrentTime().Sub(rt.proposalTime).Milliseconds()
+	}
+
+	trace.Signatures = append(trace.Signatures, &consensus.SignatureTrace{
+		PubKey:  hex.EncodeToString(pubKey),
+		PeerID:  pid.Pretty(),
+		Offset:  offset,
+		Latency: latency,
+	})
+}
+
+// AggregatedSignatureReady records the moment the aggregated signature was computed or received
+func (rt *roundTracer) AggregatedSignatureReady(round int64) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getRound(round)
+	if trace == nil || trace.HasAggregatedSignature {
+		return
+	}
+
+	trace.AggregatedSignatureOffset = rt.offset()
+	trace.HasAggregatedSignature = true
+}
+
+// BlockCommitted sets the committed outcome of the round
+func (rt *roundTracer) BlockCommitted(round int64, nonce uint64) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getRound(round)
+	if trace == nil {
+		return
+	}
+
+	trace.Outcome = OutcomeCommitted
+	trace.CommittedNonce = nonce
+}
+
+// GetRounds returns a copy of the kept rounds, the most recent one being the last
+func (rt *roundTracer) GetRounds() []*consensus.RoundTrace {
+	rt.mutRounds.RLock()
+	defer rt.mutRounds.RUnlock()
+
+	rounds := make([]*consensus.RoundTrace, 0, len(rt.rounds))
+	for _, trace := range rt.rounds {
+		rounds = append(rounds, cloneRoundTrace(trace))
+	}
+
+	return rounds
+}
+
+func (rt *roundTracer) getRound(round int64) *consensus.RoundTrace {
+	if rt.currentRound == nil || rt.currentRound.Round != round {
+		return nil
+	}
+
+	return rt.currentRound
+}
+
+func (rt *roundTracer) offset() int64 {
+	return rt.syncTimer.CurrentTime().Sub(rt.roundStartTime).Milliseconds()
+}
+
+// Close writes the current round in the export writer and closes it, if possible
+func (rt *roundTracer) Close() error {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	rt.finishCurrentRound()
+	rt.currentRound = nil
+
+	closer, ok := rt.exportWriter.(io.Closer)
+	if !ok {
+		return nil
+	}
+
+	return closer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *roundTracer) IsInterfaceNil() bool {
+	return rt == nil
+}
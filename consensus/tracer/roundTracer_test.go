@@ -0,0 +1,194 @@
+package tracer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type controlledTime struct {
+	current time.Time
+}
+
+func (ct *controlledTime) advance(duration time.Duration) {
+	ct.current = ct.current.Add(duration)
+}
+
+func createMockArgsRoundTracer(ct *controlledTime) ArgsRoundTracer {
+	return ArgsRoundTracer{
+		SyncTimer: &mock.SyncTimerMock{
+			CurrentTimeCalled: func() time.Time {
+				return ct.current
+			},
+		},
+		NumRoundsToKeep: 2,
+	}
+}
+
+func TestNewRoundTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sync timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTracer(&controlledTime{})
+		args.SyncTimer = nil
+		rt, err := NewRoundTracer(args)
+
+		assert.True(t, check.IfNil(rt))
+		assert.Equal(t, ErrNilSyncTimer, err)
+	})
+	t.Run("invalid number of rounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTracer(&controlledTime{})
+		args.NumRoundsToKeep = 0
+		rt, err := NewRoundTracer(args)
+
+		assert.True(t, check.IfNil(rt))
+		assert.True(t, errors.Is(err, ErrInvalidNumRoundsToKeep))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rt, err := NewRoundTracer(createMockArgsRoundTracer(&controlledTime{}))
+
+		assert.False(t, check.IfNil(rt))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRoundTracer_ShouldRecordTheRoundTimeline(t *testing.T) {
+	t.Parallel()
+
+	ct := &controlledTime{current: time.Unix(1000, 0)}
+	rt, _ := NewRoundTracer(createMockArgsRoundTracer(ct))
+
+	roundStart := ct.current
+	rt.StartRound(5, roundStart)
+	rt.SetLeader(5, []byte("leader"))
+	rt.StartSubround(5, "(START_ROUND)")
+	ct.advance(10 * time.Millisecond)
+	rt.EndSubround(5, "(START_ROUND)", true)
+	rt.StartSubround(5, "(BLOCK)")
+	ct.advance(100 * time.Millisecond)
+	rt.BlockProposed(5, []byte("hash"), true)
+	rt.EndSubround(5, "(BLOCK)", true)
+	ct.advance(40 * time.Millisecond)
+	rt.SignatureReceived(5, []byte("pk"), core.PeerID("pid"))
+	ct.advance(50 * time.Millisecond)
+	rt.AggregatedSignatureReady(5)
+	rt.BlockCommitted(5, 37)
+
+	// events for other rounds should be ignored
+	rt.SignatureReceived(4, []byte("pk2"), core.PeerID("pid2"))
+
+	rounds := rt.GetRounds()
+	require.Equal(t, 1, len(rounds))
+	trace := rounds[0]
+	assert.Equal(t, int64(5), trace.Round)
+	assert.Equal(t, "6c6561646572", trace.Leader)
+	require.Equal(t, 2, len(trace.Subrounds))
+	assert.Equal(t, &consensus.SubroundTrace{Name: "(START_ROUND)", StartOffset: 0, EndOffset: 10, Finished: true}, trace.Subrounds[0])
+	assert.Equal(t, &consensus.SubroundTrace{Name: "(BLOCK)", StartOffset: 10, EndOffset: 110, Finished: true}, trace.Subrounds[1])
+	assert.Equal(t, &consensus.BlockProposalTrace{HeaderHash: "68617368", Offset: 110, SelfProposed: true}, trace.BlockProposal)
+	require.Equal(t, 1, len(trace.Signatures))
+	assert.Equal(t, int64(150), trace.Signatures[0].Offset)
+	assert.Equal(t, int64(40), trace.Signatures[0].Latency)
+	assert.True(t, trace.HasAggregatedSignature)
+	assert.Equal(t, int64(200), trace.AggregatedSignatureOffset)
+	assert.Equal(t, OutcomeCommitted, trace.Outcome)
+	assert.Equal(t, uint64(37), trace.CommittedNonce)
+}
+
+func TestRoundTracer_NotFinishedSubroundShouldSetOutcome(t *testing.T) {
+	t.Parallel()
+
+	ct := &controlledTime{current: time.Unix(1000, 0)}
+	rt, _ := NewRoundTracer(createMockArgsRoundTracer(ct))
+
+	rt.StartRound(1, ct.current)
+	rt.StartSubround(1, "(SIGNATURE)")
+	rt.EndSubround(1, "(SIGNATURE)", false)
+	rt.StartRound(2, ct.current)
+
+	rounds := rt.GetRounds()
+	require.Equal(t, 2, len(rounds))
+	assert.Equal(t, OutcomeTimedOutPrefix+"(SIGNATURE)", rounds[0].Outcome)
+	assert.Equal(t, "", rounds[1].Outcome)
+}
+
+func TestRoundTracer_ShouldKeepOnlyTheLastRounds(t *testing.T) {
+	t.Parallel()
+
+	ct := &controlledTime{current: time.Unix(1000, 0)}
+	rt, _ := NewRoundTracer(createMockArgsRoundTracer(ct))
+
+	rt.StartRound(1, ct.current)
+	rt.StartRound(2, ct.current)
+	rt.StartRound(2, ct.current)
+	rt.StartRound(3, ct.current)
+
+	rounds := rt.GetRounds()
+	require.Equal(t, 2, len(rounds))
+	assert.Equal(t, int64(2), rounds[0].Round)
+	assert.Equal(t, int64(3), rounds[1].Round)
+	assert.Equal(t, OutcomeNoBlock, rounds[0].Outcome)
+}
+
+func TestRoundTracer_GetRoundsShouldReturnCopies(t *testing.T) {
+	t.Parallel()
+
+	ct := &controlledTime{current: time.Unix(1000, 0)}
+	rt, _ := NewRoundTracer(createMockArgsRoundTracer(ct))
+
+	rt.StartRound(1, ct.current)
+	rt.StartSubround(1, "(BLOCK)")
+	rounds := rt.GetRounds()
+	rounds[0].Subrounds[0].Name = "changed"
+	rounds[0].Outcome = "changed"
+
+	rounds = rt.GetRounds()
+	assert.Equal(t, "(BLOCK)", rounds[0].Subrounds[0].Name)
+	assert.Equal(t, "", rounds[0].Outcome)
+}
+
+func TestRoundTracer_ShouldExportFinishedRounds(t *testing.T) {
+	t.Parallel()
+
+	ct := &controlledTime{current: time.Unix(1000, 0)}
+	args := createMockArgsRoundTracer(ct)
+	buff := &bytes.Buffer{}
+	args.ExportWriter = buff
+	rt, _ := NewRoundTracer(args)
+
+	rt.StartRound(1, ct.current)
+	rt.BlockCommitted(1, 10)
+	rt.StartRound(2, ct.current)
+	err := rt.Close()
+	assert.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Equal(t, 2, len(lines))
+
+	exported := &consensus.RoundTrace{}
+	err = json.Unmarshal([]byte(lines[0]), exported)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), exported.Round)
+	assert.Equal(t, OutcomeCommitted, exported.Outcome)
+
+	err = json.Unmarshal([]byte(lines[1]), exported)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), exported.Round)
+	assert.Equal(t, OutcomeNoBlock, exported.Outcome)
+}
@@ -93,6 +93,8 @@ const (
 	ESDTSuppliesUnit UnitType = 18
 	// RoundHdrHashDataUnit is the round- block header hash storage data unit identifier
 	RoundHdrHashDataUnit UnitType = 19
+	// EpochEconomicsUnit is the per-epoch economics and rewards reports storage unit identifier
+	EpochEconomicsUnit UnitType = 20
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
 
 var errorDisabledHistoryRepository = errors.New("history repository is disabled")
@@ -51,6 +52,16 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (string, error) {
 	return "", errorDisabledHistoryRepository
 }
 
+// GetESDTHolders -
+func (nhr *nilHistoryRepository) GetESDTHolders(_ string, _ uint32, _ uint32) (*esdtSupply.HoldersPage, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetESDTSupplyHistory -
+func (nhr *nilHistoryRepository) GetESDTSupplyHistory(_ string, _ esdtSupply.SupplyHistoryFilter) (*esdtSupply.SupplyHistoryResult, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
@@ -3,3 +3,14 @@ package esdtSupply
 import "errors"
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
+
+var errNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrHoldersIndexDisabled signals that the ESDT holders index was requested while it is not enabled
+var ErrHoldersIndexDisabled = errors.New("the ESDT holders index is not enabled")
+
+var errNilBlockHeader = errors.New("nil block header")
+
+var errInconsistentHoldersIndex = errors.New("inconsistent holders index")
+
+var errInconsistentSupplyHistory = errors.New("inconsistent supply history")
@@ -10,48 +10,68 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var log = logger.GetOrCreate("dblookupext/esdtSupply")
 
+// ArgsSuppliesProcessor holds the arguments needed to create a new supplies processor
+type ArgsSuppliesProcessor struct {
+	Marshalizer         marshal.Marshalizer
+	SuppliesStorer      storage.Storer
+	LogsStorer          storage.Storer
+	ShardCoordinator    sharding.Coordinator
+	HoldersIndexEnabled bool
+}
+
 type suppliesProcessor struct {
-	logsProc *logsProcessor
-	logsGet  *logsGetter
-	mutex    sync.Mutex
+	logsProc   *logsProcessor
+	logsGet    *logsGetter
+	holdersIdx *holdersIndex
+	mutex      sync.Mutex
 }
 
 // NewSuppliesProcessor will create a new instance of the supplies processor
-func NewSuppliesProcessor(
-	marshalizer marshal.Marshalizer,
-	suppliesStorer storage.Storer,
-	logsStorer storage.Storer,
-) (*suppliesProcessor, error) {
-	if check.IfNil(marshalizer) {
+func NewSuppliesProcessor(args ArgsSuppliesProcessor) (*suppliesProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
 		return nil, core.ErrNilMarshalizer
 	}
-	if check.IfNil(suppliesStorer) {
+	if check.IfNil(args.SuppliesStorer) {
 		return nil, core.ErrNilStore
 	}
-	if check.IfNil(logsStorer) {
+	if check.IfNil(args.LogsStorer) {
 		return nil, core.ErrNilStore
 	}
+	if args.HoldersIndexEnabled && check.IfNil(args.ShardCoordinator) {
+		return nil, errNilShardCoordinator
+	}
 
-	logsGet := newLogsGetter(marshalizer, logsStorer)
-	logsProc := newLogsProcessor(marshalizer, suppliesStorer)
+	var holdersIdx *holdersIndex
+	if args.HoldersIndexEnabled {
+		holdersIdx = newHoldersIndex(args.SuppliesStorer, args.ShardCoordinator)
+	}
+
+	logsGet := newLogsGetter(args.Marshalizer, args.LogsStorer)
+	logsProc := newLogsProcessor(args.Marshalizer, args.SuppliesStorer, holdersIdx)
 
 	return &suppliesProcessor{
-		logsProc: logsProc,
-		logsGet:  logsGet,
+		logsProc:   logsProc,
+		logsGet:    logsGet,
+		holdersIdx: holdersIdx,
 	}, nil
 }
 
 // ProcessLogs will process the provided logs
-func (sp *suppliesProcessor) ProcessLogs(blockNonce uint64, logs map[string]data.LogHandler) error {
+func (sp *suppliesProcessor) ProcessLogs(blockHeader data.HeaderHandler, logs map[string]data.LogHandler) error {
+	if check.IfNil(blockHeader) {
+		return errNilBlockHeader
+	}
+
 	sp.mutex.Lock()
 	defer sp.mutex.Unlock()
 
-	return sp.logsProc.processLogs(blockNonce, logs, false)
+	return sp.logsProc.processLogs(blockHeader, logs, false)
 }
 
 // RevertChanges will revert supplies changes based on the provided block body
@@ -68,7 +88,7 @@ func (sp *suppliesProcessor) RevertChanges(header data.HeaderHandler, body data.
 		return err
 	}
 
-	return sp.logsProc.processLogs(header.GetNonce(), logsFromDB, true)
+	return sp.logsProc.processLogs(header, logsFromDB, true)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -76,6 +96,38 @@ func (sp *suppliesProcessor) GetESDTSupply(token string) (string, error) {
 	return sp.logsProc.getESDTSupply(token)
 }
 
+// GetESDTHolders will return a page of the holders of the given token from the holders index
+func (sp *suppliesProcessor) GetESDTHolders(token string, offset uint32, limit uint32) (*HoldersPage, error) {
+	if sp.holdersIdx == nil {
+		return nil, ErrHoldersIndexDisabled
+	}
+
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	return sp.holdersIdx.getHoldersPage(token, offset, limit)
+}
+
+// GetESDTSupplyHistory will return the current supply of the given token, its minted and burned breakdown and the
+// per-epoch snapshots and supply changing events matching the provided filter
+func (sp *suppliesProcessor) GetESDTSupplyHistory(token string, filter SupplyHistoryFilter) (*SupplyHistoryResult, error) {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	result, err := sp.logsProc.history.getFilteredHistory(token, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := sp.logsProc.getSupply([]byte(token))
+	if err != nil {
+		return nil, err
+	}
+	result.Supply = supply.Supply
+
+	return result, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sp *suppliesProcessor) IsInterfaceNil() bool {
 	return sp == nil
@@ -7,25 +7,47 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
 
+func createMockArgsSuppliesProcessor() ArgsSuppliesProcessor {
+	return ArgsSuppliesProcessor{
+		Marshalizer:      &testscommon.MarshalizerMock{},
+		SuppliesStorer:   &testscommon.StorerStub{},
+		LogsStorer:       &testscommon.StorerStub{},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(2),
+	}
+}
+
 func TestNewSuppliesProcessor(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewSuppliesProcessor(nil, &testscommon.StorerStub{}, &testscommon.StorerStub{})
+	args := createMockArgsSuppliesProcessor()
+	args.Marshalizer = nil
+	_, err := NewSuppliesProcessor(args)
 	require.Equal(t, core.ErrNilMarshalizer, err)
 
-	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, nil, &testscommon.StorerStub{})
+	args = createMockArgsSuppliesProcessor()
+	args.SuppliesStorer = nil
+	_, err = NewSuppliesProcessor(args)
 	require.Equal(t, core.ErrNilStore, err)
 
-	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, &testscommon.StorerStub{}, nil)
+	args = createMockArgsSuppliesProcessor()
+	args.LogsStorer = nil
+	_, err = NewSuppliesProcessor(args)
 	require.Equal(t, core.ErrNilStore, err)
 
-	proc, err := NewSuppliesProcessor(&testscommon.MarshalizerMock{}, &testscommon.StorerStub{}, &testscommon.StorerStub{})
+	args = createMockArgsSuppliesProcessor()
+	args.HoldersIndexEnabled = true
+	args.ShardCoordinator = nil
+	_, err = NewSuppliesProcessor(args)
+	require.Equal(t, errNilShardCoordinator, err)
+
+	proc, err := NewSuppliesProcessor(createMockArgsSuppliesProcessor())
 	require.Nil(t, err)
 	require.NotNil(t, proc)
 	require.False(t, proc.IsInterfaceNil())
@@ -70,6 +92,10 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 			return nil, storage.ErrKeyNotFound
 		},
 		PutCalled: func(key, data []byte) error {
+			if isSupplyHistoryKey(key) {
+				return nil
+			}
+
 			supplyKey := string(token) + "-" + string(big.NewInt(2).Bytes())
 			require.Equal(t, supplyKey, string(key))
 
@@ -81,10 +107,13 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 		},
 	}
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &testscommon.StorerStub{})
+	args := createMockArgsSuppliesProcessor()
+	args.Marshalizer = marshalizer
+	args.SuppliesStorer = suppliesStorer
+	suppliesProc, err := NewSuppliesProcessor(args)
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(0, logs)
+	err = suppliesProc.ProcessLogs(&block.Header{Nonce: 0}, logs)
 	require.Nil(t, err)
 }
 
@@ -92,7 +121,9 @@ func TestSupplyESDT_GetSupply(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &testscommon.MarshalizerMock{}
-	proc, _ := NewSuppliesProcessor(marshalizer, &testscommon.StorerStub{
+	args := createMockArgsSuppliesProcessor()
+	args.Marshalizer = marshalizer
+	args.SuppliesStorer = &testscommon.StorerStub{
 		GetCalled: func(key []byte) ([]byte, error) {
 			if string(key) == "my-token" {
 				supply := &SupplyESDT{Supply: big.NewInt(123456)}
@@ -100,7 +131,8 @@ func TestSupplyESDT_GetSupply(t *testing.T) {
 			}
 			return nil, errors.New("local err")
 		},
-	}, &testscommon.StorerStub{})
+	}
+	proc, _ := NewSuppliesProcessor(args)
 
 	res, err := proc.GetESDTSupply("my-token")
 	require.Nil(t, err)
@@ -0,0 +1,378 @@
+package esdtSupply
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	holdersKeyPrefix       = "holders-"
+	minTopicsWithReceiver  = 4
+	receiverTopicIndex     = 3
+	holderOperationCredit  = holderOperation(0)
+	holderOperationDebit   = holderOperation(1)
+	holderOperationMove    = holderOperation(2)
+	holderOperationWipeOut = holderOperation(3)
+)
+
+type holderOperation int
+
+// HolderESDT holds the balance of an address for a token, as seen by the holders index
+type HolderESDT struct {
+	Address []byte   `json:"address"`
+	Balance *big.Int `json:"balance"`
+}
+
+// holderRecordESDT is the record stored for each holder of a token, under its own key. The position is the index
+// of the holder in the dense list of the token holders, used for pagination
+type holderRecordESDT struct {
+	Balance  *big.Int `json:"balance"`
+	Position uint32   `json:"position"`
+}
+
+// numHoldersESDT holds the number of holders of a token
+type numHoldersESDT struct {
+	NumHolders uint32 `json:"numHolders"`
+}
+
+// HoldersPage holds a page of the holders of a token together with the total number of holders
+type HoldersPage struct {
+	NumHolders uint32
+	Holders    []*HolderESDT
+}
+
+type holderChange struct {
+	delta   *big.Int
+	wipeOut bool
+}
+
+// holdersIndex keeps, for each token, the addresses from the self shard that hold a positive balance. The balances
+// are built only from the events processed since the index was enabled, so the index should be enabled on a node
+// that syncs from genesis in order to hold accurate values.
+// Each (token, holder) pair is stored under its own key, next to a dense list of positions that maps each index in
+// [0, numHolders) to a holder address, so a page of holders is read without loading all the holders of the token.
+// A removed holder is replaced in the list by the last one, so the pages hold the holders in the order they were
+// added, except for those moved in the place of removed holders
+type holdersIndex struct {
+	marshalizer      marshal.Marshalizer
+	storer           storage.Storer
+	shardCoordinator sharding.Coordinator
+	operations       map[string]holderOperation
+}
+
+func newHoldersIndex(storer storage.Storer, shardCoordinator sharding.Coordinator) *holdersIndex {
+	return &holdersIndex{
+		marshalizer:      common.NewApiRecordsMarshalizer(),
+		storer:           storer,
+		shardCoordinator: shardCoordinator,
+		operations: map[string]holderOperation{
+			core.BuiltInFunctionESDTLocalMint:        holderOperationCredit,
+			core.BuiltInFunctionESDTNFTCreate:        holderOperationCredit,
+			core.BuiltInFunctionESDTNFTAddQuantity:   holderOperationCredit,
+			core.BuiltInFunctionESDTLocalBurn:        holderOperationDebit,
+			core.BuiltInFunctionESDTNFTBurn:          holderOperationDebit,
+			core.BuiltInFunctionESDTBurn:             holderOperationDebit,
+			core.BuiltInFunctionESDTTransfer:         holderOperationMove,
+			core.BuiltInFunctionESDTNFTTransfer:      holderOperationMove,
+			core.BuiltInFunctionMultiESDTNFTTransfer: holderOperationMove,
+			core.BuiltInFunctionESDTWipe:             holderOperationWipeOut,
+		},
+	}
+}
+
+func (hi *holdersIndex) processEvent(event *transaction.Event, changes map[string]map[string]*holderChange, isRevert bool) {
+	operation, found := hi.operations[string(event.Identifier)]
+	if !found {
+		return
+	}
+	if len(event.Topics) < 3 {
+		return
+	}
+
+	tokenIdentifier := string(computeTokenIdentifier(event))
+	value := big.NewInt(0).SetBytes(event.Topics[2])
+	if isRevert {
+		value.Neg(value)
+	}
+
+	switch operation {
+	case holderOperationCredit:
+		hi.addChange(changes, tokenIdentifier, event.Address, value)
+	case holderOperationDebit:
+		hi.addChange(changes, tokenIdentifier, event.Address, big.NewInt(0).Neg(value))
+	case holderOperationMove:
+		if len(event.Topics) < minTopicsWithReceiver {
+			return
+		}
+		hi.addChange(changes, tokenIdentifier, event.Address, big.NewInt(0).Neg(value))
+		hi.addChange(changes, tokenIdentifier, event.Topics[receiverTopicIndex], value)
+	case holderOperationWipeOut:
+		// the wiped balance is not part of the event so a wipe can not be reverted
+		if isRevert || len(event.Topics) < minTopicsWithReceiver {
+			return
+		}
+		hi.wipeOut(changes, tokenIdentifier, event.Topics[receiverTopicIndex])
+	}
+}
+
+func (hi *holdersIndex) addChange(changes map[string]map[string]*holderChange, token string, address []byte, delta *big.Int) {
+	change := hi.getChange(changes, token, address)
+	if change == nil {
+		return
+	}
+
+	change.delta.Add(change.delta, delta)
+}
+
+func (hi *holdersIndex) wipeOut(changes map[string]map[string]*holderChange, token string, address []byte) {
+	change := hi.getChange(changes, token, address)
+	if change == nil {
+		return
+	}
+
+	change.delta.SetInt64(0)
+	change.wipeOut = true
+}
+
+func (hi *holdersIndex) getChange(changes map[string]map[string]*holderChange, token string, address []byte) *holderChange {
+	if len(address) == 0 || hi.shardCoordinator.ComputeId(address) != hi.shardCoordinator.SelfId() {
+		return nil
+	}
+
+	tokenChanges, found := changes[token]
+	if !found {
+		tokenChanges = make(map[string]*holderChange)
+		changes[token] = tokenChanges
+	}
+
+	change, found := tokenChanges[string(address)]
+	if !found {
+		change = &holderChange{
+			delta: big.NewInt(0),
+		}
+		tokenChanges[string(address)] = change
+	}
+
+	return change
+}
+
+func (hi *holdersIndex) saveChanges(changes map[string]map[string]*holderChange) error {
+	for _, token := range sortedTokens(changes) {
+		err := hi.saveTokenChanges(token, changes[token])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hi *holdersIndex) saveTokenChanges(token string, tokenChanges map[string]*holderChange) error {
+	numHolders, err := hi.getNumHolders(token)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range sortedAddresses(tokenChanges) {
+		change := tokenChanges[address]
+		record, errGet := hi.getHolder(token, []byte(address))
+		if errGet != nil {
+			return errGet
+		}
+
+		balance := big.NewInt(0)
+		if record != nil && !change.wipeOut {
+			balance.Set(record.Balance)
+		}
+		balance.Add(balance, change.delta)
+
+		switch {
+		case balance.Sign() > 0 && record != nil:
+			record.Balance = balance
+			err = hi.put(holderKey(token, []byte(address)), record)
+		case balance.Sign() > 0:
+			err = hi.addHolder(token, []byte(address), balance, numHolders)
+			numHolders++
+		case record != nil:
+			err = hi.removeHolder(token, []byte(address), record, numHolders)
+			numHolders--
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return hi.put(numHoldersKey(token), &numHoldersESDT{NumHolders: numHolders})
+}
+
+func (hi *holdersIndex) addHolder(token string, address []byte, balance *big.Int, numHolders uint32) error {
+	err := hi.storer.Put(holderPositionKey(token, numHolders), address)
+	if err != nil {
+		return err
+	}
+
+	return hi.put(holderKey(token, address), &holderRecordESDT{
+		Balance:  balance,
+		Position: numHolders,
+	})
+}
+
+// removeHolder moves the last holder of the token in the position of the removed holder, keeping the positions dense
+func (hi *holdersIndex) removeHolder(token string, address []byte, record *holderRecordESDT, numHolders uint32) error {
+	lastPosition := numHolders - 1
+	if record.Position != lastPosition {
+		lastAddress, err := hi.storer.Get(holderPositionKey(token, lastPosition))
+		if err != nil {
+			return err
+		}
+		lastRecord, err := hi.getHolder(token, lastAddress)
+		if err != nil {
+			return err
+		}
+		if lastRecord == nil {
+			return fmt.Errorf("%w for position %d of token %s", errInconsistentHoldersIndex, lastPosition, token)
+		}
+
+		lastRecord.Position = record.Position
+		err = hi.put(holderKey(token, lastAddress), lastRecord)
+		if err != nil {
+			return err
+		}
+		err = hi.storer.Put(holderPositionKey(token, record.Position), lastAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := hi.storer.Remove(holderPositionKey(token, lastPosition))
+	if err != nil {
+		return err
+	}
+
+	return hi.storer.Remove(holderKey(token, address))
+}
+
+func (hi *holdersIndex) getNumHolders(token string) (uint32, error) {
+	numHolders := &numHoldersESDT{}
+	found, err := hi.get(numHoldersKey(token), numHolders)
+	if err != nil || !found {
+		return 0, err
+	}
+
+	return numHolders.NumHolders, nil
+}
+
+// getHolder returns nil if the address does not hold the token
+func (hi *holdersIndex) getHolder(token string, address []byte) (*holderRecordESDT, error) {
+	record := &holderRecordESDT{}
+	found, err := hi.get(holderKey(token, address), record)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (hi *holdersIndex) getHoldersPage(token string, offset uint32, limit uint32) (*HoldersPage, error) {
+	numHolders, err := hi.getNumHolders(token)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &HoldersPage{
+		NumHolders: numHolders,
+		Holders:    make([]*HolderESDT, 0),
+	}
+	if offset >= numHolders {
+		return page, nil
+	}
+
+	end := numHolders
+	if limit > 0 && limit < numHolders-offset {
+		end = offset + limit
+	}
+	for position := offset; position < end; position++ {
+		address, errGet := hi.storer.Get(holderPositionKey(token, position))
+		if errGet != nil {
+			return nil, errGet
+		}
+		record, errGet := hi.getHolder(token, address)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if record == nil {
+			return nil, fmt.Errorf("%w for position %d of token %s", errInconsistentHoldersIndex, position, token)
+		}
+
+		page.Holders = append(page.Holders, &HolderESDT{
+			Address: address,
+			Balance: record.Balance,
+		})
+	}
+
+	return page, nil
+}
+
+func (hi *holdersIndex) get(key []byte, value interface{}) (bool, error) {
+	buff, err := hi.storer.Get(key)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, hi.marshalizer.Unmarshal(value, buff)
+}
+
+func (hi *holdersIndex) put(key []byte, value interface{}) error {
+	buff, err := hi.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return hi.storer.Put(key, buff)
+}
+
+func sortedTokens(changes map[string]map[string]*holderChange) []string {
+	tokens := make([]string, 0, len(changes))
+	for token := range changes {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	return tokens
+}
+
+func sortedAddresses(tokenChanges map[string]*holderChange) []string {
+	addresses := make([]string, 0, len(tokenChanges))
+	for address := range tokenChanges {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
+
+// the token is hex encoded in the keys so that the keys of a token can not collide with the ones of another token
+func numHoldersKey(token string) []byte {
+	return []byte(fmt.Sprintf("%s%s", holdersKeyPrefix, hex.EncodeToString([]byte(token))))
+}
+
+func holderKey(token string, address []byte) []byte {
+	return []byte(fmt.Sprintf("%s%s-address-%s", holdersKeyPrefix, hex.EncodeToString([]byte(token)), hex.EncodeToString(address)))
+}
+
+func holderPositionKey(token string, position uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s-position-%d", holdersKeyPrefix, hex.EncodeToString([]byte(token)), position))
+}
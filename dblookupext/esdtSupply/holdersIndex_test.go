@@ -0,0 +1,289 @@
+package esdtSupply
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	holderA       = []byte("aaaa")
+	holderB       = []byte("bbbb")
+	foreignHolder = []byte("cccc")
+)
+
+func createMapStorer() *testscommon.StorerStub {
+	values := make(map[string][]byte)
+
+	return &testscommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			value, found := values[string(key)]
+			if !found {
+				return nil, storage.ErrKeyNotFound
+			}
+			return value, nil
+		},
+		PutCalled: func(key, data []byte) error {
+			values[string(key)] = data
+			return nil
+		},
+		RemoveCalled: func(key []byte) error {
+			delete(values, string(key))
+			return nil
+		},
+		RangeKeysCalled: func(handler func(key []byte, val []byte) bool) {
+			for key, value := range values {
+				if !handler([]byte(key), value) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func createHoldersTestSuppliesProcessor(t *testing.T) *suppliesProcessor {
+	shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == string(foreignHolder) {
+			return 1
+		}
+		return 0
+	}
+
+	args := createMockArgsSuppliesProcessor()
+	args.SuppliesStorer = createMapStorer()
+	args.ShardCoordinator = shardCoordinator
+	args.HoldersIndexEnabled = true
+
+	proc, err := NewSuppliesProcessor(args)
+	require.Nil(t, err)
+
+	return proc
+}
+
+func createEvent(identifier string, address []byte, topics ...[]byte) *transaction.Event {
+	return &transaction.Event{
+		Identifier: []byte(identifier),
+		Address:    address,
+		Topics:     topics,
+	}
+}
+
+func createHoldersTestLogs(token []byte) map[string]data.LogHandler {
+	zero := big.NewInt(0).Bytes()
+
+	return map[string]data.LogHandler{
+		"txLog": &transaction.Log{
+			Events: []*transaction.Event{
+				createEvent(core.BuiltInFunctionESDTLocalMint, holderA, token, zero, big.NewInt(100).Bytes()),
+				createEvent(core.BuiltInFunctionESDTTransfer, holderA, token, zero, big.NewInt(30).Bytes(), holderB),
+				createEvent(core.BuiltInFunctionESDTTransfer, holderA, token, zero, big.NewInt(20).Bytes(), foreignHolder),
+				createEvent(core.BuiltInFunctionESDTLocalBurn, holderB, token, zero, big.NewInt(5).Bytes()),
+				createEvent(core.BuiltInFunctionESDTNFTCreate, holderA, []byte("NFT-abcdef"), big.NewInt(1).Bytes(), big.NewInt(1).Bytes()),
+			},
+		},
+	}
+}
+
+func TestSuppliesProcessor_GetESDTHoldersDisabledIndex(t *testing.T) {
+	t.Parallel()
+
+	proc, _ := NewSuppliesProcessor(createMockArgsSuppliesProcessor())
+
+	page, err := proc.GetESDTHolders("TKN-abcdef", 0, 10)
+	assert.Nil(t, page)
+	assert.Equal(t, ErrHoldersIndexDisabled, err)
+}
+
+func TestSuppliesProcessor_ProcessLogsShouldUpdateHolders(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createHoldersTestSuppliesProcessor(t)
+
+	err := proc.ProcessLogs(&block.Header{Nonce: 1}, createHoldersTestLogs(token))
+	require.Nil(t, err)
+
+	page, err := proc.GetESDTHolders(string(token), 0, 0)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), page.NumHolders)
+	require.Equal(t, 2, len(page.Holders))
+	assert.Equal(t, &HolderESDT{Address: holderA, Balance: big.NewInt(50)}, page.Holders[0])
+	assert.Equal(t, &HolderESDT{Address: holderB, Balance: big.NewInt(25)}, page.Holders[1])
+
+	page, err = proc.GetESDTHolders(string(token), 1, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), page.NumHolders)
+	require.Equal(t, 1, len(page.Holders))
+	assert.Equal(t, holderB, page.Holders[0].Address)
+
+	page, err = proc.GetESDTHolders(string(token), 5, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), page.NumHolders)
+	assert.Equal(t, 0, len(page.Holders))
+
+	page, err = proc.GetESDTHolders("NFT-abcdef-01", 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(page.Holders))
+	assert.Equal(t, holderA, page.Holders[0].Address)
+}
+
+func TestSuppliesProcessor_RevertShouldRemoveHolders(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createHoldersTestSuppliesProcessor(t)
+	logs := createHoldersTestLogs(token)
+
+	err := proc.ProcessLogs(&block.Header{Nonce: 1}, logs)
+	require.Nil(t, err)
+
+	err = proc.logsProc.processLogs(&block.Header{Nonce: 1}, logs, true)
+	require.Nil(t, err)
+
+	page, err := proc.GetESDTHolders(string(token), 0, 10)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(0), page.NumHolders)
+	assert.Equal(t, 0, len(page.Holders))
+}
+
+func TestSuppliesProcessor_WipeShouldRemoveHolder(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createHoldersTestSuppliesProcessor(t)
+
+	err := proc.ProcessLogs(&block.Header{Nonce: 1}, createHoldersTestLogs(token))
+	require.Nil(t, err)
+
+	wipeLogs := map[string]data.LogHandler{
+		"wipeLog": &transaction.Log{
+			Events: []*transaction.Event{
+				createEvent(core.BuiltInFunctionESDTWipe, []byte("owner"), token, big.NewInt(0).Bytes(), big.NewInt(0).Bytes(), holderB),
+			},
+		},
+	}
+	err = proc.ProcessLogs(&block.Header{Nonce: 2}, wipeLogs)
+	require.Nil(t, err)
+
+	page, err := proc.GetESDTHolders(string(token), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(page.Holders))
+	assert.Equal(t, holderA, page.Holders[0].Address)
+}
+
+func TestSuppliesProcessor_HoldersShouldBeStoredUnderTheirOwnKeys(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createHoldersTestSuppliesProcessor(t)
+
+	err := proc.ProcessLogs(&block.Header{Nonce: 1}, createHoldersTestLogs(token))
+	require.Nil(t, err)
+
+	numHolderRecords := 0
+	proc.holdersIdx.storer.RangeKeys(func(key []byte, _ []byte) bool {
+		if strings.Contains(string(key), "-address-") {
+			numHolderRecords++
+		}
+		return true
+	})
+	// holderA and holderB for the fungible token, holderA for the NFT
+	assert.Equal(t, 3, numHolderRecords)
+
+	record, err := proc.holdersIdx.getHolder(string(token), holderB)
+	require.Nil(t, err)
+	assert.Equal(t, &holderRecordESDT{Balance: big.NewInt(25), Position: 1}, record)
+
+	record, err = proc.holdersIdx.getHolder(string(token), foreignHolder)
+	require.Nil(t, err)
+	assert.Nil(t, record)
+}
+
+func TestSuppliesProcessor_RemovedHolderShouldBeReplacedByTheLastOne(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	holderC := []byte("dddd")
+	proc := createHoldersTestSuppliesProcessor(t)
+	zero := big.NewInt(0).Bytes()
+
+	err := proc.ProcessLogs(&block.Header{Nonce: 1}, createHoldersTestLogs(token))
+	require.Nil(t, err)
+
+	logs := map[string]data.LogHandler{
+		"txLog": &transaction.Log{
+			Events: []*transaction.Event{
+				createEvent(core.BuiltInFunctionESDTTransfer, holderA, token, zero, big.NewInt(10).Bytes(), holderC),
+			},
+		},
+	}
+	err = proc.ProcessLogs(&block.Header{Nonce: 2}, logs)
+	require.Nil(t, err)
+
+	logs = map[string]data.LogHandler{
+		"txLog": &transaction.Log{
+			Events: []*transaction.Event{
+				createEvent(core.BuiltInFunctionESDTLocalBurn, holderA, token, zero, big.NewInt(40).Bytes()),
+			},
+		},
+	}
+	err = proc.ProcessLogs(&block.Header{Nonce: 3}, logs)
+	require.Nil(t, err)
+
+	page, err := proc.GetESDTHolders(string(token), 0, 0)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), page.NumHolders)
+	require.Equal(t, 2, len(page.Holders))
+	assert.Equal(t, &HolderESDT{Address: holderC, Balance: big.NewInt(10)}, page.Holders[0])
+	assert.Equal(t, &HolderESDT{Address: holderB, Balance: big.NewInt(25)}, page.Holders[1])
+
+	record, err := proc.holdersIdx.getHolder(string(token), holderA)
+	require.Nil(t, err)
+	assert.Nil(t, record)
+
+	_, err = proc.holdersIdx.storer.Get(holderPositionKey(string(token), 2))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestSuppliesProcessor_GetESDTHoldersShouldPropagateStorerErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	proc := createHoldersTestSuppliesProcessor(t)
+	proc.holdersIdx.storer = &testscommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+
+	page, err := proc.GetESDTHolders("TKN-abcdef", 0, 10)
+	assert.Nil(t, page)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestSuppliesProcessor_GetESDTHoldersWrappedKeyNotFoundShouldReturnEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	proc := createHoldersTestSuppliesProcessor(t)
+	proc.holdersIdx.storer = &testscommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, fmt.Errorf("%w: %s", storage.ErrKeyNotFound, key)
+		},
+	}
+
+	page, err := proc.GetESDTHolders("TKN-abcdef", 0, 10)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(page.Holders))
+}
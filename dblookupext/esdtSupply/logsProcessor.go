@@ -15,16 +15,25 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
+type blockChanges struct {
+	supplies       map[string]*SupplyESDT
+	holdersChanges map[string]map[string]*holderChange
+	historyChanges map[string]*supplyHistoryChange
+}
+
 type logsProcessor struct {
 	marshalizer        marshal.Marshalizer
 	suppliesStorer     storage.Storer
 	nonceProc          *nonceProcessor
+	holdersIdx         *holdersIndex
+	history            *supplyHistory
 	fungibleOperations map[string]struct{}
 }
 
 func newLogsProcessor(
 	marshalizer marshal.Marshalizer,
 	suppliesStorer storage.Storer,
+	holdersIdx *holdersIndex,
 ) *logsProcessor {
 	nonceProc := newNonceProcessor(marshalizer, suppliesStorer)
 
@@ -32,6 +41,8 @@ func newLogsProcessor(
 		nonceProc:      nonceProc,
 		marshalizer:    marshalizer,
 		suppliesStorer: suppliesStorer,
+		holdersIdx:     holdersIdx,
+		history:        newSupplyHistory(suppliesStorer),
 		fungibleOperations: map[string]struct{}{
 			core.BuiltInFunctionESDTLocalBurn:      {},
 			core.BuiltInFunctionESDTLocalMint:      {},
@@ -43,7 +54,8 @@ func newLogsProcessor(
 	}
 }
 
-func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]data.LogHandler, isRevert bool) error {
+func (lp *logsProcessor) processLogs(header data.HeaderHandler, logs map[string]data.LogHandler, isRevert bool) error {
+	blockNonce := header.GetNonce()
 	shouldProcess, err := lp.nonceProc.shouldProcessLog(blockNonce, isRevert)
 	if err != nil {
 		return err
@@ -52,27 +64,49 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]data.Log
 		return nil
 	}
 
-	supplies := make(map[string]*SupplyESDT)
-	for _, logHandler := range logs {
+	changes := &blockChanges{
+		supplies:       make(map[string]*SupplyESDT),
+		holdersChanges: make(map[string]map[string]*holderChange),
+		historyChanges: make(map[string]*supplyHistoryChange),
+	}
+	for txHash, logHandler := range logs {
 		if check.IfNil(logHandler) {
 			continue
 		}
 
-		errProc := lp.processLog(logHandler, supplies, isRevert)
+		errProc := lp.processLog(txHash, header, logHandler, changes, isRevert)
 		if errProc != nil {
 			return errProc
 		}
 	}
 
-	err = lp.saveSupplies(supplies)
+	err = lp.saveSupplies(changes.supplies)
+	if err != nil {
+		return err
+	}
+
+	err = lp.history.saveChanges(header, changes.historyChanges, changes.supplies, isRevert)
 	if err != nil {
 		return err
 	}
 
+	if lp.holdersIdx != nil {
+		err = lp.holdersIdx.saveChanges(changes.holdersChanges)
+		if err != nil {
+			return err
+		}
+	}
+
 	return lp.nonceProc.saveNonceInStorage(blockNonce)
 }
 
-func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool) error {
+func (lp *logsProcessor) processLog(
+	txHash string,
+	header data.HeaderHandler,
+	txLog data.LogHandler,
+	changes *blockChanges,
+	isRevert bool,
+) error {
 	for _, entryHandler := range txLog.GetLogEvents() {
 		if check.IfNil(entryHandler) {
 			continue
@@ -83,14 +117,20 @@ func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*
 			continue
 		}
 
+		if lp.holdersIdx != nil {
+			lp.holdersIdx.processEvent(event, changes.holdersChanges, isRevert)
+		}
+
 		if lp.shouldIgnoreEvent(event) {
 			continue
 		}
 
-		err := lp.processEvent(event, supplies, isRevert)
+		err := lp.processEvent(event, changes.supplies, isRevert)
 		if err != nil {
 			return err
 		}
+
+		lp.history.processEvent(txHash, header, event, changes.historyChanges)
 	}
 
 	return nil
@@ -117,14 +157,7 @@ func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies map[str
 		return nil
 	}
 
-	tokenIdentifier := txLog.Topics[0]
-	if len(txLog.Topics[1]) != 0 {
-		nonceBytes := txLog.Topics[1]
-		nonceHexStr := hex.EncodeToString(nonceBytes)
-
-		tokenIdentifier = bytes.Join([][]byte{tokenIdentifier, []byte(nonceHexStr)}, []byte("-"))
-	}
-
+	tokenIdentifier := computeTokenIdentifier(txLog)
 	bigValue := big.NewInt(0).SetBytes(txLog.Topics[2])
 
 	negValue := string(txLog.Identifier) == core.BuiltInFunctionESDTLocalBurn || string(txLog.Identifier) == core.BuiltInFunctionESDTNFTBurn ||
@@ -160,6 +193,17 @@ func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies map[str
 	return nil
 }
 
+func computeTokenIdentifier(txLog *transaction.Event) []byte {
+	tokenIdentifier := txLog.Topics[0]
+	if len(txLog.Topics[1]) == 0 {
+		return tokenIdentifier
+	}
+
+	nonceHexStr := hex.EncodeToString(txLog.Topics[1])
+
+	return bytes.Join([][]byte{tokenIdentifier, []byte(nonceHexStr)}, []byte("-"))
+}
+
 func (lp *logsProcessor) getSupply(tokenIdentifier []byte) (*SupplyESDT, error) {
 	supplyFromStorageBytes, err := lp.suppliesStorer.Get(tokenIdentifier)
 	if err != nil {
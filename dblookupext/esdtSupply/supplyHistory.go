@@ -0,0 +1,512 @@
+package esdtSupply
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	supplyHistoryKeyPrefix = "supply-history-"
+	supplyEventKeyPrefix   = "supply-event-"
+	supplyChangeMint       = supplyChangeType(0)
+	supplyChangeBurn       = supplyChangeType(1)
+	supplyChangeWipe       = supplyChangeType(2)
+)
+
+type supplyChangeType int
+
+// SupplySnapshotESDT holds the supply of a token and its cumulative minted and burned amounts as they were after the
+// last processed block of an epoch
+type SupplySnapshotESDT struct {
+	Epoch          uint32   `json:"epoch"`
+	Supply         *big.Int `json:"supply"`
+	Minted         *big.Int `json:"minted"`
+	Burned         *big.Int `json:"burned"`
+	FirstTimestamp uint64   `json:"firstTimestamp"`
+	LastTimestamp  uint64   `json:"lastTimestamp"`
+	LastBlockNonce uint64   `json:"lastBlockNonce"`
+	NumEvents      uint32   `json:"numEvents"`
+}
+
+// SupplyHistoryESDT holds the cumulative minted and burned amounts of a token together with its per-epoch snapshots
+type SupplyHistoryESDT struct {
+	Minted    *big.Int              `json:"minted"`
+	Burned    *big.Int              `json:"burned"`
+	Snapshots []*SupplySnapshotESDT `json:"snapshots"`
+}
+
+// SupplyEventESDT holds a supply changing event of a token. The wipe events do not carry the wiped balance, so their
+// amount is left nil
+type SupplyEventESDT struct {
+	TxHash     []byte   `json:"txHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	Epoch      uint32   `json:"epoch"`
+	Timestamp  uint64   `json:"timestamp"`
+	Identifier string   `json:"identifier"`
+	Amount     *big.Int `json:"amount,omitempty"`
+}
+
+// SupplyHistoryFilter holds the inclusive epoch and timestamp ranges used when fetching the supply history of a token,
+// together with the page of the matching events to be returned. A zero limit returns all the events from the offset
+type SupplyHistoryFilter struct {
+	StartEpoch     uint32
+	EndEpoch       uint32
+	StartTimestamp uint64
+	EndTimestamp   uint64
+	Offset         uint32
+	Limit          uint32
+}
+
+// SupplyHistoryResult holds the current supply of a token, its breakdown, the snapshots matching a filter and a page of
+// the events matching the same filter, next to the total number of matching events
+type SupplyHistoryResult struct {
+	Supply    *big.Int
+	Minted    *big.Int
+	Burned    *big.Int
+	Snapshots []*SupplySnapshotESDT
+	NumEvents uint32
+	Events    []*SupplyEventESDT
+}
+
+type supplyHistoryChange struct {
+	minted *big.Int
+	burned *big.Int
+	events []*SupplyEventESDT
+}
+
+// supplyHistory keeps, next to the current supply, the breakdown of the supply changes of each token.
+// Each event is stored under its own key, made of the token, the epoch and the position of the event in the dense
+// list of the events of that epoch, while the snapshot of the epoch holds the number of events. As the events of an
+// epoch are appended in the order of their blocks, a block is saved without reading the previous events, a revert
+// only removes the last events and a page of events is read as a range of positions
+type supplyHistory struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+	changeTypes map[string]supplyChangeType
+}
+
+func newSupplyHistory(storer storage.Storer) *supplyHistory {
+	return &supplyHistory{
+		marshalizer: common.NewApiRecordsMarshalizer(),
+		storer:      storer,
+		changeTypes: map[string]supplyChangeType{
+			core.BuiltInFunctionESDTLocalMint:      supplyChangeMint,
+			core.BuiltInFunctionESDTNFTCreate:      supplyChangeMint,
+			core.BuiltInFunctionESDTNFTAddQuantity: supplyChangeMint,
+			core.BuiltInFunctionESDTLocalBurn:      supplyChangeBurn,
+			core.BuiltInFunctionESDTNFTBurn:        supplyChangeBurn,
+			core.BuiltInFunctionESDTWipe:           supplyChangeWipe,
+		},
+	}
+}
+
+func (sh *supplyHistory) processEvent(
+	txHash string,
+	header data.HeaderHandler,
+	event *transaction.Event,
+	changes map[string]*supplyHistoryChange,
+) {
+	changeType, found := sh.changeTypes[string(event.Identifier)]
+	if !found || len(event.Topics) < 3 {
+		return
+	}
+
+	tokenIdentifier := string(computeTokenIdentifier(event))
+	change, found := changes[tokenIdentifier]
+	if !found {
+		change = &supplyHistoryChange{
+			minted: big.NewInt(0),
+			burned: big.NewInt(0),
+		}
+		changes[tokenIdentifier] = change
+	}
+
+	// the wipe events always carry a zero value, the wiped balance being known only to the account of the holder
+	var amount *big.Int
+	switch changeType {
+	case supplyChangeMint:
+		amount = big.NewInt(0).SetBytes(event.Topics[2])
+		change.minted.Add(change.minted, amount)
+	case supplyChangeBurn:
+		amount = big.NewInt(0).SetBytes(event.Topics[2])
+		change.burned.Add(change.burned, amount)
+	}
+
+	change.events = append(change.events, &SupplyEventESDT{
+		TxHash:     []byte(txHash),
+		BlockNonce: header.GetNonce(),
+		Epoch:      header.GetEpoch(),
+		Timestamp:  header.GetTimeStamp(),
+		Identifier: string(event.Identifier),
+		Amount:     amount,
+	})
+}
+
+func (sh *supplyHistory) saveChanges(
+	header data.HeaderHandler,
+	changes map[string]*supplyHistoryChange,
+	supplies map[string]*SupplyESDT,
+	isRevert bool,
+) error {
+	for token, change := range changes {
+		supply := big.NewInt(0)
+		supplyESDT, found := supplies[token]
+		if found {
+			supply = supplyESDT.Supply
+		}
+
+		err := sh.saveTokenChange(header, token, change, supply, isRevert)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sh *supplyHistory) saveTokenChange(
+	header data.HeaderHandler,
+	token string,
+	change *supplyHistoryChange,
+	supply *big.Int,
+	isRevert bool,
+) error {
+	history, err := sh.getHistory(token)
+	if err != nil {
+		return err
+	}
+
+	epoch := header.GetEpoch()
+	numEvents := uint32(0)
+	snapshot := getSnapshot(history, epoch)
+	if snapshot != nil {
+		numEvents = snapshot.NumEvents
+	}
+
+	var lastEvent *SupplyEventESDT
+	if isRevert {
+		history.Minted.Sub(history.Minted, change.minted)
+		history.Burned.Sub(history.Burned, change.burned)
+		numEvents, lastEvent, err = sh.removeBlockEvents(token, epoch, numEvents, header.GetNonce())
+	} else {
+		history.Minted.Add(history.Minted, change.minted)
+		history.Burned.Add(history.Burned, change.burned)
+		numEvents, lastEvent, err = sh.appendBlockEvents(token, epoch, numEvents, change.events)
+	}
+	if err != nil {
+		return err
+	}
+
+	history.Snapshots = updateSnapshots(history, epoch, supply, numEvents, lastEvent)
+
+	return sh.put(supplyHistoryKey(token), history)
+}
+
+func (sh *supplyHistory) appendBlockEvents(
+	token string,
+	epoch uint32,
+	numEvents uint32,
+	events []*SupplyEventESDT,
+) (uint32, *SupplyEventESDT, error) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return bytes.Compare(events[i].TxHash, events[j].TxHash) < 0
+	})
+
+	var lastEvent *SupplyEventESDT
+	for _, event := range events {
+		err := sh.put(supplyEventKey(token, epoch, numEvents), event)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		numEvents++
+		lastEvent = event
+	}
+
+	return numEvents, lastEvent, nil
+}
+
+// removeBlockEvents removes the events of the reverted block, which are the last ones of the epoch, and returns the
+// remaining number of events together with the last remaining event
+func (sh *supplyHistory) removeBlockEvents(
+	token string,
+	epoch uint32,
+	numEvents uint32,
+	blockNonce uint64,
+) (uint32, *SupplyEventESDT, error) {
+	for numEvents > 0 {
+		event, err := sh.getEvent(token, epoch, numEvents-1)
+		if err != nil {
+			return 0, nil, err
+		}
+		if event.BlockNonce != blockNonce {
+			return numEvents, event, nil
+		}
+
+		err = sh.storer.Remove(supplyEventKey(token, epoch, numEvents-1))
+		if err != nil {
+			return 0, nil, err
+		}
+		numEvents--
+	}
+
+	return 0, nil, nil
+}
+
+func getSnapshot(history *SupplyHistoryESDT, epoch uint32) *SupplySnapshotESDT {
+	for _, snapshot := range history.Snapshots {
+		if snapshot.Epoch == epoch {
+			return snapshot
+		}
+	}
+
+	return nil
+}
+
+// updateSnapshots sets the snapshot of the epoch as it is after the last event of the epoch
+func updateSnapshots(
+	history *SupplyHistoryESDT,
+	epoch uint32,
+	supply *big.Int,
+	numEvents uint32,
+	lastEvent *SupplyEventESDT,
+) []*SupplySnapshotESDT {
+	snapshots := make([]*SupplySnapshotESDT, 0, len(history.Snapshots)+1)
+	var current *SupplySnapshotESDT
+	for _, snapshot := range history.Snapshots {
+		if snapshot.Epoch == epoch {
+			current = snapshot
+			continue
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	// an epoch left without any supply changing event after a revert does not need a snapshot anymore
+	if numEvents == 0 || lastEvent == nil {
+		return snapshots
+	}
+
+	if current == nil {
+		current = &SupplySnapshotESDT{
+			Epoch:          epoch,
+			FirstTimestamp: lastEvent.Timestamp,
+		}
+	}
+	current.Supply = big.NewInt(0).Set(supply)
+	current.Minted = big.NewInt(0).Set(history.Minted)
+	current.Burned = big.NewInt(0).Set(history.Burned)
+	current.LastTimestamp = lastEvent.Timestamp
+	current.LastBlockNonce = lastEvent.BlockNonce
+	current.NumEvents = numEvents
+
+	snapshots = append(snapshots, current)
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Epoch < snapshots[j].Epoch
+	})
+
+	return snapshots
+}
+
+func (sh *supplyHistory) getHistory(token string) (*SupplyHistoryESDT, error) {
+	history := &SupplyHistoryESDT{}
+	err := sh.get(supplyHistoryKey(token), history)
+	if err != nil {
+		return nil, err
+	}
+
+	if history.Minted == nil {
+		history.Minted = big.NewInt(0)
+	}
+	if history.Burned == nil {
+		history.Burned = big.NewInt(0)
+	}
+
+	return history, nil
+}
+
+func (sh *supplyHistory) getEvent(token string, epoch uint32, position uint32) (*SupplyEventESDT, error) {
+	buff, err := sh.storer.Get(supplyEventKey(token, epoch, position))
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return nil, fmt.Errorf("%w: missing event %d of epoch %d for token %s", errInconsistentSupplyHistory, position, epoch, token)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	event := &SupplyEventESDT{}
+	err = sh.marshalizer.Unmarshal(event, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (sh *supplyHistory) getFilteredHistory(token string, filter SupplyHistoryFilter) (*SupplyHistoryResult, error) {
+	history, err := sh.getHistory(token)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SupplyHistoryResult{
+		Minted:    history.Minted,
+		Burned:    history.Burned,
+		Snapshots: make([]*SupplySnapshotESDT, 0),
+		Events:    make([]*SupplyEventESDT, 0),
+	}
+	toSkip := filter.Offset
+	for _, snapshot := range history.Snapshots {
+		isInEpochRange := snapshot.Epoch >= filter.StartEpoch && snapshot.Epoch <= filter.EndEpoch
+		isInTimeRange := snapshot.LastTimestamp >= filter.StartTimestamp && snapshot.FirstTimestamp <= filter.EndTimestamp
+		if !isInEpochRange || !isInTimeRange {
+			continue
+		}
+
+		result.Snapshots = append(result.Snapshots, snapshot)
+
+		first, end, errSearch := sh.searchEventsInTimeRange(token, snapshot, filter)
+		if errSearch != nil {
+			return nil, errSearch
+		}
+		result.NumEvents += end - first
+
+		if toSkip >= end-first {
+			toSkip -= end - first
+			continue
+		}
+		first += toSkip
+		toSkip = 0
+
+		errAppend := sh.appendEventsPage(token, snapshot.Epoch, first, end, filter.Limit, result)
+		if errAppend != nil {
+			return nil, errAppend
+		}
+	}
+
+	return result, nil
+}
+
+// searchEventsInTimeRange returns the range of positions [first, end) of the events of the snapshot's epoch that are
+// in the time range of the filter. The events of an epoch are ordered by timestamp so the bounds are binary searched
+func (sh *supplyHistory) searchEventsInTimeRange(
+	token string,
+	snapshot *SupplySnapshotESDT,
+	filter SupplyHistoryFilter,
+) (uint32, uint32, error) {
+	first := uint32(0)
+	end := snapshot.NumEvents
+	var err error
+	if snapshot.FirstTimestamp < filter.StartTimestamp {
+		first, err = sh.searchEvent(token, snapshot.Epoch, snapshot.NumEvents, func(event *SupplyEventESDT) bool {
+			return event.Timestamp >= filter.StartTimestamp
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if snapshot.LastTimestamp > filter.EndTimestamp {
+		end, err = sh.searchEvent(token, snapshot.Epoch, snapshot.NumEvents, func(event *SupplyEventESDT) bool {
+			return event.Timestamp > filter.EndTimestamp
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if end < first {
+		end = first
+	}
+
+	return first, end, nil
+}
+
+// searchEvent returns the position of the first event of the epoch for which the condition holds
+func (sh *supplyHistory) searchEvent(
+	token string,
+	epoch uint32,
+	numEvents uint32,
+	condition func(event *SupplyEventESDT) bool,
+) (uint32, error) {
+	var err error
+	position := sort.Search(int(numEvents), func(i int) bool {
+		if err != nil {
+			return true
+		}
+
+		event, errGet := sh.getEvent(token, epoch, uint32(i))
+		if errGet != nil {
+			err = errGet
+			return true
+		}
+
+		return condition(event)
+	})
+
+	return uint32(position), err
+}
+
+func (sh *supplyHistory) appendEventsPage(
+	token string,
+	epoch uint32,
+	first uint32,
+	end uint32,
+	limit uint32,
+	result *SupplyHistoryResult,
+) error {
+	for position := first; position < end; position++ {
+		if limit > 0 && uint32(len(result.Events)) >= limit {
+			return nil
+		}
+
+		event, err := sh.getEvent(token, epoch, position)
+		if err != nil {
+			return err
+		}
+
+		result.Events = append(result.Events, event)
+	}
+
+	return nil
+}
+
+// get leaves the value unchanged if the key is not found
+func (sh *supplyHistory) get(key []byte, value interface{}) error {
+	buff, err := sh.storer.Get(key)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return sh.marshalizer.Unmarshal(value, buff)
+}
+
+func (sh *supplyHistory) put(key []byte, value interface{}) error {
+	buff, err := sh.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return sh.storer.Put(key, buff)
+}
+
+func supplyHistoryKey(token string) []byte {
+	return []byte(supplyHistoryKeyPrefix + token)
+}
+
+// the token is hex encoded in the key so that the events of a token can not collide with the ones of another token
+func supplyEventKey(token string, epoch uint32, position uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s-%d-%d", supplyEventKeyPrefix, hex.EncodeToString([]byte(token)), epoch, position))
+}
@@ -0,0 +1,344 @@
+package esdtSupply
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func isSupplyHistoryKey(key []byte) bool {
+	return strings.HasPrefix(string(key), supplyHistoryKeyPrefix) || strings.HasPrefix(string(key), supplyEventKeyPrefix)
+}
+
+func createSupplyHistoryTestSuppliesProcessor(t *testing.T) *suppliesProcessor {
+	args := createMockArgsSuppliesProcessor()
+	args.SuppliesStorer = createMapStorer()
+
+	proc, err := NewSuppliesProcessor(args)
+	require.Nil(t, err)
+
+	return proc
+}
+
+func createSupplyHistoryTestLogs(token []byte, events ...*transaction.Event) map[string]data.LogHandler {
+	logs := make(map[string]data.LogHandler)
+	for i, event := range events {
+		txHash := string([]byte{byte(i)}) + string(token)
+		logs[txHash] = &transaction.Log{
+			Events: []*transaction.Event{event},
+		}
+	}
+
+	return logs
+}
+
+func allEpochsFilter() SupplyHistoryFilter {
+	return SupplyHistoryFilter{
+		StartEpoch:     0,
+		EndEpoch:       1000,
+		StartTimestamp: 0,
+		EndTimestamp:   100000,
+	}
+}
+
+func processSupplyHistoryTestBlocks(t *testing.T, proc *suppliesProcessor, token []byte) {
+	zero := big.NewInt(0).Bytes()
+
+	logs := createSupplyHistoryTestLogs(token,
+		createEvent(core.BuiltInFunctionESDTLocalMint, holderA, token, zero, big.NewInt(100).Bytes()),
+		createEvent(core.BuiltInFunctionESDTLocalBurn, holderA, token, zero, big.NewInt(10).Bytes()),
+		createEvent(core.BuiltInFunctionESDTTransfer, holderA, token, zero, big.NewInt(10).Bytes(), holderB),
+	)
+	err := proc.ProcessLogs(&block.Header{Nonce: 1, Epoch: 1, TimeStamp: 100}, logs)
+	require.Nil(t, err)
+
+	logs = createSupplyHistoryTestLogs(token,
+		createEvent(core.BuiltInFunctionESDTLocalMint, holderA, token, zero, big.NewInt(50).Bytes()),
+	)
+	err = proc.ProcessLogs(&block.Header{Nonce: 2, Epoch: 1, TimeStamp: 200}, logs)
+	require.Nil(t, err)
+
+	// as emitted by the ESDTWipe built-in function, the wipe event carries a zero value and the wiped address
+	logs = createSupplyHistoryTestLogs(token,
+		createEvent(core.BuiltInFunctionESDTWipe, []byte("owner"), token, zero, zero, holderB),
+	)
+	err = proc.ProcessLogs(&block.Header{Nonce: 3, Epoch: 2, TimeStamp: 300}, logs)
+	require.Nil(t, err)
+}
+
+func TestSuppliesProcessor_ProcessLogsNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proc := createSupplyHistoryTestSuppliesProcessor(t)
+
+	err := proc.ProcessLogs(nil, make(map[string]data.LogHandler))
+	assert.Equal(t, errNilBlockHeader, err)
+}
+
+func TestSuppliesProcessor_GetESDTSupplyHistoryUnknownToken(t *testing.T) {
+	t.Parallel()
+
+	proc := createSupplyHistoryTestSuppliesProcessor(t)
+
+	result, err := proc.GetESDTSupplyHistory("TKN-abcdef", allEpochsFilter())
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), result.Supply)
+	assert.Equal(t, big.NewInt(0), result.Minted)
+	assert.Equal(t, big.NewInt(0), result.Burned)
+	assert.Equal(t, 0, len(result.Snapshots))
+	assert.Equal(t, 0, len(result.Events))
+}
+
+func TestSuppliesProcessor_GetESDTSupplyHistoryShouldPropagateStorerErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsSuppliesProcessor()
+	args.SuppliesStorer = &testscommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	proc, _ := NewSuppliesProcessor(args)
+
+	result, err := proc.GetESDTSupplyHistory("TKN-abcdef", allEpochsFilter())
+	assert.Nil(t, result)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestSuppliesProcessor_GetESDTSupplyHistoryWrappedKeyNotFoundShouldReturnEmptyHistory(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSuppliesProcessor()
+	args.SuppliesStorer = &testscommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, fmt.Errorf("%w: %s", storage.ErrKeyNotFound, key)
+		},
+	}
+	proc, _ := NewSuppliesProcessor(args)
+
+	result, err := proc.GetESDTSupplyHistory("TKN-abcdef", allEpochsFilter())
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(result.Events))
+}
+
+func TestSuppliesProcessor_GetESDTSupplyHistoryShouldTrackBreakdownAndSnapshots(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createSupplyHistoryTestSuppliesProcessor(t)
+	processSupplyHistoryTestBlocks(t, proc, token)
+
+	result, err := proc.GetESDTSupplyHistory(string(token), allEpochsFilter())
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(140), result.Supply)
+	assert.Equal(t, big.NewInt(150), result.Minted)
+	assert.Equal(t, big.NewInt(10), result.Burned)
+
+	require.Equal(t, 2, len(result.Snapshots))
+	assert.Equal(t, &SupplySnapshotESDT{
+		Epoch:          1,
+		Supply:         big.NewInt(140),
+		Minted:         big.NewInt(150),
+		Burned:         big.NewInt(10),
+		FirstTimestamp: 100,
+		LastTimestamp:  200,
+		LastBlockNonce: 2,
+		NumEvents:      3,
+	}, result.Snapshots[0])
+	assert.Equal(t, &SupplySnapshotESDT{
+		Epoch:          2,
+		Supply:         big.NewInt(140),
+		Minted:         big.NewInt(150),
+		Burned:         big.NewInt(10),
+		FirstTimestamp: 300,
+		LastTimestamp:  300,
+		LastBlockNonce: 3,
+		NumEvents:      1,
+	}, result.Snapshots[1])
+
+	assert.Equal(t, uint32(4), result.NumEvents)
+	require.Equal(t, 4, len(result.Events))
+	assert.Equal(t, core.BuiltInFunctionESDTLocalMint, result.Events[0].Identifier)
+	assert.Equal(t, big.NewInt(100), result.Events[0].Amount)
+	assert.Equal(t, append([]byte{0}, token...), result.Events[0].TxHash)
+	assert.Equal(t, core.BuiltInFunctionESDTLocalBurn, result.Events[1].Identifier)
+	assert.Equal(t, uint64(1), result.Events[1].BlockNonce)
+	assert.Equal(t, uint64(2), result.Events[2].BlockNonce)
+	assert.Equal(t, core.BuiltInFunctionESDTWipe, result.Events[3].Identifier)
+	assert.Equal(t, uint32(2), result.Events[3].Epoch)
+	assert.Equal(t, uint64(300), result.Events[3].Timestamp)
+	assert.Nil(t, result.Events[3].Amount)
+}
+
+func TestSuppliesProcessor_GetESDTSupplyHistoryShouldApplyFilter(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createSupplyHistoryTestSuppliesProcessor(t)
+	processSupplyHistoryTestBlocks(t, proc, token)
+
+	filter := allEpochsFilter()
+	filter.StartEpoch = 2
+	result, err := proc.GetESDTSupplyHistory(string(token), filter)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(result.Snapshots))
+	assert.Equal(t, uint32(2), result.Snapshots[0].Epoch)
+	require.Equal(t, 1, len(result.Events))
+	assert.Equal(t, core.BuiltInFunctionESDTWipe, result.Events[0].Identifier)
+
+	filter = allEpochsFilter()
+	filter.StartTimestamp = 150
+	filter.EndTimestamp = 250
+	result, err = proc.GetESDTSupplyHistory(string(token), filter)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(result.Snapshots))
+	assert.Equal(t, uint32(1), result.Snapshots[0].Epoch)
+	assert.Equal(t, uint32(1), result.NumEvents)
+	require.Equal(t, 1, len(result.Events))
+	assert.Equal(t, uint64(2), result.Events[0].BlockNonce)
+	assert.Equal(t, big.NewInt(150), result.Minted)
+}
+
+func TestSuppliesProcessor_GetESDTSupplyHistoryShouldPaginateEvents(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createSupplyHistoryTestSuppliesProcessor(t)
+	processSupplyHistoryTestBlocks(t, proc, token)
+
+	t.Run("page across epochs", func(t *testing.T) {
+		filter := allEpochsFilter()
+		filter.Offset = 2
+		filter.Limit = 2
+		result, err := proc.GetESDTSupplyHistory(string(token), filter)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(4), result.NumEvents)
+		assert.Equal(t, 2, len(result.Snapshots))
+		require.Equal(t, 2, len(result.Events))
+		assert.Equal(t, uint64(2), result.Events[0].BlockNonce)
+		assert.Equal(t, uint64(3), result.Events[1].BlockNonce)
+	})
+	t.Run("offset past the events", func(t *testing.T) {
+		filter := allEpochsFilter()
+		filter.Offset = 4
+		result, err := proc.GetESDTSupplyHistory(string(token), filter)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(4), result.NumEvents)
+		assert.Equal(t, 0, len(result.Events))
+	})
+	t.Run("page inside the time range", func(t *testing.T) {
+		filter := allEpochsFilter()
+		filter.EndTimestamp = 100
+		filter.Offset = 1
+		filter.Limit = 5
+		result, err := proc.GetESDTSupplyHistory(string(token), filter)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(2), result.NumEvents)
+		require.Equal(t, 1, len(result.Events))
+		assert.Equal(t, core.BuiltInFunctionESDTLocalBurn, result.Events[0].Identifier)
+	})
+}
+
+func TestSuppliesProcessor_ProcessLogsShouldStoreEachEventUnderItsOwnKey(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	args := createMockArgsSuppliesProcessor()
+	storer := createMapStorer()
+	puts := make(map[string]int)
+	args.SuppliesStorer = &testscommon.StorerStub{
+		GetCalled:    storer.Get,
+		RemoveCalled: storer.Remove,
+		PutCalled: func(key, data []byte) error {
+			puts[string(key)]++
+			return storer.Put(key, data)
+		},
+	}
+	proc, err := NewSuppliesProcessor(args)
+	require.Nil(t, err)
+	processSupplyHistoryTestBlocks(t, proc, token)
+
+	numEventPuts := 0
+	for key, numPuts := range puts {
+		if strings.HasPrefix(key, supplyEventKeyPrefix) {
+			assert.Equal(t, 1, numPuts)
+			numEventPuts++
+		}
+	}
+	assert.Equal(t, 4, numEventPuts)
+}
+
+func TestSuppliesProcessor_RevertShouldRemoveSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createSupplyHistoryTestSuppliesProcessor(t)
+	processSupplyHistoryTestBlocks(t, proc, token)
+
+	zero := big.NewInt(0).Bytes()
+	wipeLogs := createSupplyHistoryTestLogs(token,
+		createEvent(core.BuiltInFunctionESDTWipe, []byte("owner"), token, zero, zero, holderB),
+	)
+	err := proc.logsProc.processLogs(&block.Header{Nonce: 3, Epoch: 2, TimeStamp: 300}, wipeLogs, true)
+	require.Nil(t, err)
+
+	result, err := proc.GetESDTSupplyHistory(string(token), allEpochsFilter())
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(140), result.Supply)
+	assert.Equal(t, big.NewInt(150), result.Minted)
+	require.Equal(t, 1, len(result.Snapshots))
+	assert.Equal(t, uint32(1), result.Snapshots[0].Epoch)
+	assert.Equal(t, 3, len(result.Events))
+}
+
+func TestSuppliesProcessor_RevertShouldRestoreThePreviousBlockOfTheEpoch(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	proc := createSupplyHistoryTestSuppliesProcessor(t)
+
+	zero := big.NewInt(0).Bytes()
+	logs := createSupplyHistoryTestLogs(token,
+		createEvent(core.BuiltInFunctionESDTLocalMint, holderA, token, zero, big.NewInt(100).Bytes()),
+	)
+	err := proc.ProcessLogs(&block.Header{Nonce: 1, Epoch: 1, TimeStamp: 100}, logs)
+	require.Nil(t, err)
+
+	mintLogs := createSupplyHistoryTestLogs(token,
+		createEvent(core.BuiltInFunctionESDTLocalMint, holderA, token, zero, big.NewInt(50).Bytes()),
+	)
+	err = proc.ProcessLogs(&block.Header{Nonce: 2, Epoch: 1, TimeStamp: 200}, mintLogs)
+	require.Nil(t, err)
+
+	err = proc.logsProc.processLogs(&block.Header{Nonce: 2, Epoch: 1, TimeStamp: 200}, mintLogs, true)
+	require.Nil(t, err)
+
+	result, err := proc.GetESDTSupplyHistory(string(token), allEpochsFilter())
+	require.Nil(t, err)
+	require.Equal(t, 1, len(result.Snapshots))
+	assert.Equal(t, &SupplySnapshotESDT{
+		Epoch:          1,
+		Supply:         big.NewInt(100),
+		Minted:         big.NewInt(100),
+		Burned:         big.NewInt(0),
+		FirstTimestamp: 100,
+		LastTimestamp:  100,
+		LastBlockNonce: 1,
+		NumEvents:      1,
+	}, result.Snapshots[0])
+	assert.Equal(t, uint32(1), result.NumEvents)
+	require.Equal(t, 1, len(result.Events))
+	assert.Equal(t, uint64(1), result.Events[0].BlockNonce)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dblookupext/disabled"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
@@ -23,6 +24,7 @@ type ArgsHistoryRepositoryFactory struct {
 	Marshalizer              marshal.Marshalizer
 	Hasher                   hashing.Hasher
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator         sharding.Coordinator
 }
 
 type historyRepositoryFactory struct {
@@ -32,6 +34,7 @@ type historyRepositoryFactory struct {
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
 	uInt64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	shardCoordinator         sharding.Coordinator
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -56,6 +59,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
 		uInt64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		shardCoordinator:         args.ShardCoordinator,
 	}, nil
 }
 
@@ -65,11 +69,13 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return disabled.NewNilHistoryRepository()
 	}
 
-	esdtSuppliesHandler, err := esdtSupply.NewSuppliesProcessor(
-		hpf.marshalizer,
-		hpf.store.GetStorer(dataRetriever.ESDTSuppliesUnit),
-		hpf.store.GetStorer(dataRetriever.TxLogsUnit),
-	)
+	esdtSuppliesHandler, err := esdtSupply.NewSuppliesProcessor(esdtSupply.ArgsSuppliesProcessor{
+		Marshalizer:         hpf.marshalizer,
+		SuppliesStorer:      hpf.store.GetStorer(dataRetriever.ESDTSuppliesUnit),
+		LogsStorer:          hpf.store.GetStorer(dataRetriever.TxLogsUnit),
+		ShardCoordinator:    hpf.shardCoordinator,
+		HoldersIndexEnabled: hpf.dbLookupExtensionsConfig.ESDTHoldersIndexEnabled,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -162,7 +163,7 @@ func (hr *historyRepository) RecordBlock(
 		return err
 	}
 
-	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), logs)
+	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader, logs)
 	if err != nil {
 		return err
 	}
@@ -475,6 +476,16 @@ func (hr *historyRepository) GetESDTSupply(token string) (string, error) {
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetESDTHolders will return a page of the holders of the given token from the holders index
+func (hr *historyRepository) GetESDTHolders(token string, offset uint32, limit uint32) (*esdtSupply.HoldersPage, error) {
+	return hr.esdtSuppliesHandler.GetESDTHolders(token, offset, limit)
+}
+
+// GetESDTSupplyHistory will return the supply history of the given token, filtered by the provided epoch and time ranges
+func (hr *historyRepository) GetESDTSupplyHistory(token string, filter esdtSupply.SupplyHistoryFilter) (*esdtSupply.SupplyHistoryResult, error) {
+	return hr.esdtSuppliesHandler.GetESDTSupplyHistory(token, filter)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
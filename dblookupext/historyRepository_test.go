@@ -21,11 +21,15 @@ import (
 )
 
 func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
-	sp, _ := esdtSupply.NewSuppliesProcessor(&mock.MarshalizerMock{}, &testscommon.StorerStub{
-		GetCalled: func(key []byte) ([]byte, error) {
-			return nil, storage.ErrKeyNotFound
+	sp, _ := esdtSupply.NewSuppliesProcessor(esdtSupply.ArgsSuppliesProcessor{
+		Marshalizer: &mock.MarshalizerMock{},
+		SuppliesStorer: &testscommon.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, storage.ErrKeyNotFound
+			},
 		},
-	}, &testscommon.StorerStub{})
+		LogsStorer: &testscommon.StorerStub{},
+	})
 
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
@@ -2,6 +2,7 @@ package dblookupext
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
 
 // HistoryRepositoryFactory can create new instances of HistoryRepository
@@ -26,6 +27,8 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (string, error)
+	GetESDTHolders(token string, offset uint32, limit uint32) (*esdtSupply.HoldersPage, error)
+	GetESDTSupplyHistory(token string, filter esdtSupply.SupplyHistoryFilter) (*esdtSupply.SupplyHistoryResult, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -41,8 +44,10 @@ type BlockTracker interface {
 
 // SuppliesHandler defines the interface of a supplies processor
 type SuppliesHandler interface {
-	ProcessLogs(blockNonce uint64, logs map[string]data.LogHandler) error
+	ProcessLogs(blockHeader data.HeaderHandler, logs map[string]data.LogHandler) error
 	RevertChanges(header data.HeaderHandler, body data.BodyHandler) error
 	GetESDTSupply(token string) (string, error)
+	GetESDTHolders(token string, offset uint32, limit uint32) (*esdtSupply.HoldersPage, error)
+	GetESDTSupplyHistory(token string, filter esdtSupply.SupplyHistoryFilter) (*esdtSupply.SupplyHistoryResult, error)
 	IsInterfaceNil() bool
 }
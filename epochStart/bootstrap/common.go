@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -106,3 +107,26 @@ func checkArguments(args ArgsEpochStartBootstrap) error {
 
 	return nil
 }
+
+// getLocalSnapshotHash returns the decoded pinned hash of the local snapshot epoch start meta block, or nil if the
+// local snapshot bootstrap is disabled. An enabled bootstrap needs a valid hash, as the snapshot would not be verified
+func getLocalSnapshotHash(args ArgsEpochStartBootstrap) ([]byte, error) {
+	if !args.GeneralConfig.LocalSnapshotBootstrap.Enabled {
+		return nil, nil
+	}
+
+	hexHash := args.GeneralConfig.LocalSnapshotBootstrap.EpochStartMetaBlockHash
+	if len(hexHash) == 0 {
+		return nil, fmt.Errorf("%w: empty hash", epochStart.ErrInvalidLocalSnapshotHash)
+	}
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", epochStart.ErrInvalidLocalSnapshotHash, err.Error())
+	}
+	if len(hash) != args.CoreComponentsHolder.Hasher().Size() {
+		return nil, fmt.Errorf("%w: hash of length %d, expected %d",
+			epochStart.ErrInvalidLocalSnapshotHash, len(hash), args.CoreComponentsHolder.Hasher().Size())
+	}
+
+	return hash, nil
+}
@@ -14,6 +14,7 @@ import (
 	disabledGenesis "github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
+	disabledAntiflood "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -96,6 +97,7 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
 		PreferredPeersHolder:      disabled.NewPreferredPeersHolder(),
 		RequestHandler:            args.RequestHandler,
+		LoadMonitor:               &disabledAntiflood.LoadMonitor{},
 	}
 
 	interceptorsContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(containerFactoryArgs)
@@ -0,0 +1,418 @@
+package bootstrap
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/factory"
+)
+
+func (e *epochStartBootstrap) isLocalSnapshotEnabled() bool {
+	return len(e.localSnapshotHash) > 0
+}
+
+// prepareEpochFromLocalSnapshot starts from the local storage regardless of how old it is, without requesting the
+// epoch start data from the network. The epoch start meta block and the tries found in storage are trusted only if
+// they match the pinned hash, while the blocks produced meanwhile will be synced once peers become available
+func (e *epochStartBootstrap) prepareEpochFromLocalSnapshot() (Parameters, error) {
+	e.initializeFromLocalStorage()
+	if !e.baseData.storageExists {
+		return Parameters{}, epochStart.ErrMissingLocalSnapshot
+	}
+
+	log.Info("starting from the local snapshot",
+		"epoch", e.baseData.lastEpoch,
+		"last round", e.baseData.lastRound,
+		"shard ID", e.baseData.shardId,
+	)
+
+	return e.prepareEpochFromStorage()
+}
+
+// prepareShuffledOutFromLocalSnapshot starts a shuffled out node in its new shard without requesting anything from the
+// network, so the state tries of the new shard have to be found in the local snapshot as well
+func (e *epochStartBootstrap) prepareShuffledOutFromLocalSnapshot(newShardId uint32) (Parameters, error) {
+	err := e.checkLocalSnapshotTries(newShardId)
+	if err != nil {
+		return Parameters{}, fmt.Errorf("%w: new shard %d, %s", epochStart.ErrLocalSnapshotShuffledOut, newShardId, err.Error())
+	}
+
+	parameters := Parameters{
+		Epoch:       e.baseData.lastEpoch,
+		SelfShardId: newShardId,
+		NumOfShards: e.baseData.numberOfShards,
+		NodesConfig: e.nodesConfig,
+	}
+	e.setEpochStartMetrics()
+
+	return parameters, nil
+}
+
+func (e *epochStartBootstrap) checkLocalSnapshotEpochStartMeta() error {
+	if !e.isLocalSnapshotEnabled() {
+		return nil
+	}
+
+	hash, err := core.CalculateHash(e.coreComponentsHolder.InternalMarshalizer(), e.coreComponentsHolder.Hasher(), e.epochStartMeta)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, e.localSnapshotHash) {
+		return fmt.Errorf("%w for epoch %d: expected %s, got %s",
+			epochStart.ErrLocalSnapshotHashMismatch,
+			e.epochStartMeta.Epoch,
+			hex.EncodeToString(e.localSnapshotHash),
+			hex.EncodeToString(hash),
+		)
+	}
+
+	log.Info("local snapshot epoch start meta block verified", "epoch", e.epochStartMeta.Epoch, "hash", hash)
+
+	return nil
+}
+
+// checkLocalSnapshotTries verifies that the state tries of the provided shard, committed in the trusted epoch
+// start meta block, are completely found in the local snapshot
+func (e *epochStartBootstrap) checkLocalSnapshotTries(shardID uint32) error {
+	if !e.isLocalSnapshotEnabled() {
+		return nil
+	}
+
+	if shardID == core.MetachainShardId {
+		err := e.checkTrieInLocalSnapshot(factory.UserAccountTrie, e.epochStartMeta.RootHash)
+		if err != nil {
+			return err
+		}
+
+		return e.checkTrieInLocalSnapshot(factory.PeerAccountTrie, e.epochStartMeta.ValidatorStatsRootHash)
+	}
+
+	for _, shardData := range e.epochStartMeta.EpochStart.LastFinalizedHeaders {
+		if shardData.ShardID == shardID {
+			return e.checkTrieInLocalSnapshot(factory.UserAccountTrie, shardData.RootHash)
+		}
+	}
+
+	return epochStart.ErrEpochStartDataForShardNotFound
+}
+
+// checkTrieInLocalSnapshot verifies that all the nodes of the trie with the provided root hash are found in the local
+// snapshot. For the accounts trie, the data tries of all the accounts are verified as well
+func (e *epochStartBootstrap) checkTrieInLocalSnapshot(trieType string, rootHash []byte) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, trie.EmptyTrieHash) {
+		return nil
+	}
+
+	tr := e.trieContainer.Get([]byte(trieType))
+	if check.IfNil(tr) {
+		return fmt.Errorf("%w: %s, root hash %s", epochStart.ErrMissingTrieRootInLocalSnapshot, trieType, hex.EncodeToString(rootHash))
+	}
+
+	log.Info("verifying the trie from the local snapshot", "trie", trieType, "root hash", rootHash)
+
+	mainTrie, err := checkCompleteTrie(tr, trieType, rootHash)
+	if err != nil {
+		return err
+	}
+	if trieType != factory.UserAccountTrie {
+		return nil
+	}
+
+	return e.checkDataTriesInLocalSnapshot(mainTrie, rootHash)
+}
+
+func (e *epochStartBootstrap) checkDataTriesInLocalSnapshot(mainTrie common.Trie, rootHash []byte) error {
+	leavesChannel, err := mainTrie.GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return err
+	}
+
+	checkedDataTries := make(map[string]struct{})
+	for leaf := range leavesChannel {
+		account := state.NewEmptyUserAccount()
+		err = e.coreComponentsHolder.InternalMarshalizer().Unmarshal(account, leaf.Value())
+		if err != nil {
+			log.Trace("checkDataTriesInLocalSnapshot: leaf is not an account", "key", leaf.Key(), "error", err)
+			continue
+		}
+		if len(account.RootHash) == 0 || bytes.Equal(account.RootHash, trie.EmptyTrieHash) {
+			continue
+		}
+
+		_, isChecked := checkedDataTries[string(account.RootHash)]
+		if isChecked {
+			continue
+		}
+
+		_, err = checkCompleteTrie(mainTrie, factory.UserAccountTrie+" data trie", account.RootHash)
+		if err != nil {
+			return err
+		}
+		checkedDataTries[string(account.RootHash)] = struct{}{}
+	}
+
+	log.Info("local snapshot data tries verified", "num data tries", len(checkedDataTries))
+
+	return nil
+}
+
+func checkCompleteTrie(tr common.Trie, trieType string, rootHash []byte) (common.Trie, error) {
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s, root hash %s, %s",
+			epochStart.ErrMissingTrieRootInLocalSnapshot,
+			trieType,
+			hex.EncodeToString(rootHash),
+			err.Error(),
+		)
+	}
+
+	_, err = recreatedTrie.GetAllHashes()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s, root hash %s, %s",
+			epochStart.ErrIncompleteTrieInLocalSnapshot,
+			trieType,
+			hex.EncodeToString(rootHash),
+			err.Error(),
+		)
+	}
+
+	return recreatedTrie, nil
+}
+
+// checkLocalSnapshotNodesConfig verifies the nodes config found in the local snapshot against the validators info
+// committed in the peer miniblocks of the trusted epoch start meta block: each eligible or waiting validator of the
+// nodes config has to be found there, while none of the eligible, waiting or new validators found there can be missing
+func (e *epochStartBootstrap) checkLocalSnapshotNodesConfig() error {
+	if !e.isLocalSnapshotEnabled() || e.epochStartMeta.GetEpoch() == 0 {
+		return nil
+	}
+
+	epochConfig, ok := e.nodesConfig.EpochsConfig[fmt.Sprint(e.epochStartMeta.Epoch)]
+	if !ok {
+		return fmt.Errorf("%w: missing config for epoch %d", epochStart.ErrLocalSnapshotNodesConfigMismatch, e.epochStartMeta.Epoch)
+	}
+
+	validatorsInfo, err := e.getLocalSnapshotValidatorsInfo()
+	if err != nil {
+		return err
+	}
+
+	validatorsLists := make(map[string]string, len(validatorsInfo))
+	for _, validatorInfo := range validatorsInfo {
+		validatorsLists[string(validatorInfo.PublicKey)] = validatorInfo.List
+	}
+
+	configuredValidators := make(map[string]struct{})
+	for _, shardValidators := range []map[string][]*sharding.SerializableValidator{epochConfig.EligibleValidators, epochConfig.WaitingValidators} {
+		for _, validators := range shardValidators {
+			for _, validator := range validators {
+				list := validatorsLists[string(validator.PubKey)]
+				if !canBeInNodesConfig(list) {
+					return fmt.Errorf("%w: validator %s has list %q in the epoch start meta block",
+						epochStart.ErrLocalSnapshotNodesConfigMismatch,
+						hex.EncodeToString(validator.PubKey),
+						list,
+					)
+				}
+
+				configuredValidators[string(validator.PubKey)] = struct{}{}
+			}
+		}
+	}
+
+	for pubKey, list := range validatorsLists {
+		_, isConfigured := configuredValidators[pubKey]
+		if !isConfigured && list != string(common.LeavingList) && canBeInNodesConfig(list) {
+			return fmt.Errorf("%w: %s validator %s is missing from the nodes config",
+				epochStart.ErrLocalSnapshotNodesConfigMismatch,
+				list,
+				hex.EncodeToString([]byte(pubKey)),
+			)
+		}
+	}
+
+	log.Info("local snapshot nodes config verified", "epoch", e.epochStartMeta.Epoch, "num validators", len(configuredValidators))
+
+	return nil
+}
+
+func canBeInNodesConfig(list string) bool {
+	switch list {
+	case string(common.EligibleList), string(common.WaitingList), string(common.NewList), string(common.LeavingList):
+		return true
+	default:
+		return false
+	}
+}
+
+// checkLocalSnapshotLastHeader verifies that the header the node resumes from chains back to the trusted epoch start
+// meta block. A metachain header has to reach the pinned meta block through the previous hashes, while a shard header
+// has to reach the start of epoch shard block which notarizes the pinned meta block
+func (e *epochStartBootstrap) checkLocalSnapshotLastHeader(lastHeader bootstrapStorage.BootstrapHeaderInfo) error {
+	if !e.isLocalSnapshotEnabled() || e.baseData.lastEpoch == 0 {
+		return nil
+	}
+
+	storers, err := e.openLocalSnapshotStorers(e.getLocalSnapshotHeadersConfig())
+	if err != nil {
+		return err
+	}
+	defer closeLocalSnapshotStorers(storers)
+
+	isMeta := e.baseData.shardId == core.MetachainShardId
+	hash := lastHeader.Hash
+	for {
+		if isMeta && bytes.Equal(hash, e.localSnapshotHash) {
+			break
+		}
+
+		header, errGet := e.getLocalSnapshotHeader(storers, hash)
+		if errGet != nil {
+			return errGet
+		}
+		if header.GetEpoch() < e.epochStartMeta.Epoch || header.GetNonce() == 0 {
+			return fmt.Errorf("%w: header %s does not chain back to the epoch start meta block",
+				epochStart.ErrLocalSnapshotLastHeaderMismatch, hex.EncodeToString(lastHeader.Hash))
+		}
+		if !isMeta && header.IsStartOfEpochBlock() {
+			if !bytes.Equal(header.GetEpochStartMetaHash(), e.localSnapshotHash) {
+				return fmt.Errorf("%w: start of epoch block %s notarizes the epoch start meta block %s",
+					epochStart.ErrLocalSnapshotLastHeaderMismatch, hex.EncodeToString(hash), hex.EncodeToString(header.GetEpochStartMetaHash()))
+			}
+			break
+		}
+
+		hash = header.GetPrevHash()
+	}
+
+	log.Info("local snapshot last header verified", "nonce", lastHeader.Nonce, "hash", lastHeader.Hash)
+
+	return nil
+}
+
+func (e *epochStartBootstrap) getLocalSnapshotHeadersConfig() config.DBConfig {
+	if e.baseData.shardId == core.MetachainShardId {
+		return e.generalConfig.MetaBlockStorage.DB
+	}
+
+	return e.generalConfig.BlockHeaderStorage.DB
+}
+
+func (e *epochStartBootstrap) getLocalSnapshotHeader(storers []storage.Storer, hash []byte) (data.HeaderHandler, error) {
+	for _, storer := range storers {
+		buff, err := storer.Get(hash)
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(e.coreComponentsHolder.Hasher().Compute(string(buff)), hash) {
+			continue
+		}
+
+		var header data.HeaderHandler = &block.Header{}
+		if e.baseData.shardId == core.MetachainShardId {
+			header = &block.MetaBlock{}
+		}
+		err = e.coreComponentsHolder.InternalMarshalizer().Unmarshal(header, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		return header, nil
+	}
+
+	return nil, fmt.Errorf("%w: header %s missing from local snapshot",
+		epochStart.ErrLocalSnapshotLastHeaderMismatch, hex.EncodeToString(hash))
+}
+
+// openLocalSnapshotStorers opens the storers of the provided config for the last epoch found in storage and for the
+// previous one, as the blocks around the epoch start can be committed before the storers change the epoch
+func (e *epochStartBootstrap) openLocalSnapshotStorers(dbConfig config.DBConfig) ([]storage.Storer, error) {
+	epochs := []uint32{e.baseData.lastEpoch}
+	if e.baseData.lastEpoch > 0 {
+		epochs = append(epochs, e.baseData.lastEpoch-1)
+	}
+
+	storers := make([]storage.Storer, 0, len(epochs))
+	for _, epoch := range epochs {
+		storer, err := e.storageOpenerHandler.OpenDB(dbConfig, e.baseData.shardId, epoch)
+		if err != nil {
+			closeLocalSnapshotStorers(storers)
+			return nil, err
+		}
+		storers = append(storers, storer)
+	}
+
+	return storers, nil
+}
+
+func closeLocalSnapshotStorers(storers []storage.Storer) {
+	for _, storer := range storers {
+		log.LogIfError(storer.Close())
+	}
+}
+
+// getLocalSnapshotValidatorsInfo reads the peer miniblocks of the epoch start meta block from the miniblocks storage
+// of the local snapshot
+func (e *epochStartBootstrap) getLocalSnapshotValidatorsInfo() ([]*state.ShardValidatorInfo, error) {
+	storers, err := e.openLocalSnapshotStorers(e.generalConfig.MiniBlocksStorage.DB)
+	if err != nil {
+		return nil, err
+	}
+	defer closeLocalSnapshotStorers(storers)
+
+	validatorsInfo := make([]*state.ShardValidatorInfo, 0)
+	for _, mbHeader := range findPeerMiniBlockHeaders(e.epochStartMeta) {
+		miniBlock, err := e.getLocalSnapshotMiniBlock(storers, mbHeader.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, buff := range miniBlock.TxHashes {
+			validatorInfo := &state.ShardValidatorInfo{}
+			err = e.coreComponentsHolder.InternalMarshalizer().Unmarshal(validatorInfo, buff)
+			if err != nil {
+				return nil, err
+			}
+
+			validatorsInfo = append(validatorsInfo, validatorInfo)
+		}
+	}
+
+	return validatorsInfo, nil
+}
+
+func (e *epochStartBootstrap) getLocalSnapshotMiniBlock(storers []storage.Storer, hash []byte) (*block.MiniBlock, error) {
+	for _, storer := range storers {
+		buff, err := storer.Get(hash)
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(e.coreComponentsHolder.Hasher().Compute(string(buff)), hash) {
+			continue
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = e.coreComponentsHolder.InternalMarshalizer().Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		return miniBlock, nil
+	}
+
+	return nil, fmt.Errorf("%w: hash %s", epochStart.ErrMissingPeerMiniBlockInLocalSnapshot, hex.EncodeToString(hash))
+}
@@ -0,0 +1,648 @@
+package bootstrap
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLocalSnapshotEpochStartMeta() *block.MetaBlock {
+	return &block.MetaBlock{
+		Epoch:                  5,
+		Nonce:                  1000,
+		RootHash:               []byte("meta root hash"),
+		ValidatorStatsRootHash: []byte("validator stats root hash"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{ShardID: 0, RootHash: []byte("shard 0 root hash")},
+				{ShardID: 1, RootHash: trie.EmptyTrieHash},
+			},
+		},
+	}
+}
+
+func createLocalSnapshotBootstrap(t *testing.T, metaBlock *block.MetaBlock) *epochStartBootstrap {
+	coreComp, cryptoComp := createComponentsForEpochStart()
+	hash, err := core.CalculateHash(coreComp.InternalMarshalizer(), coreComp.Hasher(), metaBlock)
+	require.Nil(t, err)
+
+	args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+	args.GeneralConfig.LocalSnapshotBootstrap.Enabled = true
+	args.GeneralConfig.LocalSnapshotBootstrap.EpochStartMetaBlockHash = hex.EncodeToString(hash)
+
+	epochStartProvider, err := NewEpochStartBootstrap(args)
+	require.Nil(t, err)
+	epochStartProvider.epochStartMeta = metaBlock
+
+	return epochStartProvider
+}
+
+func TestNewEpochStartBootstrap_InvalidLocalSnapshotHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createComponentsForEpochStart()
+
+	for _, hash := range []string{"", "not hex", "aabb", strings.Repeat("a", 65)} {
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		args.GeneralConfig.LocalSnapshotBootstrap.Enabled = true
+		args.GeneralConfig.LocalSnapshotBootstrap.EpochStartMetaBlockHash = hash
+
+		epochStartProvider, err := NewEpochStartBootstrap(args)
+		assert.Nil(t, epochStartProvider)
+		assert.True(t, errors.Is(err, epochStart.ErrInvalidLocalSnapshotHash))
+	}
+}
+
+func TestEpochStartBootstrap_CheckLocalSnapshotEpochStartMeta(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should not check", func(t *testing.T) {
+		t.Parallel()
+
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		epochStartProvider, _ := NewEpochStartBootstrap(createMockEpochStartBootstrapArgs(coreComp, cryptoComp))
+		epochStartProvider.epochStartMeta = createLocalSnapshotEpochStartMeta()
+
+		assert.Nil(t, epochStartProvider.checkLocalSnapshotEpochStartMeta())
+	})
+	t.Run("pinned hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotBootstrap(t, createLocalSnapshotEpochStartMeta())
+
+		assert.Nil(t, epochStartProvider.checkLocalSnapshotEpochStartMeta())
+	})
+	t.Run("different meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotBootstrap(t, createLocalSnapshotEpochStartMeta())
+		epochStartProvider.epochStartMeta.Nonce++
+
+		err := epochStartProvider.checkLocalSnapshotEpochStartMeta()
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotHashMismatch))
+	})
+}
+
+func commitLocalSnapshotTrie(t *testing.T, tr common.Trie, values map[string][]byte) (common.Trie, []byte) {
+	newTrie, err := tr.Recreate(trie.EmptyTrieHash)
+	require.Nil(t, err)
+	for key, value := range values {
+		require.Nil(t, newTrie.Update([]byte(key), value))
+	}
+	require.Nil(t, newTrie.Commit())
+
+	rootHash, err := newTrie.RootHash()
+	require.Nil(t, err)
+
+	return newTrie, rootHash
+}
+
+func commitLocalSnapshotAccountsTrie(t *testing.T, epochStartProvider *epochStartBootstrap) (mainRootHash []byte, dataTrie common.Trie) {
+	tries, _ := epochStartProvider.GetTriesComponents()
+	userAccountsTrie := tries.Get([]byte(factory.UserAccountTrie))
+
+	dataTrie, dataRootHash := commitLocalSnapshotTrie(t, userAccountsTrie, map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+	})
+
+	account, err := state.NewUserAccount([]byte("address with data"))
+	require.Nil(t, err)
+	account.SetRootHash(dataRootHash)
+	accountBytes, err := epochStartProvider.coreComponentsHolder.InternalMarshalizer().Marshal(account)
+	require.Nil(t, err)
+
+	emptyAccount, _ := state.NewUserAccount([]byte("address without data"))
+	emptyAccountBytes, err := epochStartProvider.coreComponentsHolder.InternalMarshalizer().Marshal(emptyAccount)
+	require.Nil(t, err)
+
+	_, mainRootHash = commitLocalSnapshotTrie(t, userAccountsTrie, map[string][]byte{
+		"address with data":    accountBytes,
+		"address without data": emptyAccountBytes,
+	})
+
+	return mainRootHash, dataTrie
+}
+
+func removeLocalSnapshotTrieNode(t *testing.T, epochStartProvider *epochStartBootstrap, trieType string, tr common.Trie) {
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+	hashes, err := tr.GetAllHashes()
+	require.Nil(t, err)
+
+	_, storageManagers := epochStartProvider.GetTriesComponents()
+	for _, hash := range hashes {
+		if !bytes.Equal(hash, rootHash) {
+			require.Nil(t, storageManagers[trieType].Database().Remove(hash))
+			return
+		}
+	}
+
+	require.Fail(t, "trie has a single node")
+}
+
+func TestEpochStartBootstrap_CheckLocalSnapshotTries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing meta tries roots should error", func(t *testing.T) {
+		t.Parallel()
+
+		metaBlock := createLocalSnapshotEpochStartMeta()
+		epochStartProvider := createLocalSnapshotBootstrap(t, metaBlock)
+		require.Nil(t, epochStartProvider.createTriesComponentsForShardId(core.MetachainShardId))
+
+		err := epochStartProvider.checkLocalSnapshotTries(core.MetachainShardId)
+		assert.True(t, errors.Is(err, epochStart.ErrMissingTrieRootInLocalSnapshot))
+
+		metaBlock.RootHash, _ = commitLocalSnapshotAccountsTrie(t, epochStartProvider)
+
+		err = epochStartProvider.checkLocalSnapshotTries(core.MetachainShardId)
+		assert.True(t, errors.Is(err, epochStart.ErrMissingTrieRootInLocalSnapshot))
+		assert.True(t, strings.Contains(err.Error(), factory.PeerAccountTrie))
+	})
+	t.Run("complete meta tries should work", func(t *testing.T) {
+		t.Parallel()
+
+		metaBlock := createLocalSnapshotEpochStartMeta()
+		epochStartProvider := createLocalSnapshotBootstrap(t, metaBlock)
+		require.Nil(t, epochStartProvider.createTriesComponentsForShardId(core.MetachainShardId))
+
+		tries, _ := epochStartProvider.GetTriesComponents()
+		metaBlock.RootHash, _ = commitLocalSnapshotAccountsTrie(t, epochStartProvider)
+		_, metaBlock.ValidatorStatsRootHash = commitLocalSnapshotTrie(t, tries.Get([]byte(factory.PeerAccountTrie)), map[string][]byte{
+			"validator1": []byte("peer account 1"),
+			"validator2": []byte("peer account 2"),
+		})
+
+		assert.Nil(t, epochStartProvider.checkLocalSnapshotTries(core.MetachainShardId))
+	})
+	t.Run("incomplete main trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		metaBlock := createLocalSnapshotEpochStartMeta()
+		epochStartProvider := createLocalSnapshotBootstrap(t, metaBlock)
+		require.Nil(t, epochStartProvider.createTriesComponentsForShardId(0))
+
+		tries, _ := epochStartProvider.GetTriesComponents()
+		mainTrie, rootHash := commitLocalSnapshotTrie(t, tries.Get([]byte(factory.UserAccountTrie)), map[string][]byte{
+			"address1": []byte("account 1"),
+			"address2": []byte("account 2"),
+			"address3": []byte("account 3"),
+		})
+		metaBlock.EpochStart.LastFinalizedHeaders[0].RootHash = rootHash
+		require.Nil(t, epochStartProvider.checkLocalSnapshotTries(0))
+
+		removeLocalSnapshotTrieNode(t, epochStartProvider, factory.UserAccountTrie, mainTrie)
+
+		err := epochStartProvider.checkLocalSnapshotTries(0)
+		assert.True(t, errors.Is(err, epochStart.ErrIncompleteTrieInLocalSnapshot))
+	})
+	t.Run("incomplete data trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		metaBlock := createLocalSnapshotEpochStartMeta()
+		epochStartProvider := createLocalSnapshotBootstrap(t, metaBlock)
+		require.Nil(t, epochStartProvider.createTriesComponentsForShardId(0))
+
+		rootHash, dataTrie := commitLocalSnapshotAccountsTrie(t, epochStartProvider)
+		metaBlock.EpochStart.LastFinalizedHeaders[0].RootHash = rootHash
+		require.Nil(t, epochStartProvider.checkLocalSnapshotTries(0))
+
+		removeLocalSnapshotTrieNode(t, epochStartProvider, factory.UserAccountTrie, dataTrie)
+
+		err := epochStartProvider.checkLocalSnapshotTries(0)
+		assert.True(t, errors.Is(err, epochStart.ErrIncompleteTrieInLocalSnapshot))
+		assert.True(t, strings.Contains(err.Error(), "data trie"))
+	})
+	t.Run("empty shard trie should work", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotBootstrap(t, createLocalSnapshotEpochStartMeta())
+
+		assert.Nil(t, epochStartProvider.checkLocalSnapshotTries(1))
+	})
+	t.Run("unknown shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotBootstrap(t, createLocalSnapshotEpochStartMeta())
+
+		err := epochStartProvider.checkLocalSnapshotTries(2)
+		assert.Equal(t, epochStart.ErrEpochStartDataForShardNotFound, err)
+	})
+}
+
+func createLocalSnapshotValidatorsInfo() []*state.ShardValidatorInfo {
+	return []*state.ShardValidatorInfo{
+		{PublicKey: []byte("eligible"), ShardId: 0, List: string(common.EligibleList)},
+		{PublicKey: []byte("waiting"), ShardId: 1, List: string(common.WaitingList)},
+		{PublicKey: []byte("new"), ShardId: 0, List: string(common.NewList)},
+		{PublicKey: []byte("leaving"), ShardId: 1, List: string(common.LeavingList)},
+		{PublicKey: []byte("jailed"), ShardId: 0, List: string(common.JailedList)},
+	}
+}
+
+func createLocalSnapshotNodesConfig(epoch uint32) *sharding.NodesCoordinatorRegistry {
+	return &sharding.NodesCoordinatorRegistry{
+		CurrentEpoch: epoch,
+		EpochsConfig: map[string]*sharding.EpochValidators{
+			fmt.Sprint(epoch): {
+				EligibleValidators: map[string][]*sharding.SerializableValidator{
+					"0": {{PubKey: []byte("waiting")}},
+					"1": {{PubKey: []byte("eligible")}},
+				},
+				WaitingValidators: map[string][]*sharding.SerializableValidator{
+					"0": {{PubKey: []byte("new")}},
+				},
+			},
+		},
+	}
+}
+
+// addLocalSnapshotPeerMiniBlock adds to the meta block the header of a peer miniblock holding the provided validators
+// info and returns the storer holding that miniblock
+func addLocalSnapshotPeerMiniBlock(t *testing.T, metaBlock *block.MetaBlock, validatorsInfo []*state.ShardValidatorInfo) *mock.StorerMock {
+	coreComp, _ := createComponentsForEpochStart()
+	miniBlock := &block.MiniBlock{
+		SenderShardID:   core.MetachainShardId,
+		ReceiverShardID: core.AllShardId,
+		Type:            block.PeerBlock,
+	}
+	for _, validatorInfo := range validatorsInfo {
+		buff, err := coreComp.InternalMarshalizer().Marshal(validatorInfo)
+		require.Nil(t, err)
+		miniBlock.TxHashes = append(miniBlock.TxHashes, buff)
+	}
+
+	miniBlockBytes, err := coreComp.InternalMarshalizer().Marshal(miniBlock)
+	require.Nil(t, err)
+	miniBlockHash := coreComp.Hasher().Compute(string(miniBlockBytes))
+	metaBlock.MiniBlockHeaders = append(metaBlock.MiniBlockHeaders, block.MiniBlockHeader{
+		Hash:            miniBlockHash,
+		SenderShardID:   core.MetachainShardId,
+		ReceiverShardID: core.AllShardId,
+		Type:            block.PeerBlock,
+	})
+
+	storer := mock.NewStorerMock()
+	_ = storer.Put(miniBlockHash, miniBlockBytes)
+
+	return storer
+}
+
+func createLocalSnapshotNodesConfigBootstrap(t *testing.T, validatorsInfo []*state.ShardValidatorInfo) *epochStartBootstrap {
+	metaBlock := createLocalSnapshotEpochStartMeta()
+	miniBlocksStorer := addLocalSnapshotPeerMiniBlock(t, metaBlock, validatorsInfo)
+
+	epochStartProvider := createLocalSnapshotBootstrap(t, metaBlock)
+	epochStartProvider.baseData.lastEpoch = metaBlock.Epoch
+	epochStartProvider.nodesConfig = createLocalSnapshotNodesConfig(metaBlock.Epoch)
+	epochStartProvider.storageOpenerHandler = &mock.UnitOpenerStub{
+		OpenDBCalled: func(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Storer, error) {
+			if epoch == metaBlock.Epoch-1 {
+				return miniBlocksStorer, nil
+			}
+
+			return mock.NewStorerMock(), nil
+		},
+	}
+
+	return epochStartProvider
+}
+
+func TestEpochStartBootstrap_CheckLocalSnapshotNodesConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("matching nodes config should work", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotNodesConfigBootstrap(t, createLocalSnapshotValidatorsInfo())
+
+		assert.Nil(t, epochStartProvider.checkLocalSnapshotNodesConfig())
+	})
+	t.Run("missing epoch config should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotNodesConfigBootstrap(t, createLocalSnapshotValidatorsInfo())
+		epochStartProvider.nodesConfig = createLocalSnapshotNodesConfig(4)
+
+		err := epochStartProvider.checkLocalSnapshotNodesConfig()
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotNodesConfigMismatch))
+	})
+	t.Run("missing peer miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotNodesConfigBootstrap(t, createLocalSnapshotValidatorsInfo())
+		epochStartProvider.storageOpenerHandler = &mock.UnitOpenerStub{
+			OpenDBCalled: func(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Storer, error) {
+				return mock.NewStorerMock(), nil
+			},
+		}
+
+		err := epochStartProvider.checkLocalSnapshotNodesConfig()
+		assert.True(t, errors.Is(err, epochStart.ErrMissingPeerMiniBlockInLocalSnapshot))
+	})
+	t.Run("unknown validator should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotNodesConfigBootstrap(t, createLocalSnapshotValidatorsInfo())
+		epochConfig := epochStartProvider.nodesConfig.EpochsConfig["5"]
+		epochConfig.WaitingValidators["1"] = []*sharding.SerializableValidator{{PubKey: []byte("unknown")}}
+
+		err := epochStartProvider.checkLocalSnapshotNodesConfig()
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotNodesConfigMismatch))
+		assert.True(t, strings.Contains(err.Error(), hex.EncodeToString([]byte("unknown"))))
+	})
+	t.Run("jailed validator should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotNodesConfigBootstrap(t, createLocalSnapshotValidatorsInfo())
+		epochConfig := epochStartProvider.nodesConfig.EpochsConfig["5"]
+		epochConfig.WaitingValidators["1"] = []*sharding.SerializableValidator{{PubKey: []byte("jailed")}}
+
+		err := epochStartProvider.checkLocalSnapshotNodesConfig()
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotNodesConfigMismatch))
+	})
+	t.Run("missing validator should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotNodesConfigBootstrap(t, createLocalSnapshotValidatorsInfo())
+		delete(epochStartProvider.nodesConfig.EpochsConfig["5"].WaitingValidators, "0")
+
+		err := epochStartProvider.checkLocalSnapshotNodesConfig()
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotNodesConfigMismatch))
+		assert.True(t, strings.Contains(err.Error(), hex.EncodeToString([]byte("new"))))
+	})
+}
+
+// storeLocalSnapshotHeaders chains the provided headers through the previous hashes, stores them and returns the
+// hash of the last one
+func storeLocalSnapshotHeaders(t *testing.T, storer *mock.StorerMock, headers ...data.HeaderHandler) []byte {
+	coreComp, _ := createComponentsForEpochStart()
+
+	var hash []byte
+	for _, header := range headers {
+		if len(hash) > 0 {
+			header.SetPrevHash(hash)
+		}
+
+		buff, err := coreComp.InternalMarshalizer().Marshal(header)
+		require.Nil(t, err)
+		hash = coreComp.Hasher().Compute(string(buff))
+		_ = storer.Put(hash, buff)
+	}
+
+	return hash
+}
+
+func createLocalSnapshotShardHeaders(epoch uint32, epochStartMetaHash []byte) []data.HeaderHandler {
+	return []data.HeaderHandler{
+		&block.Header{Nonce: 9, Epoch: epoch - 1, PrevHash: []byte("previous epoch header")},
+		&block.Header{Nonce: 10, Epoch: epoch, EpochStartMetaHash: epochStartMetaHash},
+		&block.Header{Nonce: 11, Epoch: epoch},
+	}
+}
+
+func TestEpochStartBootstrap_CheckLocalSnapshotLastHeader(t *testing.T) {
+	t.Parallel()
+
+	createLastHeaderBootstrap := func(t *testing.T, shardID uint32, storer *mock.StorerMock) *epochStartBootstrap {
+		metaBlock := createLocalSnapshotEpochStartMeta()
+		epochStartProvider := createLocalSnapshotBootstrap(t, metaBlock)
+		epochStartProvider.baseData.lastEpoch = metaBlock.Epoch
+		epochStartProvider.baseData.shardId = shardID
+		epochStartProvider.storageOpenerHandler = &mock.UnitOpenerStub{
+			OpenDBCalled: func(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Storer, error) {
+				if epoch == metaBlock.Epoch {
+					return storer, nil
+				}
+
+				return mock.NewStorerMock(), nil
+			},
+		}
+
+		return epochStartProvider
+	}
+
+	t.Run("disabled should not check", func(t *testing.T) {
+		t.Parallel()
+
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		epochStartProvider, _ := NewEpochStartBootstrap(createMockEpochStartBootstrapArgs(coreComp, cryptoComp))
+		epochStartProvider.baseData.lastEpoch = 5
+
+		err := epochStartProvider.checkLocalSnapshotLastHeader(bootstrapStorage.BootstrapHeaderInfo{Hash: []byte("missing")})
+		assert.Nil(t, err)
+	})
+	t.Run("shard header chaining to the epoch start meta block should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer := mock.NewStorerMock()
+		epochStartProvider := createLastHeaderBootstrap(t, 0, storer)
+		headers := createLocalSnapshotShardHeaders(5, epochStartProvider.localSnapshotHash)
+		lastHeaderHash := storeLocalSnapshotHeaders(t, storer, headers...)
+
+		err := epochStartProvider.checkLocalSnapshotLastHeader(bootstrapStorage.BootstrapHeaderInfo{Hash: lastHeaderHash})
+		assert.Nil(t, err)
+	})
+	t.Run("shard header notarizing another epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := mock.NewStorerMock()
+		epochStartProvider := createLastHeaderBootstrap(t, 0, storer)
+		headers := createLocalSnapshotShardHeaders(5, []byte("another epoch start meta block"))
+		lastHeaderHash := storeLocalSnapshotHeaders(t, storer, headers...)
+
+		err := epochStartProvider.checkLocalSnapshotLastHeader(bootstrapStorage.BootstrapHeaderInfo{Hash: lastHeaderHash})
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotLastHeaderMismatch))
+	})
+	t.Run("shard header not reaching the start of epoch block should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := mock.NewStorerMock()
+		epochStartProvider := createLastHeaderBootstrap(t, 0, storer)
+		headers := createLocalSnapshotShardHeaders(5, nil)
+		lastHeaderHash := storeLocalSnapshotHeaders(t, storer, headers...)
+
+		err := epochStartProvider.checkLocalSnapshotLastHeader(bootstrapStorage.BootstrapHeaderInfo{Hash: lastHeaderHash})
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotLastHeaderMismatch))
+	})
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLastHeaderBootstrap(t, 0, mock.NewStorerMock())
+
+		err := epochStartProvider.checkLocalSnapshotLastHeader(bootstrapStorage.BootstrapHeaderInfo{Hash: []byte("missing")})
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotLastHeaderMismatch))
+	})
+	t.Run("metachain header chaining to the epoch start meta block should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer := mock.NewStorerMock()
+		epochStartProvider := createLastHeaderBootstrap(t, core.MetachainShardId, storer)
+		lastHeaderHash := storeLocalSnapshotHeaders(t, storer,
+			&block.MetaBlock{Nonce: 1001, Epoch: 5, PrevHash: epochStartProvider.localSnapshotHash},
+			&block.MetaBlock{Nonce: 1002, Epoch: 5},
+		)
+
+		err := epochStartProvider.checkLocalSnapshotLastHeader(bootstrapStorage.BootstrapHeaderInfo{Hash: lastHeaderHash})
+		assert.Nil(t, err)
+
+		err = epochStartProvider.checkLocalSnapshotLastHeader(bootstrapStorage.BootstrapHeaderInfo{Hash: epochStartProvider.localSnapshotHash})
+		assert.Nil(t, err)
+	})
+	t.Run("metachain header on another chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := mock.NewStorerMock()
+		epochStartProvider := createLastHeaderBootstrap(t, core.MetachainShardId, storer)
+		lastHeaderHash := storeLocalSnapshotHeaders(t, storer,
+			&block.MetaBlock{Nonce: 999, Epoch: 4},
+			&block.MetaBlock{Nonce: 1000, Epoch: 5},
+			&block.MetaBlock{Nonce: 1001, Epoch: 5},
+		)
+
+		err := epochStartProvider.checkLocalSnapshotLastHeader(bootstrapStorage.BootstrapHeaderInfo{Hash: lastHeaderHash})
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotLastHeaderMismatch))
+	})
+}
+
+func TestEpochStartBootstrap_PrepareEpochFromStorageShuffledOutWithLocalSnapshot(t *testing.T) {
+	t.Parallel()
+
+	pubKey := []byte("eligible")
+	createShuffledOutBootstrap := func(t *testing.T, newShardRootHash []byte) *epochStartBootstrap {
+		metaBlock := createLocalSnapshotEpochStartMeta()
+		metaBlock.EpochStart.LastFinalizedHeaders[1].RootHash = newShardRootHash
+		miniBlocksStorer := addLocalSnapshotPeerMiniBlock(t, metaBlock, createLocalSnapshotValidatorsInfo())
+		epochStartProvider := createLocalSnapshotBootstrap(t, metaBlock)
+		epochStartProvider.baseData.lastEpoch = metaBlock.Epoch
+		epochStartProvider.baseData.shardId = 0
+		cryptoComp, ok := epochStartProvider.cryptoComponentsHolder.(*mock.CryptoComponentsMock)
+		require.True(t, ok)
+		cryptoComp.PubKey = &cryptoMocks.PublicKeyStub{
+			ToByteArrayStub: func() ([]byte, error) {
+				return pubKey, nil
+			},
+		}
+
+		lastHeaderHash := storeLocalSnapshotHeaders(t, miniBlocksStorer, createLocalSnapshotShardHeaders(metaBlock.Epoch, epochStartProvider.localSnapshotHash)...)
+
+		marshalizer := epochStartProvider.coreComponentsHolder.InternalMarshalizer()
+		metaBlockBytes, _ := marshalizer.Marshal(metaBlock)
+		nodesConfigBytes, _ := json.Marshal(createLocalSnapshotNodesConfig(metaBlock.Epoch))
+		roundBytes, _ := json.Marshal(&bootstrapStorage.RoundNum{Num: 10})
+		bootstrapDataBytes, _ := json.Marshal(&bootstrapStorage.BootstrapData{
+			LastHeader:                bootstrapStorage.BootstrapHeaderInfo{ShardId: 0, Epoch: metaBlock.Epoch, Nonce: 11, Hash: lastHeaderHash},
+			NodesCoordinatorConfigKey: []byte("key"),
+		})
+		bootstrapStorer := &testscommon.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				if bytes.Equal([]byte(common.HighestRoundFromBootStorage), key) {
+					return roundBytes, nil
+				}
+
+				return bootstrapDataBytes, nil
+			},
+			SearchFirstCalled: func(key []byte) ([]byte, error) {
+				if bytes.Equal([]byte(core.EpochStartIdentifier(metaBlock.Epoch)), key) {
+					return metaBlockBytes, nil
+				}
+
+				return nodesConfigBytes, nil
+			},
+		}
+		epochStartProvider.storageOpenerHandler = &mock.UnitOpenerStub{
+			GetMostRecentBootstrapStorageUnitCalled: func() (storage.Storer, error) {
+				return bootstrapStorer, nil
+			},
+			OpenDBCalled: func(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Storer, error) {
+				assert.Equal(t, uint32(0), shardID)
+				return miniBlocksStorer, nil
+			},
+		}
+		return epochStartProvider
+	}
+
+	t.Run("new shard in local snapshot should start without syncing from network", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createShuffledOutBootstrap(t, trie.EmptyTrieHash)
+
+		params, err := epochStartProvider.prepareEpochFromStorage()
+		require.Nil(t, err)
+		assert.True(t, epochStartProvider.shuffledOut)
+		assert.Equal(t, uint32(1), params.SelfShardId)
+		assert.Equal(t, uint32(5), params.Epoch)
+	})
+	t.Run("new shard missing from local snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createShuffledOutBootstrap(t, []byte("missing root hash"))
+
+		_, err := epochStartProvider.prepareEpochFromStorage()
+		assert.True(t, errors.Is(err, epochStart.ErrLocalSnapshotShuffledOut))
+		assert.True(t, strings.Contains(err.Error(), epochStart.ErrMissingTrieRootInLocalSnapshot.Error()))
+	})
+}
+
+func TestEpochStartBootstrap_StartFromSavedEpochWithLocalSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing local storage should error without syncing from network", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotBootstrap(t, createLocalSnapshotEpochStartMeta())
+		epochStartProvider.latestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+			GetCalled: func() (storage.LatestDataFromStorage, error) {
+				return storage.LatestDataFromStorage{}, errors.New("no storage")
+			},
+		}
+
+		_, shouldContinue, err := epochStartProvider.startFromSavedEpoch()
+		assert.False(t, shouldContinue)
+		assert.Equal(t, epochStart.ErrMissingLocalSnapshot, err)
+	})
+	t.Run("old local storage should be used without syncing from network", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createLocalSnapshotBootstrap(t, createLocalSnapshotEpochStartMeta())
+		epochStartProvider.latestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+			GetCalled: func() (storage.LatestDataFromStorage, error) {
+				return storage.LatestDataFromStorage{Epoch: 5, LastRound: 10}, nil
+			},
+		}
+		errOpen := errors.New("cannot open bootstrap storage")
+		epochStartProvider.storageOpenerHandler = &mock.UnitOpenerStub{
+			GetMostRecentBootstrapStorageUnitCalled: func() (storage.Storer, error) {
+				return nil, errOpen
+			},
+		}
+
+		_, shouldContinue, err := epochStartProvider.startFromSavedEpoch()
+		assert.False(t, shouldContinue)
+		assert.Equal(t, errOpen, err)
+	})
+}
@@ -57,10 +57,11 @@ func (e *epochStartBootstrap) getShardIDForLatestEpoch() (uint32, bool, error) {
 		return 0, false, err
 	}
 
-	_, e.nodesConfig, err = e.getLastBootstrapData(storer)
+	bootstrapData, nodesConfig, err := e.getLastBootstrapData(storer)
 	if err != nil {
 		return 0, false, err
 	}
+	e.nodesConfig = nodesConfig
 
 	pubKey, err := e.cryptoComponentsHolder.PublicKey().ToByteArray()
 	if err != nil {
@@ -72,6 +73,21 @@ func (e *epochStartBootstrap) getShardIDForLatestEpoch() (uint32, bool, error) {
 		return 0, false, err
 	}
 
+	err = e.checkLocalSnapshotEpochStartMeta()
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = e.checkLocalSnapshotNodesConfig()
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = e.checkLocalSnapshotLastHeader(bootstrapData.LastHeader)
+	if err != nil {
+		return 0, false, err
+	}
+
 	e.baseData.numberOfShards = uint32(len(e.epochStartMeta.EpochStart.LastFinalizedHeaders))
 	if e.baseData.numberOfShards == 0 {
 		e.baseData.numberOfShards = e.genesisShardCoordinator.NumberOfShards()
@@ -98,6 +114,11 @@ func (e *epochStartBootstrap) prepareEpochFromStorage() (Parameters, error) {
 	}
 
 	if !isShuffledOut {
+		err = e.checkLocalSnapshotTries(newShardId)
+		if err != nil {
+			return Parameters{}, err
+		}
+
 		parameters := Parameters{
 			Epoch:       e.baseData.lastEpoch,
 			SelfShardId: e.baseData.shardId,
@@ -113,6 +134,10 @@ func (e *epochStartBootstrap) prepareEpochFromStorage() (Parameters, error) {
 	log.Debug("prepareEpochFromStorage for shuffled out", "initial shard id", e.baseData.shardId, "new shard id", newShardId)
 	e.baseData.shardId = newShardId
 
+	if e.isLocalSnapshotEnabled() {
+		return e.prepareShuffledOutFromLocalSnapshot(newShardId)
+	}
+
 	err = e.createRequestHandler()
 	if err != nil {
 		return Parameters{}, err
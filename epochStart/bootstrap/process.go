@@ -98,6 +98,7 @@ type epochStartBootstrap struct {
 	numConcurrentTrieSyncers   int
 	maxHardCapForMissingNodes  int
 	trieSyncerVersion          int
+	localSnapshotHash          []byte
 
 	// created components
 	requestHandler            process.RequestHandler
@@ -194,6 +195,11 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 
 	log.Debug("process: enable epoch for transaction signed with tx hash", "epoch", epochStartProvider.enableSignTxWithHashEpoch)
 
+	epochStartProvider.localSnapshotHash, err = getLocalSnapshotHash(args)
+	if err != nil {
+		return nil, err
+	}
+
 	whiteListCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(epochStartProvider.generalConfig.WhiteListPool))
 	if err != nil {
 		return nil, err
@@ -385,6 +391,11 @@ func (e *epochStartBootstrap) bootstrapFromLocalStorage() (Parameters, error) {
 		return Parameters{}, err
 	}
 
+	err = e.checkLocalSnapshotTries(newShardId)
+	if err != nil {
+		return Parameters{}, err
+	}
+
 	epochToStart := e.baseData.lastEpoch
 	if shuffledOut {
 		epochToStart = e.startEpoch
@@ -409,6 +420,11 @@ func (e *epochStartBootstrap) cleanupOnBootstrapFinish() {
 }
 
 func (e *epochStartBootstrap) startFromSavedEpoch() (Parameters, bool, error) {
+	if e.isLocalSnapshotEnabled() {
+		params, err := e.prepareEpochFromLocalSnapshot()
+		return params, false, err
+	}
+
 	isStartInEpochZero := e.isStartInEpochZero()
 	isCurrentEpochSaved := e.computeIfCurrentEpochIsSaved()
 
@@ -0,0 +1,15 @@
+package economicsReport
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilReport signals that a nil report has been provided
+var ErrNilReport = errors.New("nil economics report")
+
+// ErrReportNotFound signals that no economics report was saved for the requested epoch
+var ErrReportNotFound = errors.New("economics report not found")
+
+// ErrNodeRewardsNotFound signals that no rewards were recorded for the requested node
+var ErrNodeRewardsNotFound = errors.New("node rewards not found")
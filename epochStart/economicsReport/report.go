@@ -0,0 +1,83 @@
+package economicsReport
+
+import "math/big"
+
+// EpochReport holds the full economics and rewards breakdown computed by the metachain at the start of an epoch
+type EpochReport struct {
+	Epoch                         uint32                 `json:"epoch"`
+	MetaBlockRound                uint64                 `json:"metaBlockRound"`
+	MetaBlockNonce                uint64                 `json:"metaBlockNonce"`
+	PrevEpochStartRound           uint64                 `json:"prevEpochStartRound"`
+	RewardsCreatorVersion         uint32                 `json:"rewardsCreatorVersion"`
+	InflationRate                 float64                `json:"inflationRate"`
+	TotalSupply                   *big.Int               `json:"totalSupply"`
+	NewlyMinted                   *big.Int               `json:"newlyMinted"`
+	AccumulatedFees               *big.Int               `json:"accumulatedFees"`
+	DeveloperFees                 *big.Int               `json:"developerFees"`
+	LeaderFees                    *big.Int               `json:"leaderFees"`
+	TotalToDistribute             *big.Int               `json:"totalToDistribute"`
+	RewardsPerBlock               *big.Int               `json:"rewardsPerBlock"`
+	RewardsForBlocks              *big.Int               `json:"rewardsForBlocks"`
+	BaseRewards                   *big.Int               `json:"baseRewards"`
+	TopUpRewards                  *big.Int               `json:"topUpRewards"`
+	ProtocolSustainabilityAddress []byte                 `json:"protocolSustainabilityAddress"`
+	ProtocolSustainabilityRewards *big.Int               `json:"protocolSustainabilityRewards"`
+	NodePrice                     *big.Int               `json:"nodePrice"`
+	NumBlocks                     uint64                 `json:"numBlocks"`
+	Shards                        []*ShardReport         `json:"shards"`
+	Nodes                         []*NodeReport          `json:"nodes"`
+	RewardAddresses               []*RewardAddressReport `json:"rewardAddresses"`
+}
+
+// ShardReport holds the rewards breakdown of a shard for an epoch
+type ShardReport struct {
+	ShardID                    uint32   `json:"shardID"`
+	NumBlocks                  uint64   `json:"numBlocks"`
+	NumEligibleNodes           uint32   `json:"numEligibleNodes"`
+	BaseRewardsPerBlockPerNode *big.Int `json:"baseRewardsPerBlockPerNode"`
+	BaseRewards                *big.Int `json:"baseRewards"`
+	TopUpRewards               *big.Int `json:"topUpRewards"`
+	LeaderFees                 *big.Int `json:"leaderFees"`
+}
+
+// NodeReport holds the rewards computed for a node in an epoch. The rewards of a node that did not sign or propose
+// any block are not distributed and go to the protocol sustainability address instead
+type NodeReport struct {
+	PublicKey                  []byte   `json:"publicKey"`
+	ShardID                    uint32   `json:"shardID"`
+	RewardAddress              []byte   `json:"rewardAddress"`
+	NumSelectedInSuccessBlocks uint32   `json:"numSelectedInSuccessBlocks"`
+	LeaderSuccess              uint32   `json:"leaderSuccess"`
+	ValidatorSuccess           uint32   `json:"validatorSuccess"`
+	TopUpStake                 *big.Int `json:"topUpStake"`
+	BaseReward                 *big.Int `json:"baseReward"`
+	TopUpReward                *big.Int `json:"topUpReward"`
+	LeaderFees                 *big.Int `json:"leaderFees"`
+	Distributed                bool     `json:"distributed"`
+}
+
+// RewardAddressReport holds the rewards sent to a reward address in an epoch, aggregated over all its nodes
+type RewardAddressReport struct {
+	Address      []byte   `json:"address"`
+	NumNodes     uint32   `json:"numNodes"`
+	BaseRewards  *big.Int `json:"baseRewards"`
+	TopUpRewards *big.Int `json:"topUpRewards"`
+	LeaderFees   *big.Int `json:"leaderFees"`
+	Total        *big.Int `json:"total"`
+}
+
+// NodeEpochRewards holds the rewards of a node for one epoch, as kept in the node rewards history
+type NodeEpochRewards struct {
+	Epoch         uint32   `json:"epoch"`
+	ShardID       uint32   `json:"shardID"`
+	RewardAddress []byte   `json:"rewardAddress"`
+	BaseReward    *big.Int `json:"baseReward"`
+	TopUpReward   *big.Int `json:"topUpReward"`
+	LeaderFees    *big.Int `json:"leaderFees"`
+	Distributed   bool     `json:"distributed"`
+}
+
+// NodeRewardsHistory holds the per-epoch rewards of a node, sorted by epoch
+type NodeRewardsHistory struct {
+	Epochs []*NodeEpochRewards `json:"epochs"`
+}
@@ -0,0 +1,164 @@
+package economicsReport
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("epochStart/economicsReport")
+
+const (
+	epochReportKeyPrefix = "epoch-"
+	nodeRewardsKeyPrefix = "node-"
+)
+
+type reportStorer struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+	mutStorer   sync.Mutex
+}
+
+// NewReportStorer creates a component able to save and load the per-epoch economics reports and the per-node
+// rewards history
+func NewReportStorer(storer storage.Storer) (*reportStorer, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+
+	return &reportStorer{
+		storer:      storer,
+		marshalizer: common.NewApiRecordsMarshalizer(),
+	}, nil
+}
+
+// SaveReport saves the provided epoch report and adds the rewards of each node to its rewards history. Saving the
+// report of an epoch again overwrites the previously saved values
+func (rs *reportStorer) SaveReport(report *EpochReport) error {
+	if report == nil {
+		return ErrNilReport
+	}
+
+	rs.mutStorer.Lock()
+	defer rs.mutStorer.Unlock()
+
+	err := rs.put(epochReportKey(report.Epoch), report)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range report.Nodes {
+		err = rs.addNodeRewards(report.Epoch, node)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("saved economics report", "epoch", report.Epoch, "num nodes", len(report.Nodes))
+
+	return nil
+}
+
+func (rs *reportStorer) addNodeRewards(epoch uint32, node *NodeReport) error {
+	history := &NodeRewardsHistory{}
+	err := rs.get(nodeRewardsKey(node.PublicKey), history)
+	if err != nil && err != ErrReportNotFound {
+		return err
+	}
+
+	epochs := make([]*NodeEpochRewards, 0, len(history.Epochs)+1)
+	for _, epochRewards := range history.Epochs {
+		if epochRewards.Epoch == epoch {
+			continue
+		}
+		epochs = append(epochs, epochRewards)
+	}
+
+	epochs = append(epochs, &NodeEpochRewards{
+		Epoch:         epoch,
+		ShardID:       node.ShardID,
+		RewardAddress: node.RewardAddress,
+		BaseReward:    node.BaseReward,
+		TopUpReward:   node.TopUpReward,
+		LeaderFees:    node.LeaderFees,
+		Distributed:   node.Distributed,
+	})
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i].Epoch < epochs[j].Epoch
+	})
+	history.Epochs = epochs
+
+	return rs.put(nodeRewardsKey(node.PublicKey), history)
+}
+
+// GetEpochReport returns the economics report saved for the provided epoch
+func (rs *reportStorer) GetEpochReport(epoch uint32) (*EpochReport, error) {
+	rs.mutStorer.Lock()
+	defer rs.mutStorer.Unlock()
+
+	report := &EpochReport{}
+	err := rs.get(epochReportKey(epoch), report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// GetNodeRewards returns the rewards history of the node with the provided public key
+func (rs *reportStorer) GetNodeRewards(publicKey []byte) (*NodeRewardsHistory, error) {
+	rs.mutStorer.Lock()
+	defer rs.mutStorer.Unlock()
+
+	history := &NodeRewardsHistory{}
+	err := rs.get(nodeRewardsKey(publicKey), history)
+	if err == ErrReportNotFound {
+		return nil, ErrNodeRewardsNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (rs *reportStorer) get(key []byte, value interface{}) error {
+	buff, err := rs.storer.Get(key)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return ErrReportNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return rs.marshalizer.Unmarshal(value, buff)
+}
+
+func (rs *reportStorer) put(key []byte, value interface{}) error {
+	buff, err := rs.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return rs.storer.Put(key, buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *reportStorer) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+func epochReportKey(epoch uint32) []byte {
+	return []byte(fmt.Sprintf("%s%d", epochReportKeyPrefix, epoch))
+}
+
+func nodeRewardsKey(publicKey []byte) []byte {
+	return append([]byte(nodeRewardsKeyPrefix), publicKey...)
+}
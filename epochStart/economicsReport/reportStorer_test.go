@@ -0,0 +1,140 @@
+package economicsReport
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createReport(epoch uint32, baseReward int64) *EpochReport {
+	return &EpochReport{
+		Epoch:       epoch,
+		TotalSupply: big.NewInt(1000),
+		Nodes: []*NodeReport{
+			{
+				PublicKey:     []byte("node1"),
+				RewardAddress: []byte("owner"),
+				BaseReward:    big.NewInt(baseReward),
+				TopUpReward:   big.NewInt(1),
+				LeaderFees:    big.NewInt(2),
+				Distributed:   true,
+			},
+			{
+				PublicKey:   []byte("node2"),
+				ShardID:     1,
+				BaseReward:  big.NewInt(0),
+				TopUpReward: big.NewInt(0),
+				LeaderFees:  big.NewInt(0),
+			},
+		},
+	}
+}
+
+func TestNewReportStorer(t *testing.T) {
+	t.Parallel()
+
+	rs, err := NewReportStorer(nil)
+	assert.True(t, check.IfNil(rs))
+	assert.Equal(t, ErrNilStorer, err)
+
+	rs, err = NewReportStorer(genericMocks.NewStorerMock("reports", 0))
+	assert.False(t, check.IfNil(rs))
+	assert.Nil(t, err)
+}
+
+func TestReportStorer_SaveReportNilReportShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rs, _ := NewReportStorer(genericMocks.NewStorerMock("reports", 0))
+
+	err := rs.SaveReport(nil)
+	assert.Equal(t, ErrNilReport, err)
+}
+
+func TestReportStorer_GetMissingValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rs, _ := NewReportStorer(genericMocks.NewStorerMock("reports", 0))
+
+	report, err := rs.GetEpochReport(3)
+	assert.Nil(t, report)
+	assert.Equal(t, ErrReportNotFound, err)
+
+	history, err := rs.GetNodeRewards([]byte("node1"))
+	assert.Nil(t, history)
+	assert.Equal(t, ErrNodeRewardsNotFound, err)
+}
+
+func TestReportStorer_SaveReportShouldKeepNodesHistory(t *testing.T) {
+	t.Parallel()
+
+	rs, _ := NewReportStorer(genericMocks.NewStorerMock("reports", 0))
+
+	require.Nil(t, rs.SaveReport(createReport(5, 10)))
+	require.Nil(t, rs.SaveReport(createReport(3, 20)))
+	// saving the same epoch again should replace the previous values
+	require.Nil(t, rs.SaveReport(createReport(5, 30)))
+
+	report, err := rs.GetEpochReport(5)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), report.TotalSupply)
+	require.Equal(t, 2, len(report.Nodes))
+	assert.Equal(t, big.NewInt(30), report.Nodes[0].BaseReward)
+
+	history, err := rs.GetNodeRewards([]byte("node1"))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(history.Epochs))
+	assert.Equal(t, &NodeEpochRewards{
+		Epoch:         3,
+		RewardAddress: []byte("owner"),
+		BaseReward:    big.NewInt(20),
+		TopUpReward:   big.NewInt(1),
+		LeaderFees:    big.NewInt(2),
+		Distributed:   true,
+	}, history.Epochs[0])
+	assert.Equal(t, uint32(5), history.Epochs[1].Epoch)
+	assert.Equal(t, big.NewInt(30), history.Epochs[1].BaseReward)
+
+	history, err = rs.GetNodeRewards([]byte("node2"))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(history.Epochs))
+	assert.Equal(t, uint32(1), history.Epochs[0].ShardID)
+	assert.False(t, history.Epochs[0].Distributed)
+}
+
+func TestReportStorer_StorerErrorsShouldBePropagated(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	putCalled := false
+	rs, _ := NewReportStorer(&testscommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+		PutCalled: func(key, data []byte) error {
+			if strings.HasPrefix(string(key), nodeRewardsKeyPrefix) {
+				putCalled = true
+			}
+			return nil
+		},
+	})
+
+	err := rs.SaveReport(createReport(3, 20))
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, putCalled)
+
+	report, err := rs.GetEpochReport(3)
+	assert.Nil(t, report)
+	assert.Equal(t, expectedErr, err)
+
+	history, err := rs.GetNodeRewards([]byte("node1"))
+	assert.Nil(t, history)
+	assert.Equal(t, expectedErr, err)
+}
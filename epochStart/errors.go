@@ -316,3 +316,33 @@ var ErrEmptyESDTOwnerAddress = errors.New("empty ESDT owner address")
 
 // ErrNilCurrentNetworkEpochSetter signals that a nil current network epoch setter has been provided
 var ErrNilCurrentNetworkEpochSetter = errors.New("nil current network epoch setter")
+
+// ErrNilEconomicsReportSaver signals that a nil economics report saver has been provided
+var ErrNilEconomicsReportSaver = errors.New("nil economics report saver")
+
+// ErrInvalidLocalSnapshotHash signals that the pinned hash of the local snapshot epoch start meta block is invalid
+var ErrInvalidLocalSnapshotHash = errors.New("invalid local snapshot epoch start meta block hash")
+
+// ErrMissingLocalSnapshot signals that the node was configured to start from a local snapshot but none was found
+var ErrMissingLocalSnapshot = errors.New("missing local snapshot")
+
+// ErrLocalSnapshotHashMismatch signals that the epoch start meta block found in the local snapshot does not have the pinned hash
+var ErrLocalSnapshotHashMismatch = errors.New("local snapshot epoch start meta block hash mismatch")
+
+// ErrMissingTrieRootInLocalSnapshot signals that a root hash committed in the epoch start meta block is missing from the local snapshot
+var ErrMissingTrieRootInLocalSnapshot = errors.New("trie root hash missing from local snapshot")
+
+// ErrIncompleteTrieInLocalSnapshot signals that some nodes of a trie committed in the epoch start meta block are missing from the local snapshot
+var ErrIncompleteTrieInLocalSnapshot = errors.New("incomplete trie in local snapshot")
+
+// ErrLocalSnapshotNodesConfigMismatch signals that the nodes config found in the local snapshot does not match the validators info of the epoch start meta block
+var ErrLocalSnapshotNodesConfigMismatch = errors.New("local snapshot nodes config does not match the epoch start meta block")
+
+// ErrMissingPeerMiniBlockInLocalSnapshot signals that a peer miniblock of the epoch start meta block is missing from the local snapshot
+var ErrMissingPeerMiniBlockInLocalSnapshot = errors.New("peer miniblock missing from local snapshot")
+
+// ErrLocalSnapshotShuffledOut signals that the node was shuffled out to a shard whose state is not found in the local snapshot
+var ErrLocalSnapshotShuffledOut = errors.New("node shuffled out to a shard missing from the local snapshot")
+
+// ErrLocalSnapshotLastHeaderMismatch signals that the header the node resumes from does not chain back to the epoch start meta block of the local snapshot
+var ErrLocalSnapshotLastHeaderMismatch = errors.New("local snapshot last header does not chain back to the epoch start meta block")
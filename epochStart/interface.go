@@ -172,11 +172,13 @@ type EpochEconomicsDataProvider interface {
 	SetLeadersFees(fees *big.Int)
 	SetRewardsToBeDistributed(rewards *big.Int)
 	SetRewardsToBeDistributedForBlocks(rewards *big.Int)
+	SetInflationRate(rate float64)
 	NumberOfBlocks() uint64
 	NumberOfBlocksPerShard() map[uint32]uint64
 	LeaderFees() *big.Int
 	RewardsToBeDistributed() *big.Int
 	RewardsToBeDistributedForBlocks() *big.Int
+	InflationRate() float64
 	IsInterfaceNil() bool
 }
 
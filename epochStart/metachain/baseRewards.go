@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -40,6 +41,7 @@ type BaseRewardsCreatorArgs struct {
 	DelegationSystemSCEnableEpoch uint32
 	UserAccountsDB                state.AccountsAdapter
 	RewardsFix1EpochEnable        uint32
+	EconomicsReportSaver          EconomicsReportSaver
 }
 
 type baseRewardsCreator struct {
@@ -61,6 +63,8 @@ type baseRewardsCreator struct {
 	userAccountsDB                     state.AccountsAdapter
 	mutRewardsData                     sync.RWMutex
 	rewardsFix1EnableEpoch             uint32
+	economicsReportSaver               EconomicsReportSaver
+	economicsReport                    *economicsReport.EpochReport
 }
 
 // NewBaseRewardsCreator will create a new base rewards creator instance
@@ -103,6 +107,7 @@ func NewBaseRewardsCreator(args BaseRewardsCreatorArgs) (*baseRewardsCreator, er
 		userAccountsDB:                     args.UserAccountsDB,
 		mapBaseRewardsPerBlockPerValidator: make(map[uint32]*big.Int),
 		rewardsFix1EnableEpoch:             args.RewardsFix1EpochEnable,
+		economicsReportSaver:               args.EconomicsReportSaver,
 	}
 
 	return brc, nil
@@ -189,11 +194,13 @@ func (brc *baseRewardsCreator) GetRewardsTxs(body *block.Body) map[string]data.T
 }
 
 // SaveTxBlockToStorage saves created data to storage
-func (brc *baseRewardsCreator) SaveTxBlockToStorage(_ *block.MetaBlock, body *block.Body) {
+func (brc *baseRewardsCreator) SaveTxBlockToStorage(metaBlock *block.MetaBlock, body *block.Body) {
 	if check.IfNil(body) {
 		return
 	}
 
+	brc.saveEconomicsReport(metaBlock)
+
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type != block.RewardsBlock {
 			continue
@@ -311,16 +318,41 @@ func checkBaseArgs(args BaseRewardsCreatorArgs) error {
 	if check.IfNil(args.UserAccountsDB) {
 		return epochStart.ErrNilAccountsDB
 	}
+	if check.IfNil(args.EconomicsReportSaver) {
+		return epochStart.ErrNilEconomicsReportSaver
+	}
 
 	return nil
 }
 
+func (brc *baseRewardsCreator) saveEconomicsReport(metaBlock *block.MetaBlock) {
+	if check.IfNil(metaBlock) {
+		return
+	}
+
+	brc.mutRewardsData.RLock()
+	report := brc.economicsReport
+	brc.mutRewardsData.RUnlock()
+
+	if report == nil || report.Epoch != metaBlock.Epoch || report.MetaBlockRound != metaBlock.Round {
+		log.Debug("baseRewardsCreator.saveEconomicsReport - no economics report computed for the meta block",
+			"epoch", metaBlock.Epoch, "round", metaBlock.Round)
+		return
+	}
+
+	err := brc.economicsReportSaver.SaveReport(report)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveEconomicsReport", "epoch", report.Epoch, "error", err)
+	}
+}
+
 // CreateBlockStarted announces block creation started and cleans inside data
 func (brc *baseRewardsCreator) clean() {
 	brc.mapBaseRewardsPerBlockPerValidator = make(map[uint32]*big.Int)
 	brc.currTxs.Clean()
 	brc.accumulatedRewards = big.NewInt(0)
 	brc.protocolSustainabilityValue = big.NewInt(0)
+	brc.economicsReport = nil
 }
 
 func (brc *baseRewardsCreator) isSystemDelegationSC(address []byte) bool {
@@ -159,6 +159,18 @@ func TestBaseRewardsCreator_NilUserAccountsDB(t *testing.T) {
 	assert.Equal(t, epochStart.ErrNilAccountsDB, err)
 }
 
+func TestBaseRewardsCreator_NilEconomicsReportSaver(t *testing.T) {
+	t.Parallel()
+
+	args := getBaseRewardsArguments()
+	args.EconomicsReportSaver = nil
+
+	rwd, err := NewBaseRewardsCreator(args)
+
+	assert.True(t, check.IfNil(rwd))
+	assert.Equal(t, epochStart.ErrNilEconomicsReportSaver, err)
+}
+
 func TestBaseRewardsCreator_clean(t *testing.T) {
 	t.Parallel()
 
@@ -1149,6 +1161,7 @@ func getBaseRewardsArguments() BaseRewardsCreatorArgs {
 		},
 		UserAccountsDB:         userAccountsDB,
 		RewardsFix1EpochEnable: 0,
+		EconomicsReportSaver:   &mock.EconomicsReportSaverStub{},
 	}
 }
 
@@ -158,6 +158,7 @@ func (e *economics) ComputeEndOfEpochEconomics(
 	e.economicsDataNotified.SetLeadersFees(rewardsForLeaders)
 	e.economicsDataNotified.SetRewardsToBeDistributed(totalRewardsToBeDistributed)
 	e.economicsDataNotified.SetRewardsToBeDistributedForBlocks(remainingToBeDistributed)
+	e.economicsDataNotified.SetInflationRate(inflationRate)
 
 	prevEpochStartHash, err := core.CalculateHash(e.marshalizer, e.hasher, prevEpochStart)
 	if err != nil {
@@ -11,6 +11,7 @@ type epochEconomicsStatistics struct {
 	leaderFees                      *big.Int
 	rewardsToBeDistributed          *big.Int
 	rewardsToBeDistributedForBlocks *big.Int // without leader fees, protocol sustainability and developer fees
+	inflationRate                   float64
 	mutEconomicsStatistics          sync.RWMutex
 }
 
@@ -69,6 +70,14 @@ func (es *epochEconomicsStatistics) SetRewardsToBeDistributedForBlocks(rewards *
 	es.rewardsToBeDistributedForBlocks = big.NewInt(0).Set(rewards)
 }
 
+// SetInflationRate sets the yearly inflation rate used when computing the end of epoch economics
+func (es *epochEconomicsStatistics) SetInflationRate(rate float64) {
+	es.mutEconomicsStatistics.Lock()
+	defer es.mutEconomicsStatistics.Unlock()
+
+	es.inflationRate = rate
+}
+
 // NumberOfBlocks returns the number of blocks produced in the epoch
 func (es *epochEconomicsStatistics) NumberOfBlocks() uint64 {
 	es.mutEconomicsStatistics.RLock()
@@ -115,6 +124,14 @@ func (es *epochEconomicsStatistics) RewardsToBeDistributedForBlocks() *big.Int {
 	return big.NewInt(0).Set(es.rewardsToBeDistributedForBlocks)
 }
 
+// InflationRate returns the yearly inflation rate used when computing the end of epoch economics
+func (es *epochEconomicsStatistics) InflationRate() float64 {
+	es.mutEconomicsStatistics.RLock()
+	defer es.mutEconomicsStatistics.RUnlock()
+
+	return es.inflationRate
+}
+
 // IsInterfaceNil returns nil if the underlying object is nil
 func (es *epochEconomicsStatistics) IsInterfaceNil() bool {
 	return es == nil
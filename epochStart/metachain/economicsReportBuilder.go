@@ -0,0 +1,183 @@
+package metachain
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+const (
+	rewardsCreatorVersion1 = uint32(1)
+	rewardsCreatorVersion2 = uint32(2)
+)
+
+// economicsReportBuilder gathers the rewards computed for each node while the rewards miniblocks are created and
+// aggregates them per shard and per reward address
+type economicsReportBuilder struct {
+	report    *economicsReport.EpochReport
+	shards    map[uint32]*economicsReport.ShardReport
+	addresses map[string]*economicsReport.RewardAddressReport
+}
+
+func newEconomicsReportBuilder(
+	metaBlock *block.MetaBlock,
+	computedEconomics *block.Economics,
+	rewardsCreatorVersion uint32,
+) *economicsReportBuilder {
+	return &economicsReportBuilder{
+		report: &economicsReport.EpochReport{
+			Epoch:                 metaBlock.Epoch,
+			MetaBlockRound:        metaBlock.Round,
+			MetaBlockNonce:        metaBlock.Nonce,
+			PrevEpochStartRound:   computedEconomics.PrevEpochStartRound,
+			RewardsCreatorVersion: rewardsCreatorVersion,
+			TotalSupply:           copyOrZero(computedEconomics.TotalSupply),
+			NewlyMinted:           copyOrZero(computedEconomics.TotalNewlyMinted),
+			AccumulatedFees:       copyOrZero(metaBlock.AccumulatedFeesInEpoch),
+			DeveloperFees:         copyOrZero(metaBlock.DevFeesInEpoch),
+			LeaderFees:            big.NewInt(0),
+			TotalToDistribute:     copyOrZero(computedEconomics.TotalToDistribute),
+			RewardsPerBlock:       copyOrZero(computedEconomics.RewardsPerBlock),
+			RewardsForBlocks:      big.NewInt(0),
+			BaseRewards:           big.NewInt(0),
+			TopUpRewards:          big.NewInt(0),
+			NodePrice:             copyOrZero(computedEconomics.NodePrice),
+		},
+		shards:    make(map[uint32]*economicsReport.ShardReport),
+		addresses: make(map[string]*economicsReport.RewardAddressReport),
+	}
+}
+
+func (erb *economicsReportBuilder) setBaseRewardsPerBlockPerNode(baseRewardsPerBlockPerNode map[uint32]*big.Int) {
+	for shardID, value := range baseRewardsPerBlockPerNode {
+		erb.getShard(shardID).BaseRewardsPerBlockPerNode = big.NewInt(0).Set(value)
+	}
+}
+
+func (erb *economicsReportBuilder) setNumBlocksPerShard(blocksPerShard map[uint32]uint64) {
+	for shardID, numBlocks := range blocksPerShard {
+		erb.getShard(shardID).NumBlocks = numBlocks
+		erb.report.NumBlocks += numBlocks
+	}
+}
+
+// addNode adds the rewards of a node. The rewards of the nodes that did not propose nor validate any block are only
+// reported, as they were not distributed
+func (erb *economicsReportBuilder) addNode(
+	valInfo *state.ValidatorInfo,
+	baseReward *big.Int,
+	topUpReward *big.Int,
+	topUpStake *big.Int,
+	distributed bool,
+) {
+	leaderFees := copyOrZero(valInfo.AccumulatedFees)
+	node := &economicsReport.NodeReport{
+		PublicKey:                  valInfo.PublicKey,
+		ShardID:                    valInfo.ShardId,
+		RewardAddress:              valInfo.RewardAddress,
+		NumSelectedInSuccessBlocks: valInfo.NumSelectedInSuccessBlocks,
+		LeaderSuccess:              valInfo.LeaderSuccess,
+		ValidatorSuccess:           valInfo.ValidatorSuccess,
+		TopUpStake:                 copyOrZero(topUpStake),
+		BaseReward:                 copyOrZero(baseReward),
+		TopUpReward:                copyOrZero(topUpReward),
+		LeaderFees:                 leaderFees,
+		Distributed:                distributed,
+	}
+	erb.report.Nodes = append(erb.report.Nodes, node)
+
+	shard := erb.getShard(node.ShardID)
+	shard.NumEligibleNodes++
+	if !distributed {
+		return
+	}
+
+	shard.BaseRewards.Add(shard.BaseRewards, node.BaseReward)
+	shard.TopUpRewards.Add(shard.TopUpRewards, node.TopUpReward)
+	shard.LeaderFees.Add(shard.LeaderFees, node.LeaderFees)
+
+	erb.report.BaseRewards.Add(erb.report.BaseRewards, node.BaseReward)
+	erb.report.TopUpRewards.Add(erb.report.TopUpRewards, node.TopUpReward)
+	erb.report.LeaderFees.Add(erb.report.LeaderFees, node.LeaderFees)
+
+	address, found := erb.addresses[string(node.RewardAddress)]
+	if !found {
+		address = &economicsReport.RewardAddressReport{
+			Address:      node.RewardAddress,
+			BaseRewards:  big.NewInt(0),
+			TopUpRewards: big.NewInt(0),
+			LeaderFees:   big.NewInt(0),
+			Total:        big.NewInt(0),
+		}
+		erb.addresses[string(node.RewardAddress)] = address
+	}
+	address.NumNodes++
+	address.BaseRewards.Add(address.BaseRewards, node.BaseReward)
+	address.TopUpRewards.Add(address.TopUpRewards, node.TopUpReward)
+	address.LeaderFees.Add(address.LeaderFees, node.LeaderFees)
+	address.Total.Add(address.Total, node.BaseReward)
+	address.Total.Add(address.Total, node.TopUpReward)
+	address.Total.Add(address.Total, node.LeaderFees)
+}
+
+func (erb *economicsReportBuilder) getShard(shardID uint32) *economicsReport.ShardReport {
+	shard, found := erb.shards[shardID]
+	if !found {
+		shard = &economicsReport.ShardReport{
+			ShardID:                    shardID,
+			BaseRewardsPerBlockPerNode: big.NewInt(0),
+			BaseRewards:                big.NewInt(0),
+			TopUpRewards:               big.NewInt(0),
+			LeaderFees:                 big.NewInt(0),
+		}
+		erb.shards[shardID] = shard
+	}
+
+	return shard
+}
+
+func (erb *economicsReportBuilder) build(
+	protocolSustainabilityAddress []byte,
+	protocolSustainabilityRewards *big.Int,
+) *economicsReport.EpochReport {
+	report := erb.report
+	report.ProtocolSustainabilityAddress = protocolSustainabilityAddress
+	report.ProtocolSustainabilityRewards = copyOrZero(protocolSustainabilityRewards)
+
+	report.Shards = make([]*economicsReport.ShardReport, 0, len(erb.shards))
+	for _, shard := range erb.shards {
+		report.Shards = append(report.Shards, shard)
+	}
+	sort.Slice(report.Shards, func(i, j int) bool {
+		return report.Shards[i].ShardID < report.Shards[j].ShardID
+	})
+
+	sort.Slice(report.Nodes, func(i, j int) bool {
+		if report.Nodes[i].ShardID != report.Nodes[j].ShardID {
+			return report.Nodes[i].ShardID < report.Nodes[j].ShardID
+		}
+		return bytes.Compare(report.Nodes[i].PublicKey, report.Nodes[j].PublicKey) < 0
+	})
+
+	report.RewardAddresses = make([]*economicsReport.RewardAddressReport, 0, len(erb.addresses))
+	for _, address := range erb.addresses {
+		report.RewardAddresses = append(report.RewardAddresses, address)
+	}
+	sort.Slice(report.RewardAddresses, func(i, j int) bool {
+		return bytes.Compare(report.RewardAddresses[i].Address, report.RewardAddresses[j].Address) < 0
+	})
+
+	return report
+}
+
+func copyOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
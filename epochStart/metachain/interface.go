@@ -0,0 +1,9 @@
+package metachain
+
+import "github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+
+// EconomicsReportSaver defines the component able to persist the economics and rewards breakdown of an epoch
+type EconomicsReportSaver interface {
+	SaveReport(report *economicsReport.EpochReport) error
+	IsInterfaceNil() bool
+}
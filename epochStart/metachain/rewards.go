@@ -7,7 +7,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/common/validatorInfo"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/state"
 )
@@ -90,9 +92,39 @@ func (rc *rewardsCreator) CreateRewardsMiniBlocks(
 		return nil, err
 	}
 
+	rc.economicsReport = rc.createEconomicsReport(metaBlock, validatorsInfo, &economicsData, protSustRwdTx.Value)
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
+func (rc *rewardsCreator) createEconomicsReport(
+	metaBlock *block.MetaBlock,
+	validatorsInfo map[uint32][]*state.ValidatorInfo,
+	economicsData *block.Economics,
+	protocolSustainabilityRewards *big.Int,
+) *economicsReport.EpochReport {
+	builder := newEconomicsReportBuilder(metaBlock, economicsData, rewardsCreatorVersion1)
+	builder.setBaseRewardsPerBlockPerNode(rc.mapBaseRewardsPerBlockPerValidator)
+
+	for _, shardValidatorsInfo := range validatorsInfo {
+		for _, valInfo := range shardValidatorsInfo {
+			if !validatorInfo.WasEligibleInCurrentEpoch(valInfo) {
+				continue
+			}
+
+			baseReward := big.NewInt(0).Mul(
+				rc.mapBaseRewardsPerBlockPerValidator[valInfo.ShardId],
+				big.NewInt(0).SetUint64(uint64(valInfo.NumSelectedInSuccessBlocks)))
+			builder.addNode(valInfo, baseReward, big.NewInt(0), big.NewInt(0), rc.isNodeRewarded(valInfo, metaBlock.Epoch))
+		}
+	}
+
+	report := builder.build(rc.protocolSustainabilityAddress, protocolSustainabilityRewards)
+	report.RewardsForBlocks.Set(report.BaseRewards)
+
+	return report
+}
+
 func (rc *rewardsCreator) adjustProtocolSustainabilityRewards(protocolSustainabilityRwdTx *rewardTx.RewardTx, dustRewards *big.Int) {
 	if protocolSustainabilityRwdTx.Value.Cmp(big.NewInt(0)) < 0 {
 		log.Error("negative rewards protocol sustainability")
@@ -172,12 +204,7 @@ func (rc *rewardsCreator) computeValidatorInfoPerRewardAddress(
 			rewardsPerBlockPerNodeForShard := rc.mapBaseRewardsPerBlockPerValidator[validatorInfo.ShardId]
 			protocolRewardValue := big.NewInt(0).Mul(rewardsPerBlockPerNodeForShard, big.NewInt(0).SetUint64(uint64(validatorInfo.NumSelectedInSuccessBlocks)))
 
-			isFix1Enabled := rc.isRewardsFix1Enabled(epoch)
-			if isFix1Enabled && validatorInfo.LeaderSuccess == 0 && validatorInfo.ValidatorSuccess == 0 {
-				protocolSustainabilityRwd.Value.Add(protocolSustainabilityRwd.Value, protocolRewardValue)
-				continue
-			}
-			if !isFix1Enabled && validatorInfo.LeaderSuccess == 0 && validatorInfo.ValidatorFailure == 0 {
+			if !rc.isNodeRewarded(validatorInfo, epoch) {
 				protocolSustainabilityRwd.Value.Add(protocolSustainabilityRwd.Value, protocolRewardValue)
 				continue
 			}
@@ -223,6 +250,14 @@ func (rc *rewardsCreator) IsInterfaceNil() bool {
 	return rc == nil
 }
 
+func (rc *rewardsCreator) isNodeRewarded(valInfo *state.ValidatorInfo, epoch uint32) bool {
+	if rc.isRewardsFix1Enabled(epoch) {
+		return valInfo.LeaderSuccess != 0 || valInfo.ValidatorSuccess != 0
+	}
+
+	return valInfo.LeaderSuccess != 0 || valInfo.ValidatorFailure != 0
+}
+
 func (rc *rewardsCreator) isRewardsFix1Enabled(epoch uint32) bool {
 	return epoch > rc.rewardsFix1EnableEpoch
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/common/validatorInfo"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -122,9 +123,35 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 		return nil, err
 	}
 
+	rc.economicsReport = rc.createEconomicsReport(metaBlock, computedEconomics, nodesRewardInfo, protRwdTx.Value)
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
+func (rc *rewardsCreatorV2) createEconomicsReport(
+	metaBlock *block.MetaBlock,
+	computedEconomics *block.Economics,
+	nodesRewardInfo map[uint32][]*nodeRewardsData,
+	protocolSustainabilityRewards *big.Int,
+) *economicsReport.EpochReport {
+	builder := newEconomicsReportBuilder(metaBlock, computedEconomics, rewardsCreatorVersion2)
+	builder.setBaseRewardsPerBlockPerNode(rc.mapBaseRewardsPerBlockPerValidator)
+	builder.setNumBlocksPerShard(rc.economicsDataProvider.NumberOfBlocksPerShard())
+
+	for _, nodeInfoList := range nodesRewardInfo {
+		for _, nodeInfo := range nodeInfoList {
+			isRewarded := nodeInfo.valInfo.LeaderSuccess != 0 || nodeInfo.valInfo.ValidatorSuccess != 0
+			builder.addNode(nodeInfo.valInfo, nodeInfo.baseReward, nodeInfo.topUpReward, nodeInfo.topUpStake, isRewarded)
+		}
+	}
+
+	report := builder.build(rc.protocolSustainabilityAddress, protocolSustainabilityRewards)
+	report.InflationRate = rc.economicsDataProvider.InflationRate()
+	report.RewardsForBlocks = rc.economicsDataProvider.RewardsToBeDistributedForBlocks()
+
+	return report
+}
+
 func (rc *rewardsCreatorV2) adjustProtocolSustainabilityRewards(protocolSustainabilityRwdTx *rewardTx.RewardTx, dustRewards *big.Int) {
 	if protocolSustainabilityRwdTx.Value.Cmp(big.NewInt(0)) < 0 {
 		log.Error("negative rewards protocol sustainability")
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -1670,6 +1671,92 @@ func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestNewRewardsCreatorV2_CreateRewardsMiniBlocksShouldSaveEconomicsReport(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	nbEligiblePerShard := uint32(400)
+	dummyRwd, _ := NewRewardsCreatorV2(args)
+	vInfo := createDefaultValidatorInfo(nbEligiblePerShard, args.ShardCoordinator, args.NodesConfigProvider, 100, defaultBlocksPerShard)
+	nodesRewardInfo := dummyRwd.initNodesRewardsInfo(vInfo)
+	_, _ = setDummyValuesInNodesRewardInfo(nodesRewardInfo, nbEligiblePerShard, tuStake, 0)
+
+	args.StakingDataProvider = &mock.StakingDataProviderStub{
+		GetTotalTopUpStakeEligibleNodesCalled: func() *big.Int {
+			totalTopUpStake, _ := big.NewInt(0).SetString("3000000000000000000000000", 10)
+			return totalTopUpStake
+		},
+		GetNodeStakedTopUpCalled: func(blsKey []byte) (*big.Int, error) {
+			for shardID, vList := range vInfo {
+				for i, v := range vList {
+					if bytes.Equal(v.PublicKey, blsKey) {
+						return nodesRewardInfo[shardID][i].topUpStake, nil
+					}
+				}
+			}
+			return nil, fmt.Errorf("not found")
+		},
+	}
+	blocksPerShard := make(map[uint32]uint64)
+	for shardID := range createShardsMap(args.ShardCoordinator) {
+		blocksPerShard[shardID] = 14400
+	}
+	args.EconomicsDataProvider.SetNumberOfBlocksPerShard(blocksPerShard)
+	rewardsForBlocks, _ := big.NewInt(0).SetString("5000000000000000000000", 10)
+	args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(rewardsForBlocks)
+	args.EconomicsDataProvider.SetInflationRate(0.1)
+
+	var savedReport *economicsReport.EpochReport
+	args.EconomicsReportSaver = &mock.EconomicsReportSaverStub{
+		SaveReportCalled: func(report *economicsReport.EpochReport) error {
+			savedReport = report
+			return nil
+		},
+	}
+
+	rwd, err := NewRewardsCreatorV2(args)
+	require.Nil(t, err)
+
+	metaBlock := &block.MetaBlock{
+		Epoch:          3,
+		Round:          1000,
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(0),
+	}
+	miniBlocks, err := rwd.CreateRewardsMiniBlocks(metaBlock, vInfo, &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	sumRewards := big.NewInt(0)
+	for _, mb := range miniBlocks {
+		for _, txHash := range mb.TxHashes {
+			tx, errGet := rwd.currTxs.GetTx(txHash)
+			require.Nil(t, errGet)
+			sumRewards.Add(sumRewards, tx.GetValue())
+		}
+	}
+
+	rwd.SaveTxBlockToStorage(&block.MetaBlock{Epoch: 3, Round: 1001}, &block.Body{})
+	require.Nil(t, savedReport)
+
+	rwd.SaveTxBlockToStorage(metaBlock, &block.Body{})
+	require.NotNil(t, savedReport)
+
+	require.Equal(t, uint32(3), savedReport.Epoch)
+	require.Equal(t, uint64(1000), savedReport.MetaBlockRound)
+	require.Equal(t, rewardsCreatorVersion2, savedReport.RewardsCreatorVersion)
+	require.Equal(t, 0.1, savedReport.InflationRate)
+	require.Equal(t, rewardsForBlocks, savedReport.RewardsForBlocks)
+	require.Equal(t, uint64(14400)*uint64(len(blocksPerShard)), savedReport.NumBlocks)
+	require.Equal(t, len(blocksPerShard), len(savedReport.Shards))
+	require.Equal(t, int(nbEligiblePerShard)*len(blocksPerShard), len(savedReport.Nodes))
+
+	distributed := big.NewInt(0).Set(savedReport.ProtocolSustainabilityRewards)
+	for _, address := range savedReport.RewardAddresses {
+		distributed.Add(distributed, address.Total)
+	}
+	require.Equal(t, sumRewards, distributed)
+}
+
 func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks2169Nodes(t *testing.T) {
 	t.Parallel()
 
@@ -9,7 +9,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -583,6 +585,71 @@ func TestRewardsCreator_SaveTxBlockToStorage(t *testing.T) {
 	assert.True(t, putMbWasCalled)
 }
 
+func TestRewardsCreator_SaveTxBlockToStorageShouldSaveEconomicsReport(t *testing.T) {
+	t.Parallel()
+
+	var savedReport *economicsReport.EpochReport
+	args := getRewardsArguments()
+	args.EconomicsReportSaver = &mock.EconomicsReportSaverStub{
+		SaveReportCalled: func(report *economicsReport.EpochReport) error {
+			savedReport = report
+			return nil
+		},
+	}
+	rwd, _ := NewRewardsCreator(args)
+
+	mb := &block.MetaBlock{
+		Epoch:          2,
+		Round:          100,
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(0),
+	}
+	valInfo := make(map[uint32][]*state.ValidatorInfo)
+	valInfo[0] = []*state.ValidatorInfo{
+		{
+			PublicKey:                  []byte("pubkey1"),
+			RewardAddress:              []byte("address"),
+			ShardId:                    0,
+			List:                       string(common.EligibleList),
+			AccumulatedFees:            big.NewInt(100),
+			LeaderSuccess:              1,
+			NumSelectedInSuccessBlocks: 2,
+		},
+		{
+			PublicKey:                  []byte("pubkey2"),
+			RewardAddress:              []byte("address"),
+			ShardId:                    0,
+			List:                       string(common.EligibleList),
+			AccumulatedFees:            big.NewInt(0),
+			NumSelectedInSuccessBlocks: 1,
+		},
+		{
+			PublicKey:       []byte("pubkey3"),
+			ShardId:         0,
+			List:            string(common.WaitingList),
+			AccumulatedFees: big.NewInt(0),
+		},
+	}
+	_, err := rwd.CreateRewardsMiniBlocks(mb, valInfo, &mb.EpochStart.Economics)
+	require.Nil(t, err)
+
+	rwd.SaveTxBlockToStorage(mb, &block.Body{})
+	require.NotNil(t, savedReport)
+
+	baseRewardPerBlock := rwd.mapBaseRewardsPerBlockPerValidator[0]
+	assert.Equal(t, uint32(2), savedReport.Epoch)
+	assert.Equal(t, rewardsCreatorVersion1, savedReport.RewardsCreatorVersion)
+	require.Equal(t, 2, len(savedReport.Nodes))
+	assert.True(t, savedReport.Nodes[0].Distributed)
+	assert.Equal(t, big.NewInt(0).Mul(baseRewardPerBlock, big.NewInt(2)), savedReport.Nodes[0].BaseReward)
+	assert.False(t, savedReport.Nodes[1].Distributed)
+	require.Equal(t, 1, len(savedReport.RewardAddresses))
+	assert.Equal(t, uint32(1), savedReport.RewardAddresses[0].NumNodes)
+	expectedTotal := big.NewInt(0).Add(savedReport.Nodes[0].BaseReward, big.NewInt(100))
+	assert.Equal(t, expectedTotal, savedReport.RewardAddresses[0].Total)
+	assert.Equal(t, rwd.GetProtocolSustainabilityRewards(), savedReport.ProtocolSustainabilityRewards)
+}
+
 func TestRewardsCreator_addValidatorRewardsToMiniBlocks(t *testing.T) {
 	t.Parallel()
 
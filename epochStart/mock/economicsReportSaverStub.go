@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+
+// EconomicsReportSaverStub -
+type EconomicsReportSaverStub struct {
+	SaveReportCalled func(report *economicsReport.EpochReport) error
+}
+
+// SaveReport -
+func (ers *EconomicsReportSaverStub) SaveReport(report *economicsReport.EpochReport) error {
+	if ers.SaveReportCalled != nil {
+		return ers.SaveReportCalled(report)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ers *EconomicsReportSaverStub) IsInterfaceNil() bool {
+	return ers == nil
+}
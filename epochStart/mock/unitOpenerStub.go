@@ -1,13 +1,31 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/storage"
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
 
 // UnitOpenerStub -
 type UnitOpenerStub struct {
+	GetMostRecentBootstrapStorageUnitCalled func() (storage.Storer, error)
+	OpenDBCalled                            func(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Storer, error)
 }
 
 // GetMostRecentBootstrapStorageUnit -
 func (u *UnitOpenerStub) GetMostRecentBootstrapStorageUnit() (storage.Storer, error) {
+	if u.GetMostRecentBootstrapStorageUnitCalled != nil {
+		return u.GetMostRecentBootstrapStorageUnitCalled()
+	}
+
+	return &StorerMock{}, nil
+}
+
+// OpenDB -
+func (u *UnitOpenerStub) OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Storer, error) {
+	if u.OpenDBCalled != nil {
+		return u.OpenDBCalled(dbConfig, shardID, epoch)
+	}
+
 	return &StorerMock{}, nil
 }
 
@@ -0,0 +1,278 @@
+package rewardsSimulator
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/headersCache"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	cacheSize    = 100
+	cacheShards  = 1
+	cacheBytes   = 1024 * 1024
+	headersSize  = 10
+	headersEvict = 1
+)
+
+var _ epochStart.StakingDataProvider = (*recordedStakingData)(nil)
+
+// recordedStakingData replays the top-up of the nodes as it was recorded in the economics report of an epoch
+type recordedStakingData struct {
+	nodePrice  *big.Int
+	numNodes   int64
+	totalTopUp *big.Int
+	topUps     map[string]*big.Int
+}
+
+func newRecordedStakingData(report *economicsReport.EpochReport) *recordedStakingData {
+	rsd := &recordedStakingData{
+		nodePrice:  big.NewInt(0).Set(report.NodePrice),
+		numNodes:   int64(len(report.Nodes)),
+		totalTopUp: big.NewInt(0),
+		topUps:     make(map[string]*big.Int, len(report.Nodes)),
+	}
+	for _, node := range report.Nodes {
+		topUp := big.NewInt(0)
+		if node.TopUpStake != nil {
+			topUp.Set(node.TopUpStake)
+		}
+
+		rsd.topUps[string(node.PublicKey)] = topUp
+		rsd.totalTopUp.Add(rsd.totalTopUp, topUp)
+	}
+
+	return rsd
+}
+
+// GetTotalStakeEligibleNodes returns the node price of all the eligible nodes plus their top-up
+func (rsd *recordedStakingData) GetTotalStakeEligibleNodes() *big.Int {
+	totalStake := big.NewInt(0).Mul(rsd.nodePrice, big.NewInt(rsd.numNodes))
+
+	return totalStake.Add(totalStake, rsd.totalTopUp)
+}
+
+// GetTotalTopUpStakeEligibleNodes returns the recorded top-up of all the eligible nodes
+func (rsd *recordedStakingData) GetTotalTopUpStakeEligibleNodes() *big.Int {
+	return big.NewInt(0).Set(rsd.totalTopUp)
+}
+
+// GetNodeStakedTopUp returns the recorded top-up of the provided node
+func (rsd *recordedStakingData) GetNodeStakedTopUp(blsKey []byte) (*big.Int, error) {
+	topUp, found := rsd.topUps[string(blsKey)]
+	if !found {
+		return nil, epochStart.ErrOwnerDoesntHaveEligibleNodesInEpoch
+	}
+
+	return big.NewInt(0).Set(topUp), nil
+}
+
+// PrepareStakingDataForRewards does nothing as the staking data was already recorded
+func (rsd *recordedStakingData) PrepareStakingDataForRewards(_ map[uint32][][]byte) error {
+	return nil
+}
+
+// FillValidatorInfo does nothing as the staking data was already recorded
+func (rsd *recordedStakingData) FillValidatorInfo(_ []byte) error {
+	return nil
+}
+
+// ComputeUnQualifiedNodes returns no nodes as the simulation does not change the nodes configuration
+func (rsd *recordedStakingData) ComputeUnQualifiedNodes(_ map[uint32][]*state.ValidatorInfo) ([][]byte, map[string][][]byte, error) {
+	return make([][]byte, 0), make(map[string][][]byte), nil
+}
+
+// Clean does nothing
+func (rsd *recordedStakingData) Clean() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rsd *recordedStakingData) IsInterfaceNil() bool {
+	return rsd == nil
+}
+
+// consensusGroupSizes provides the consensus group sizes used when the base rewards per block per node are computed
+type consensusGroupSizes struct {
+	shardConsensusGroupSize int
+	metaConsensusGroupSize  int
+}
+
+// ConsensusGroupSize returns the consensus group size of the provided shard
+func (cgs *consensusGroupSizes) ConsensusGroupSize(shardID uint32) int {
+	if shardID == core.MetachainShardId {
+		return cgs.metaConsensusGroupSize
+	}
+
+	return cgs.shardConsensusGroupSize
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cgs *consensusGroupSizes) IsInterfaceNil() bool {
+	return cgs == nil
+}
+
+// roundDuration provides the configured round duration
+type roundDuration struct {
+	duration time.Duration
+}
+
+// TimeDuration returns the round duration
+func (rd *roundDuration) TimeDuration() time.Duration {
+	return rd.duration
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rd *roundDuration) IsInterfaceNil() bool {
+	return rd == nil
+}
+
+// delegationAccounts considers that every account it is asked about is a delegation system smart contract. The
+// rewards creator only asks about the reward addresses located in the metachain and, as the simulation has no access
+// to the accounts state, those are considered to be delegation contracts
+type delegationAccounts struct {
+	state.AccountsAdapter
+}
+
+// GetExistingAccount returns an account marked as a delegation system smart contract
+func (da *delegationAccounts) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = account.DataTrieTracker().SaveKeyValue([]byte(core.DelegationSystemSCKey), []byte{1})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (da *delegationAccounts) IsInterfaceNil() bool {
+	return da == nil
+}
+
+// disabledBuiltInFunctionsCost is used by the economics data as the simulation does not compute any transaction fee
+type disabledBuiltInFunctionsCost struct {
+}
+
+// ComputeBuiltInCost returns 0
+func (dbc *disabledBuiltInFunctionsCost) ComputeBuiltInCost(_ data.TransactionWithFeeHandler) uint64 {
+	return 0
+}
+
+// IsBuiltInFuncCall returns false
+func (dbc *disabledBuiltInFunctionsCost) IsBuiltInFuncCall(_ data.TransactionWithFeeHandler) bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dbc *disabledBuiltInFunctionsCost) IsInterfaceNil() bool {
+	return dbc == nil
+}
+
+// reportCollector keeps the economics report produced by the rewards creator instead of persisting it
+type reportCollector struct {
+	mutReport sync.Mutex
+	report    *economicsReport.EpochReport
+}
+
+// SaveReport keeps the provided report
+func (rc *reportCollector) SaveReport(report *economicsReport.EpochReport) error {
+	rc.mutReport.Lock()
+	rc.report = report
+	rc.mutReport.Unlock()
+
+	return nil
+}
+
+func (rc *reportCollector) getReport() *economicsReport.EpochReport {
+	rc.mutReport.Lock()
+	defer rc.mutReport.Unlock()
+
+	return rc.report
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *reportCollector) IsInterfaceNil() bool {
+	return rc == nil
+}
+
+func createMemoryStorer() (storage.Storer, error) {
+	cache, err := lrucache.NewCache(cacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageUnit.NewStorageUnit(cache, memorydb.New())
+}
+
+func createDataPool() (dataRetriever.PoolsHolder, error) {
+	cacheConfig := storageUnit.CacheConfig{
+		Type:        storageUnit.LRUCache,
+		Capacity:    cacheSize,
+		SizeInBytes: cacheBytes,
+		Shards:      cacheShards,
+	}
+	transactions, err := shardedData.NewShardedData("simulatedTxs", cacheConfig)
+	if err != nil {
+		return nil, err
+	}
+	unsignedTransactions, err := shardedData.NewShardedData("simulatedUnsignedTxs", cacheConfig)
+	if err != nil {
+		return nil, err
+	}
+	rewardTransactions, err := shardedData.NewShardedData("simulatedRewardTxs", cacheConfig)
+	if err != nil {
+		return nil, err
+	}
+	headers, err := headersCache.NewHeadersPool(config.HeadersPoolConfig{
+		MaxHeadersPerShard:            headersSize,
+		NumElementsToRemoveOnEviction: headersEvict,
+	})
+	if err != nil {
+		return nil, err
+	}
+	currentBlockTransactions, err := dataPool.NewCurrentBlockPool()
+	if err != nil {
+		return nil, err
+	}
+
+	cachers := make([]storage.Cacher, 0, 5)
+	for i := 0; i < 5; i++ {
+		cacher, errCreate := lrucache.NewCache(cacheSize)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+		cachers = append(cachers, cacher)
+	}
+
+	return dataPool.NewDataPool(dataPool.DataPoolArgs{
+		Transactions:             transactions,
+		UnsignedTransactions:     unsignedTransactions,
+		RewardTransactions:       rewardTransactions,
+		Headers:                  headers,
+		MiniBlocks:               cachers[0],
+		PeerChangesBlocks:        cachers[1],
+		TrieNodes:                cachers[2],
+		TrieNodesChunks:          cachers[3],
+		CurrentBlockTransactions: currentBlockTransactions,
+		SmartContracts:           cachers[4],
+	})
+}
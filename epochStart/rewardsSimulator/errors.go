@@ -0,0 +1,27 @@
+package rewardsSimulator
+
+import "errors"
+
+// ErrNilEconomicsConfig signals that a nil economics config has been provided
+var ErrNilEconomicsConfig = errors.New("nil economics config")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrInvalidRoundDuration signals that an invalid round duration has been provided
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrInvalidConsensusGroupSize signals that an invalid consensus group size has been provided
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size")
+
+// ErrNilReport signals that a nil economics report has been provided
+var ErrNilReport = errors.New("nil economics report")
+
+// ErrUnsupportedRewardsCreatorVersion signals that the provided report was not computed by the staking v2 rewards creator
+var ErrUnsupportedRewardsCreatorVersion = errors.New("unsupported rewards creator version")
+
+// ErrInvalidEpochReport signals that the provided report does not hold enough data to replay its epoch
+var ErrInvalidEpochReport = errors.New("invalid epoch report")
+
+// ErrNoReportCreated signals that the rewards creator did not produce any economics report
+var ErrNoReportCreated = errors.New("no economics report created")
@@ -0,0 +1,12 @@
+package rewardsSimulator
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type economicsHandler interface {
+	process.RewardsHandler
+	GenesisTotalSupply() *big.Int
+}
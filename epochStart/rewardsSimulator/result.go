@@ -0,0 +1,173 @@
+package rewardsSimulator
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+)
+
+// ValueDelta holds a value as it actually was, the simulated one and the difference between them
+type ValueDelta struct {
+	Actual    *big.Int `json:"actual"`
+	Simulated *big.Int `json:"simulated"`
+	Delta     *big.Int `json:"delta"`
+}
+
+// EpochDelta holds the differences between the actual and the simulated economics of an epoch
+type EpochDelta struct {
+	ActualInflationRate           float64     `json:"actualInflationRate"`
+	SimulatedInflationRate        float64     `json:"simulatedInflationRate"`
+	NewlyMinted                   *ValueDelta `json:"newlyMinted"`
+	TotalToDistribute             *ValueDelta `json:"totalToDistribute"`
+	RewardsPerBlock               *ValueDelta `json:"rewardsPerBlock"`
+	BaseRewards                   *ValueDelta `json:"baseRewards"`
+	TopUpRewards                  *ValueDelta `json:"topUpRewards"`
+	LeaderFees                    *ValueDelta `json:"leaderFees"`
+	ProtocolSustainabilityRewards *ValueDelta `json:"protocolSustainabilityRewards"`
+}
+
+// ShardDelta holds the differences between the actual and the simulated rewards of a shard
+type ShardDelta struct {
+	ShardID      uint32      `json:"shardID"`
+	BaseRewards  *ValueDelta `json:"baseRewards"`
+	TopUpRewards *ValueDelta `json:"topUpRewards"`
+	LeaderFees   *ValueDelta `json:"leaderFees"`
+	Total        *ValueDelta `json:"total"`
+}
+
+// NodeDelta holds the differences between the actual and the simulated rewards of a node. The total of a node is zero
+// when its rewards were not distributed
+type NodeDelta struct {
+	PublicKey     []byte      `json:"publicKey"`
+	ShardID       uint32      `json:"shardID"`
+	RewardAddress []byte      `json:"rewardAddress"`
+	BaseReward    *ValueDelta `json:"baseReward"`
+	TopUpReward   *ValueDelta `json:"topUpReward"`
+	LeaderFees    *ValueDelta `json:"leaderFees"`
+	Total         *ValueDelta `json:"total"`
+}
+
+// SimulationResult holds the actual and the simulated economics reports of an epoch together with the per-shard and
+// per-node differences between them
+type SimulationResult struct {
+	Epoch     uint32                       `json:"epoch"`
+	Totals    *EpochDelta                  `json:"totals"`
+	Shards    []*ShardDelta                `json:"shards"`
+	Nodes     []*NodeDelta                 `json:"nodes"`
+	Actual    *economicsReport.EpochReport `json:"actual"`
+	Simulated *economicsReport.EpochReport `json:"simulated"`
+}
+
+func newValueDelta(actual *big.Int, simulated *big.Int) *ValueDelta {
+	actualValue := valueOrZero(actual)
+	simulatedValue := valueOrZero(simulated)
+
+	return &ValueDelta{
+		Actual:    actualValue,
+		Simulated: simulatedValue,
+		Delta:     big.NewInt(0).Sub(simulatedValue, actualValue),
+	}
+}
+
+func newSimulationResult(actual *economicsReport.EpochReport, simulated *economicsReport.EpochReport) *SimulationResult {
+	return &SimulationResult{
+		Epoch: actual.Epoch,
+		Totals: &EpochDelta{
+			ActualInflationRate:           actual.InflationRate,
+			SimulatedInflationRate:        simulated.InflationRate,
+			NewlyMinted:                   newValueDelta(actual.NewlyMinted, simulated.NewlyMinted),
+			TotalToDistribute:             newValueDelta(actual.TotalToDistribute, simulated.TotalToDistribute),
+			RewardsPerBlock:               newValueDelta(actual.RewardsPerBlock, simulated.RewardsPerBlock),
+			BaseRewards:                   newValueDelta(actual.BaseRewards, simulated.BaseRewards),
+			TopUpRewards:                  newValueDelta(actual.TopUpRewards, simulated.TopUpRewards),
+			LeaderFees:                    newValueDelta(actual.LeaderFees, simulated.LeaderFees),
+			ProtocolSustainabilityRewards: newValueDelta(actual.ProtocolSustainabilityRewards, simulated.ProtocolSustainabilityRewards),
+		},
+		Shards:    computeShardDeltas(actual.Shards, simulated.Shards),
+		Nodes:     computeNodeDeltas(actual.Nodes, simulated.Nodes),
+		Actual:    actual,
+		Simulated: simulated,
+	}
+}
+
+func computeShardDeltas(actual []*economicsReport.ShardReport, simulated []*economicsReport.ShardReport) []*ShardDelta {
+	simulatedShards := make(map[uint32]*economicsReport.ShardReport, len(simulated))
+	for _, shard := range simulated {
+		simulatedShards[shard.ShardID] = shard
+	}
+
+	deltas := make([]*ShardDelta, 0, len(actual))
+	for _, actualShard := range actual {
+		simulatedShard, found := simulatedShards[actualShard.ShardID]
+		if !found {
+			simulatedShard = &economicsReport.ShardReport{ShardID: actualShard.ShardID}
+		}
+
+		deltas = append(deltas, &ShardDelta{
+			ShardID:      actualShard.ShardID,
+			BaseRewards:  newValueDelta(actualShard.BaseRewards, simulatedShard.BaseRewards),
+			TopUpRewards: newValueDelta(actualShard.TopUpRewards, simulatedShard.TopUpRewards),
+			LeaderFees:   newValueDelta(actualShard.LeaderFees, simulatedShard.LeaderFees),
+			Total:        newValueDelta(shardTotal(actualShard), shardTotal(simulatedShard)),
+		})
+	}
+
+	return deltas
+}
+
+func computeNodeDeltas(actual []*economicsReport.NodeReport, simulated []*economicsReport.NodeReport) []*NodeDelta {
+	simulatedNodes := make(map[string]*economicsReport.NodeReport, len(simulated))
+	for _, node := range simulated {
+		simulatedNodes[string(node.PublicKey)] = node
+	}
+
+	deltas := make([]*NodeDelta, 0, len(actual))
+	for _, actualNode := range actual {
+		simulatedNode, found := simulatedNodes[string(actualNode.PublicKey)]
+		if !found {
+			simulatedNode = &economicsReport.NodeReport{PublicKey: actualNode.PublicKey}
+		}
+
+		deltas = append(deltas, &NodeDelta{
+			PublicKey:     actualNode.PublicKey,
+			ShardID:       actualNode.ShardID,
+			RewardAddress: actualNode.RewardAddress,
+			BaseReward:    newValueDelta(actualNode.BaseReward, simulatedNode.BaseReward),
+			TopUpReward:   newValueDelta(actualNode.TopUpReward, simulatedNode.TopUpReward),
+			LeaderFees:    newValueDelta(actualNode.LeaderFees, simulatedNode.LeaderFees),
+			Total:         newValueDelta(nodeTotal(actualNode), nodeTotal(simulatedNode)),
+		})
+	}
+
+	return deltas
+}
+
+func shardTotal(shard *economicsReport.ShardReport) *big.Int {
+	total := big.NewInt(0)
+	total.Add(total, valueOrZero(shard.BaseRewards))
+	total.Add(total, valueOrZero(shard.TopUpRewards))
+	total.Add(total, valueOrZero(shard.LeaderFees))
+
+	return total
+}
+
+func nodeTotal(node *economicsReport.NodeReport) *big.Int {
+	total := big.NewInt(0)
+	if !node.Distributed {
+		return total
+	}
+
+	total.Add(total, valueOrZero(node.BaseReward))
+	total.Add(total, valueOrZero(node.TopUpReward))
+	total.Add(total, valueOrZero(node.LeaderFees))
+
+	return total
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
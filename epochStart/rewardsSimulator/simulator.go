@@ -0,0 +1,347 @@
+package rewardsSimulator
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var log = logger.GetOrCreate("epochStart/rewardsSimulator")
+
+const rewardsCreatorVersion2 = uint32(2)
+
+// ArgsRewardsSimulator holds the arguments needed to create a rewards simulator
+type ArgsRewardsSimulator struct {
+	Economics               *config.EconomicsConfig
+	AddressPubkeyConverter  core.PubkeyConverter
+	RoundDuration           time.Duration
+	ShardConsensusGroupSize uint32
+	MetaConsensusGroupSize  uint32
+}
+
+type rewardsSimulator struct {
+	economicsConfig        *config.EconomicsConfig
+	addressPubkeyConverter core.PubkeyConverter
+	roundDuration          time.Duration
+	consensusGroupSizes    *consensusGroupSizes
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+}
+
+// NewRewardsSimulator creates a component able to replay the end of epoch economics and the staking v2 rewards of a
+// historical epoch with alternative economics parameters
+func NewRewardsSimulator(args ArgsRewardsSimulator) (*rewardsSimulator, error) {
+	if args.Economics == nil {
+		return nil, ErrNilEconomicsConfig
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.RoundDuration < time.Second {
+		return nil, ErrInvalidRoundDuration
+	}
+	if args.ShardConsensusGroupSize == 0 || args.MetaConsensusGroupSize == 0 {
+		return nil, ErrInvalidConsensusGroupSize
+	}
+
+	return &rewardsSimulator{
+		economicsConfig:        args.Economics,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		roundDuration:          args.RoundDuration,
+		consensusGroupSizes: &consensusGroupSizes{
+			shardConsensusGroupSize: int(args.ShardConsensusGroupSize),
+			metaConsensusGroupSize:  int(args.MetaConsensusGroupSize),
+		},
+		marshalizer: &marshal.GogoProtoMarshalizer{},
+		hasher:      blake2b.NewBlake2b(),
+	}, nil
+}
+
+// Simulate recomputes the economics and the rewards of the epoch described by the provided report using the
+// alternative economics parameters and returns the differences from what actually happened. The validators, their
+// top-up, the produced blocks and the fees of the epoch are the ones recorded in the report
+func (rs *rewardsSimulator) Simulate(actual *economicsReport.EpochReport) (*SimulationResult, error) {
+	err := checkReport(actual)
+	if err != nil {
+		return nil, err
+	}
+
+	numShards := computeNumShards(actual)
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(numShards, core.MetachainShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsHandler, err := rs.createRewardsHandler(actual.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	prevEpochStart, epochStartMetaBlock := rs.createEpochStartMetaBlocks(actual, numShards)
+	store, err := rs.createStore(prevEpochStart)
+	if err != nil {
+		return nil, err
+	}
+
+	economicsStatistics := metachain.NewEpochEconomicsStatistics()
+	endOfEpochEconomics, err := metachain.NewEndOfEpochEconomicsDataCreator(metachain.ArgsNewEpochEconomics{
+		Marshalizer:           rs.marshalizer,
+		Hasher:                rs.hasher,
+		Store:                 store,
+		ShardCoordinator:      shardCoordinator,
+		RewardsHandler:        rewardsHandler,
+		RoundTime:             &roundDuration{duration: rs.roundDuration},
+		GenesisTotalSupply:    rewardsHandler.GenesisTotalSupply(),
+		EconomicsDataNotified: economicsStatistics,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	computedEconomics, err := endOfEpochEconomics.ComputeEndOfEpochEconomics(epochStartMetaBlock)
+	if err != nil {
+		return nil, err
+	}
+	epochStartMetaBlock.EpochStart.Economics = *computedEconomics
+
+	simulated, err := rs.computeRewards(actual, epochStartMetaBlock, computedEconomics, shardCoordinator, rewardsHandler, economicsStatistics)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("simulated epoch rewards",
+		"epoch", actual.Epoch,
+		"actual inflation rate", actual.InflationRate,
+		"simulated inflation rate", simulated.InflationRate,
+		"actual total to distribute", actual.TotalToDistribute,
+		"simulated total to distribute", simulated.TotalToDistribute,
+	)
+
+	return newSimulationResult(actual, simulated), nil
+}
+
+func (rs *rewardsSimulator) computeRewards(
+	actual *economicsReport.EpochReport,
+	epochStartMetaBlock *block.MetaBlock,
+	computedEconomics *block.Economics,
+	shardCoordinator sharding.Coordinator,
+	rewardsHandler process.RewardsHandler,
+	economicsStatistics epochStart.EpochEconomicsDataProvider,
+) (*economicsReport.EpochReport, error) {
+	rewardsStorer, err := createMemoryStorer()
+	if err != nil {
+		return nil, err
+	}
+	miniBlocksStorer, err := createMemoryStorer()
+	if err != nil {
+		return nil, err
+	}
+	dataPool, err := createDataPool()
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &reportCollector{}
+	rewardsCreator, err := metachain.NewRewardsCreatorV2(metachain.RewardsCreatorArgsV2{
+		BaseRewardsCreatorArgs: metachain.BaseRewardsCreatorArgs{
+			ShardCoordinator:              shardCoordinator,
+			PubkeyConverter:               rs.addressPubkeyConverter,
+			RewardsStorage:                rewardsStorer,
+			MiniBlockStorage:              miniBlocksStorer,
+			Hasher:                        rs.hasher,
+			Marshalizer:                   rs.marshalizer,
+			DataPool:                      dataPool,
+			ProtocolSustainabilityAddress: rewardsHandler.ProtocolSustainabilityAddress(),
+			NodesConfigProvider:           rs.consensusGroupSizes,
+			UserAccountsDB:                &delegationAccounts{AccountsAdapter: disabled.NewAccountsAdapter()},
+			EconomicsReportSaver:          collector,
+		},
+		StakingDataProvider:   newRecordedStakingData(actual),
+		EconomicsDataProvider: economicsStatistics,
+		RewardsHandler:        rewardsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = rewardsCreator.CreateRewardsMiniBlocks(epochStartMetaBlock, createValidatorsInfo(actual), computedEconomics)
+	if err != nil {
+		return nil, err
+	}
+
+	// saving the rewards of an empty body only hands over the computed economics report
+	rewardsCreator.SaveTxBlockToStorage(epochStartMetaBlock, &block.Body{})
+	simulated := collector.getReport()
+	if simulated == nil {
+		return nil, ErrNoReportCreated
+	}
+
+	return simulated, nil
+}
+
+func (rs *rewardsSimulator) createRewardsHandler(epoch uint32) (economicsHandler, error) {
+	epochNotifier := forking.NewGenericEpochNotifier()
+	economicsData, err := economics.NewEconomicsData(economics.ArgsNewEconomicsData{
+		BuiltInFunctionsCostHandler: &disabledBuiltInFunctionsCost{},
+		Economics:                   rs.economicsConfig,
+		EpochNotifier:               epochNotifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards settings are selected the same way as when the epoch start block of the epoch was created
+	epochNotifier.CheckEpoch(&block.MetaBlock{Epoch: epoch})
+
+	return economicsData, nil
+}
+
+// createEpochStartMetaBlocks creates the previous and the current epoch start blocks so that the blocks produced in
+// each shard during the epoch and the rounds passed are the ones recorded in the report
+func (rs *rewardsSimulator) createEpochStartMetaBlocks(
+	actual *economicsReport.EpochReport,
+	numShards uint32,
+) (*block.MetaBlock, *block.MetaBlock) {
+	numBlocksPerShard := make(map[uint32]uint64, len(actual.Shards))
+	for _, shard := range actual.Shards {
+		numBlocksPerShard[shard.ShardID] = shard.NumBlocks
+	}
+
+	prevLastFinalizedHeaders := make([]block.EpochStartShardData, 0, numShards)
+	lastFinalizedHeaders := make([]block.EpochStartShardData, 0, numShards)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		prevLastFinalizedHeaders = append(prevLastFinalizedHeaders, block.EpochStartShardData{
+			ShardID: shardID,
+			Round:   actual.PrevEpochStartRound,
+		})
+		lastFinalizedHeaders = append(lastFinalizedHeaders, block.EpochStartShardData{
+			ShardID: shardID,
+			Round:   actual.MetaBlockRound,
+			Nonce:   numBlocksPerShard[shardID],
+		})
+	}
+
+	prevEpochStart := &block.MetaBlock{
+		Epoch: actual.Epoch - 1,
+		Round: actual.PrevEpochStartRound,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: prevLastFinalizedHeaders,
+			Economics: block.Economics{
+				TotalSupply: big.NewInt(0).Sub(valueOrZero(actual.TotalSupply), valueOrZero(actual.NewlyMinted)),
+				NodePrice:   valueOrZero(actual.NodePrice),
+			},
+		},
+	}
+	epochStartMetaBlock := &block.MetaBlock{
+		Epoch:                  actual.Epoch,
+		Round:                  actual.MetaBlockRound,
+		Nonce:                  numBlocksPerShard[core.MetachainShardId],
+		AccumulatedFeesInEpoch: valueOrZero(actual.AccumulatedFees),
+		DevFeesInEpoch:         valueOrZero(actual.DeveloperFees),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: lastFinalizedHeaders,
+		},
+	}
+
+	return prevEpochStart, epochStartMetaBlock
+}
+
+func (rs *rewardsSimulator) createStore(prevEpochStart *block.MetaBlock) (dataRetriever.StorageService, error) {
+	metaBlocksStorer, err := createMemoryStorer()
+	if err != nil {
+		return nil, err
+	}
+
+	buff, err := rs.marshalizer.Marshal(prevEpochStart)
+	if err != nil {
+		return nil, err
+	}
+
+	err = metaBlocksStorer.Put([]byte(core.EpochStartIdentifier(prevEpochStart.Epoch)), buff)
+	if err != nil {
+		return nil, err
+	}
+
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.MetaBlockUnit, metaBlocksStorer)
+
+	return store, nil
+}
+
+func createValidatorsInfo(report *economicsReport.EpochReport) map[uint32][]*state.ValidatorInfo {
+	validatorsInfo := make(map[uint32][]*state.ValidatorInfo)
+	for _, node := range report.Nodes {
+		validatorsInfo[node.ShardID] = append(validatorsInfo[node.ShardID], &state.ValidatorInfo{
+			PublicKey:                  node.PublicKey,
+			ShardId:                    node.ShardID,
+			List:                       string(common.EligibleList),
+			RewardAddress:              node.RewardAddress,
+			LeaderSuccess:              node.LeaderSuccess,
+			ValidatorSuccess:           node.ValidatorSuccess,
+			NumSelectedInSuccessBlocks: node.NumSelectedInSuccessBlocks,
+			AccumulatedFees:            valueOrZero(node.LeaderFees),
+		})
+	}
+
+	return validatorsInfo
+}
+
+func computeNumShards(report *economicsReport.EpochReport) uint32 {
+	numShards := uint32(0)
+	for _, shard := range report.Shards {
+		if shard.ShardID != core.MetachainShardId {
+			numShards++
+		}
+	}
+
+	return numShards
+}
+
+func checkReport(report *economicsReport.EpochReport) error {
+	if report == nil {
+		return ErrNilReport
+	}
+	if report.RewardsCreatorVersion != rewardsCreatorVersion2 {
+		return fmt.Errorf("%w, version %d", ErrUnsupportedRewardsCreatorVersion, report.RewardsCreatorVersion)
+	}
+	if report.Epoch == 0 {
+		return fmt.Errorf("%w, the genesis epoch has no rewards", ErrInvalidEpochReport)
+	}
+	if report.PrevEpochStartRound >= report.MetaBlockRound {
+		return fmt.Errorf("%w, previous epoch start round %d, epoch start round %d",
+			ErrInvalidEpochReport, report.PrevEpochStartRound, report.MetaBlockRound)
+	}
+	if computeNumShards(report) == 0 {
+		return fmt.Errorf("%w, no shard data", ErrInvalidEpochReport)
+	}
+	if report.NodePrice == nil || report.TotalSupply == nil {
+		return fmt.Errorf("%w, missing total supply or node price", ErrInvalidEpochReport)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *rewardsSimulator) IsInterfaceNil() bool {
+	return rs == nil
+}
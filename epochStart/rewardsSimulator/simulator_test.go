@@ -0,0 +1,263 @@
+package rewardsSimulator
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createEconomicsConfig() *config.EconomicsConfig {
+	return &config.EconomicsConfig{
+		GlobalSettings: config.GlobalSettings{
+			GenesisTotalSupply: "20000000000000000000000000",
+			MinimumInflation:   0,
+			YearSettings: []*config.YearSetting{
+				{
+					Year:             1,
+					MaximumInflation: 0.1,
+				},
+			},
+			Denomination: 18,
+		},
+		RewardsSettings: config.RewardsSettings{
+			RewardsConfigByEpoch: []config.EpochRewardSettings{
+				{
+					LeaderPercentage:                 0.1,
+					DeveloperPercentage:              0.3,
+					ProtocolSustainabilityPercentage: 0.1,
+					ProtocolSustainabilityAddress:    "erd1932eft30w753xyvme8d49qejgkjc09n5e49w4mwdjtm0neld797su0dlxp",
+					TopUpGradientPoint:               "3000000000000000000000000",
+					TopUpFactor:                      0.25,
+					EpochEnable:                      0,
+				},
+			},
+		},
+		FeeSettings: config.FeeSettings{
+			GasLimitSettings: []config.GasLimitSetting{
+				{
+					MaxGasLimitPerBlock:         "1500000000",
+					MaxGasLimitPerMiniBlock:     "1500000000",
+					MaxGasLimitPerMetaBlock:     "15000000000",
+					MaxGasLimitPerMetaMiniBlock: "15000000000",
+					MinGasLimit:                 "50000",
+				},
+			},
+			MinGasPrice:      "1000000000",
+			GasPerDataByte:   "1500",
+			GasPriceModifier: 0.01,
+		},
+	}
+}
+
+func createMockArgsRewardsSimulator() ArgsRewardsSimulator {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, log)
+
+	return ArgsRewardsSimulator{
+		Economics:               createEconomicsConfig(),
+		AddressPubkeyConverter:  converter,
+		RoundDuration:           6 * time.Second,
+		ShardConsensusGroupSize: 2,
+		MetaConsensusGroupSize:  2,
+	}
+}
+
+func createNodeReport(shardID uint32, index byte, topUp int64) *economicsReport.NodeReport {
+	return &economicsReport.NodeReport{
+		PublicKey:                  bytes.Repeat([]byte{byte(shardID), index}, 48),
+		ShardID:                    shardID,
+		RewardAddress:              append(bytes.Repeat([]byte{index}, 31), byte(shardID)),
+		NumSelectedInSuccessBlocks: 900,
+		LeaderSuccess:              450,
+		ValidatorSuccess:           900,
+		TopUpStake:                 big.NewInt(0).Mul(big.NewInt(topUp), big.NewInt(1000000000000000000)),
+		LeaderFees:                 big.NewInt(1000000000000000),
+	}
+}
+
+func createHistoricalReport() *economicsReport.EpochReport {
+	nodePrice, _ := big.NewInt(0).SetString("2500000000000000000000", 10)
+	totalSupply, _ := big.NewInt(0).SetString("20000000000000000000000000", 10)
+
+	return &economicsReport.EpochReport{
+		Epoch:                 2,
+		MetaBlockRound:        2000,
+		PrevEpochStartRound:   1000,
+		RewardsCreatorVersion: rewardsCreatorVersion2,
+		TotalSupply:           totalSupply,
+		NewlyMinted:           big.NewInt(0),
+		AccumulatedFees:       big.NewInt(10000000000000000),
+		DeveloperFees:         big.NewInt(1000000000000000),
+		NodePrice:             nodePrice,
+		Shards: []*economicsReport.ShardReport{
+			{ShardID: 0, NumBlocks: 900},
+			{ShardID: 1, NumBlocks: 900},
+			{ShardID: core.MetachainShardId, NumBlocks: 900},
+		},
+		Nodes: []*economicsReport.NodeReport{
+			createNodeReport(0, 1, 100),
+			createNodeReport(0, 2, 5000),
+			createNodeReport(1, 1, 0),
+			createNodeReport(1, 2, 1000),
+			createNodeReport(core.MetachainShardId, 1, 2000),
+			createNodeReport(core.MetachainShardId, 2, 300),
+		},
+	}
+}
+
+// simulateActualReport computes the report the rewards creator produces for the historical epoch with the default
+// economics parameters, so that it can be used as what actually happened
+func simulateActualReport(t *testing.T) *economicsReport.EpochReport {
+	simulator, err := NewRewardsSimulator(createMockArgsRewardsSimulator())
+	require.Nil(t, err)
+
+	result, err := simulator.Simulate(createHistoricalReport())
+	require.Nil(t, err)
+
+	return result.Simulated
+}
+
+func TestNewRewardsSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil economics config", func(t *testing.T) {
+		args := createMockArgsRewardsSimulator()
+		args.Economics = nil
+		simulator, err := NewRewardsSimulator(args)
+		assert.Nil(t, simulator)
+		assert.Equal(t, ErrNilEconomicsConfig, err)
+	})
+	t.Run("nil pubkey converter", func(t *testing.T) {
+		args := createMockArgsRewardsSimulator()
+		args.AddressPubkeyConverter = nil
+		simulator, err := NewRewardsSimulator(args)
+		assert.Nil(t, simulator)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("invalid round duration", func(t *testing.T) {
+		args := createMockArgsRewardsSimulator()
+		args.RoundDuration = time.Millisecond
+		simulator, err := NewRewardsSimulator(args)
+		assert.Nil(t, simulator)
+		assert.Equal(t, ErrInvalidRoundDuration, err)
+	})
+	t.Run("invalid consensus group size", func(t *testing.T) {
+		args := createMockArgsRewardsSimulator()
+		args.MetaConsensusGroupSize = 0
+		simulator, err := NewRewardsSimulator(args)
+		assert.Nil(t, simulator)
+		assert.Equal(t, ErrInvalidConsensusGroupSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		simulator, err := NewRewardsSimulator(createMockArgsRewardsSimulator())
+		assert.Nil(t, err)
+		assert.False(t, simulator.IsInterfaceNil())
+	})
+}
+
+func TestRewardsSimulator_SimulateInvalidReportShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simulator, _ := NewRewardsSimulator(createMockArgsRewardsSimulator())
+
+	result, err := simulator.Simulate(nil)
+	assert.Nil(t, result)
+	assert.Equal(t, ErrNilReport, err)
+
+	report := createHistoricalReport()
+	report.RewardsCreatorVersion = 1
+	result, err = simulator.Simulate(report)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrUnsupportedRewardsCreatorVersion))
+
+	report = createHistoricalReport()
+	report.PrevEpochStartRound = report.MetaBlockRound
+	result, err = simulator.Simulate(report)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrInvalidEpochReport))
+
+	report = createHistoricalReport()
+	report.Shards = report.Shards[2:]
+	result, err = simulator.Simulate(report)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrInvalidEpochReport))
+}
+
+func TestRewardsSimulator_SimulateSameParametersShouldHaveNoDelta(t *testing.T) {
+	t.Parallel()
+
+	actual := simulateActualReport(t)
+	simulator, _ := NewRewardsSimulator(createMockArgsRewardsSimulator())
+
+	result, err := simulator.Simulate(actual)
+	require.Nil(t, err)
+
+	assert.Equal(t, actual.Epoch, result.Epoch)
+	assert.Equal(t, result.Totals.ActualInflationRate, result.Totals.SimulatedInflationRate)
+	assert.Equal(t, 0, result.Totals.TotalToDistribute.Delta.Sign())
+	assert.Equal(t, 0, result.Totals.ProtocolSustainabilityRewards.Delta.Sign())
+	require.Equal(t, 3, len(result.Shards))
+	for _, shard := range result.Shards {
+		assert.Equal(t, 0, shard.Total.Delta.Sign())
+	}
+	require.Equal(t, 6, len(result.Nodes))
+	for _, node := range result.Nodes {
+		assert.True(t, node.Total.Actual.Cmp(big.NewInt(0)) > 0)
+		assert.Equal(t, 0, node.Total.Delta.Sign())
+	}
+}
+
+func TestRewardsSimulator_SimulateTopUpFactorShouldMoveRewardsToTopUp(t *testing.T) {
+	t.Parallel()
+
+	actual := simulateActualReport(t)
+	args := createMockArgsRewardsSimulator()
+	args.Economics.RewardsSettings.RewardsConfigByEpoch[0].TopUpFactor = 0.5
+	simulator, _ := NewRewardsSimulator(args)
+
+	result, err := simulator.Simulate(actual)
+	require.Nil(t, err)
+
+	assert.Equal(t, 0, result.Totals.TotalToDistribute.Delta.Sign())
+	assert.True(t, result.Totals.TopUpRewards.Delta.Cmp(big.NewInt(0)) > 0)
+	assert.True(t, result.Totals.BaseRewards.Delta.Cmp(big.NewInt(0)) < 0)
+	for _, node := range result.Nodes {
+		assert.True(t, node.BaseReward.Delta.Cmp(big.NewInt(0)) < 0)
+		if node.TopUpReward.Actual.Cmp(big.NewInt(0)) == 0 {
+			assert.Equal(t, 0, node.TopUpReward.Delta.Sign())
+			continue
+		}
+		assert.True(t, node.TopUpReward.Delta.Cmp(big.NewInt(0)) > 0)
+	}
+}
+
+func TestRewardsSimulator_SimulateLowerInflationShouldLowerRewards(t *testing.T) {
+	t.Parallel()
+
+	actual := simulateActualReport(t)
+	args := createMockArgsRewardsSimulator()
+	args.Economics.GlobalSettings.YearSettings[0].MaximumInflation = 0.05
+	simulator, _ := NewRewardsSimulator(args)
+
+	result, err := simulator.Simulate(actual)
+	require.Nil(t, err)
+
+	assert.Equal(t, 0.1, result.Totals.ActualInflationRate)
+	assert.Equal(t, 0.05, result.Totals.SimulatedInflationRate)
+	assert.True(t, result.Totals.NewlyMinted.Delta.Cmp(big.NewInt(0)) < 0)
+	assert.Equal(t, 0, result.Totals.LeaderFees.Delta.Sign())
+	for _, shard := range result.Shards {
+		assert.True(t, shard.Total.Delta.Cmp(big.NewInt(0)) < 0)
+	}
+	for _, node := range result.Nodes {
+		assert.True(t, node.Total.Delta.Cmp(big.NewInt(0)) < 0)
+	}
+}
@@ -260,6 +260,9 @@ var ErrNilPeerBlackListHandler = errors.New("nil peer black list handler")
 // ErrNilPeerHonestyHandler signals that a nil peer honesty handler was provided
 var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
 
+// ErrNilLoadMonitor signals that a nil load monitor was provided
+var ErrNilLoadMonitor = errors.New("nil load monitor")
+
 // ErrNilPeerShardMapper signals that a nil peer shard mapper was provided
 var ErrNilPeerShardMapper = errors.New("nil peer shard mapper")
 
@@ -452,8 +455,20 @@ var ErrNilDataPoolsHolder = errors.New("nil data pools holder")
 // ErrNilNodeRedundancyHandler signals that a nil node redundancy handler was provided
 var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
 
+// ErrNilManagedPeersHolder signals that a nil managed peers holder was provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
 // ErrNilLocker signals that a nil locker was provided
 var ErrNilLocker = errors.New("nil locker")
 
 // ErrNilCurrentEpochProvider signals that a nil current epoch provider was provided
 var ErrNilCurrentEpochProvider = errors.New("nil current epoch provider")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrRemoteSignerMissingKey signals that the remote signer does not hold the configured validator key
+var ErrRemoteSignerMissingKey = errors.New("the remote signer does not hold the configured validator key")
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -231,6 +232,16 @@ func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, er
 	return nil, errNodeStarting
 }
 
+// GetConsensusRounds returns nil and error
+func (inf *initialNodeFacade) GetConsensusRounds() ([]*consensus.RoundTrace, error) {
+	return nil, errNodeStarting
+}
+
+// GetSlashingEvidences returns nil and error
+func (inf *initialNodeFacade) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	return nil, errNodeStarting
+}
+
 // GetThrottlerForEndpoint returns nil and false
 func (inf *initialNodeFacade) GetThrottlerForEndpoint(_ string) (core.Throttler, bool) {
 	return nil, false
@@ -281,6 +292,26 @@ func (inf *initialNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, errNodeStarting
 }
 
+// GetGovernanceProposals returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposals() (*common.GovernanceProposalsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposal returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposal(_ string) (*common.GovernanceProposalResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceVotes returns nil and error
+func (inf *initialNodeFacade) GetGovernanceVotes(_ string) (*common.GovernanceVotesResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationContract returns nil and error
+func (inf *initialNodeFacade) GetDelegationContract(_ string) (*common.DelegationContractResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetESDTData returns nil and error
 func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
 	return nil, errNodeStarting
@@ -301,6 +332,41 @@ func (inf *initialNodeFacade) GetTokenSupply(_ string) (string, error) {
 	return "", errNodeStarting
 }
 
+// GetESDTTokenData returns nil and error
+func (inf *initialNodeFacade) GetESDTTokenData(_ string) (*common.ESDTTokenResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetESDTHolders returns nil and error
+func (inf *initialNodeFacade) GetESDTHolders(_ string, _ uint32, _ uint32) (*common.ESDTHoldersResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetESDTSupplyHistory returns nil and error
+func (inf *initialNodeFacade) GetESDTSupplyHistory(_ string, _ common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetEpochEconomicsReport returns nil and error
+func (inf *initialNodeFacade) GetEpochEconomicsReport(_ uint32) (*common.EpochEconomicsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetValidatorRewards returns nil and error
+func (inf *initialNodeFacade) GetValidatorRewards(_ string) (*common.ValidatorRewardsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetValidatorLifecycle returns nil and error
+func (inf *initialNodeFacade) GetValidatorLifecycle(_ string) (*common.ValidatorLifecycleResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetStakingQueue returns nil and error
+func (inf *initialNodeFacade) GetStakingQueue(_ common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+	return nil, errNodeStarting
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (inf *initialNodeFacade) IsInterfaceNil() bool {
 	return inf == nil
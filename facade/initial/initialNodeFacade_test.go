@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -117,6 +118,14 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, qp)
 	assert.Equal(t, errNodeStarting, err)
 
+	cr, err := inf.GetConsensusRounds()
+	assert.Nil(t, cr)
+	assert.Equal(t, errNodeStarting, err)
+
+	se, err := inf.GetSlashingEvidences()
+	assert.Nil(t, se)
+	assert.Equal(t, errNodeStarting, err)
+
 	th, b := inf.GetThrottlerForEndpoint("")
 	assert.Nil(t, th)
 	assert.False(t, b)
@@ -180,6 +189,30 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, ds)
 	assert.Equal(t, errNodeStarting, err)
 
+	gps, err := inf.GetGovernanceProposals()
+	assert.Nil(t, gps)
+	assert.Equal(t, errNodeStarting, err)
+
+	gp, err := inf.GetGovernanceProposal("")
+	assert.Nil(t, gp)
+	assert.Equal(t, errNodeStarting, err)
+
+	gv, err := inf.GetGovernanceVotes("")
+	assert.Nil(t, gv)
+	assert.Equal(t, errNodeStarting, err)
+
+	dc, err := inf.GetDelegationContract("")
+	assert.Nil(t, dc)
+	assert.Equal(t, errNodeStarting, err)
+
+	vl, err := inf.GetValidatorLifecycle("")
+	assert.Nil(t, vl)
+	assert.Equal(t, errNodeStarting, err)
+
+	sq, err := inf.GetStakingQueue(common.StakingQueueFilter{})
+	assert.Nil(t, sq)
+	assert.Equal(t, errNodeStarting, err)
+
 	mssa, err := inf.GetESDTsRoles("")
 	assert.Nil(t, mssa)
 	assert.Equal(t, errNodeStarting, err)
@@ -192,5 +225,25 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Empty(t, s1)
 	assert.Equal(t, errNodeStarting, err)
 
+	tokenData, err := inf.GetESDTTokenData("")
+	assert.Nil(t, tokenData)
+	assert.Equal(t, errNodeStarting, err)
+
+	holders, err := inf.GetESDTHolders("", 0, 0)
+	assert.Nil(t, holders)
+	assert.Equal(t, errNodeStarting, err)
+
+	supplyHistory, err := inf.GetESDTSupplyHistory("", common.ESDTSupplyHistoryFilter{})
+	assert.Nil(t, supplyHistory)
+	assert.Equal(t, errNodeStarting, err)
+
+	economicsReport, err := inf.GetEpochEconomicsReport(0)
+	assert.Nil(t, economicsReport)
+	assert.Equal(t, errNodeStarting, err)
+
+	validatorRewards, err := inf.GetValidatorRewards("")
+	assert.Nil(t, validatorRewards)
+	assert.Equal(t, errNodeStarting, err)
+
 	assert.False(t, check.IfNil(inf))
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -52,6 +53,21 @@ type NodeHandler interface {
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (string, error)
 
+	// GetESDTTokenData returns the decoded esdt system smart contract entry of a token with its role holders
+	GetESDTTokenData(token string) (*common.ESDTTokenResponse, error)
+
+	// GetESDTHolders returns a page of the current shard holders of a token
+	GetESDTHolders(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error)
+
+	// GetESDTSupplyHistory returns the supply history of a token, filtered by epoch and time ranges
+	GetESDTSupplyHistory(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error)
+
+	// GetEpochEconomicsReport returns the economics and rewards breakdown computed at the start of an epoch
+	GetEpochEconomicsReport(epoch uint32) (*common.EpochEconomicsResponse, error)
+
+	// GetValidatorRewards returns the per-epoch rewards history of a validator
+	GetValidatorRewards(publicKey string) (*common.ValidatorRewardsResponse, error)
+
 	// CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
@@ -89,6 +105,8 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRounds() ([]*consensus.RoundTrace, error)
+	GetSlashingEvidences() ([]*consensus.SlashingEvidence, error)
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
@@ -113,6 +131,13 @@ type ApiResolver interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetGovernanceProposals() (*common.GovernanceProposalsResponse, error)
+	GetHardforkProposals() (*common.GovernanceProposalsResponse, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error)
+	GetDelegationContract(contract string) (*common.DelegationContractResponse, error)
+	GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error)
+	GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error)
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -16,6 +17,13 @@ type ApiResolverStub struct {
 	GetTotalStakedValueHandler        func() (*api.StakeValues, error)
 	GetDirectStakedListHandler        func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler          func() ([]*api.Delegator, error)
+	GetGovernanceProposalsHandler     func() (*common.GovernanceProposalsResponse, error)
+	GetHardforkProposalsHandler       func() (*common.GovernanceProposalsResponse, error)
+	GetGovernanceProposalHandler      func(reference string) (*common.GovernanceProposalResponse, error)
+	GetGovernanceVotesHandler         func(address string) (*common.GovernanceVotesResponse, error)
+	GetDelegationContractHandler      func(contract string) (*common.DelegationContractResponse, error)
+	GetValidatorLifecycleHandler      func(blsKey string) (*common.ValidatorLifecycleResponse, error)
+	GetStakingQueueHandler            func(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error)
 }
 
 // ExecuteSCQuery -
@@ -56,6 +64,69 @@ func (ars *ApiResolverStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals() (*common.GovernanceProposalsResponse, error) {
+	if ars.GetGovernanceProposalsHandler != nil {
+		return ars.GetGovernanceProposalsHandler()
+	}
+
+	return nil, nil
+}
+
+// GetHardforkProposals -
+func (ars *ApiResolverStub) GetHardforkProposals() (*common.GovernanceProposalsResponse, error) {
+	if ars.GetHardforkProposalsHandler != nil {
+		return ars.GetHardforkProposalsHandler()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error) {
+	if ars.GetGovernanceProposalHandler != nil {
+		return ars.GetGovernanceProposalHandler(reference)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (ars *ApiResolverStub) GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error) {
+	if ars.GetGovernanceVotesHandler != nil {
+		return ars.GetGovernanceVotesHandler(address)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationContract -
+func (ars *ApiResolverStub) GetDelegationContract(contract string) (*common.DelegationContractResponse, error) {
+	if ars.GetDelegationContractHandler != nil {
+		return ars.GetDelegationContractHandler(contract)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorLifecycle -
+func (ars *ApiResolverStub) GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+	if ars.GetValidatorLifecycleHandler != nil {
+		return ars.GetValidatorLifecycleHandler(blsKey)
+	}
+
+	return nil, nil
+}
+
+// GetStakingQueue -
+func (ars *ApiResolverStub) GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+	if ars.GetStakingQueueHandler != nil {
+		return ars.GetStakingQueueHandler(filter)
+	}
+
+	return nil, nil
+}
+
 // Close -
 func (ars *ApiResolverStub) Close() error {
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -38,6 +39,8 @@ type NodeStub struct {
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsCalled                       func() ([]*consensus.RoundTrace, error)
+	GetSlashingEvidencesCalled                     func() ([]*consensus.SlashingEvidence, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRoundCalled                          func(round uint64, withTxs bool) (*api.Block, error)
@@ -49,6 +52,11 @@ type NodeStub struct {
 	GetESDTsRolesCalled                            func(address string) (map[string][]string, error)
 	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string) ([]string, error)
+	GetESDTTokenDataCalled                         func(token string) (*common.ESDTTokenResponse, error)
+	GetESDTHoldersCalled                           func(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error)
+	GetESDTSupplyHistoryCalled                     func(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error)
+	GetEpochEconomicsReportCalled                  func(epoch uint32) (*common.EpochEconomicsResponse, error)
+	GetValidatorRewardsCalled                      func(publicKey string) (*common.ValidatorRewardsResponse, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
@@ -220,6 +228,24 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetConsensusRounds -
+func (ns *NodeStub) GetConsensusRounds() ([]*consensus.RoundTrace, error) {
+	if ns.GetConsensusRoundsCalled != nil {
+		return ns.GetConsensusRoundsCalled()
+	}
+
+	return make([]*consensus.RoundTrace, 0), nil
+}
+
+// GetSlashingEvidences -
+func (ns *NodeStub) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	if ns.GetSlashingEvidencesCalled != nil {
+		return ns.GetSlashingEvidencesCalled()
+	}
+
+	return make([]*consensus.SlashingEvidence, 0), nil
+}
+
 // GetESDTData -
 func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
 	if ns.GetESDTDataCalled != nil {
@@ -261,6 +287,46 @@ func (ns *NodeStub) GetTokenSupply(_ string) (string, error) {
 	return "", nil
 }
 
+// GetESDTTokenData -
+func (ns *NodeStub) GetESDTTokenData(token string) (*common.ESDTTokenResponse, error) {
+	if ns.GetESDTTokenDataCalled != nil {
+		return ns.GetESDTTokenDataCalled(token)
+	}
+	return nil, nil
+}
+
+// GetESDTHolders -
+func (ns *NodeStub) GetESDTHolders(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error) {
+	if ns.GetESDTHoldersCalled != nil {
+		return ns.GetESDTHoldersCalled(token, offset, limit)
+	}
+	return nil, nil
+}
+
+// GetESDTSupplyHistory -
+func (ns *NodeStub) GetESDTSupplyHistory(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error) {
+	if ns.GetESDTSupplyHistoryCalled != nil {
+		return ns.GetESDTSupplyHistoryCalled(token, filter)
+	}
+	return nil, nil
+}
+
+// GetEpochEconomicsReport -
+func (ns *NodeStub) GetEpochEconomicsReport(epoch uint32) (*common.EpochEconomicsResponse, error) {
+	if ns.GetEpochEconomicsReportCalled != nil {
+		return ns.GetEpochEconomicsReportCalled(epoch)
+	}
+	return nil, nil
+}
+
+// GetValidatorRewards -
+func (ns *NodeStub) GetValidatorRewards(publicKey string) (*common.ValidatorRewardsResponse, error) {
+	if ns.GetValidatorRewardsCalled != nil {
+		return ns.GetValidatorRewardsCalled(publicKey)
+	}
+	return nil, nil
+}
+
 // GetAllIssuedESDTs -
 func (ns *NodeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	if ns.GetAllIssuedESDTsCalled != nil {
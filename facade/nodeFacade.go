@@ -18,6 +18,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -211,6 +212,31 @@ func (nf *nodeFacade) GetTokenSupply(token string) (string, error) {
 	return nf.node.GetTokenSupply(token)
 }
 
+// GetESDTTokenData returns the esdt system smart contract entry of a token together with its role holders
+func (nf *nodeFacade) GetESDTTokenData(token string) (*common.ESDTTokenResponse, error) {
+	return nf.node.GetESDTTokenData(token)
+}
+
+// GetESDTHolders returns a page of the current shard holders of a token
+func (nf *nodeFacade) GetESDTHolders(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error) {
+	return nf.node.GetESDTHolders(token, offset, limit)
+}
+
+// GetESDTSupplyHistory returns the supply history of a token, filtered by epoch and time ranges
+func (nf *nodeFacade) GetESDTSupplyHistory(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error) {
+	return nf.node.GetESDTSupplyHistory(token, filter)
+}
+
+// GetEpochEconomicsReport returns the economics and rewards breakdown computed at the start of an epoch
+func (nf *nodeFacade) GetEpochEconomicsReport(epoch uint32) (*common.EpochEconomicsResponse, error) {
+	return nf.node.GetEpochEconomicsReport(epoch)
+}
+
+// GetValidatorRewards returns the per-epoch rewards history of a validator
+func (nf *nodeFacade) GetValidatorRewards(publicKey string) (*common.ValidatorRewardsResponse, error) {
+	return nf.node.GetValidatorRewards(publicKey)
+}
+
 // GetAllIssuedESDTs returns all the issued esdts from the esdt system smart contract
 func (nf *nodeFacade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	return nf.node.GetAllIssuedESDTs(tokenType)
@@ -314,6 +340,36 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	return nf.apiResolver.GetDelegatorsList()
 }
 
+// GetGovernanceProposals will output the governance proposals together with their tallies and status
+func (nf *nodeFacade) GetGovernanceProposals() (*common.GovernanceProposalsResponse, error) {
+	return nf.apiResolver.GetGovernanceProposals()
+}
+
+// GetGovernanceProposal will output the governance proposal identified by the provided reference
+func (nf *nodeFacade) GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error) {
+	return nf.apiResolver.GetGovernanceProposal(reference)
+}
+
+// GetGovernanceVotes will output the governance votes cast by or delegated to the provided address
+func (nf *nodeFacade) GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error) {
+	return nf.apiResolver.GetGovernanceVotes(address)
+}
+
+// GetDelegationContract will output the configuration, nodes, rewards history and delegators of a delegation contract
+func (nf *nodeFacade) GetDelegationContract(contract string) (*common.DelegationContractResponse, error) {
+	return nf.apiResolver.GetDelegationContract(contract)
+}
+
+// GetValidatorLifecycle will output the staking, rating and shuffling status of the provided BLS key
+func (nf *nodeFacade) GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+	return nf.apiResolver.GetValidatorLifecycle(blsKey)
+}
+
+// GetStakingQueue will output the staking queue entries matching the provided filter
+func (nf *nodeFacade) GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+	return nf.apiResolver.GetStakingQueue(filter)
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -359,6 +415,16 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetConsensusRounds returns the timelines of the last traced consensus rounds
+func (nf *nodeFacade) GetConsensusRounds() ([]*consensus.RoundTrace, error) {
+	return nf.node.GetConsensusRounds()
+}
+
+// GetSlashingEvidences returns the slashing evidences detected by the node
+func (nf *nodeFacade) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	return nf.node.GetSlashingEvidences()
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -600,6 +601,42 @@ func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	assert.Equal(t, []core.QueryP2PPeerInfo{pinfo}, val)
 }
 
+func TestNodeFacade_GetConsensusRounds(t *testing.T) {
+	t.Parallel()
+
+	rounds := []*consensus.RoundTrace{{Round: 1}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetConsensusRoundsCalled: func() ([]*consensus.RoundTrace, error) {
+			return rounds, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetConsensusRounds()
+
+	assert.Nil(t, err)
+	assert.Equal(t, rounds, val)
+}
+
+func TestNodeFacade_GetSlashingEvidences(t *testing.T) {
+	t.Parallel()
+
+	evidences := []*consensus.SlashingEvidence{{Round: 1}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetSlashingEvidencesCalled: func() ([]*consensus.SlashingEvidence, error) {
+			return evidences, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetSlashingEvidences()
+
+	assert.Nil(t, err)
+	assert.Equal(t, evidences, val)
+}
+
 func TestNodeFacade_GetThrottlerForEndpointNoConfigShouldReturnNilAndFalse(t *testing.T) {
 	t.Parallel()
 
@@ -744,6 +781,105 @@ func TestNodeFacade_GetAllIssuedESDTs(t *testing.T) {
 	assert.Equal(t, expectedValue, res)
 }
 
+func TestNodeFacade_GetESDTTokenData(t *testing.T) {
+	t.Parallel()
+
+	expectedValue := &common.ESDTTokenResponse{Identifier: "TKN-abcdef"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetESDTTokenDataCalled: func(token string) (*common.ESDTTokenResponse, error) {
+			assert.Equal(t, expectedValue.Identifier, token)
+			return expectedValue, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetESDTTokenData(expectedValue.Identifier)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, res)
+}
+
+func TestNodeFacade_GetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	expectedValue := &common.ESDTHoldersResponse{Token: "TKN-abcdef", NumHolders: 1}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetESDTHoldersCalled: func(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error) {
+			assert.Equal(t, expectedValue.Token, token)
+			assert.Equal(t, uint32(5), offset)
+			assert.Equal(t, uint32(10), limit)
+			return expectedValue, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetESDTHolders(expectedValue.Token, 5, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, res)
+}
+
+func TestNodeFacade_GetESDTSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	expectedValue := &common.ESDTSupplyHistoryResponse{Token: "TKN-abcdef", Supply: "37"}
+	expectedFilter := common.ESDTSupplyHistoryFilter{StartEpoch: 1, EndEpoch: 2, StartTimestamp: 3, EndTimestamp: 4}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetESDTSupplyHistoryCalled: func(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error) {
+			assert.Equal(t, expectedValue.Token, token)
+			assert.Equal(t, expectedFilter, filter)
+			return expectedValue, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetESDTSupplyHistory(expectedValue.Token, expectedFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, res)
+}
+
+func TestNodeFacade_GetEpochEconomicsReport(t *testing.T) {
+	t.Parallel()
+
+	expectedValue := &common.EpochEconomicsResponse{Epoch: 4, BaseRewards: "100"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetEpochEconomicsReportCalled: func(epoch uint32) (*common.EpochEconomicsResponse, error) {
+			assert.Equal(t, expectedValue.Epoch, epoch)
+			return expectedValue, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetEpochEconomicsReport(expectedValue.Epoch)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, res)
+}
+
+func TestNodeFacade_GetValidatorRewards(t *testing.T) {
+	t.Parallel()
+
+	expectedValue := &common.ValidatorRewardsResponse{PublicKey: "aabb"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetValidatorRewardsCalled: func(publicKey string) (*common.ValidatorRewardsResponse, error) {
+			assert.Equal(t, expectedValue.PublicKey, publicKey)
+			return expectedValue, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetValidatorRewards(expectedValue.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, res)
+}
+
 func TestNodeFacade_GetESDTsWithRole(t *testing.T) {
 	t.Parallel()
 
@@ -853,6 +989,120 @@ func TestNodeFacade_GetDelegatorsList(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestNodeFacade_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalsHandler: func() (*common.GovernanceProposalsResponse, error) {
+			called = true
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetGovernanceProposals()
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalHandler: func(reference string) (*common.GovernanceProposalResponse, error) {
+			called = true
+			assert.Equal(t, "reference", reference)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetGovernanceProposal("reference")
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceVotesHandler: func(address string) (*common.GovernanceVotesResponse, error) {
+			called = true
+			assert.Equal(t, "address", address)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetGovernanceVotes("address")
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetDelegationContractHandler: func(contract string) (*common.DelegationContractResponse, error) {
+			called = true
+			assert.Equal(t, "contract", contract)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetDelegationContract("contract")
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetValidatorLifecycle(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetValidatorLifecycleHandler: func(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+			called = true
+			assert.Equal(t, "blsKey", blsKey)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetValidatorLifecycle("blsKey")
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetStakingQueue(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	filter := common.StakingQueueFilter{Owner: "owner", Offset: 1, Limit: 5}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetStakingQueueHandler: func(providedFilter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+			called = true
+			assert.Equal(t, filter, providedFilter)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetStakingQueue(filter)
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
 func TestNodeFacade_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
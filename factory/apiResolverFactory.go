@@ -149,6 +149,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		PublicKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
 		BlockChain:         args.DataComponents.Blockchain(),
 		QueryService:       scQueryService,
+		Marshalizer:        args.CoreComponents.InternalMarshalizer(),
 	}
 	totalStakedValueHandler, err := trieIteratorsFactory.CreateTotalStakedValueHandler(argsProcessors)
 	if err != nil {
@@ -165,13 +166,39 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	governanceHandler, err := trieIteratorsFactory.CreateGovernanceHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationContractHandler, err := trieIteratorsFactory.CreateDelegationContractHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorLifecycleHandler, err := trieIteratorsFactory.CreateValidatorLifecycleHandler(trieIterators.ArgValidatorLifecycleProcessor{
+		ArgTrieIteratorProcessor:  argsProcessors,
+		ValidatorPubKeyConverter:  args.CoreComponents.ValidatorPubKeyConverter(),
+		ValidatorsProvider:        args.ProcessComponents.ValidatorsProvider(),
+		NodesCoordinator:          args.ProcessComponents.NodesCoordinator(),
+		UnBondPeriod:              args.Configs.SystemSCConfig.StakingSystemSCConfig.UnBondPeriod,
+		RoundsPerEpoch:            uint64(args.Configs.GeneralConfig.EpochStartConfig.RoundsPerEpoch),
+		MaxNodesChangeEnableEpoch: args.Configs.EpochConfig.EnableEpochs.MaxNodesChangeEnableEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:          scQueryService,
-		StatusMetricsHandler:    args.CoreComponents.StatusHandlerUtils().Metrics(),
-		TxCostHandler:           txCostHandler,
-		TotalStakedValueHandler: totalStakedValueHandler,
-		DirectStakedListHandler: directStakedListHandler,
-		DelegatedListHandler:    delegatedListHandler,
+		SCQueryService:            scQueryService,
+		StatusMetricsHandler:      args.CoreComponents.StatusHandlerUtils().Metrics(),
+		TxCostHandler:             txCostHandler,
+		TotalStakedValueHandler:   totalStakedValueHandler,
+		DirectStakedListHandler:   directStakedListHandler,
+		DelegatedListHandler:      delegatedListHandler,
+		GovernanceHandler:         governanceHandler,
+		DelegationContractHandler: delegationContractHandler,
+		ValidatorLifecycleHandler: validatorLifecycleHandler,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
 	metachainEpochStart "github.com/ElrondNetwork/elrond-go/epochStart/metachain"
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	processDisabled "github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
@@ -362,6 +363,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		VMContainersFactory: vmFactory,
 		VmContainer:         vmContainer,
 		GasHandler:          gasHandler,
+		LoadMonitor:         pcf.network.LoadMonitor(),
 	}
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
@@ -655,6 +657,11 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 
 	rewardsStorage := pcf.data.StorageService().GetStorer(dataRetriever.RewardTransactionUnit)
 	miniBlockStorage := pcf.data.StorageService().GetStorer(dataRetriever.MiniBlockUnit)
+	economicsReportSaver, err := economicsReport.NewReportStorer(pcf.data.StorageService().GetStorer(dataRetriever.EpochEconomicsUnit))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	argsEpochRewards := metachainEpochStart.RewardsCreatorProxyArgs{
 		BaseRewardsCreatorArgs: metachainEpochStart.BaseRewardsCreatorArgs{
 			ShardCoordinator:              pcf.bootstrapComponents.ShardCoordinator(),
@@ -669,6 +676,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 			UserAccountsDB:                pcf.state.AccountsAdapter(),
 			RewardsFix1EpochEnable:        enableEpochs.SwitchJailWaitingEnableEpoch,
 			DelegationSystemSCEnableEpoch: pcf.epochConfig.EnableEpochs.StakingV2EnableEpoch,
+			EconomicsReportSaver:          economicsReportSaver,
 		},
 		StakingDataProvider:   stakingDataProvider,
 		RewardsHandler:        pcf.coreData.EconomicsData(),
@@ -720,6 +728,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		VMContainersFactory: vmFactory,
 		VmContainer:         vmContainer,
 		GasHandler:          gasHandler,
+		LoadMonitor:         pcf.network.LoadMonitor(),
 	}
 
 	esdtOwnerAddress, err := pcf.coreData.AddressPubKeyConverter().Decode(pcf.systemSCConfig.ESDTSystemSCConfig.OwnerAddress)
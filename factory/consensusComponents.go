@@ -1,23 +1,32 @@
 package factory
 
 import (
+	"strings"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/core/watchdog"
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/consensus/tracer"
 	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/process"
+	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state/syncer"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
@@ -52,13 +61,15 @@ type consensusComponentsFactory struct {
 }
 
 type consensusComponents struct {
-	chronology         consensus.ChronologyHandler
-	bootstrapper       process.Bootstrapper
-	broadcastMessenger consensus.BroadcastMessenger
-	worker             ConsensusWorker
-	hardforkTrigger    HardforkTrigger
-	consensusTopic     string
-	consensusGroupSize int
+	chronology           consensus.ChronologyHandler
+	bootstrapper         process.Bootstrapper
+	broadcastMessenger   consensus.BroadcastMessenger
+	worker               ConsensusWorker
+	hardforkTrigger      HardforkTrigger
+	roundTracer          consensus.RoundTracer
+	equivocationDetector consensus.EquivocationDetector
+	consensusTopic       string
+	consensusGroupSize   int
 }
 
 // NewConsensusComponentsFactory creates an instance of consensusComponentsFactory
@@ -128,7 +139,12 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, errors.ErrGenesisBlockNotInitialized
 	}
 
-	cc.chronology, err = ccf.createChronology()
+	cc.roundTracer, err = ccf.createRoundTracer()
+	if err != nil {
+		return nil, err
+	}
+
+	cc.chronology, err = ccf.createChronology(cc.roundTracer)
 	if err != nil {
 		return nil, err
 	}
@@ -161,11 +177,17 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		ccf.dataComponents.Datapool().Headers(),
 		ccf.processComponents.InterceptorsContainer(),
 		ccf.coreComponents.AlarmScheduler(),
+		ccf.processComponents.ManagedPeersHolder(),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	cc.equivocationDetector, err = ccf.createEquivocationDetector()
+	if err != nil {
+		return nil, err
+	}
+
 	marshalizer := ccf.coreComponents.InternalMarshalizer()
 	sizeCheckDelta := ccf.config.Marshalizer.SizeCheckDelta
 	if sizeCheckDelta > 0 {
@@ -196,6 +218,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		PublicKeySize:            ccf.config.ValidatorPubkeyConverter.Length,
 		AppStatusHandler:         ccf.coreComponents.StatusHandler(),
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
+		EquivocationDetector:     cc.equivocationDetector,
+		ManagedPeersHolder:       ccf.processComponents.ManagedPeersHolder(),
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -205,6 +229,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 
 	cc.worker.StartWorking()
 	ccf.dataComponents.Datapool().Headers().RegisterHandler(cc.worker.ReceivedHeader)
+	ccf.registerEquivocationDetectorOnHeaderInterceptors(cc.equivocationDetector)
 
 	// apply consensus group size on the input antiflooder just before consensus creation topic
 	ccf.networkComponents.InputAntiFloodHandler().ApplyConsensusSize(
@@ -216,6 +241,15 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	keysSigner, err := keysManagement.NewKeysSigner(keysManagement.ArgsKeysSigner{
+		MainPrivateKey:     ccf.cryptoComponents.PrivateKey(),
+		ManagedPeersHolder: ccf.processComponents.ManagedPeersHolder(),
+		SingleSigner:       ccf.cryptoComponents.BlockSigner(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	consensusArgs := &spos.ConsensusCoreArgs{
 		BlockChain:                    ccf.dataComponents.Blockchain(),
 		BlockProcessor:                ccf.processComponents.BlockProcessor(),
@@ -237,6 +271,9 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		HeaderSigVerifier:             ccf.processComponents.HeaderSigVerifier(),
 		FallbackHeaderValidator:       ccf.processComponents.FallbackHeaderValidator(),
 		NodeRedundancyHandler:         ccf.processComponents.NodeRedundancyHandler(),
+		RoundTracer:                   cc.roundTracer,
+		ManagedPeersHolder:            ccf.processComponents.ManagedPeersHolder(),
+		KeysSigner:                    keysSigner,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -290,11 +327,106 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	err = cc.roundTracer.Close()
+	if err != nil {
+		return err
+	}
+	err = cc.equivocationDetector.Close()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyHandler, error) {
+func (ccf *consensusComponentsFactory) createRoundTracer() (consensus.RoundTracer, error) {
+	tracerConfig := ccf.config.Debug.ConsensusTracer
+	if !tracerConfig.Enabled {
+		return tracer.NewDisabledRoundTracer(), nil
+	}
+
+	args := tracer.ArgsRoundTracer{
+		SyncTimer:       ccf.coreComponents.SyncTimer(),
+		NumRoundsToKeep: tracerConfig.NumRoundsToKeep,
+	}
+	if tracerConfig.ExportToDisk {
+		exportFile, err := core.CreateFile(core.ArgCreateFileArgument{
+			Directory:     tracerConfig.ExportFolder,
+			Prefix:        "consensus-rounds",
+			FileExtension: "jsonl",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		args.ExportWriter = exportFile
+	}
+
+	return tracer.NewRoundTracer(args)
+}
+
+func (ccf *consensusComponentsFactory) createEquivocationDetector() (consensus.EquivocationDetector, error) {
+	detectorConfig := ccf.config.SlashingDetector
+	if !detectorConfig.Enabled {
+		return slashing.NewDisabledEquivocationDetector(), nil
+	}
+
+	shardID := core.GetShardIDString(ccf.processComponents.ShardCoordinator().SelfId())
+	dbConfig := storageFactory.GetDBFromConfig(detectorConfig.EvidenceStorage.DB)
+	dbConfig.FilePath = ccf.coreComponents.PathHandler().PathForStatic(shardID, detectorConfig.EvidenceStorage.DB.FilePath)
+	evidenceStorer, err := storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(detectorConfig.EvidenceStorage.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(detectorConfig.EvidenceStorage.Bloom),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	args := slashing.ArgsEquivocationDetector{
+		Marshalizer:       ccf.coreComponents.InternalMarshalizer(),
+		Hasher:            ccf.coreComponents.Hasher(),
+		NodesCoordinator:  ccf.processComponents.NodesCoordinator(),
+		KeyGenerator:      ccf.cryptoComponents.BlockSignKeyGen(),
+		SingleSigVerifier: ccf.cryptoComponents.BlockSigner(),
+		ShardCoordinator:  ccf.processComponents.ShardCoordinator(),
+		SyncTimer:         ccf.coreComponents.SyncTimer(),
+		Storer:            evidenceStorer,
+		OutportHandler:    ccf.statusComponents.OutportHandler(),
+		NumRoundsToKeep:   detectorConfig.NumRoundsToKeep,
+	}
+	detector, err := slashing.NewEquivocationDetector(args)
+	if err != nil {
+		_ = evidenceStorer.Close()
+		return nil, err
+	}
+
+	return detector, nil
+}
+
+// registerEquivocationDetectorOnHeaderInterceptors feeds the equivocation detector with all the intercepted shard
+// and metachain headers, so conflicting proposals are detected even if they are not received on the consensus topic
+func (ccf *consensusComponentsFactory) registerEquivocationDetectorOnHeaderInterceptors(detector consensus.EquivocationDetector) {
+	handler := func(_ string, hash []byte, value interface{}) {
+		header, ok := value.(data.HeaderHandler)
+		if !ok {
+			return
+		}
+
+		detector.AddHeader(hash, header)
+	}
+
+	ccf.processComponents.InterceptorsContainer().Iterate(func(key string, interceptor process.Interceptor) bool {
+		isHeaderTopic := strings.HasPrefix(key, procFactory.ShardBlocksTopic) || strings.HasPrefix(key, procFactory.MetachainBlocksTopic)
+		if isHeaderTopic {
+			interceptor.RegisterHandler(handler)
+		}
+
+		return true
+	})
+}
+
+func (ccf *consensusComponentsFactory) createChronology(roundTracer consensus.RoundTracer) (consensus.ChronologyHandler, error) {
 	wd := ccf.coreComponents.Watchdog()
 	if ccf.statusComponents.OutportHandler().HasDrivers() {
 		log.Warn("node is running with an outport with attached drivers. Chronology watchdog will be turned off as " +
@@ -313,6 +445,7 @@ func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyH
 		SyncTimer:        ccf.coreComponents.SyncTimer(),
 		Watchdog:         wd,
 		AppStatusHandler: ccf.coreComponents.StatusHandler(),
+		RoundTracer:      roundTracer,
 	}
 	chronologyHandler, err := chronology.NewChronology(chronologyArg)
 	if err != nil {
@@ -128,6 +128,12 @@ func (mcc *managedConsensusComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.broadcastMessenger) {
 		return errors.ErrNilBroadcastMessenger
 	}
+	if check.IfNil(mcc.roundTracer) {
+		return errors.ErrNilRoundTracer
+	}
+	if check.IfNil(mcc.equivocationDetector) {
+		return errors.ErrNilEquivocationDetector
+	}
 
 	return nil
 }
@@ -144,6 +150,30 @@ func (mcc *managedConsensusComponents) HardforkTrigger() HardforkTrigger {
 	return mcc.consensusComponents.hardforkTrigger
 }
 
+// RoundTracer returns the consensus round tracer
+func (mcc *managedConsensusComponents) RoundTracer() consensus.RoundTracer {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.roundTracer
+}
+
+// EquivocationDetector returns the component detecting the validators that sign conflicting data
+func (mcc *managedConsensusComponents) EquivocationDetector() consensus.EquivocationDetector {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.equivocationDetector
+}
+
 // IsInterfaceNil returns true if the underlying object is nil
 func (mcc *managedConsensusComponents) IsInterfaceNil() bool {
 	return mcc == nil
@@ -39,6 +39,8 @@ func TestManagedConsensusComponents_Create_ShouldWork(t *testing.T) {
 	require.Nil(t, managedConsensusComponents.BroadcastMessenger())
 	require.Nil(t, managedConsensusComponents.Chronology())
 	require.Nil(t, managedConsensusComponents.ConsensusWorker())
+	require.Nil(t, managedConsensusComponents.RoundTracer())
+	require.Nil(t, managedConsensusComponents.EquivocationDetector())
 	require.Error(t, managedConsensusComponents.CheckSubcomponents())
 
 	err = managedConsensusComponents.Create()
@@ -46,6 +48,8 @@ func TestManagedConsensusComponents_Create_ShouldWork(t *testing.T) {
 	require.NotNil(t, managedConsensusComponents.BroadcastMessenger())
 	require.NotNil(t, managedConsensusComponents.Chronology())
 	require.NotNil(t, managedConsensusComponents.ConsensusWorker())
+	require.NotNil(t, managedConsensusComponents.RoundTracer())
+	require.NotNil(t, managedConsensusComponents.EquivocationDetector())
 	require.NoError(t, managedConsensusComponents.CheckSubcomponents())
 }
 
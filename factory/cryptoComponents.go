@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
@@ -25,6 +26,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/factory/peerSignatureHandler"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/remote"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -43,6 +46,7 @@ type CryptoComponentsFactoryArgs struct {
 	KeyLoader                            KeyLoaderHandler
 	IsInImportMode                       bool
 	ImportModeNoSigCheck                 bool
+	RemoteSignerConfig                   config.RemoteSignerConfig
 }
 
 type cryptoComponentsFactory struct {
@@ -55,6 +59,7 @@ type cryptoComponentsFactory struct {
 	keyLoader                            KeyLoaderHandler
 	isInImportMode                       bool
 	importModeNoSigCheck                 bool
+	remoteSignerConfig                   config.RemoteSignerConfig
 }
 
 // cryptoParams holds the node public/private key data
@@ -100,6 +105,7 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 		keyLoader:                            args.KeyLoader,
 		isInImportMode:                       args.IsInImportMode,
 		importModeNoSigCheck:                 args.ImportModeNoSigCheck,
+		remoteSignerConfig:                   args.RemoteSignerConfig,
 	}
 
 	return ccf, nil
@@ -159,7 +165,12 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
-	peerSigHandler, err := peerSignatureHandler.NewPeerSignatureHandler(cachePkPIDSignature, interceptSingleSigner, blockSignKeyGen)
+	peerIDSigner, err := keysManagement.NewPeerIDSigner(interceptSingleSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	peerSigHandler, err := peerSignatureHandler.NewPeerSignatureHandler(cachePkPIDSignature, peerIDSigner, blockSignKeyGen)
 	if err != nil {
 		return nil, err
 	}
@@ -255,6 +266,9 @@ func (ccf *cryptoComponentsFactory) createCryptoParams(
 	if ccf.isInImportMode {
 		return ccf.generateCryptoParams(keygen)
 	}
+	if ccf.remoteSignerConfig.Enabled {
+		return ccf.createRemoteCryptoParams(keygen)
+	}
 
 	return ccf.readCryptoParams(keygen)
 }
@@ -306,6 +320,75 @@ func (ccf *cryptoComponentsFactory) generateCryptoParams(keygen crypto.KeyGenera
 	return cp, nil
 }
 
+func (ccf *cryptoComponentsFactory) createRemoteCryptoParams(keygen crypto.KeyGenerator) (*cryptoParams, error) {
+	cfg := ccf.remoteSignerConfig
+	log.Info("the validator key is held by the remote signer", "URL", cfg.URL, "public key", cfg.PublicKey)
+
+	validatorKeyConverter := ccf.coreComponentsHolder.ValidatorPubKeyConverter()
+	pkBytes, err := validatorKeyConverter.Decode(cfg.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the remote signer public key %s", err, cfg.PublicKey)
+	}
+
+	cp := &cryptoParams{
+		publicKeyBytes:  pkBytes,
+		publicKeyString: validatorKeyConverter.Encode(pkBytes),
+	}
+	cp.publicKey, err = keygen.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := remote.NewClientTLSConfig(remote.ArgsTLSConfig{
+		CertificateFile:   cfg.CertificateFile,
+		KeyFile:           cfg.KeyFile,
+		CACertificateFile: cfg.CACertificateFile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slashingProtector, err := remote.NewSlashingProtector(cfg.SlashingProtectionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteSigner, err := remote.NewRemoteSigner(remote.ArgsRemoteSigner{
+		URL:               cfg.URL,
+		TLSConfig:         tlsConfig,
+		RequestTimeout:    time.Duration(cfg.RequestTimeoutInMilliseconds) * time.Millisecond,
+		SlashingProtector: slashingProtector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	remoteKeys, err := remoteSigner.GetPublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	if !containsKey(remoteKeys, pkBytes) {
+		return nil, fmt.Errorf("%w for public key %s", errors.ErrRemoteSignerMissingKey, cp.publicKeyString)
+	}
+
+	cp.privateKey, err = remote.NewRemotePrivateKey(cp.publicKey, remoteSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (ccf *cryptoComponentsFactory) getSkPk() ([]byte, []byte, error) {
 	encodedSk, pkString, err := ccf.keyLoader.LoadKey(ccf.validatorKeyPemFileName, ccf.skIndex)
 	if err != nil {
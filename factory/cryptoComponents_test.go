@@ -334,6 +334,49 @@ func TestCryptoComponentsFactory_CreateCryptoParamsOK(t *testing.T) {
 	require.NotNil(t, cryptoParams)
 }
 
+func TestCryptoComponentsFactory_CreateCryptoParamsRemoteSignerInvalidPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.RemoteSignerConfig = config.RemoteSignerConfig{
+		Enabled:   true,
+		PublicKey: "not a public key",
+	}
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	suite, _ := ccf.GetSuite()
+	blockSignKeyGen := signing.NewKeyGenerator(suite)
+
+	cryptoParams, err := ccf.CreateCryptoParams(blockSignKeyGen)
+	require.Nil(t, cryptoParams)
+	require.NotNil(t, err)
+}
+
+func TestCryptoComponentsFactory_CreateCryptoParamsRemoteSignerMissingCertificatesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.RemoteSignerConfig = config.RemoteSignerConfig{
+		Enabled:                      true,
+		URL:                          "https://127.0.0.1:9443",
+		PublicKey:                    dummyPk,
+		CertificateFile:              "missing.crt",
+		KeyFile:                      "missing.key",
+		CACertificateFile:            "missingCA.crt",
+		RequestTimeoutInMilliseconds: 1000,
+	}
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	suite, _ := ccf.GetSuite()
+	blockSignKeyGen := signing.NewKeyGenerator(suite)
+
+	cryptoParams, err := ccf.CreateCryptoParams(blockSignKeyGen)
+	require.Nil(t, cryptoParams)
+	require.NotNil(t, err)
+}
+
 func TestCryptoComponentsFactory_GetSkPkInvalidSkBytesShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,9 @@
 package factory
 
 import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/appStatusPolling"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/common"
@@ -88,3 +91,8 @@ func SetShardCoordinator(shardCoordinator sharding.Coordinator, holder Bootstrap
 
 	mbf.bootstrapComponents.shardCoordinator = shardCoordinator
 }
+
+// RegisterCpuStatistics -
+func RegisterCpuStatistics(ctx context.Context, appStatusPollingHandler *appStatusPolling.AppStatusPolling, loadMonitor process.LoadMonitor) error {
+	return registerCpuStatistics(ctx, appStatusPollingHandler, loadMonitor)
+}
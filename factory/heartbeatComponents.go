@@ -151,6 +151,9 @@ func (hcf *heartbeatComponentsFactory) Create() (*heartbeatComponents, error) {
 		HardforkTrigger:      hcf.hardforkTrigger,
 		CurrentBlockProvider: hcf.dataComponents.Blockchain(),
 		RedundancyHandler:    hcf.redundancyHandler,
+		ManagedPeersHolder:   hcf.processComponents.ManagedPeersHolder(),
+		// the managed keys heartbeats are spread over the minimum time between two heartbeat rounds
+		ManagedKeysSendInterval: time.Second * time.Duration(hcf.config.Heartbeat.MinTimeToWaitBetweenBroadcastsInSec),
 	}
 
 	hbc.sender, err = heartbeatProcess.NewSender(argSender)
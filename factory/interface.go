@@ -209,6 +209,7 @@ type NetworkComponentsHolder interface {
 	PeerBlackListHandler() process.PeerBlackListCacher
 	PeerHonestyHandler() PeerHonestyHandler
 	PreferredPeersHolderHandler() PreferredPeersHolderHandler
+	LoadMonitor() process.LoadMonitor
 	IsInterfaceNil() bool
 }
 
@@ -255,6 +256,7 @@ type ProcessComponentsHolder interface {
 	ImportStartHandler() update.ImportStartHandler
 	RequestedItemsHandler() dataRetriever.RequestedItemsHandler
 	NodeRedundancyHandler() consensus.NodeRedundancyHandler
+	ManagedPeersHolder() common.ManagedPeersHolder
 	CurrentEpochProvider() process.CurrentNetworkEpochProviderHandler
 	IsInterfaceNil() bool
 }
@@ -369,6 +371,7 @@ type HardforkTrigger interface {
 	TriggerReceived(payload []byte, data []byte, pkBytes []byte) (bool, error)
 	RecordedTriggerMessage() ([]byte, bool)
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
+	TriggerFromGovernance(epoch uint32) error
 	CreateData() []byte
 	AddCloser(closer update.Closer) error
 	NotifyTriggerReceived() <-chan struct{}
@@ -383,6 +386,8 @@ type ConsensusComponentsHolder interface {
 	BroadcastMessenger() consensus.BroadcastMessenger
 	ConsensusGroupSize() (int, error)
 	HardforkTrigger() HardforkTrigger
+	RoundTracer() consensus.RoundTracer
+	EquivocationDetector() consensus.EquivocationDetector
 	IsInterfaceNil() bool
 }
 
@@ -4,7 +4,8 @@ import "github.com/ElrondNetwork/elrond-go-core/core"
 
 // GasScheduleNotifierMock -
 type GasScheduleNotifierMock struct {
-	GasSchedule map[string]map[string]uint64
+	GasScheduleVersion string
+	GasSchedule        map[string]map[string]uint64
 }
 
 // NewGasScheduleNotifierMock -
@@ -25,6 +26,11 @@ func (g *GasScheduleNotifierMock) LatestGasSchedule() map[string]map[string]uint
 	return g.GasSchedule
 }
 
+// LatestGasScheduleVersion -
+func (g *GasScheduleNotifierMock) LatestGasScheduleVersion() string {
+	return g.GasScheduleVersion
+}
+
 // UnRegisterAll -
 func (g *GasScheduleNotifierMock) UnRegisterAll() {
 }
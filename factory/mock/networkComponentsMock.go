@@ -13,6 +13,7 @@ type NetworkComponentsMock struct {
 	OutputAntiFlood      factory.P2PAntifloodHandler
 	PeerBlackList        process.PeerBlackListCacher
 	PreferredPeersHolder factory.PreferredPeersHolderHandler
+	LoadMonitorField     process.LoadMonitor
 }
 
 // PubKeyCacher -
@@ -65,6 +66,11 @@ func (ncm *NetworkComponentsMock) PreferredPeersHolderHandler() factory.Preferre
 	return ncm.PreferredPeersHolder
 }
 
+// LoadMonitor -
+func (ncm *NetworkComponentsMock) LoadMonitor() process.LoadMonitor {
+	return ncm.LoadMonitorField
+}
+
 // IsInterfaceNil -
 func (ncm *NetworkComponentsMock) IsInterfaceNil() bool {
 	return ncm == nil
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
@@ -42,6 +43,7 @@ type ProcessComponentsMock struct {
 	ImportStartHandlerInternal     update.ImportStartHandler
 	RequestedItemsHandlerInternal  dataRetriever.RequestedItemsHandler
 	NodeRedundancyHandlerInternal  consensus.NodeRedundancyHandler
+	ManagedPeersHolderInternal     common.ManagedPeersHolder
 	CurrentEpochProviderInternal   process.CurrentNetworkEpochProviderHandler
 }
 
@@ -205,6 +207,11 @@ func (pcm *ProcessComponentsMock) NodeRedundancyHandler() consensus.NodeRedundan
 	return pcm.NodeRedundancyHandlerInternal
 }
 
+// ManagedPeersHolder -
+func (pcm *ProcessComponentsMock) ManagedPeersHolder() common.ManagedPeersHolder {
+	return pcm.ManagedPeersHolderInternal
+}
+
 // CurrentEpochProvider -
 func (pcm *ProcessComponentsMock) CurrentEpochProvider() process.CurrentNetworkEpochProviderHandler {
 	return pcm.CurrentEpochProviderInternal
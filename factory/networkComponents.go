@@ -60,6 +60,7 @@ type networkComponents struct {
 	antifloodConfig        config.AntifloodConfig
 	peerHonestyHandler     consensus.PeerHonestyHandler
 	peersHolder            PreferredPeersHolderHandler
+	loadMonitor            process.LoadMonitor
 	closeFunc              context.CancelFunc
 }
 
@@ -181,6 +182,7 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		antifloodConfig:        ncf.mainConfig.Antiflood,
 		peerHonestyHandler:     peerHonestyHandler,
 		peersHolder:            peersHolder,
+		loadMonitor:            antiFloodComponents.LoadMonitor,
 		closeFunc:              cancelFunc,
 	}, nil
 }
@@ -88,6 +88,9 @@ func (mnc *managedNetworkComponents) CheckSubcomponents() error {
 	if check.IfNil(mnc.peerHonestyHandler) {
 		return errors.ErrNilPeerHonestyHandler
 	}
+	if check.IfNil(mnc.loadMonitor) {
+		return errors.ErrNilLoadMonitor
+	}
 
 	return nil
 }
@@ -176,6 +179,18 @@ func (mnc *managedNetworkComponents) PreferredPeersHolderHandler() PreferredPeer
 	return mnc.networkComponents.peersHolder
 }
 
+// LoadMonitor returns the load monitor used by the adaptive antiflood
+func (mnc *managedNetworkComponents) LoadMonitor() process.LoadMonitor {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.loadMonitor
+}
+
 // IsInterfaceNil returns true if the value under the interface is nil
 func (mnc *managedNetworkComponents) IsInterfaceNil() bool {
 	return mnc == nil
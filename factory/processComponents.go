@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -33,11 +34,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/genesis/checking"
 	processGenesis "github.com/ElrondNetwork/elrond-go/genesis/process"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
 	"github.com/ElrondNetwork/elrond-go/process/block/poolsCleaner"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
@@ -96,6 +99,7 @@ type processComponents struct {
 	requestedItemsHandler       dataRetriever.RequestedItemsHandler
 	importHandler               update.ImportHandler
 	nodeRedundancyHandler       consensus.NodeRedundancyHandler
+	managedPeersHolder          common.ManagedPeersHolder
 	currentEpochProvider        dataRetriever.CurrentNetworkEpochProviderHandler
 	vmFactoryForTxSimulator     process.VirtualMachinesContainerFactory
 }
@@ -108,7 +112,7 @@ type ProcessComponentsFactoryArgs struct {
 	ImportDBConfig         config.ImportDbConfig
 	AccountsParser         genesis.AccountsParser
 	SmartContractParser    genesis.InitialSmartContractParser
-	GasSchedule            core.GasScheduleNotifier
+	GasSchedule            common.GasScheduleNotifierAPI
 	NodesCoordinator       sharding.NodesCoordinator
 	RequestedItemsHandler  dataRetriever.RequestedItemsHandler
 	WhiteListHandler       process.WhiteListHandler
@@ -120,6 +124,8 @@ type ProcessComponentsFactoryArgs struct {
 	WorkingDir             string
 	HistoryRepo            dblookupext.HistoryRepository
 
+	AllValidatorKeysPemFileName string
+
 	Data                DataComponentsHolder
 	CoreData            CoreComponentsHolder
 	Crypto              CryptoComponentsHolder
@@ -136,7 +142,7 @@ type processComponentsFactory struct {
 	importDBConfig         config.ImportDbConfig
 	accountsParser         genesis.AccountsParser
 	smartContractParser    genesis.InitialSmartContractParser
-	gasSchedule            core.GasScheduleNotifier
+	gasSchedule            common.GasScheduleNotifierAPI
 	nodesCoordinator       sharding.NodesCoordinator
 	requestedItemsHandler  dataRetriever.RequestedItemsHandler
 	whiteListHandler       process.WhiteListHandler
@@ -151,6 +157,8 @@ type processComponentsFactory struct {
 	epochNotifier          process.EpochNotifier
 	importHandler          update.ImportHandler
 
+	allValidatorKeysPemFileName string
+
 	data                DataComponentsHolder
 	coreData            CoreComponentsHolder
 	crypto              CryptoComponentsHolder
@@ -193,6 +201,8 @@ func NewProcessComponentsFactory(args ProcessComponentsFactoryArgs) (*processCom
 		workingDir:             args.WorkingDir,
 		historyRepo:            args.HistoryRepo,
 		epochNotifier:          args.CoreData.EpochNotifier(),
+
+		allValidatorKeysPemFileName: args.AllValidatorKeysPemFileName,
 	}, nil
 }
 
@@ -447,12 +457,23 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	builtInFunctionsCost, err := economics.NewBuiltInFunctionsCost(&economics.ArgsBuiltInFunctionCost{
+		ArgsParser:  smartContract.NewArgumentParser(),
+		GasSchedule: pcf.gasSchedule,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	txSimulatorProcessorArgs := &txsimulator.ArgsTxSimulator{
 		AddressPubKeyConverter: pcf.coreData.AddressPubKeyConverter(),
 		ShardCoordinator:       pcf.bootstrapComponents.ShardCoordinator(),
 		VMOutputCacher:         vmOutputCacher,
 		Hasher:                 pcf.coreData.Hasher(),
 		Marshalizer:            pcf.coreData.InternalMarshalizer(),
+		EconomicsFee:           pcf.coreData.EconomicsData(),
+		BuiltInFunctionsCost:   builtInFunctionsCost,
+		GasScheduleVersion:     pcf.gasSchedule,
 	}
 
 	blockProcessor, vmFactoryTxSimulator, err := pcf.newBlockProcessor(
@@ -522,6 +543,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	managedPeersHolder, err := pcf.createManagedPeersHolder()
+	if err != nil {
+		return nil, err
+	}
+
 	return &processComponents{
 		nodesCoordinator:            pcf.nodesCoordinator,
 		shardCoordinator:            pcf.bootstrapComponents.ShardCoordinator(),
@@ -555,11 +581,47 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		requestedItemsHandler:       pcf.requestedItemsHandler,
 		importHandler:               pcf.importHandler,
 		nodeRedundancyHandler:       nodeRedundancyHandler,
+		managedPeersHolder:          managedPeersHolder,
 		currentEpochProvider:        currentEpochProvider,
 		vmFactoryForTxSimulator:     vmFactoryTxSimulator,
 	}, nil
 }
 
+func (pcf *processComponentsFactory) createManagedPeersHolder() (common.ManagedPeersHolder, error) {
+	args := keysManagement.ArgsManagedPeersHolder{
+		KeyGenerator:    pcf.crypto.BlockSignKeyGen(),
+		Messenger:       pcf.network.NetworkMessenger(),
+		RedundancyLevel: pcf.prefConfigs.RedundancyLevel,
+	}
+	managedPeersHolder, err := keysManagement.NewManagedPeersHolder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(pcf.allValidatorKeysPemFileName)
+	if err != nil {
+		log.Debug("no managed keys loaded, the node will run in single key mode",
+			"file", pcf.allValidatorKeysPemFileName, "reason", err.Error())
+		return managedPeersHolder, nil
+	}
+
+	mainPublicKey := string(pcf.crypto.PublicKeyBytes())
+	for i, privateKey := range privateKeys {
+		pkBytes, errDecode := hex.DecodeString(publicKeys[i])
+		if errDecode == nil && string(pkBytes) == mainPublicKey {
+			log.Debug("skipping the main validator key from the managed keys", "public key", publicKeys[i])
+			continue
+		}
+
+		err = managedPeersHolder.AddManagedPeer(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the managed keys from file %s", err, pcf.allValidatorKeysPemFileName)
+		}
+	}
+
+	return managedPeersHolder, nil
+}
+
 func (pcf *processComponentsFactory) newValidatorStatisticsProcessor() (process.ValidatorStatisticsProcessor, error) {
 
 	storageService := pcf.data.StorageService()
@@ -694,6 +756,7 @@ func (pcf *processComponentsFactory) generateGenesisHeadersAndApplyInitialBalanc
 		VirtualMachineConfig: genesisVmConfig,
 		TxLogsProcessor:      pcf.txLogsProcessor,
 		HardForkConfig:       pcf.config.Hardfork,
+		GeneralConfig:        &pcf.config,
 		TrieStorageManagers:  pcf.state.TrieStorageManagers(),
 		SystemSCConfig:       *pcf.systemSCConfig,
 		ImportStartHandler:   pcf.importStartHandler,
@@ -1178,6 +1241,7 @@ func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
 		EnableSignTxWithHashEpoch: pcf.epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
 		PreferredPeersHolder:      pcf.network.PreferredPeersHolderHandler(),
 		RequestHandler:            requestHandler,
+		LoadMonitor:               pcf.network.LoadMonitor(),
 	}
 	log.Debug("shardInterceptor: enable epoch for transaction signed with tx hash", "epoch", shardInterceptorsContainerFactoryArgs.EnableSignTxWithHashEpoch)
 
@@ -1221,6 +1285,7 @@ func (pcf *processComponentsFactory) newMetaInterceptorContainerFactory(
 		EnableSignTxWithHashEpoch: pcf.epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
 		PreferredPeersHolder:      pcf.network.PreferredPeersHolderHandler(),
 		RequestHandler:            requestHandler,
+		LoadMonitor:               pcf.network.LoadMonitor(),
 	}
 	log.Debug("metaInterceptor: enable epoch for transaction signed with tx hash", "epoch", metaInterceptorsContainerFactoryArgs.EnableSignTxWithHashEpoch)
 
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
@@ -145,6 +146,9 @@ func (m *managedProcessComponents) CheckSubcomponents() error {
 	if check.IfNil(m.processComponents.nodeRedundancyHandler) {
 		return errors.ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(m.processComponents.managedPeersHolder) {
+		return errors.ErrNilManagedPeersHolder
+	}
 	if check.IfNil(m.processComponents.currentEpochProvider) {
 		return errors.ErrNilCurrentEpochProvider
 	}
@@ -500,6 +504,18 @@ func (m *managedProcessComponents) NodeRedundancyHandler() consensus.NodeRedunda
 	return m.processComponents.nodeRedundancyHandler
 }
 
+// ManagedPeersHolder returns the managed peers holder
+func (m *managedProcessComponents) ManagedPeersHolder() common.ManagedPeersHolder {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.managedPeersHolder
+}
+
 // CurrentEpochProvider returns the current epoch provider that can decide if an epoch is active or not on the network
 func (m *managedProcessComponents) CurrentEpochProvider() process.CurrentNetworkEpochProviderHandler {
 	m.mutProcessComponents.RLock()
@@ -324,7 +324,7 @@ func (msc *managedStatusComponents) startMachineStatisticsPolling(ctx context.Co
 		return fmt.Errorf("%w, cannot init AppStatusPolling", err)
 	}
 
-	err = registerCpuStatistics(ctx, appStatusPollingHandler)
+	err = registerCpuStatistics(ctx, appStatusPollingHandler, msc.statusComponentsFactory.networkComponents.LoadMonitor())
 	if err != nil {
 		return err
 	}
@@ -386,7 +386,16 @@ func registerNetStatistics(ctx context.Context, appStatusPollingHandler *appStat
 	})
 }
 
-func registerCpuStatistics(ctx context.Context, appStatusPollingHandler *appStatusPolling.AppStatusPolling) error {
+// registerCpuStatistics samples the CPU load and feeds it to both the status handler and the adaptive antiflood load monitor
+func registerCpuStatistics(
+	ctx context.Context,
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	loadMonitor process.LoadMonitor,
+) error {
+	if check.IfNil(loadMonitor) {
+		return errors.ErrNilLoadMonitor
+	}
+
 	cpuStats, err := machine.NewCpuStatistics()
 	if err != nil {
 		return err
@@ -405,7 +414,9 @@ func registerCpuStatistics(ctx context.Context, appStatusPollingHandler *appStat
 	}()
 
 	return appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
-		appStatusHandler.SetUInt64Value(common.MetricCpuLoadPercent, cpuStats.CpuPercentUsage())
+		cpuLoadPercent := cpuStats.CpuPercentUsage()
+		appStatusHandler.SetUInt64Value(common.MetricCpuLoadPercent, cpuLoadPercent)
+		loadMonitor.SetCpuLoadPercent(cpuLoadPercent)
 	})
 }
 
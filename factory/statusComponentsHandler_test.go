@@ -1,10 +1,16 @@
 package factory_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core/appStatusPolling"
+	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
 
@@ -70,3 +76,35 @@ func TestManagedStatusComponents_CheckSubcomponents(t *testing.T) {
 	err = managedStatusComponents.CheckSubcomponents()
 	require.NoError(t, err)
 }
+
+func TestRegisterCpuStatistics_ShouldFeedTheLoadMonitor(t *testing.T) {
+	t.Parallel()
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(&statusHandlerMock.AppStatusHandlerStub{}, time.Second, log)
+	require.Nil(t, err)
+
+	err = factory.RegisterCpuStatistics(context.Background(), appStatusPollingHandler, nil)
+	require.Equal(t, errors.ErrNilLoadMonitor, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chFed := make(chan struct{}, 1)
+	loadMonitor := &testscommon.LoadMonitorStub{
+		SetCpuLoadPercentCalled: func(_ uint64) {
+			select {
+			case chFed <- struct{}{}:
+			default:
+			}
+		},
+	}
+	err = factory.RegisterCpuStatistics(ctx, appStatusPollingHandler, loadMonitor)
+	require.Nil(t, err)
+	appStatusPollingHandler.Poll(ctx)
+
+	select {
+	case <-chFed:
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "the load monitor was not fed")
+	}
+}
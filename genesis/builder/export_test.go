@@ -0,0 +1,6 @@
+package builder
+
+// ComputeContractAddress -
+func ComputeContractAddress(ownerAddress []byte, ownerNonce uint64, vmType []byte) ([]byte, error) {
+	return computeContractAddress(ownerAddress, ownerNonce, vmType)
+}
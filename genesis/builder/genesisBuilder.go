@@ -0,0 +1,500 @@
+package builder
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/checking"
+	"github.com/ElrondNetwork/elrond-go/genesis/data"
+	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("genesis/builder")
+
+const (
+	// GenesisReportFileName is the file holding the consistency report of the generated genesis
+	GenesisReportFileName = "genesisReport.json"
+	// GenesisFileName is the file holding the genesis accounts
+	GenesisFileName = "genesis.json"
+	// GenesisSmartContractsFileName is the file holding the genesis smart contracts
+	GenesisSmartContractsFileName = "genesisSmartContracts.json"
+
+	keysFolder           = "keys"
+	walletsFolder        = "wallets"
+	nodeFolderPattern    = "node-%d"
+	validatorKeyFileName = "validatorKey.pem"
+	walletKeyFileName    = "walletKey.pem"
+	delegatorKeyPattern  = "delegator-%d.pem"
+	walletKeyPattern     = "wallet-%d.pem"
+	treasuryKeyFileName  = "treasury.pem"
+	delegationOwnerKey   = "delegationOwner.pem"
+	dnsOwnerKey          = "dnsOwner.pem"
+
+	arwenVmType              = "0500"
+	delegationInitParameters = "%validator_sc_address%@03E8@00@030D40@030D40"
+	delegationVersion        = "0.4.*"
+	dnsInitParameters        = "056bc75e2d63100000"
+	dnsVersion               = "0.2.*"
+	jsonIndent               = "  "
+)
+
+// ArgsGenesisBuilder holds the arguments needed to create a genesis builder
+type ArgsGenesisBuilder struct {
+	NumOfShards              uint32
+	NumOfNodesPerShard       uint32
+	NumOfMetachainNodes      uint32
+	ShardConsensusGroupSize  uint32
+	MetaConsensusGroupSize   uint32
+	GenesisMaxNumShards      uint32
+	StartTime                int64
+	RoundDuration            uint64
+	NumOfWallets             uint32
+	InitialBalance           *big.Int
+	NumOfDelegatedNodes      uint32
+	NumOfDelegators          uint32
+	DelegationContractFile   string
+	DNSContractFile          string
+	EntireSupply             *big.Int
+	NodePrice                *big.Int
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	WalletKeyGenerator       crypto.KeyGenerator
+	ValidatorKeyGenerator    crypto.KeyGenerator
+	OutputFolder             string
+}
+
+type genesisBuilder struct {
+	args                  ArgsGenesisBuilder
+	nodesSetupFile        string
+	genesisFile           string
+	smartContractsFile    string
+	delegationAddress     string
+	initialAccounts       []*data.InitialAccount
+	initialNodes          []*sharding.InitialNode
+	initialSmartContracts []*data.InitialSmartContract
+	report                *Report
+}
+
+// NewGenesisBuilder creates a builder able to generate the keys and the genesis configuration files of a local network
+func NewGenesisBuilder(args ArgsGenesisBuilder) (*genesisBuilder, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genesisBuilder{
+		args:               args,
+		nodesSetupFile:     filepath.Join(args.OutputFolder, common.NodesSetupJsonFileName),
+		genesisFile:        filepath.Join(args.OutputFolder, GenesisFileName),
+		smartContractsFile: filepath.Join(args.OutputFolder, GenesisSmartContractsFileName),
+	}, nil
+}
+
+func checkArgs(args ArgsGenesisBuilder) error {
+	if args.NumOfShards < 1 || args.NumOfShards > args.GenesisMaxNumShards {
+		return fmt.Errorf("%w: %d, maximum is %d", genesis.ErrInvalidNumberOfShards, args.NumOfShards, args.GenesisMaxNumShards)
+	}
+	if args.ShardConsensusGroupSize < 1 || args.ShardConsensusGroupSize > args.NumOfNodesPerShard {
+		return fmt.Errorf("%w: shard consensus group size %d for %d nodes per shard",
+			genesis.ErrInvalidConsensusGroupSize, args.ShardConsensusGroupSize, args.NumOfNodesPerShard)
+	}
+	if args.MetaConsensusGroupSize < 1 || args.MetaConsensusGroupSize > args.NumOfMetachainNodes {
+		return fmt.Errorf("%w: metachain consensus group size %d for %d metachain nodes",
+			genesis.ErrInvalidConsensusGroupSize, args.MetaConsensusGroupSize, args.NumOfMetachainNodes)
+	}
+	numOfNodes := args.NumOfShards*args.NumOfNodesPerShard + args.NumOfMetachainNodes
+	if args.NumOfDelegatedNodes > numOfNodes {
+		return fmt.Errorf("%w: %d delegated nodes out of %d nodes", genesis.ErrInvalidNumberOfNodes, args.NumOfDelegatedNodes, numOfNodes)
+	}
+	if args.NumOfDelegatedNodes > 0 {
+		if args.NumOfDelegators < 1 {
+			return genesis.ErrInvalidNumberOfDelegators
+		}
+		if len(args.DelegationContractFile) == 0 {
+			return genesis.ErrMissingDelegationContract
+		}
+	}
+	if args.InitialBalance == nil {
+		return genesis.ErrNilInitialBalance
+	}
+	if args.EntireSupply == nil {
+		return genesis.ErrNilEntireSupply
+	}
+	if args.NodePrice == nil {
+		return genesis.ErrNilInitialNodePrice
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return fmt.Errorf("%w for address", genesis.ErrNilPubkeyConverter)
+	}
+	if check.IfNil(args.ValidatorPubkeyConverter) {
+		return fmt.Errorf("%w for validator", genesis.ErrNilPubkeyConverter)
+	}
+	if check.IfNil(args.WalletKeyGenerator) {
+		return fmt.Errorf("%w for wallets", genesis.ErrNilKeyGenerator)
+	}
+	if check.IfNil(args.ValidatorKeyGenerator) {
+		return fmt.Errorf("%w for validators", genesis.ErrNilKeyGenerator)
+	}
+	if len(args.OutputFolder) == 0 {
+		return genesis.ErrEmptyOutputFolder
+	}
+
+	return nil
+}
+
+// Build generates the keys and the genesis, nodes setup and genesis smart contracts files in the output folder and
+// validates them with the same parsers and checkers used by the node at startup
+func (gb *genesisBuilder) Build() (*Report, error) {
+	gb.initialAccounts = make([]*data.InitialAccount, 0)
+	gb.initialNodes = make([]*sharding.InitialNode, 0)
+	gb.initialSmartContracts = make([]*data.InitialSmartContract, 0)
+	gb.report = newReport(gb.args)
+
+	err := os.MkdirAll(filepath.Join(gb.args.OutputFolder, keysFolder, walletsFolder), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := []func() error{
+		gb.createSmartContracts,
+		gb.createNodes,
+		gb.createDelegators,
+		gb.createWallets,
+		gb.createTreasury,
+		gb.saveConfigFiles,
+		gb.validate,
+	}
+	for _, step := range steps {
+		err = step()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = saveJson(filepath.Join(gb.args.OutputFolder, GenesisReportFileName), gb.report)
+	if err != nil {
+		return nil, err
+	}
+
+	return gb.report, nil
+}
+
+func (gb *genesisBuilder) createSmartContracts() error {
+	if gb.args.NumOfDelegatedNodes > 0 {
+		owner, err := gb.generateWallet(filepath.Join(walletsFolder, delegationOwnerKey))
+		if err != nil {
+			return err
+		}
+
+		vmType, _ := hex.DecodeString(arwenVmType)
+		delegationAddress, err := computeContractAddress(owner, genesisAccountNonce, vmType)
+		if err != nil {
+			return err
+		}
+
+		gb.delegationAddress = gb.args.AddressPubkeyConverter.Encode(delegationAddress)
+		gb.report.DelegationContractAddress = gb.delegationAddress
+		gb.initialSmartContracts = append(gb.initialSmartContracts, &data.InitialSmartContract{
+			Owner:          gb.args.AddressPubkeyConverter.Encode(owner),
+			Filename:       gb.args.DelegationContractFile,
+			VmType:         arwenVmType,
+			InitParameters: delegationInitParameters,
+			Type:           genesis.DelegationType,
+			Version:        delegationVersion,
+		})
+	}
+
+	if len(gb.args.DNSContractFile) > 0 {
+		owner, err := gb.generateWallet(filepath.Join(walletsFolder, dnsOwnerKey))
+		if err != nil {
+			return err
+		}
+
+		gb.initialSmartContracts = append(gb.initialSmartContracts, &data.InitialSmartContract{
+			Owner:          gb.args.AddressPubkeyConverter.Encode(owner),
+			Filename:       gb.args.DNSContractFile,
+			VmType:         arwenVmType,
+			InitParameters: dnsInitParameters,
+			Type:           genesis.DNSType,
+			Version:        dnsVersion,
+		})
+	}
+
+	gb.report.NumOfSmartContracts = len(gb.initialSmartContracts)
+
+	return nil
+}
+
+// createNodes generates the metachain nodes first and then the nodes of each shard, as the nodes setup assigns
+// the initial nodes to shards in the order they are listed. The first nodes are backed by the delegation contract
+// while the others are staked by their own wallets
+func (gb *genesisBuilder) createNodes() error {
+	numOfNodes := gb.args.NumOfShards*gb.args.NumOfNodesPerShard + gb.args.NumOfMetachainNodes
+	for i := uint32(0); i < numOfNodes; i++ {
+		nodeFolder := fmt.Sprintf(nodeFolderPattern, i)
+		pubKey, err := gb.generateKey(gb.args.ValidatorKeyGenerator, gb.args.ValidatorPubkeyConverter, filepath.Join(nodeFolder, validatorKeyFileName))
+		if err != nil {
+			return err
+		}
+
+		initialNode := &sharding.InitialNode{
+			PubKey:  gb.args.ValidatorPubkeyConverter.Encode(pubKey),
+			Address: gb.delegationAddress,
+		}
+		gb.initialNodes = append(gb.initialNodes, initialNode)
+
+		if i < gb.args.NumOfDelegatedNodes {
+			gb.report.NumOfDelegatedNodes++
+			continue
+		}
+
+		address, err := gb.generateWallet(filepath.Join(nodeFolder, walletKeyFileName))
+		if err != nil {
+			return err
+		}
+
+		initialNode.Address = gb.args.AddressPubkeyConverter.Encode(address)
+		gb.addInitialAccount(address, big.NewInt(0).Set(gb.args.NodePrice), nil)
+		gb.report.NumOfStakedNodes++
+	}
+
+	return nil
+}
+
+func (gb *genesisBuilder) createDelegators() error {
+	if gb.args.NumOfDelegatedNodes == 0 {
+		return nil
+	}
+
+	totalDelegated := big.NewInt(0).Mul(gb.args.NodePrice, big.NewInt(int64(gb.args.NumOfDelegatedNodes)))
+	numOfDelegators := big.NewInt(int64(gb.args.NumOfDelegators))
+	delegatedValue := big.NewInt(0).Div(totalDelegated, numOfDelegators)
+	remainder := big.NewInt(0).Mod(totalDelegated, numOfDelegators)
+
+	for i := uint32(0); i < gb.args.NumOfDelegators; i++ {
+		address, err := gb.generateWallet(filepath.Join(walletsFolder, fmt.Sprintf(delegatorKeyPattern, i)))
+		if err != nil {
+			return err
+		}
+
+		value := big.NewInt(0).Set(delegatedValue)
+		if i == 0 {
+			value.Add(value, remainder)
+		}
+
+		gb.addInitialAccount(address, big.NewInt(0), &data.DelegationData{
+			Address: gb.delegationAddress,
+			Value:   value,
+		})
+	}
+
+	return nil
+}
+
+func (gb *genesisBuilder) createWallets() error {
+	for i := uint32(0); i < gb.args.NumOfWallets; i++ {
+		address, err := gb.generateWallet(filepath.Join(walletsFolder, fmt.Sprintf(walletKeyPattern, i)))
+		if err != nil {
+			return err
+		}
+
+		gb.addInitialAccount(address, big.NewInt(0), nil)
+	}
+
+	return nil
+}
+
+// createTreasury adds an account holding the part of the entire supply not allocated to the other accounts, so the
+// generated genesis file matches the entire supply defined in the economics configuration
+func (gb *genesisBuilder) createTreasury() error {
+	remaining := big.NewInt(0).Sub(gb.args.EntireSupply, gb.report.TotalSupply)
+	if remaining.Sign() < 0 {
+		return fmt.Errorf("%w: the generated accounts need %s, entire supply is %s",
+			genesis.ErrEntireSupplyExceeded, gb.report.TotalSupply.String(), gb.args.EntireSupply.String())
+	}
+	if remaining.Sign() == 0 {
+		return nil
+	}
+
+	address, err := gb.generateWallet(filepath.Join(walletsFolder, treasuryKeyFileName))
+	if err != nil {
+		return err
+	}
+
+	gb.initialAccounts = append(gb.initialAccounts, &data.InitialAccount{
+		Address:      gb.args.AddressPubkeyConverter.Encode(address),
+		Supply:       big.NewInt(0).Set(remaining),
+		Balance:      big.NewInt(0).Set(remaining),
+		StakingValue: big.NewInt(0),
+	})
+	gb.report.NumOfAccounts++
+	gb.report.TotalBalance.Add(gb.report.TotalBalance, remaining)
+	gb.report.TotalSupply.Add(gb.report.TotalSupply, remaining)
+	gb.report.TreasuryBalance = remaining
+
+	return nil
+}
+
+func (gb *genesisBuilder) addInitialAccount(address []byte, stakingValue *big.Int, delegation *data.DelegationData) {
+	balance := big.NewInt(0).Set(gb.args.InitialBalance)
+	supply := big.NewInt(0).Add(balance, stakingValue)
+	if delegation != nil {
+		supply.Add(supply, delegation.Value)
+		gb.report.TotalDelegated.Add(gb.report.TotalDelegated, delegation.Value)
+	}
+
+	gb.initialAccounts = append(gb.initialAccounts, &data.InitialAccount{
+		Address:      gb.args.AddressPubkeyConverter.Encode(address),
+		Supply:       supply,
+		Balance:      balance,
+		StakingValue: stakingValue,
+		Delegation:   delegation,
+	})
+
+	gb.report.NumOfAccounts++
+	gb.report.TotalBalance.Add(gb.report.TotalBalance, balance)
+	gb.report.TotalStaked.Add(gb.report.TotalStaked, stakingValue)
+	gb.report.TotalSupply.Add(gb.report.TotalSupply, supply)
+}
+
+func (gb *genesisBuilder) saveConfigFiles() error {
+	nodesSetup := &sharding.NodesSetup{
+		StartTime:                   gb.args.StartTime,
+		RoundDuration:               gb.args.RoundDuration,
+		ConsensusGroupSize:          gb.args.ShardConsensusGroupSize,
+		MinNodesPerShard:            gb.args.NumOfNodesPerShard,
+		MetaChainConsensusGroupSize: gb.args.MetaConsensusGroupSize,
+		MetaChainMinNodes:           gb.args.NumOfMetachainNodes,
+		InitialNodes:                gb.initialNodes,
+	}
+
+	err := saveJson(gb.nodesSetupFile, nodesSetup)
+	if err != nil {
+		return err
+	}
+
+	err = saveJson(gb.genesisFile, gb.initialAccounts)
+	if err != nil {
+		return err
+	}
+
+	err = saveJson(gb.smartContractsFile, gb.initialSmartContracts)
+	if err != nil {
+		return err
+	}
+
+	gb.report.Files = []string{gb.genesisFile, gb.nodesSetupFile, gb.smartContractsFile}
+
+	return nil
+}
+
+// validate reloads the generated files with the components used by the node when creating the genesis block
+func (gb *genesisBuilder) validate() error {
+	accountsParser, err := parsing.NewAccountsParser(
+		gb.genesisFile,
+		gb.args.EntireSupply,
+		gb.args.AddressPubkeyConverter,
+		gb.args.WalletKeyGenerator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w while parsing %s", err, gb.genesisFile)
+	}
+
+	_, err = parsing.NewSmartContractsParser(
+		gb.smartContractsFile,
+		gb.args.AddressPubkeyConverter,
+		gb.args.WalletKeyGenerator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w while parsing %s", err, gb.smartContractsFile)
+	}
+
+	nodesSetup, err := sharding.NewNodesSetup(
+		gb.nodesSetupFile,
+		gb.args.AddressPubkeyConverter,
+		gb.args.ValidatorPubkeyConverter,
+		gb.args.GenesisMaxNumShards,
+	)
+	if err != nil {
+		return fmt.Errorf("%w while parsing %s", err, gb.nodesSetupFile)
+	}
+	if nodesSetup.NumberOfShards() != gb.args.NumOfShards {
+		return fmt.Errorf("%w: nodes setup computed %d shards instead of %d",
+			genesis.ErrInvalidNumberOfShards, nodesSetup.NumberOfShards(), gb.args.NumOfShards)
+	}
+
+	nodesChecker, err := checking.NewNodesSetupChecker(
+		accountsParser,
+		gb.args.NodePrice,
+		gb.args.ValidatorPubkeyConverter,
+		gb.args.ValidatorKeyGenerator,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = nodesChecker.Check(nodesSetup.AllInitialNodes())
+	if err != nil {
+		return fmt.Errorf("%w while checking %s against %s", err, gb.nodesSetupFile, gb.genesisFile)
+	}
+
+	gb.report.fillShards(nodesSetup)
+	log.Debug("generated genesis files are valid", "output folder", gb.args.OutputFolder)
+
+	return nil
+}
+
+func (gb *genesisBuilder) generateWallet(relativePath string) ([]byte, error) {
+	return gb.generateKey(gb.args.WalletKeyGenerator, gb.args.AddressPubkeyConverter, relativePath)
+}
+
+func (gb *genesisBuilder) generateKey(keyGen crypto.KeyGenerator, converter core.PubkeyConverter, relativePath string) ([]byte, error) {
+	sk, pk := keyGen.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	pkBytes, err := pk.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	filename := filepath.Join(gb.args.OutputFolder, keysFolder, relativePath)
+	err = os.MkdirAll(filepath.Dir(filename), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ioutil.WriteFile(filename, encodePemKey(skBytes, converter.Encode(pkBytes)), core.FileModeUserReadWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return pkBytes, nil
+}
+
+func saveJson(filename string, object interface{}) error {
+	buff, err := json.MarshalIndent(object, "", jsonIndent)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, buff, core.FileModeReadWrite)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gb *genesisBuilder) IsInterfaceNil() bool {
+	return gb == nil
+}
@@ -0,0 +1,285 @@
+package builder_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/builder"
+	"github.com/ElrondNetwork/elrond-go/genesis/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var nodePrice = big.NewInt(2500)
+
+var log = logger.GetOrCreate("genesis/builder_test")
+
+func createMockArgsGenesisBuilder(outputFolder string) builder.ArgsGenesisBuilder {
+	addressConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, log)
+	validatorConverter, _ := pubkeyConverter.NewHexPubkeyConverter(96)
+
+	return builder.ArgsGenesisBuilder{
+		NumOfShards:              2,
+		NumOfNodesPerShard:       2,
+		NumOfMetachainNodes:      2,
+		ShardConsensusGroupSize:  2,
+		MetaConsensusGroupSize:   1,
+		GenesisMaxNumShards:      3,
+		RoundDuration:            6000,
+		NumOfWallets:             3,
+		InitialBalance:           big.NewInt(100),
+		EntireSupply:             big.NewInt(1000000),
+		NodePrice:                nodePrice,
+		AddressPubkeyConverter:   addressConverter,
+		ValidatorPubkeyConverter: validatorConverter,
+		WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		OutputFolder:             outputFolder,
+	}
+}
+
+func createContractFile(t *testing.T, name string) string {
+	filename := filepath.Join(t.TempDir(), name)
+	require.Nil(t, ioutil.WriteFile(filename, []byte("wasm code"), core.FileModeReadWrite))
+
+	return filename
+}
+
+func countFiles(t *testing.T, folder string) int {
+	numFiles := 0
+	err := filepath.Walk(folder, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			numFiles++
+		}
+		return err
+	})
+	require.Nil(t, err)
+
+	return numFiles
+}
+
+func TestNewGenesisBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of shards should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.NumOfShards = 4
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.True(t, errors.Is(err, genesis.ErrInvalidNumberOfShards))
+	})
+	t.Run("shard consensus larger than the shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.ShardConsensusGroupSize = 3
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.True(t, errors.Is(err, genesis.ErrInvalidConsensusGroupSize))
+	})
+	t.Run("metachain consensus larger than the metachain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.MetaConsensusGroupSize = 3
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.True(t, errors.Is(err, genesis.ErrInvalidConsensusGroupSize))
+	})
+	t.Run("too many delegated nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.NumOfDelegatedNodes = 7
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.True(t, errors.Is(err, genesis.ErrInvalidNumberOfNodes))
+	})
+	t.Run("delegated nodes without delegators should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.NumOfDelegatedNodes = 1
+		args.DelegationContractFile = "delegation.wasm"
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.Equal(t, genesis.ErrInvalidNumberOfDelegators, err)
+	})
+	t.Run("delegated nodes without delegation contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.NumOfDelegatedNodes = 1
+		args.NumOfDelegators = 1
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.Equal(t, genesis.ErrMissingDelegationContract, err)
+	})
+	t.Run("nil initial balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.InitialBalance = nil
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.Equal(t, genesis.ErrNilInitialBalance, err)
+	})
+	t.Run("nil entire supply should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.EntireSupply = nil
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.Equal(t, genesis.ErrNilEntireSupply, err)
+	})
+	t.Run("nil node price should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.NodePrice = nil
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.Equal(t, genesis.ErrNilInitialNodePrice, err)
+	})
+	t.Run("nil address pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.AddressPubkeyConverter = nil
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.True(t, errors.Is(err, genesis.ErrNilPubkeyConverter))
+	})
+	t.Run("nil validator key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGenesisBuilder("output")
+		args.ValidatorKeyGenerator = nil
+		gb, err := builder.NewGenesisBuilder(args)
+		assert.True(t, check.IfNil(gb))
+		assert.True(t, errors.Is(err, genesis.ErrNilKeyGenerator))
+	})
+	t.Run("empty output folder should error", func(t *testing.T) {
+		t.Parallel()
+
+		gb, err := builder.NewGenesisBuilder(createMockArgsGenesisBuilder(""))
+		assert.True(t, check.IfNil(gb))
+		assert.Equal(t, genesis.ErrEmptyOutputFolder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gb, err := builder.NewGenesisBuilder(createMockArgsGenesisBuilder("output"))
+		assert.False(t, check.IfNil(gb))
+		assert.Nil(t, err)
+	})
+}
+
+func TestComputeContractAddress_ShouldMatchTheGenesisDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, log)
+	owner, _ := converter.Decode("erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80")
+	vmType, _ := hex.DecodeString("0500")
+
+	address, err := builder.ComputeContractAddress(owner, 0, vmType)
+	require.Nil(t, err)
+	assert.Equal(t, "erd1qqqqqqqqqqqqqpgqrchxzx5uu8sv3ceg8nx8cxc0gesezure5awqn46gtd", converter.Encode(address))
+
+	_, err = builder.ComputeContractAddress(owner, 0, []byte("vm type"))
+	assert.Equal(t, genesis.ErrInvalidVmType, err)
+}
+
+func TestGenesisBuilder_BuildWithStakedAndDelegatedNodesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	outputFolder := t.TempDir()
+	args := createMockArgsGenesisBuilder(outputFolder)
+	args.NumOfDelegatedNodes = 3
+	args.NumOfDelegators = 2
+	args.DelegationContractFile = createContractFile(t, "delegation.wasm")
+	args.DNSContractFile = createContractFile(t, "dns.wasm")
+	gb, _ := builder.NewGenesisBuilder(args)
+
+	report, err := gb.Build()
+	require.Nil(t, err)
+
+	assert.Equal(t, 3, report.NumOfStakedNodes)
+	assert.Equal(t, 3, report.NumOfDelegatedNodes)
+	assert.Equal(t, 2, report.NumOfSmartContracts)
+	// 3 node owners, 2 delegators, 3 wallets and the treasury
+	assert.Equal(t, 9, report.NumOfAccounts)
+	assert.Equal(t, big.NewInt(7500), report.TotalStaked)
+	assert.Equal(t, big.NewInt(7500), report.TotalDelegated)
+	assert.Equal(t, args.EntireSupply, report.TotalSupply)
+	assert.Equal(t, big.NewInt(1000000-7500-7500-8*100), report.TreasuryBalance)
+	require.Equal(t, 3, len(report.Shards))
+	assert.Equal(t, builder.ShardReport{ShardID: 0, NumOfEligibleNodes: 2, ConsensusGroupSize: 2}, *report.Shards[0])
+	assert.Equal(t, builder.ShardReport{ShardID: 1, NumOfEligibleNodes: 2, ConsensusGroupSize: 2}, *report.Shards[1])
+	assert.Equal(t, builder.ShardReport{ShardID: core.MetachainShardId, NumOfEligibleNodes: 2, ConsensusGroupSize: 1}, *report.Shards[2])
+
+	nodesSetup := &sharding.NodesSetup{}
+	require.Nil(t, core.LoadJsonFile(nodesSetup, filepath.Join(outputFolder, common.NodesSetupJsonFileName)))
+	require.Equal(t, 6, len(nodesSetup.InitialNodes))
+	for i, node := range nodesSetup.InitialNodes {
+		isDelegated := node.Address == report.DelegationContractAddress
+		assert.Equal(t, i < 3, isDelegated)
+	}
+
+	initialAccounts := make([]*data.InitialAccount, 0)
+	require.Nil(t, core.LoadJsonFile(&initialAccounts, filepath.Join(outputFolder, builder.GenesisFileName)))
+	assert.Equal(t, report.NumOfAccounts, len(initialAccounts))
+	assert.Equal(t, big.NewInt(3750+100), initialAccounts[3].Supply)
+	assert.Equal(t, report.DelegationContractAddress, initialAccounts[3].Delegation.Address)
+
+	savedReport := &builder.Report{}
+	require.Nil(t, core.LoadJsonFile(savedReport, filepath.Join(outputFolder, builder.GenesisReportFileName)))
+	assert.Equal(t, report.TotalSupply, savedReport.TotalSupply)
+
+	// 6 validator keys, 3 node owners, 2 delegators, 3 wallets, the treasury and the 2 contract owners
+	assert.Equal(t, 17, countFiles(t, filepath.Join(outputFolder, "keys")))
+}
+
+func TestGenesisBuilder_BuildShouldErrWhenTheEntireSupplyIsExceeded(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGenesisBuilder(t.TempDir())
+	args.EntireSupply = big.NewInt(1000)
+	gb, _ := builder.NewGenesisBuilder(args)
+
+	report, err := gb.Build()
+	assert.Nil(t, report)
+	assert.True(t, errors.Is(err, genesis.ErrEntireSupplyExceeded))
+}
+
+func TestGenesisBuilder_BuildShouldErrWhenTheDelegationContractIsMissing(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGenesisBuilder(t.TempDir())
+	args.NumOfDelegatedNodes = 1
+	args.NumOfDelegators = 1
+	args.DelegationContractFile = filepath.Join(t.TempDir(), "missing.wasm")
+	gb, _ := builder.NewGenesisBuilder(args)
+
+	report, err := gb.Build()
+	assert.Nil(t, report)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,50 @@
+package builder
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+)
+
+// genesisAccountNonce is the nonce the smart contract owners have when the genesis contracts are deployed
+const genesisAccountNonce = uint64(0)
+
+const nonceBytesLen = 8
+
+// encodePemKey returns the private key in the same PEM format as the one written by the keygenerator tool and
+// loaded by the node
+func encodePemKey(skBytes []byte, encodedPubKey string) []byte {
+	blk := &pem.Block{
+		Type:  "PRIVATE KEY for " + encodedPubKey,
+		Bytes: []byte(hex.EncodeToString(skBytes)),
+	}
+
+	return pem.EncodeToMemory(blk)
+}
+
+// computeContractAddress computes the address of a contract deployed by the provided owner, following the same
+// rules as the blockchain hook used when deploying the genesis smart contracts
+func computeContractAddress(ownerAddress []byte, ownerNonce uint64, vmType []byte) ([]byte, error) {
+	if len(ownerAddress) <= core.ShardIdentiferLen {
+		return nil, genesis.ErrInvalidOwnerAddress
+	}
+	if len(vmType) != core.VMTypeLen {
+		return nil, genesis.ErrInvalidVmType
+	}
+
+	buffNonce := make([]byte, nonceBytesLen)
+	binary.LittleEndian.PutUint64(buffNonce, ownerNonce)
+	addressAndNonce := append(append(make([]byte, 0, len(ownerAddress)+nonceBytesLen), ownerAddress...), buffNonce...)
+	address := keccak.NewKeccak().Compute(string(addressAndNonce))
+
+	prefixMask := make([]byte, core.NumInitCharactersForScAddress-core.VMTypeLen)
+	prefixMask = append(prefixMask, vmType...)
+	copy(address[:core.NumInitCharactersForScAddress], prefixMask)
+	copy(address[len(address)-core.ShardIdentiferLen:], ownerAddress[len(ownerAddress)-core.ShardIdentiferLen:])
+
+	return address, nil
+}
@@ -0,0 +1,72 @@
+package builder
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ShardReport holds the number of initial nodes assigned to a shard
+type ShardReport struct {
+	ShardID            uint32 `json:"shardID"`
+	NumOfEligibleNodes int    `json:"numOfEligibleNodes"`
+	NumOfWaitingNodes  int    `json:"numOfWaitingNodes"`
+	ConsensusGroupSize uint32 `json:"consensusGroupSize"`
+}
+
+// Report holds the consistency report of a generated genesis. It is only produced after the generated files were
+// validated against each other and against the provided entire supply and node price
+type Report struct {
+	NumOfShards               uint32         `json:"numOfShards"`
+	NumOfStakedNodes          int            `json:"numOfStakedNodes"`
+	NumOfDelegatedNodes       int            `json:"numOfDelegatedNodes"`
+	NumOfAccounts             int            `json:"numOfAccounts"`
+	NumOfSmartContracts       int            `json:"numOfSmartContracts"`
+	DelegationContractAddress string         `json:"delegationContractAddress"`
+	NodePrice                 *big.Int       `json:"nodePrice"`
+	EntireSupply              *big.Int       `json:"entireSupply"`
+	TotalSupply               *big.Int       `json:"totalSupply"`
+	TotalBalance              *big.Int       `json:"totalBalance"`
+	TotalStaked               *big.Int       `json:"totalStaked"`
+	TotalDelegated            *big.Int       `json:"totalDelegated"`
+	TreasuryBalance           *big.Int       `json:"treasuryBalance"`
+	Shards                    []*ShardReport `json:"shards"`
+	Files                     []string       `json:"files"`
+}
+
+func newReport(args ArgsGenesisBuilder) *Report {
+	return &Report{
+		NumOfShards:     args.NumOfShards,
+		NodePrice:       big.NewInt(0).Set(args.NodePrice),
+		EntireSupply:    big.NewInt(0).Set(args.EntireSupply),
+		TotalSupply:     big.NewInt(0),
+		TotalBalance:    big.NewInt(0),
+		TotalStaked:     big.NewInt(0),
+		TotalDelegated:  big.NewInt(0),
+		TreasuryBalance: big.NewInt(0),
+		Shards:          make([]*ShardReport, 0),
+	}
+}
+
+func (r *Report) fillShards(nodesSetup *sharding.NodesSetup) {
+	eligible, waiting := nodesSetup.InitialNodesInfo()
+	for shardID, nodes := range eligible {
+		consensusGroupSize := nodesSetup.GetShardConsensusGroupSize()
+		if shardID == core.MetachainShardId {
+			consensusGroupSize = nodesSetup.GetMetaConsensusGroupSize()
+		}
+
+		r.Shards = append(r.Shards, &ShardReport{
+			ShardID:            shardID,
+			NumOfEligibleNodes: len(nodes),
+			NumOfWaitingNodes:  len(waiting[shardID]),
+			ConsensusGroupSize: consensusGroupSize,
+		})
+	}
+
+	sort.Slice(r.Shards, func(i, j int) bool {
+		return r.Shards[i].ShardID < r.Shards[j].ShardID
+	})
+}
@@ -166,3 +166,36 @@ var ErrNilGeneralSettingsConfig = errors.New("nil general settings config")
 
 // ErrNilEpochConfig signals that a nil epoch config was provided
 var ErrNilEpochConfig = errors.New("nil epoch config")
+
+// ErrInvalidNumberOfShards signals that an invalid number of shards was provided
+var ErrInvalidNumberOfShards = errors.New("invalid number of shards")
+
+// ErrInvalidNumberOfNodes signals that an invalid number of nodes was provided
+var ErrInvalidNumberOfNodes = errors.New("invalid number of nodes")
+
+// ErrInvalidConsensusGroupSize signals that an invalid consensus group size was provided
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size")
+
+// ErrInvalidNumberOfDelegators signals that an invalid number of delegators was provided
+var ErrInvalidNumberOfDelegators = errors.New("invalid number of delegators")
+
+// ErrMissingDelegationContract signals that delegated nodes were requested without a delegation contract file
+var ErrMissingDelegationContract = errors.New("missing delegation contract file")
+
+// ErrNilInitialBalance signals that a nil initial balance was provided
+var ErrNilInitialBalance = errors.New("nil initial balance")
+
+// ErrEntireSupplyExceeded signals that the generated genesis accounts need more than the entire supply
+var ErrEntireSupplyExceeded = errors.New("entire supply exceeded")
+
+// ErrEmptyOutputFolder signals that an empty output folder was provided
+var ErrEmptyOutputFolder = errors.New("empty output folder")
+
+// ErrNilGeneralConfig signals that a nil general config was provided
+var ErrNilGeneralConfig = errors.New("nil general config")
+
+// ErrEmptySourceDBPath signals that the genesis fork was enabled without a source database path
+var ErrEmptySourceDBPath = errors.New("empty source database path")
+
+// ErrInvalidStakeDifferenceAddress signals that the genesis fork was enabled with an invalid stake difference address
+var ErrInvalidStakeDifferenceAddress = errors.New("invalid stake difference address")
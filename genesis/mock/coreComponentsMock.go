@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // CoreComponentsMock -
@@ -15,6 +16,8 @@ type CoreComponentsMock struct {
 	TxSignHasherField   hashing.Hasher
 	UInt64ByteSliceConv typeConverters.Uint64ByteSliceConverter
 	AddrPubKeyConv      core.PubkeyConverter
+	ValPubKeyConv       core.PubkeyConverter
+	NodesConfig         sharding.GenesisNodesSetupHandler
 	Chain               string
 	MinTxVersion        uint32
 	StatHandler         core.AppStatusHandler
@@ -50,6 +53,16 @@ func (ccm *CoreComponentsMock) AddressPubKeyConverter() core.PubkeyConverter {
 	return ccm.AddrPubKeyConv
 }
 
+// ValidatorPubKeyConverter -
+func (ccm *CoreComponentsMock) ValidatorPubKeyConverter() core.PubkeyConverter {
+	return ccm.ValPubKeyConv
+}
+
+// GenesisNodesSetup -
+func (ccm *CoreComponentsMock) GenesisNodesSetup() sharding.GenesisNodesSetupHandler {
+	return ccm.NodesConfig
+}
+
 // ChainID -
 func (ccm *CoreComponentsMock) ChainID() string {
 	return ccm.Chain
@@ -24,6 +24,8 @@ type coreComponentsHandler interface {
 	TxMarshalizer() marshal.Marshalizer
 	Hasher() hashing.Hasher
 	AddressPubKeyConverter() core.PubkeyConverter
+	ValidatorPubKeyConverter() core.PubkeyConverter
+	GenesisNodesSetup() sharding.GenesisNodesSetupHandler
 	Uint64ByteSliceConverter() typeConverters.Uint64ByteSliceConverter
 	ChainID() string
 	IsInterfaceNil() bool
@@ -55,6 +57,7 @@ type ArgsGenesisBlockCreator struct {
 	TxLogsProcessor      process.TransactionLogProcessor
 	VirtualMachineConfig config.VirtualMachineConfig
 	HardForkConfig       config.HardforkConfig
+	GeneralConfig        *config.Config
 	TrieStorageManagers  map[string]common.StorageManager
 	SystemSCConfig       config.SystemSmartContractsConfig
 	EpochConfig          *config.EpochConfig
@@ -57,7 +57,21 @@ func NewGenesisBlockCreator(arg ArgsGenesisBlockCreator) (*genesisBlockCreator,
 	}
 	gbc.arg.GenesisNodePrice = big.NewInt(0).Set(nodePrice)
 
+	if isGenesisForkEnabled(gbc.arg) {
+		// the forked chain starts from scratch, only the state is taken from the source chain
+		gbc.arg.HardForkConfig.StartRound = 0
+		gbc.arg.HardForkConfig.StartNonce = 0
+		gbc.arg.HardForkConfig.StartEpoch = 0
+	}
+
 	if mustDoHardForkImportProcess(gbc.arg) {
+		if isGenesisForkEnabled(gbc.arg) {
+			err = gbc.exportForkedState()
+			if err != nil {
+				return nil, fmt.Errorf("%w while exporting the state of the source chain", err)
+			}
+		}
+
 		err = gbc.createHardForkImportHandler()
 		if err != nil {
 			return nil, err
@@ -68,6 +82,10 @@ func NewGenesisBlockCreator(arg ArgsGenesisBlockCreator) (*genesisBlockCreator,
 }
 
 func mustDoHardForkImportProcess(arg ArgsGenesisBlockCreator) bool {
+	if isGenesisForkEnabled(arg) {
+		return arg.StartEpochNum == 0
+	}
+
 	return arg.HardForkConfig.AfterHardFork && arg.StartEpochNum <= arg.HardForkConfig.StartEpoch
 }
 
@@ -197,6 +215,9 @@ func checkArgumentsForBlockCreator(arg ArgsGenesisBlockCreator) error {
 	if arg.EpochConfig == nil {
 		return genesis.ErrNilEpochConfig
 	}
+	if isGenesisForkEnabled(arg) {
+		return checkArgumentsForGenesisFork(arg)
+	}
 
 	return nil
 }
@@ -277,6 +298,14 @@ func (gbc *genesisBlockCreator) CreateGenesisBlocks() (map[uint32]data.HeaderHan
 		return nil, err
 	}
 
+	if mustDoHardForkImportProcess(gbc.arg) && isGenesisForkEnabled(gbc.arg) {
+		// the stakes are replaced before any genesis block is created as the supply difference is moved in a shard
+		err = gbc.replaceForkedStakedData(mapArgsGenesisBlockCreator[core.MetachainShardId])
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if mustDoHardForkImportProcess(gbc.arg) {
 		selfShardID := gbc.arg.ShardCoordinator.SelfId()
 		err = createHardForkBlockProcessors(selfShardID, shardIDs, mapArgsGenesisBlockCreator, mapHardForkBlockProcessor)
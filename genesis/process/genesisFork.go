@@ -0,0 +1,502 @@
+package process
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/process/intermediate"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	triesFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/storing"
+	updateSync "github.com/ElrondNetwork/elrond-go/update/sync"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+
+	hardfork "github.com/ElrondNetwork/elrond-go/update/genesis"
+)
+
+// the storage keys of the delegation manager system smart contract
+const delegationContractsListKey = "delegationContracts"
+const delegationManagementKey = "delegationManagement"
+
+// isGenesisForkEnabled returns true if the genesis blocks have to be created from the state of an existing chain
+func isGenesisForkEnabled(arg ArgsGenesisBlockCreator) bool {
+	return arg.HardForkConfig.GenesisFork.Enabled && !arg.HardForkConfig.AfterHardFork
+}
+
+// replaceForkedStakedData replaces the validators of the source chain with the ones of the local nodes setup. The
+// staking and validator system smart contracts are deployed again on a clean state, dropping the imported keys, and
+// the configured keys are then staked as at a regular genesis. The delegation contracts of the source chain are
+// removed as well, as the nodes they point at are no longer staked. The total supply is kept: the balances of the
+// removed contracts pay for the new stakes and the difference is moved to, or taken from, the stake difference address.
+// It has to be called before the genesis blocks are created, as the stake difference address resides in a shard
+func (gbc *genesisBlockCreator) replaceForkedStakedData(metaArg ArgsGenesisBlockCreator) error {
+	nodesListSplitter, err := intermediate.NewNodesListSplitter(gbc.arg.InitialNodesSetup, gbc.arg.AccountsParser)
+	if err != nil {
+		return err
+	}
+
+	tmpArg := metaArg
+	tmpArg.Accounts = gbc.arg.importHandler.GetAccountsDBForShard(core.MetachainShardId)
+	if check.IfNil(tmpArg.Accounts) {
+		return process.ErrNilAccountsAdapter
+	}
+
+	processors, err := createProcessorsForMetaGenesisBlock(tmpArg, createGenesisConfig())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(processors.vmContainer.Close())
+	}()
+
+	releasedBalance, err := removeForkedDelegationContracts(tmpArg.Accounts, gbc.arg.Core.InternalMarshalizer())
+	if err != nil {
+		return fmt.Errorf("%w while removing the imported delegation contracts", err)
+	}
+
+	for _, address := range [][]byte{vm.StakingSCAddress, vm.ValidatorSCAddress} {
+		balance, errRemove := removeSystemSCAccount(tmpArg.Accounts, address)
+		if errRemove != nil {
+			return fmt.Errorf("%w while removing the imported system smart contract %s", errRemove, hex.EncodeToString(address))
+		}
+		releasedBalance.Add(releasedBalance, balance)
+
+		err = deploySystemSmartContract(tmpArg, processors.txProcessor, address)
+		if err != nil {
+			return fmt.Errorf("%w while deploying the system smart contract %s", err, hex.EncodeToString(address))
+		}
+	}
+
+	err = setStakedData(tmpArg, processors, nodesListSplitter)
+	if err != nil {
+		return err
+	}
+
+	// the stake transactions are processed as cross shard ones, so the validator smart contract now holds exactly
+	// the value required by the new stakes
+	stakedBalance, err := getSystemSCBalance(tmpArg.Accounts, vm.ValidatorSCAddress)
+	if err != nil {
+		return err
+	}
+
+	// the hardfork block creator only reads the root hash, the pending transactions being already committed
+	_, err = tmpArg.Accounts.Commit()
+	if err != nil {
+		return err
+	}
+
+	stakeDifference := big.NewInt(0).Sub(releasedBalance, stakedBalance)
+	err = gbc.moveStakeDifference(stakeDifference)
+	if err != nil {
+		return err
+	}
+
+	log.Info("genesis fork: replaced the staked validators",
+		"num nodes", len(nodesListSplitter.GetAllNodes()),
+		"released balance", releasedBalance,
+		"staked balance", stakedBalance,
+		"stake difference", stakeDifference,
+	)
+
+	return nil
+}
+
+// removeForkedDelegationContracts removes the delegation contracts registered in the delegation manager and resets
+// the manager's contracts list and owners. The delegated stakes are held by the validator smart contract, so the
+// returned value is only the sum of the balances of the removed contracts
+func removeForkedDelegationContracts(accounts state.AccountsAdapter, marshalizer marshal.Marshalizer) (*big.Int, error) {
+	releasedBalance := big.NewInt(0)
+	account, err := accounts.GetExistingAccount(vm.DelegationManagerSCAddress)
+	if err != nil {
+		// the delegation manager was not initialized on the source chain
+		return releasedBalance, nil
+	}
+
+	delegationManager, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	buff, err := delegationManager.DataTrieTracker().RetrieveValue([]byte(delegationContractsListKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(buff) == 0 {
+		return releasedBalance, nil
+	}
+
+	contractsList := &systemSmartContracts.DelegationContractList{}
+	err = marshalizer.Unmarshal(contractsList, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range contractsList.Addresses {
+		// the first address is only the template of the delegation contracts
+		if bytes.Equal(address, vm.FirstDelegationSCAddress) {
+			continue
+		}
+
+		owner, errOwner := getOwnerAddress(accounts, address)
+		if errOwner != nil {
+			return nil, errOwner
+		}
+
+		balance, errRemove := removeSystemSCAccount(accounts, address)
+		if errRemove != nil {
+			return nil, fmt.Errorf("%w for delegation contract %s", errRemove, hex.EncodeToString(address))
+		}
+		releasedBalance.Add(releasedBalance, balance)
+
+		if len(owner) > 0 {
+			err = delegationManager.DataTrieTracker().SaveKeyValue(owner, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = resetDelegationManager(delegationManager, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("genesis fork: removed the imported delegation contracts",
+		"num contracts", len(contractsList.Addresses)-1,
+		"released balance", releasedBalance,
+	)
+
+	return releasedBalance, accounts.SaveAccount(delegationManager)
+}
+
+func resetDelegationManager(delegationManager state.UserAccountHandler, marshalizer marshal.Marshalizer) error {
+	buff, err := marshalizer.Marshal(&systemSmartContracts.DelegationContractList{Addresses: [][]byte{vm.FirstDelegationSCAddress}})
+	if err != nil {
+		return err
+	}
+	err = delegationManager.DataTrieTracker().SaveKeyValue([]byte(delegationContractsListKey), buff)
+	if err != nil {
+		return err
+	}
+
+	buff, err = delegationManager.DataTrieTracker().RetrieveValue([]byte(delegationManagementKey))
+	if err != nil {
+		return err
+	}
+	if len(buff) == 0 {
+		return nil
+	}
+
+	// the last address is kept so that the addresses of the removed contracts are not reused
+	managementData := &systemSmartContracts.DelegationManagement{}
+	err = marshalizer.Unmarshal(managementData, buff)
+	if err != nil {
+		return err
+	}
+	managementData.NumOfContracts = 0
+
+	buff, err = marshalizer.Marshal(managementData)
+	if err != nil {
+		return err
+	}
+
+	return delegationManager.DataTrieTracker().SaveKeyValue([]byte(delegationManagementKey), buff)
+}
+
+func getOwnerAddress(accounts state.AccountsAdapter, address []byte) ([]byte, error) {
+	account, err := accounts.GetExistingAccount(address)
+	if err != nil {
+		return nil, nil
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return userAccount.GetOwnerAddress(), nil
+}
+
+func removeSystemSCAccount(accounts state.AccountsAdapter, address []byte) (*big.Int, error) {
+	account, err := accounts.GetExistingAccount(address)
+	if err != nil {
+		// the system smart contract was not deployed on the source chain
+		return big.NewInt(0), nil
+	}
+
+	balance := big.NewInt(0)
+	userAccount, ok := account.(state.UserAccountHandler)
+	if ok {
+		balance.Set(userAccount.GetBalance())
+	}
+
+	return balance, accounts.RemoveAccount(address)
+}
+
+func getSystemSCBalance(accounts state.AccountsAdapter, address []byte) (*big.Int, error) {
+	account, err := accounts.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return big.NewInt(0).Set(userAccount.GetBalance()), nil
+}
+
+// moveStakeDifference adds the provided value, that can also be negative, to the balance of the stake difference
+// address. The shard holding the address is committed so the genesis block of that shard will include the change
+func (gbc *genesisBlockCreator) moveStakeDifference(value *big.Int) error {
+	address, err := decodeStakeDifferenceAddress(gbc.arg)
+	if err != nil {
+		return err
+	}
+
+	shardID := gbc.arg.ShardCoordinator.ComputeId(address)
+	accounts := gbc.arg.importHandler.GetAccountsDBForShard(shardID)
+	if check.IfNil(accounts) {
+		return fmt.Errorf("%w for shard %d", process.ErrNilAccountsAdapter, shardID)
+	}
+
+	account, err := accounts.LoadAccount(address)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = userAccount.AddToBalance(value)
+	if err != nil {
+		return fmt.Errorf("%w while moving the stake difference %s to %s",
+			err, value.String(), gbc.arg.HardForkConfig.GenesisFork.StakeDifferenceAddress)
+	}
+
+	err = accounts.SaveAccount(userAccount)
+	if err != nil {
+		return err
+	}
+
+	_, err = accounts.Commit()
+
+	return err
+}
+
+func decodeStakeDifferenceAddress(arg ArgsGenesisBlockCreator) ([]byte, error) {
+	converter := arg.Core.AddressPubKeyConverter()
+	address, err := converter.Decode(arg.HardForkConfig.GenesisFork.StakeDifferenceAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", genesis.ErrInvalidStakeDifferenceAddress, err)
+	}
+	if len(address) != converter.Len() {
+		return nil, fmt.Errorf("%w: wrong length %d", genesis.ErrInvalidStakeDifferenceAddress, len(address))
+	}
+
+	return address, nil
+}
+
+func checkArgumentsForGenesisFork(arg ArgsGenesisBlockCreator) error {
+	if arg.GeneralConfig == nil {
+		return genesis.ErrNilGeneralConfig
+	}
+	if len(arg.HardForkConfig.GenesisFork.SourceDBPath) == 0 {
+		return genesis.ErrEmptySourceDBPath
+	}
+	_, err := decodeStakeDifferenceAddress(arg)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// exportForkedState writes the state of the source chain in the import folder, in the same format as the one
+// produced by a hardfork export. The import handler will then load it as it does after a hardfork. The export is
+// skipped if the import folder already holds a previous export, as it happens when the node is restarted
+func (gbc *genesisBlockCreator) exportForkedState() error {
+	importFolder := filepath.Join(gbc.arg.WorkingDir, gbc.arg.HardForkConfig.ImportFolder)
+	keysStorerPath := filepath.Join(importFolder, gbc.arg.HardForkConfig.ImportKeysStorageConfig.DB.FilePath)
+	if _, err := os.Stat(keysStorerPath); err == nil {
+		log.Info("genesis fork: state already exported, skipping export", "folder", importFolder)
+		return nil
+	}
+
+	forkConfig := gbc.arg.HardForkConfig.GenesisFork
+	log.Info("genesis fork: exporting state",
+		"source", forkConfig.SourceDBPath,
+		"epoch", forkConfig.SourceEpoch,
+		"folder", importFolder,
+	)
+
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(forkConfig.SourceDBPath)
+	if err != nil {
+		return err
+	}
+
+	sourceStorers := make([]update.Closer, 0)
+	defer func() {
+		for _, sourceStorer := range sourceStorers {
+			log.LogIfError(sourceStorer.Close())
+		}
+	}()
+
+	generalConfig := gbc.arg.GeneralConfig
+	metaBlockStorer, err := createStorer(
+		generalConfig.MetaBlockStorage,
+		pathManager.PathForEpoch(core.GetShardIDString(core.MetachainShardId), forkConfig.SourceEpoch, ""),
+	)
+	if err != nil {
+		return fmt.Errorf("%w while opening the source metablocks storer", err)
+	}
+	sourceStorers = append(sourceStorers, metaBlockStorer)
+
+	shardIDs := make([]uint32, 0, gbc.arg.ShardCoordinator.NumberOfShards()+1)
+	for shardID := uint32(0); shardID < gbc.arg.ShardCoordinator.NumberOfShards(); shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	accountsDBs := make(map[uint32]state.AccountsAdapter)
+	for _, shardID := range shardIDs {
+		accountsDB, trieStorageManager, errCreate := gbc.createSourceAccountsDB(
+			pathManager,
+			generalConfig.AccountsTrieStorage,
+			shardID,
+			generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+			factoryState.NewAccountCreator(),
+		)
+		if errCreate != nil {
+			return fmt.Errorf("%w while opening the source accounts of shard %d", errCreate, shardID)
+		}
+
+		accountsDBs[shardID] = accountsDB
+		sourceStorers = append(sourceStorers, trieStorageManager)
+	}
+
+	validatorAccountsDB, peerTrieStorageManager, err := gbc.createSourceAccountsDB(
+		pathManager,
+		generalConfig.PeerAccountsTrieStorage,
+		core.MetachainShardId,
+		generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
+		factoryState.NewPeerAccountCreator(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w while opening the source validator accounts", err)
+	}
+	sourceStorers = append(sourceStorers, peerTrieStorageManager)
+
+	stateSyncer, err := updateSync.NewStorageStateSyncer(updateSync.ArgsNewStorageStateSyncer{
+		MetaBlockStorer:     metaBlockStorer,
+		AccountsDBs:         accountsDBs,
+		ValidatorAccountsDB: validatorAccountsDB,
+		Marshalizer:         gbc.arg.Core.InternalMarshalizer(),
+		Hasher:              gbc.arg.Core.Hasher(),
+	})
+	if err != nil {
+		return err
+	}
+
+	keysStorer, err := createStorer(gbc.arg.HardForkConfig.ImportKeysStorageConfig, importFolder)
+	if err != nil {
+		return fmt.Errorf("%w while creating keys storer", err)
+	}
+	keysVals, err := createStorer(gbc.arg.HardForkConfig.ImportStateStorageConfig, importFolder)
+	if err != nil {
+		return fmt.Errorf("%w while creating keys-values storer", err)
+	}
+	hs, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysVals,
+		Marshalizer: gbc.arg.Core.InternalMarshalizer(),
+	})
+	if err != nil {
+		return fmt.Errorf("%w while creating hardfork storer", err)
+	}
+
+	stateExporter, err := hardfork.NewStateExporter(hardfork.ArgsNewStateExporter{
+		ShardCoordinator:         gbc.arg.ShardCoordinator,
+		StateSyncer:              stateSyncer,
+		Marshalizer:              gbc.arg.Core.InternalMarshalizer(),
+		Hasher:                   gbc.arg.Core.Hasher(),
+		HardforkStorer:           hs,
+		ExportFolder:             importFolder,
+		AddressPubKeyConverter:   gbc.arg.Core.AddressPubKeyConverter(),
+		ValidatorPubKeyConverter: gbc.arg.Core.ValidatorPubKeyConverter(),
+		GenesisNodesSetupHandler: gbc.arg.Core.GenesisNodesSetup(),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = stateExporter.ExportAll(forkConfig.SourceEpoch)
+	if err != nil {
+		// a partial export must not be taken as a complete one when the node is restarted
+		log.LogIfError(os.RemoveAll(keysStorerPath))
+		return err
+	}
+
+	return nil
+}
+
+func (gbc *genesisBlockCreator) createSourceAccountsDB(
+	pathManager storage.PathManagerHandler,
+	trieStorageConfig config.StorageConfig,
+	shardID uint32,
+	maxTrieLevelInMem uint,
+	accountFactory state.AccountFactory,
+) (state.AccountsAdapter, common.StorageManager, error) {
+	trieFactory, err := triesFactory.NewTrieFactory(triesFactory.TrieFactoryArgs{
+		Marshalizer: gbc.arg.Core.InternalMarshalizer(),
+		Hasher:      gbc.arg.Core.Hasher(),
+		PathManager: pathManager,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the source tries are only read, so neither pruning nor checkpoints are needed
+	trieStorageManager, tr, err := trieFactory.Create(triesFactory.TrieCreateArgs{
+		TrieStorageConfig: trieStorageConfig,
+		ShardID:           core.GetShardIDString(shardID),
+		MaxTrieLevelInMem: maxTrieLevelInMem,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountsDB, err := state.NewAccountsDB(
+		tr,
+		gbc.arg.Core.Hasher(),
+		gbc.arg.Core.InternalMarshalizer(),
+		accountFactory,
+		disabled.NewDisabledStoragePruningManager(),
+	)
+	if err != nil {
+		log.LogIfError(trieStorageManager.Close())
+		return nil, nil, err
+	}
+
+	return accountsDB, trieStorageManager, nil
+}
@@ -0,0 +1,531 @@
+// +build !race
+
+package process
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/mock"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	triesFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sourceEpoch = uint32(4)
+
+var stakeDifferenceAddress = append(bytes.Repeat([]byte{0xcc}, 31), 0)
+var stakeDifferenceBalance = big.NewInt(100000)
+var delegationContractAddress = append(append([]byte{}, vm.FirstDelegationSCAddress[:31]...), 0)
+var delegationContractBalance = big.NewInt(777)
+
+func createStorageConfig(filePath string) config.StorageConfig {
+	return config.StorageConfig{
+		Cache: config.CacheConfig{
+			Capacity: 100,
+			Type:     "LRU",
+		},
+		DB: config.DBConfig{
+			FilePath:          filePath,
+			Type:              string(storageUnit.LvlDBSerial),
+			BatchDelaySeconds: 1,
+			MaxBatchSize:      1,
+			MaxOpenFiles:      10,
+		},
+	}
+}
+
+func createGenesisForkArgument(t *testing.T) ArgsGenesisBlockCreator {
+	arg := createMockArgument(t, "testdata/genesisTest1.json", &mock.InitialNodesHandlerStub{}, big.NewInt(22000))
+	workingDir := t.TempDir()
+	arg.WorkingDir = workingDir
+	arg.Core.(*mock.CoreComponentsMock).IntMarsh = &marshal.GogoProtoMarshalizer{}
+	arg.Core.(*mock.CoreComponentsMock).ValPubKeyConv = mock.NewPubkeyConverterMock(96)
+	arg.Core.(*mock.CoreComponentsMock).NodesConfig = &testscommon.NodesSetupStub{}
+	var err error
+	arg.Accounts, err = createAccountAdapter(
+		arg.Core.InternalMarshalizer(),
+		arg.Core.Hasher(),
+		factoryState.NewAccountCreator(),
+		arg.TrieStorageManagers[triesFactory.UserAccountTrie],
+	)
+	require.Nil(t, err)
+	arg.GeneralConfig = &config.Config{
+		MetaBlockStorage:        createStorageConfig("MetaBlock"),
+		AccountsTrieStorage:     createStorageConfig("AccountsTrie/MainDB"),
+		PeerAccountsTrieStorage: createStorageConfig("PeerAccountsTrie/MainDB"),
+		StateTriesConfig: config.StateTriesConfig{
+			MaxStateTrieLevelInMemory: 5,
+			MaxPeerTrieLevelInMemory:  5,
+		},
+	}
+	arg.HardForkConfig = config.HardforkConfig{
+		ImportFolder:             "export",
+		ImportStateStorageConfig: createStorageConfig("ExportStateStorage/MainDB"),
+		ImportKeysStorageConfig:  createStorageConfig("ExportKeysStorageConfig/MainDB"),
+		GenesisFork: config.GenesisForkConfig{
+			Enabled:                true,
+			SourceDBPath:           filepath.Join(workingDir, "source"),
+			SourceEpoch:            sourceEpoch,
+			StakeDifferenceAddress: hex.EncodeToString(stakeDifferenceAddress),
+		},
+	}
+
+	return arg
+}
+
+// createSourceChain writes in the source database folder an account in each shard and an epoch start metablock
+// pointing to the resulting root hashes. The provided nodes are staked in the system smart contracts of the metachain
+// next to a delegation contract. The total supply of the source chain is returned along with the created accounts
+func createSourceChain(
+	t *testing.T,
+	gbc *genesisBlockCreator,
+	stakedNodes []sharding.GenesisNodeInfoHandler,
+) (map[uint32][]byte, *big.Int) {
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(gbc.arg.HardForkConfig.GenesisFork.SourceDBPath)
+	require.Nil(t, err)
+
+	generalConfig := gbc.arg.GeneralConfig
+	addresses := make(map[uint32][]byte)
+	rootHashes := make(map[uint32][]byte)
+	totalSupply := big.NewInt(0)
+	for _, shardID := range []uint32{0, 1, core.MetachainShardId} {
+		accountsDB, trieStorageManager, errCreate := gbc.createSourceAccountsDB(
+			pathManager,
+			generalConfig.AccountsTrieStorage,
+			shardID,
+			generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+			factoryState.NewAccountCreator(),
+		)
+		require.Nil(t, errCreate)
+
+		address := make([]byte, 32)
+		address[31] = byte(shardID)
+		account, _ := accountsDB.LoadAccount(address)
+		userAccount := account.(state.UserAccountHandler)
+		_ = userAccount.AddToBalance(big.NewInt(int64(shardID%100) + 1))
+		require.Nil(t, accountsDB.SaveAccount(userAccount))
+		if shardID == gbc.arg.ShardCoordinator.ComputeId(stakeDifferenceAddress) {
+			account, _ = accountsDB.LoadAccount(stakeDifferenceAddress)
+			userAccount = account.(state.UserAccountHandler)
+			_ = userAccount.AddToBalance(stakeDifferenceBalance)
+			require.Nil(t, accountsDB.SaveAccount(userAccount))
+		}
+		if shardID == core.MetachainShardId && len(stakedNodes) > 0 {
+			stakeOnSourceChain(t, gbc, accountsDB, stakedNodes)
+			addDelegationContractOnSourceChain(t, gbc, accountsDB, stakedNodes[0].AddressBytes())
+		}
+		rootHashes[shardID], err = accountsDB.Commit()
+		require.Nil(t, err)
+		totalSupply.Add(totalSupply, computeTotalSupply(t, accountsDB, rootHashes[shardID]))
+		require.Nil(t, trieStorageManager.Close())
+
+		addresses[shardID] = address
+	}
+
+	validatorAccountsDB, trieStorageManager, err := gbc.createSourceAccountsDB(
+		pathManager,
+		generalConfig.PeerAccountsTrieStorage,
+		core.MetachainShardId,
+		generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
+		factoryState.NewPeerAccountCreator(),
+	)
+	require.Nil(t, err)
+	validatorRootHash, err := validatorAccountsDB.RootHash()
+	require.Nil(t, err)
+	require.Nil(t, trieStorageManager.Close())
+
+	metaBlock := &block.MetaBlock{
+		Nonce:                  1000,
+		Round:                  1010,
+		Epoch:                  sourceEpoch,
+		RootHash:               rootHashes[core.MetachainShardId],
+		ValidatorStatsRootHash: validatorRootHash,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{ShardID: 0, RootHash: rootHashes[0], PendingMiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mb")}}},
+				{ShardID: 1, RootHash: rootHashes[1]},
+			},
+		},
+	}
+	buff, err := gbc.arg.Core.InternalMarshalizer().Marshal(metaBlock)
+	require.Nil(t, err)
+
+	metaBlockStorer, err := createStorer(
+		generalConfig.MetaBlockStorage,
+		pathManager.PathForEpoch(core.GetShardIDString(core.MetachainShardId), sourceEpoch, ""),
+	)
+	require.Nil(t, err)
+	require.Nil(t, metaBlockStorer.Put([]byte(core.EpochStartIdentifier(sourceEpoch)), buff))
+	require.Nil(t, metaBlockStorer.Close())
+
+	return addresses, totalSupply
+}
+
+func addDelegationContractOnSourceChain(t *testing.T, gbc *genesisBlockCreator, accountsDB state.AccountsAdapter, owner []byte) {
+	marshalizer := gbc.arg.Core.InternalMarshalizer()
+	account, err := accountsDB.LoadAccount(vm.DelegationManagerSCAddress)
+	require.Nil(t, err)
+	delegationManager := account.(state.UserAccountHandler)
+
+	buff, err := marshalizer.Marshal(&systemSmartContracts.DelegationContractList{
+		Addresses: [][]byte{vm.FirstDelegationSCAddress, delegationContractAddress},
+	})
+	require.Nil(t, err)
+	require.Nil(t, delegationManager.DataTrieTracker().SaveKeyValue([]byte(delegationContractsListKey), buff))
+
+	buff, err = marshalizer.Marshal(&systemSmartContracts.DelegationManagement{
+		NumOfContracts: 1,
+		LastAddress:    delegationContractAddress,
+	})
+	require.Nil(t, err)
+	require.Nil(t, delegationManager.DataTrieTracker().SaveKeyValue([]byte(delegationManagementKey), buff))
+	require.Nil(t, delegationManager.DataTrieTracker().SaveKeyValue(owner, delegationContractAddress))
+	require.Nil(t, accountsDB.SaveAccount(delegationManager))
+
+	account, err = accountsDB.LoadAccount(delegationContractAddress)
+	require.Nil(t, err)
+	delegationContract := account.(state.UserAccountHandler)
+	delegationContract.SetOwnerAddress(owner)
+	require.Nil(t, delegationContract.AddToBalance(delegationContractBalance))
+	require.Nil(t, accountsDB.SaveAccount(delegationContract))
+}
+
+func computeTotalSupply(t *testing.T, accountsDB state.AccountsAdapter, rootHash []byte) *big.Int {
+	leaves, err := accountsDB.GetAllLeaves(rootHash)
+	require.Nil(t, err)
+
+	totalSupply := big.NewInt(0)
+	for leaf := range leaves {
+		account, errGet := accountsDB.GetAccountFromBytes(leaf.Key(), leaf.Value())
+		require.Nil(t, errGet)
+		totalSupply.Add(totalSupply, account.(state.UserAccountHandler).GetBalance())
+	}
+
+	return totalSupply
+}
+
+func stakeOnSourceChain(
+	t *testing.T,
+	gbc *genesisBlockCreator,
+	accountsDB state.AccountsAdapter,
+	stakedNodes []sharding.GenesisNodeInfoHandler,
+) {
+	sourceArg := gbc.arg
+	sourceArg.Accounts = accountsDB
+	sourceArg.ShardCoordinator = &mock.ShardCoordinatorMock{NumOfShards: 2, SelfShardId: core.MetachainShardId}
+	processors, err := createProcessorsForMetaGenesisBlock(sourceArg, createGenesisConfig())
+	require.Nil(t, err)
+	require.Nil(t, deploySystemSmartContracts(sourceArg, processors.txProcessor, processors.systemSCs))
+
+	nodesListSplitter := &mock.NodesListSplitterStub{
+		GetAllNodesCalled: func() []sharding.GenesisNodeInfoHandler {
+			return stakedNodes
+		},
+	}
+	require.Nil(t, setStakedData(sourceArg, processors, nodesListSplitter))
+	require.Nil(t, processors.vmContainer.Close())
+}
+
+func createGenesisNodes(ownerPrefix byte, keys ...byte) []sharding.GenesisNodeInfoHandler {
+	nodes := make([]sharding.GenesisNodeInfoHandler, 0, len(keys))
+	for i, key := range keys {
+		owner := bytes.Repeat([]byte{ownerPrefix}, 32)
+		owner[31] = byte(i % 2)
+		nodes = append(nodes, &mock.GenesisNodeInfoHandlerMock{
+			AssignedShardValue: uint32(i % 2),
+			AddressBytesValue:  owner,
+			PubKeyBytesValue:   bytes.Repeat([]byte{key}, 96),
+		})
+	}
+
+	return nodes
+}
+
+func TestNewGenesisBlockCreator_GenesisForkInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil general config", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGenesisForkArgument(t)
+		arg.GeneralConfig = nil
+		gbc, err := NewGenesisBlockCreator(arg)
+		assert.Nil(t, gbc)
+		assert.ErrorIs(t, err, genesis.ErrNilGeneralConfig)
+	})
+	t.Run("empty source database path", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGenesisForkArgument(t)
+		arg.HardForkConfig.GenesisFork.SourceDBPath = ""
+		gbc, err := NewGenesisBlockCreator(arg)
+		assert.Nil(t, gbc)
+		assert.ErrorIs(t, err, genesis.ErrEmptySourceDBPath)
+	})
+	t.Run("invalid stake difference address", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGenesisForkArgument(t)
+		arg.HardForkConfig.GenesisFork.StakeDifferenceAddress = ""
+		gbc, err := NewGenesisBlockCreator(arg)
+		assert.Nil(t, gbc)
+		assert.ErrorIs(t, err, genesis.ErrInvalidStakeDifferenceAddress)
+
+		arg.HardForkConfig.GenesisFork.StakeDifferenceAddress = "not hex"
+		gbc, err = NewGenesisBlockCreator(arg)
+		assert.Nil(t, gbc)
+		assert.ErrorIs(t, err, genesis.ErrInvalidStakeDifferenceAddress)
+	})
+	t.Run("missing source state", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGenesisForkArgument(t)
+		gbc, err := NewGenesisBlockCreator(arg)
+		assert.Nil(t, gbc)
+		assert.NotNil(t, err)
+
+		keysStorerPath := filepath.Join(arg.WorkingDir, arg.HardForkConfig.ImportFolder, arg.HardForkConfig.ImportKeysStorageConfig.DB.FilePath)
+		_, err = os.Stat(keysStorerPath)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestMustDoHardForkImportProcess_GenesisFork(t *testing.T) {
+	t.Parallel()
+
+	arg := ArgsGenesisBlockCreator{}
+	arg.HardForkConfig.GenesisFork.Enabled = true
+	assert.True(t, mustDoHardForkImportProcess(arg))
+
+	arg.StartEpochNum = 1
+	assert.False(t, mustDoHardForkImportProcess(arg))
+
+	arg.HardForkConfig.AfterHardFork = true
+	arg.HardForkConfig.StartEpoch = 1
+	assert.True(t, mustDoHardForkImportProcess(arg))
+	assert.False(t, isGenesisForkEnabled(arg))
+}
+
+func TestNewGenesisBlockCreator_GenesisForkShouldImportSourceState(t *testing.T) {
+	t.Parallel()
+
+	arg := createGenesisForkArgument(t)
+	arg.HardForkConfig.StartRound = 100
+	arg.HardForkConfig.StartNonce = 100
+	arg.HardForkConfig.StartEpoch = 100
+	addresses, _ := createSourceChain(t, &genesisBlockCreator{arg: arg}, nil)
+
+	gbc, err := NewGenesisBlockCreator(arg)
+	require.Nil(t, err)
+
+	round, nonce, epoch := getGenesisBlocksRoundNonceEpoch(gbc.arg)
+	assert.Equal(t, uint64(0), round)
+	assert.Equal(t, uint64(0), nonce)
+	assert.Equal(t, uint32(0), epoch)
+	assert.Equal(t, uint64(0), gbc.arg.HardForkConfig.StartRound)
+	assert.Equal(t, uint64(0), gbc.arg.HardForkConfig.StartNonce)
+	assert.Equal(t, uint32(0), gbc.arg.HardForkConfig.StartEpoch)
+
+	importFolder := filepath.Join(arg.WorkingDir, arg.HardForkConfig.ImportFolder)
+	_, err = os.Stat(filepath.Join(importFolder, common.NodesSetupJsonFileName))
+	assert.Nil(t, err)
+
+	err = gbc.arg.importHandler.ImportAll()
+	require.Nil(t, err)
+
+	hardForkMetaBlock := gbc.arg.importHandler.GetHardForkMetaBlock()
+	assert.Equal(t, uint64(1000), hardForkMetaBlock.GetNonce())
+	for _, shardData := range hardForkMetaBlock.EpochStart.LastFinalizedHeaders {
+		assert.Equal(t, 0, len(shardData.PendingMiniBlockHeaders))
+	}
+	assert.Equal(t, 0, len(gbc.arg.importHandler.GetMiniBlocks()))
+	assert.Equal(t, 0, len(gbc.arg.importHandler.GetTransactions()))
+
+	for _, shardID := range []uint32{0, 1, core.MetachainShardId} {
+		accountsDB := gbc.arg.importHandler.GetAccountsDBForShard(shardID)
+		require.NotNil(t, accountsDB)
+
+		account, errGet := accountsDB.GetExistingAccount(addresses[shardID])
+		require.Nil(t, errGet)
+		assert.Equal(t, big.NewInt(int64(shardID%100)+1), account.(state.UserAccountHandler).GetBalance())
+	}
+}
+
+// createForkedGenesisBlocks creates a source chain on which the source nodes are staked and then the genesis blocks of
+// a chain forked from it, on which the fork nodes are staked
+func createForkedGenesisBlocks(
+	t *testing.T,
+	sourceNodes []sharding.GenesisNodeInfoHandler,
+	forkNodes []sharding.GenesisNodeInfoHandler,
+) (*genesisBlockCreator, map[uint32]data.HeaderHandler, *big.Int) {
+	arg := createGenesisForkArgument(t)
+	arg.InitialNodesSetup = &mock.InitialNodesHandlerStub{
+		InitialNodesInfoCalled: func() (map[uint32][]sharding.GenesisNodeInfoHandler, map[uint32][]sharding.GenesisNodeInfoHandler) {
+			eligible := make(map[uint32][]sharding.GenesisNodeInfoHandler)
+			for _, node := range forkNodes {
+				eligible[node.AssignedShard()] = append(eligible[node.AssignedShard()], node)
+			}
+
+			return eligible, make(map[uint32][]sharding.GenesisNodeInfoHandler)
+		},
+		MinNumberOfNodesCalled: func() uint32 {
+			return 1
+		},
+	}
+	_, sourceSupply := createSourceChain(t, &genesisBlockCreator{arg: arg}, sourceNodes)
+
+	gbc, err := NewGenesisBlockCreator(arg)
+	require.Nil(t, err)
+	blocks, err := gbc.CreateGenesisBlocks()
+	require.Nil(t, err)
+	require.Equal(t, 3, len(blocks))
+
+	return gbc, blocks, sourceSupply
+}
+
+func createGenesisAccountsDB(t *testing.T, gbc *genesisBlockCreator, rootHash []byte) state.AccountsAdapter {
+	accountsDB, err := createAccountAdapter(
+		gbc.arg.Core.InternalMarshalizer(),
+		gbc.arg.Core.Hasher(),
+		factoryState.NewAccountCreator(),
+		gbc.arg.TrieStorageManagers[triesFactory.UserAccountTrie],
+	)
+	require.Nil(t, err)
+	require.Nil(t, accountsDB.RecreateTrie(rootHash))
+
+	return accountsDB
+}
+
+func TestCreateGenesisBlocks_GenesisForkShouldReplaceTheStakedValidators(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	t.Parallel()
+
+	sourceNodes := createGenesisNodes(0xaa, 1, 2)
+	forkNodes := createGenesisNodes(0xbb, 3, 4)
+	gbc, blocks, _ := createForkedGenesisBlocks(t, sourceNodes, forkNodes)
+
+	// the first epoch start of the forked chain reads the owners of the eligible keys from the staking system
+	// smart contract, so the configured keys have to be found there, while the imported ones must be gone
+	metaArg := gbc.arg
+	metaArg.ShardCoordinator = &mock.ShardCoordinatorMock{NumOfShards: 2, SelfShardId: core.MetachainShardId}
+	metaArg.Accounts = createGenesisAccountsDB(t, gbc, blocks[core.MetachainShardId].GetRootHash())
+
+	processors, err := createProcessorsForMetaGenesisBlock(metaArg, metaArg.EpochConfig.EnableEpochs)
+	require.Nil(t, err)
+	defer func() {
+		_ = processors.vmContainer.Close()
+	}()
+
+	systemVM, err := processors.vmContainer.Get(factory.SystemVirtualMachine)
+	require.Nil(t, err)
+	stakingDataProvider, err := metachain.NewStakingDataProvider(systemVM, nodePrice.String())
+	require.Nil(t, err)
+
+	err = stakingDataProvider.PrepareStakingDataForRewards(map[uint32][][]byte{
+		0: {forkNodes[0].PubKeyBytes()},
+		1: {forkNodes[1].PubKeyBytes()},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0).Mul(nodePrice, big.NewInt(2)), stakingDataProvider.GetTotalStakeEligibleNodes())
+
+	for _, node := range sourceNodes {
+		err = stakingDataProvider.PrepareStakingDataForRewards(map[uint32][][]byte{0: {node.PubKeyBytes()}})
+		assert.NotNil(t, err)
+	}
+
+	validatorSC, err := metaArg.Accounts.GetExistingAccount(vm.ValidatorSCAddress)
+	require.Nil(t, err)
+	expectedBalance := big.NewInt(0).Mul(nodePrice, big.NewInt(int64(len(forkNodes))))
+	assert.Equal(t, expectedBalance, validatorSC.(state.UserAccountHandler).GetBalance())
+
+	// the imported delegation contracts are removed as the nodes they point at are no longer staked
+	_, err = metaArg.Accounts.GetExistingAccount(delegationContractAddress)
+	assert.NotNil(t, err)
+
+	account, err := metaArg.Accounts.GetExistingAccount(vm.DelegationManagerSCAddress)
+	require.Nil(t, err)
+	delegationManager := account.(state.UserAccountHandler)
+	buff, err := delegationManager.DataTrieTracker().RetrieveValue([]byte(delegationContractsListKey))
+	require.Nil(t, err)
+	contractsList := &systemSmartContracts.DelegationContractList{}
+	require.Nil(t, gbc.arg.Core.InternalMarshalizer().Unmarshal(contractsList, buff))
+	assert.Equal(t, [][]byte{vm.FirstDelegationSCAddress}, contractsList.Addresses)
+
+	buff, err = delegationManager.DataTrieTracker().RetrieveValue([]byte(delegationManagementKey))
+	require.Nil(t, err)
+	managementData := &systemSmartContracts.DelegationManagement{}
+	require.Nil(t, gbc.arg.Core.InternalMarshalizer().Unmarshal(managementData, buff))
+	assert.Equal(t, uint32(0), managementData.NumOfContracts)
+	assert.Equal(t, delegationContractAddress, managementData.LastAddress)
+
+	buff, err = delegationManager.DataTrieTracker().RetrieveValue(sourceNodes[0].AddressBytes())
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(buff))
+}
+
+func TestCreateGenesisBlocks_GenesisForkShouldKeepTheTotalSupply(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	t.Parallel()
+
+	t.Run("less nodes on the forked chain", func(t *testing.T) {
+		t.Parallel()
+
+		testGenesisForkTotalSupply(t, createGenesisNodes(0xaa, 1, 2, 3), createGenesisNodes(0xbb, 4, 5))
+	})
+	t.Run("more nodes on the forked chain", func(t *testing.T) {
+		t.Parallel()
+
+		testGenesisForkTotalSupply(t, createGenesisNodes(0xaa, 1, 2), createGenesisNodes(0xbb, 3, 4, 5, 6))
+	})
+}
+
+func testGenesisForkTotalSupply(t *testing.T, sourceNodes []sharding.GenesisNodeInfoHandler, forkNodes []sharding.GenesisNodeInfoHandler) {
+	gbc, blocks, sourceSupply := createForkedGenesisBlocks(t, sourceNodes, forkNodes)
+
+	forkSupply := big.NewInt(0)
+	for _, shardID := range []uint32{0, 1, core.MetachainShardId} {
+		rootHash := blocks[shardID].GetRootHash()
+		accountsDB := createGenesisAccountsDB(t, gbc, rootHash)
+		forkSupply.Add(forkSupply, computeTotalSupply(t, accountsDB, rootHash))
+	}
+	assert.Equal(t, sourceSupply, forkSupply)
+
+	// the stakes of the source nodes and the balance of the delegation contract pay for the stakes of the fork nodes
+	stakeDifference := big.NewInt(int64(len(sourceNodes) - len(forkNodes)))
+	stakeDifference.Mul(stakeDifference, nodePrice)
+	stakeDifference.Add(stakeDifference, delegationContractBalance)
+
+	shardID := gbc.arg.ShardCoordinator.ComputeId(stakeDifferenceAddress)
+	accountsDB := createGenesisAccountsDB(t, gbc, blocks[shardID].GetRootHash())
+	account, err := accountsDB.GetExistingAccount(stakeDifferenceAddress)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0).Add(stakeDifferenceBalance, stakeDifference), account.(state.UserAccountHandler).GetBalance())
+}
@@ -476,6 +476,28 @@ func deploySystemSmartContracts(
 	arg ArgsGenesisBlockCreator,
 	txProcessor process.TransactionProcessor,
 	systemSCs vm.SystemSCContainer,
+) error {
+	systemSCAddresses := make([][]byte, 0)
+	systemSCAddresses = append(systemSCAddresses, systemSCs.Keys()...)
+
+	sort.Slice(systemSCAddresses, func(i, j int) bool {
+		return bytes.Compare(systemSCAddresses[i], systemSCAddresses[j]) < 0
+	})
+
+	for _, address := range systemSCAddresses {
+		err := deploySystemSmartContract(arg, txProcessor, address)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deploySystemSmartContract(
+	arg ArgsGenesisBlockCreator,
+	txProcessor process.TransactionProcessor,
+	address []byte,
 ) error {
 	code := hex.EncodeToString([]byte("deploy"))
 	vmType := hex.EncodeToString(factory.SystemVirtualMachine)
@@ -486,28 +508,16 @@ func deploySystemSmartContracts(
 		Nonce:     0,
 		Value:     big.NewInt(0),
 		RcvAddr:   make([]byte, arg.Core.AddressPubKeyConverter().Len()),
+		SndAddr:   address,
 		GasPrice:  0,
 		GasLimit:  math.MaxUint64,
 		Data:      []byte(deployTxData),
 		Signature: nil,
 	}
 
-	systemSCAddresses := make([][]byte, 0)
-	systemSCAddresses = append(systemSCAddresses, systemSCs.Keys()...)
-
-	sort.Slice(systemSCAddresses, func(i, j int) bool {
-		return bytes.Compare(systemSCAddresses[i], systemSCAddresses[j]) < 0
-	})
-
-	for _, address := range systemSCAddresses {
-		tx.SndAddr = address
-		_, err := txProcessor.ProcessTransaction(tx)
-		if err != nil {
-			return err
-		}
-	}
+	_, err := txProcessor.ProcessTransaction(tx)
 
-	return nil
+	return err
 }
 
 // setStakedData sets the initial staked values to the staking smart contract
@@ -104,3 +104,9 @@ var ErrNilCurrentBlockProvider = errors.New("nil current block provider")
 
 // ErrNilRedundancyHandler signals that a nil redundancy handler was provided
 var ErrNilRedundancyHandler = errors.New("nil redundancy handler")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder was provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrInvalidManagedKeysSendInterval signals that an invalid managed keys send interval has been provided
+var ErrInvalidManagedKeysSendInterval = errors.New("invalid managed keys send interval")
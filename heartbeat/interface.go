@@ -120,3 +120,9 @@ type NodeRedundancyHandler interface {
 	ObserverPrivateKey() crypto.PrivateKey
 	IsInterfaceNil() bool
 }
+
+// ManagedPeersHolder defines the operations of an entity that holds the extra validator keys hosted by the node
+type ManagedPeersHolder interface {
+	GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey
+	IsInterfaceNil() bool
+}
@@ -1,10 +1,12 @@
 package process
 
 import (
+	"encoding/hex"
 	"fmt"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
@@ -33,6 +35,9 @@ type ArgHeartbeatSender struct {
 	HardforkTrigger      heartbeat.HardforkTrigger
 	CurrentBlockProvider heartbeat.CurrentBlockProvider
 	RedundancyHandler    heartbeat.NodeRedundancyHandler
+	ManagedPeersHolder   heartbeat.ManagedPeersHolder
+	// ManagedKeysSendInterval is the time over which the heartbeat messages of the managed keys are spread
+	ManagedKeysSendInterval time.Duration
 }
 
 // Sender periodically sends heartbeat messages on a pubsub topic
@@ -54,6 +59,10 @@ type Sender struct {
 	hardforkTrigger      heartbeat.HardforkTrigger
 	currentBlockProvider heartbeat.CurrentBlockProvider
 	redundancy           heartbeat.NodeRedundancyHandler
+	managedPeersHolder   heartbeat.ManagedPeersHolder
+
+	managedKeysSendInterval time.Duration
+	isSendingForManagedKeys atomic.Flag
 }
 
 // NewSender will create a new sender instance
@@ -88,6 +97,12 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	if check.IfNil(arg.RedundancyHandler) {
 		return nil, heartbeat.ErrNilRedundancyHandler
 	}
+	if check.IfNil(arg.ManagedPeersHolder) {
+		return nil, heartbeat.ErrNilManagedPeersHolder
+	}
+	if arg.ManagedKeysSendInterval <= 0 {
+		return nil, heartbeat.ErrInvalidManagedKeysSendInterval
+	}
 	err := VerifyHeartbeatPropertyLen("application version string", []byte(arg.VersionNumber))
 	if err != nil {
 		return nil, err
@@ -116,6 +131,9 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 		hardforkTrigger:      arg.HardforkTrigger,
 		currentBlockProvider: arg.CurrentBlockProvider,
 		redundancy:           arg.RedundancyHandler,
+		managedPeersHolder:   arg.ManagedPeersHolder,
+
+		managedKeysSendInterval: arg.ManagedKeysSendInterval,
 	}
 
 	return sender, nil
@@ -171,6 +189,81 @@ func (s *Sender) SendHeartbeat() error {
 
 	s.peerMessenger.Broadcast(s.topic, buffToSend)
 
+	s.startSendingHeartbeatsForManagedKeys(nonce)
+
+	return nil
+}
+
+// startSendingHeartbeatsForManagedKeys broadcasts, on a separate go routine, one heartbeat message for each of the
+// extra keys the node is currently managing, so that those keys are reported as online. All these messages share the
+// node's peer ID so they are spread over the managed keys send interval in order to stay under the antiflood limits
+// of the heartbeat topic. A new round is skipped while the previous one is still ongoing. The hardfork payload is
+// only carried by the main heartbeat
+func (s *Sender) startSendingHeartbeatsForManagedKeys(nonce uint64) {
+	managedKeys := s.managedPeersHolder.GetManagedKeysByCurrentNode()
+	if len(managedKeys) == 0 {
+		return
+	}
+
+	wasSending := s.isSendingForManagedKeys.Set()
+	if wasSending {
+		log.Debug("heartbeat messages for managed keys are still being sent, skipping this round")
+		return
+	}
+
+	go func() {
+		s.sendHeartbeatsForManagedKeys(managedKeys, nonce)
+		s.isSendingForManagedKeys.Unset()
+	}()
+}
+
+func (s *Sender) sendHeartbeatsForManagedKeys(managedKeys map[string]crypto.PrivateKey, nonce uint64) {
+	delayBetweenMessages := s.managedKeysSendInterval / time.Duration(len(managedKeys))
+	for pk, sk := range managedKeys {
+		err := s.sendHeartbeatForManagedKey([]byte(pk), sk, nonce)
+		if err != nil {
+			log.Debug("sendHeartbeatForManagedKey", "hex public key", hex.EncodeToString([]byte(pk)), "error", err.Error())
+		}
+
+		time.Sleep(delayBetweenMessages)
+	}
+}
+
+func (s *Sender) sendHeartbeatForManagedKey(pk []byte, sk crypto.PrivateKey, nonce uint64) error {
+	hb := &heartbeatData.Heartbeat{
+		Payload:         []byte(fmt.Sprintf("%v", time.Now())),
+		Pubkey:          pk,
+		ShardID:         s.shardCoordinator.SelfId(),
+		VersionNumber:   s.versionNumber,
+		NodeDisplayName: s.nodeDisplayName,
+		Identity:        s.keyBaseIdentity,
+		Pid:             s.peerMessenger.ID().Bytes(),
+		Nonce:           nonce,
+		PeerSubType:     uint32(s.peerSubType),
+	}
+
+	s.updateMetricsForManagedKey(hb)
+
+	err := verifyLengths(hb)
+	if err != nil {
+		log.Warn("verify hb length for managed key", "error", err.Error())
+		trimLengths(hb)
+	}
+
+	hb.Signature, err = s.peerSignatureHandler.GetPeerSignature(sk, hb.Pid)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("broadcasting message heartbeat message for managed key", "hex public key", hb.Pubkey)
+
+	buffToSend, err := s.marshalizer.Marshal(hb)
+	if err != nil {
+		return err
+	}
+
+	s.peerMessenger.Broadcast(s.topic, buffToSend)
+
 	return nil
 }
 
@@ -227,6 +320,18 @@ func (s *Sender) updateMetrics(hb *heartbeatData.Heartbeat) {
 	s.statusHandler.SetStringValue(common.MetricPeerSubType, subType.String())
 }
 
+func (s *Sender) updateMetricsForManagedKey(hb *heartbeatData.Heartbeat) {
+	result := s.computePeerList(hb.Pubkey)
+
+	nodeType := string(core.NodeTypeValidator)
+	if result == string(common.ObserverList) {
+		nodeType = string(core.NodeTypeObserver)
+	}
+
+	s.statusHandler.SetStringValue(common.GetMetricForManagedKey(common.MetricNodeType, hb.Pubkey), nodeType)
+	s.statusHandler.SetStringValue(common.GetMetricForManagedKey(common.MetricPeerType, hb.Pubkey), result)
+}
+
 func (s *Sender) computePeerList(pubkey []byte) string {
 	peerType, _, err := s.peerTypeProvider.ComputeForPubKey(pubkey)
 	if err != nil {
@@ -6,15 +6,21 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 //------- NewSender
@@ -40,6 +46,9 @@ func createMockArgHeartbeatSender() process.ArgHeartbeatSender {
 		HardforkTrigger:      &mock.HardforkTriggerStub{},
 		CurrentBlockProvider: &mock.CurrentBlockProviderStub{},
 		RedundancyHandler:    &mock.RedundancyHandlerStub{},
+		ManagedPeersHolder:   &testscommon.ManagedPeersHolderStub{},
+
+		ManagedKeysSendInterval: time.Second,
 	}
 }
 
@@ -164,6 +173,28 @@ func TestNewSender_NilRedundancyHandlerShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, heartbeat.ErrNilRedundancyHandler))
 }
 
+func TestNewSender_NilManagedPeersHolderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.ManagedPeersHolder = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.True(t, errors.Is(err, heartbeat.ErrNilManagedPeersHolder))
+}
+
+func TestNewSender_InvalidManagedKeysSendIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.ManagedKeysSendInterval = 0
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidManagedKeysSendInterval))
+}
+
 func TestNewSender_RedundancyHandlerReturnsANilObserverPrivateKeyShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -321,6 +352,165 @@ func TestSender_SendHeartbeatShouldWork(t *testing.T) {
 	assert.True(t, marshalCalled)
 }
 
+func TestSender_SendHeartbeatShouldSendForManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	mainPubKey := []byte("main pub key")
+	managedPrivateKey := &mock.PrivateKeyStub{}
+	managedPubKey := "managed pub key"
+
+	arg := createMockArgHeartbeatSender()
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() (i []byte, e error) {
+					return mainPubKey, nil
+				},
+			}
+		},
+	}
+	arg.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		GetManagedKeysByCurrentNodeCalled: func() map[string]crypto.PrivateKey {
+			return map[string]crypto.PrivateKey{
+				managedPubKey: managedPrivateKey,
+			}
+		},
+	}
+	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{
+		Signer: &mock.SinglesignStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+				if private == managedPrivateKey {
+					return []byte("managed signature"), nil
+				}
+
+				return []byte("main signature"), nil
+			},
+		},
+	}
+	mutSent := sync.Mutex{}
+	sentHeartbeats := make(map[string]*data.Heartbeat)
+	arg.Marshalizer = &mock.MarshalizerStub{
+		MarshalHandler: func(obj interface{}) (i []byte, e error) {
+			hb := obj.(*data.Heartbeat)
+			mutSent.Lock()
+			sentHeartbeats[string(hb.Pubkey)] = hb
+			mutSent.Unlock()
+
+			return nil, nil
+		},
+	}
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (common.PeerType, uint32, error) {
+			if string(pubKey) == managedPubKey {
+				return common.EligibleList, 0, nil
+			}
+
+			return common.ObserverList, 0, nil
+		},
+	}
+	setMetrics := make(map[string]string)
+	arg.StatusHandler = &statusHandlerMock.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			mutSent.Lock()
+			setMetrics[key] = value
+			mutSent.Unlock()
+		},
+	}
+	chBroadcast := make(chan struct{}, 10)
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			chBroadcast <- struct{}{}
+		},
+	}
+	arg.ManagedKeysSendInterval = time.Millisecond
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+	assert.Nil(t, err)
+
+	waitForBroadcasts(t, chBroadcast, 2)
+
+	mutSent.Lock()
+	defer mutSent.Unlock()
+
+	require.Equal(t, 2, len(sentHeartbeats))
+	assert.Equal(t, []byte("main signature"), sentHeartbeats[string(mainPubKey)].Signature)
+	assert.Equal(t, []byte("managed signature"), sentHeartbeats[managedPubKey].Signature)
+	assert.Equal(t, string(common.ObserverList), setMetrics[common.MetricPeerType])
+	assert.Equal(t, string(common.EligibleList), setMetrics[common.GetMetricForManagedKey(common.MetricPeerType, []byte(managedPubKey))])
+	assert.Equal(t, string(core.NodeTypeValidator), setMetrics[common.GetMetricForManagedKey(common.MetricNodeType, []byte(managedPubKey))])
+}
+
+func TestSender_SendHeartbeatShouldSpreadTheManagedKeysOverTheInterval(t *testing.T) {
+	t.Parallel()
+
+	numManagedKeys := 4
+	managedKeys := make(map[string]crypto.PrivateKey)
+	for i := 0; i < numManagedKeys; i++ {
+		managedKeys[fmt.Sprintf("managed pub key %d", i)] = &mock.PrivateKeyStub{}
+	}
+
+	arg := createMockArgHeartbeatSender()
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() (i []byte, e error) {
+					return []byte("main pub key"), nil
+				},
+			}
+		},
+	}
+	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{
+		Signer: &mock.SinglesignStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+				return []byte("signature"), nil
+			},
+		},
+	}
+	arg.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		GetManagedKeysByCurrentNodeCalled: func() map[string]crypto.PrivateKey {
+			return managedKeys
+		},
+	}
+	chBroadcast := make(chan struct{}, 100)
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			chBroadcast <- struct{}{}
+		},
+	}
+	interval := time.Millisecond * 400
+	arg.ManagedKeysSendInterval = interval
+	sender, _ := process.NewSender(arg)
+
+	start := time.Now()
+	err := sender.SendHeartbeat()
+	assert.Nil(t, err)
+
+	// the main heartbeat and the first managed key are sent right away
+	waitForBroadcasts(t, chBroadcast, 2)
+
+	// a new round started while the managed keys are still being sent only broadcasts the main heartbeat
+	err = sender.SendHeartbeat()
+	assert.Nil(t, err)
+
+	waitForBroadcasts(t, chBroadcast, 1+numManagedKeys-1)
+	delayBetweenMessages := interval / time.Duration(numManagedKeys)
+	assert.True(t, time.Since(start) >= delayBetweenMessages*time.Duration(numManagedKeys-1))
+
+	time.Sleep(delayBetweenMessages * 2)
+	assert.Equal(t, 0, len(chBroadcast))
+}
+
+func waitForBroadcasts(tb testing.TB, chBroadcast chan struct{}, numBroadcasts int) {
+	for i := 0; i < numBroadcasts; i++ {
+		select {
+		case <-chBroadcast:
+		case <-time.After(time.Second * 5):
+			require.Fail(tb, "timeout waiting for broadcasts")
+		}
+	}
+}
+
 func TestSender_SendHeartbeatNotABackupNodeShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -9,9 +9,6 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-crypto"
-	"github.com/ElrondNetwork/elrond-go/config"
-	"github.com/ElrondNetwork/elrond-go/factory"
-	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 )
@@ -99,47 +96,7 @@ func startNodesWithCommitBlock(nodes []*testNode, mutex *sync.Mutex, nonceForRou
 			return nil
 		}
 
-		statusComponents := integrationTests.GetDefaultStatusComponents()
-
-		consensusArgs := factory.ConsensusComponentsFactoryArgs{
-			Config: config.Config{
-				Consensus: config.ConsensusConfig{
-					Type: blsConsensusType,
-				},
-				ValidatorPubkeyConverter: config.PubkeyConfig{
-					Length:          96,
-					Type:            "bls",
-					SignatureLength: 48,
-				},
-				TrieSync: config.TrieSyncConfig{
-					NumConcurrentTrieSyncers:  5,
-					MaxHardCapForMissingNodes: 5,
-					TrieSyncerVersion:         2,
-				},
-			},
-			BootstrapRoundIndex: 0,
-			HardforkTrigger:     n.node.GetHardforkTrigger(),
-			CoreComponents:      n.node.GetCoreComponents(),
-			NetworkComponents:   n.node.GetNetworkComponents(),
-			CryptoComponents:    n.node.GetCryptoComponents(),
-			DataComponents:      n.node.GetDataComponents(),
-			ProcessComponents:   n.node.GetProcessComponents(),
-			StateComponents:     n.node.GetStateComponents(),
-			StatusComponents:    statusComponents,
-			IsInImportMode:      n.node.IsInImportMode(),
-		}
-
-		consensusFactory, err := factory.NewConsensusComponentsFactory(consensusArgs)
-		if err != nil {
-			return fmt.Errorf("NewConsensusComponentsFactory failed: %w", err)
-		}
-
-		managedConsensusComponents, err := factory.NewManagedConsensusComponents(consensusFactory)
-		if err != nil {
-			return err
-		}
-
-		err = managedConsensusComponents.Create()
+		_, err := createConsensusComponents(n)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,231 @@
+package consensus
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var _ memp2p.DeliveryHandler = (*faultInjector)(nil)
+
+// rewrittenMessage is a p2p message whose payload was altered while in transit
+type rewrittenMessage struct {
+	p2p.MessageP2P
+	data []byte
+}
+
+// Data returns the altered payload
+func (rm *rewrittenMessage) Data() []byte {
+	return rm.data
+}
+
+type argsFaultInjector struct {
+	faults        []faultDescription
+	seed          int64
+	clock         *virtualClock
+	genesisTime   time.Time
+	roundDuration time.Duration
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+}
+
+// faultInjector sits between the simulated validators and decides, for each message delivery, if the message is
+// dropped, delayed or altered according to the faults active in the current round
+type faultInjector struct {
+	faults        []faultDescription
+	clock         *virtualClock
+	genesisTime   time.Time
+	roundDuration time.Duration
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+
+	mutRandomizer sync.Mutex
+	randomizer    *rand.Rand
+
+	mutPeers    sync.RWMutex
+	peerIndexes map[core.PeerID]int
+
+	numEquivocations uint64
+}
+
+func newFaultInjector(args argsFaultInjector) *faultInjector {
+	return &faultInjector{
+		faults:        args.faults,
+		clock:         args.clock,
+		genesisTime:   args.genesisTime,
+		roundDuration: args.roundDuration,
+		marshalizer:   args.marshalizer,
+		hasher:        args.hasher,
+		randomizer:    rand.New(rand.NewSource(args.seed)),
+		peerIndexes:   make(map[core.PeerID]int),
+	}
+}
+
+func (fi *faultInjector) registerValidator(pid core.PeerID, index int) {
+	fi.mutPeers.Lock()
+	fi.peerIndexes[pid] = index
+	fi.mutPeers.Unlock()
+}
+
+func (fi *faultInjector) currentRound() int64 {
+	elapsed := fi.clock.now().Sub(fi.genesisTime)
+	if elapsed < 0 {
+		return -1
+	}
+
+	return int64(elapsed / fi.roundDuration)
+}
+
+func (fi *faultInjector) activeFaults(round int64) []faultDescription {
+	active := make([]faultDescription, 0)
+	for _, fault := range fi.faults {
+		if round >= fault.FromRound && round <= fault.ToRound {
+			active = append(active, fault)
+		}
+	}
+
+	return active
+}
+
+// HandleDelivery applies the faults active in the current round on the message sent towards the destination peer
+func (fi *faultInjector) HandleDelivery(message p2p.MessageP2P, destination core.PeerID) (p2p.MessageP2P, time.Duration, bool) {
+	fi.mutPeers.RLock()
+	sender, isSenderKnown := fi.peerIndexes[message.Peer()]
+	receiver, isReceiverKnown := fi.peerIndexes[destination]
+	fi.mutPeers.RUnlock()
+
+	if !isSenderKnown || !isReceiverKnown || sender == receiver {
+		return message, 0, true
+	}
+
+	delay := time.Duration(0)
+	for _, fault := range fi.activeFaults(fi.currentRound()) {
+		switch fault.Type {
+		case faultOffline:
+			if containsIndex(fault.Validators, sender) || containsIndex(fault.Validators, receiver) {
+				return nil, 0, false
+			}
+		case faultPartition:
+			if partitionGroup(fault.Groups, sender) != partitionGroup(fault.Groups, receiver) {
+				return nil, 0, false
+			}
+		case faultDrop:
+			if matchesIndex(fault.From, sender) && matchesIndex(fault.To, receiver) && fi.randomFloat() < fault.Probability {
+				return nil, 0, false
+			}
+		case faultDelay:
+			if matchesIndex(fault.Validators, sender) {
+				delay += time.Duration(fault.DelayMs) * time.Millisecond
+			}
+		case faultEquivocatingLeader:
+			// the receivers are split in two halves: the odd ones will see a conflicting block proposal
+			if matchesIndex(fault.Validators, sender) && receiver%2 == 1 {
+				message = fi.equivocate(message)
+			}
+		}
+	}
+
+	return message, delay, true
+}
+
+// equivocate replaces the proposed header from a block proposal message with a conflicting one, for the same round
+func (fi *faultInjector) equivocate(message p2p.MessageP2P) p2p.MessageP2P {
+	cnsMsg := &consensus.Message{}
+	err := fi.marshalizer.Unmarshal(cnsMsg, message.Data())
+	if err != nil {
+		return message
+	}
+
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	if msgType != bls.MtBlockBodyAndHeader && msgType != bls.MtBlockHeader {
+		return message
+	}
+
+	header := &dataBlock.Header{}
+	err = fi.marshalizer.Unmarshal(header, cnsMsg.Header)
+	if err != nil {
+		return message
+	}
+
+	header.TimeStamp++
+	cnsMsg.Header, err = fi.marshalizer.Marshal(header)
+	if err != nil {
+		return message
+	}
+	cnsMsg.BlockHeaderHash = fi.hasher.Compute(string(cnsMsg.Header))
+
+	buff, err := fi.marshalizer.Marshal(cnsMsg)
+	if err != nil {
+		return message
+	}
+
+	atomic.AddUint64(&fi.numEquivocations, 1)
+
+	return &rewrittenMessage{
+		MessageP2P: message,
+		data:       buff,
+	}
+}
+
+func (fi *faultInjector) randomFloat() float64 {
+	fi.mutRandomizer.Lock()
+	defer fi.mutRandomizer.Unlock()
+
+	return fi.randomizer.Float64()
+}
+
+func (fi *faultInjector) equivocationsCount() uint64 {
+	return atomic.LoadUint64(&fi.numEquivocations)
+}
+
+// isOffline returns true if the validator is configured as offline in the provided round
+func (fi *faultInjector) isOffline(index int, round int64) bool {
+	for _, fault := range fi.activeFaults(round) {
+		if fault.Type == faultOffline && containsIndex(fault.Validators, index) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fi *faultInjector) IsInterfaceNil() bool {
+	return fi == nil
+}
+
+func containsIndex(indexes []int, index int) bool {
+	for _, idx := range indexes {
+		if idx == index {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesIndex returns true if the index is in the provided list or if the list is empty (meaning all validators)
+func matchesIndex(indexes []int, index int) bool {
+	return len(indexes) == 0 || containsIndex(indexes, index)
+}
+
+// partitionGroup returns the group of the validator. Validators not listed in any group form a separate group
+func partitionGroup(groups [][]int, index int) int {
+	for i, group := range groups {
+		if containsIndex(group, index) {
+			return i
+		}
+	}
+
+	return -1
+}
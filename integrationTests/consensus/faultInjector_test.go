@@ -0,0 +1,205 @@
+package consensus
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRoundDuration = time.Second
+
+func createFaultInjectorInRound(round int64, faults ...faultDescription) *faultInjector {
+	clock := newVirtualClock(simulationGenesisTime.Add(time.Duration(round)*testRoundDuration + time.Millisecond))
+	injector := newFaultInjector(argsFaultInjector{
+		faults:        faults,
+		seed:          1,
+		clock:         clock,
+		genesisTime:   simulationGenesisTime,
+		roundDuration: testRoundDuration,
+		marshalizer:   &marshal.GogoProtoMarshalizer{},
+		hasher:        createHasher(blsConsensusType),
+	})
+
+	for i := 0; i < 4; i++ {
+		injector.registerValidator(testPid(i), i)
+	}
+
+	return injector
+}
+
+func testPid(index int) core.PeerID {
+	return core.PeerID([]byte{byte('a' + index)})
+}
+
+func createMessageFrom(index int, data []byte) p2p.MessageP2P {
+	return &mock.P2PMessageMock{
+		DataField:  data,
+		PeerField:  testPid(index),
+		TopicField: "consensus",
+	}
+}
+
+func isDelivered(injector *faultInjector, from int, to int) bool {
+	_, _, shouldDeliver := injector.HandleDelivery(createMessageFrom(from, []byte("data")), testPid(to))
+	return shouldDeliver
+}
+
+func TestFaultInjector_NoActiveFaultsShouldDeliverUnaltered(t *testing.T) {
+	t.Parallel()
+
+	injector := createFaultInjectorInRound(1, faultDescription{Type: faultOffline, FromRound: 2, ToRound: 3, Validators: []int{0}})
+	msg := createMessageFrom(0, []byte("data"))
+
+	delivered, delay, shouldDeliver := injector.HandleDelivery(msg, testPid(1))
+	assert.True(t, shouldDeliver)
+	assert.Equal(t, time.Duration(0), delay)
+	assert.True(t, delivered == msg)
+}
+
+func TestFaultInjector_OfflineValidatorShouldBeIsolated(t *testing.T) {
+	t.Parallel()
+
+	injector := createFaultInjectorInRound(2, faultDescription{Type: faultOffline, FromRound: 2, ToRound: 3, Validators: []int{0}})
+
+	assert.False(t, isDelivered(injector, 0, 1))
+	assert.False(t, isDelivered(injector, 1, 0))
+	assert.True(t, isDelivered(injector, 1, 2))
+	assert.True(t, isDelivered(injector, 0, 0))
+	assert.True(t, injector.isOffline(0, 3))
+	assert.False(t, injector.isOffline(0, 4))
+}
+
+func TestFaultInjector_PartitionShouldDropMessagesBetweenGroups(t *testing.T) {
+	t.Parallel()
+
+	injector := createFaultInjectorInRound(3, faultDescription{Type: faultPartition, FromRound: 3, ToRound: 3, Groups: [][]int{{0}, {1}}})
+
+	assert.False(t, isDelivered(injector, 0, 1))
+	assert.False(t, isDelivered(injector, 0, 2))
+	assert.False(t, isDelivered(injector, 1, 3))
+	assert.True(t, isDelivered(injector, 2, 3))
+}
+
+func TestFaultInjector_DropShouldFollowProbabilityAndDirection(t *testing.T) {
+	t.Parallel()
+
+	injector := createFaultInjectorInRound(1, faultDescription{Type: faultDrop, FromRound: 1, ToRound: 1, From: []int{0}, To: []int{1}, Probability: 1})
+	assert.False(t, isDelivered(injector, 0, 1))
+	assert.True(t, isDelivered(injector, 1, 0))
+	assert.True(t, isDelivered(injector, 0, 2))
+
+	injector = createFaultInjectorInRound(1, faultDescription{Type: faultDrop, FromRound: 1, ToRound: 1, Probability: 0.5})
+	numDelivered := 0
+	numMessages := 1000
+	for i := 0; i < numMessages; i++ {
+		if isDelivered(injector, i%4, (i+1)%4) {
+			numDelivered++
+		}
+	}
+	assert.True(t, numDelivered > numMessages/4 && numDelivered < numMessages*3/4)
+}
+
+func TestFaultInjector_DelaysShouldAccumulate(t *testing.T) {
+	t.Parallel()
+
+	injector := createFaultInjectorInRound(1,
+		faultDescription{Type: faultDelay, FromRound: 1, ToRound: 1, Validators: []int{2}, DelayMs: 100},
+		faultDescription{Type: faultDelay, FromRound: 1, ToRound: 2, DelayMs: 50},
+	)
+
+	_, delay, shouldDeliver := injector.HandleDelivery(createMessageFrom(2, []byte("data")), testPid(0))
+	assert.True(t, shouldDeliver)
+	assert.Equal(t, 150*time.Millisecond, delay)
+
+	_, delay, _ = injector.HandleDelivery(createMessageFrom(1, []byte("data")), testPid(0))
+	assert.Equal(t, 50*time.Millisecond, delay)
+}
+
+func TestFaultInjector_EquivocatingLeaderShouldAlterProposalsForHalfOfTheReceivers(t *testing.T) {
+	t.Parallel()
+
+	injector := createFaultInjectorInRound(1, faultDescription{Type: faultEquivocatingLeader, FromRound: 1, ToRound: 1, Validators: []int{0}})
+	marshalizer := injector.marshalizer
+
+	header := &dataBlock.Header{Round: 1, Nonce: 1, TimeStamp: 10}
+	headerBytes, _ := marshalizer.Marshal(header)
+	headerHash := injector.hasher.Compute(string(headerBytes))
+	cnsMsg := &consensus.Message{
+		MsgType:         int64(bls.MtBlockBodyAndHeader),
+		Header:          headerBytes,
+		BlockHeaderHash: headerHash,
+		RoundIndex:      1,
+	}
+	buff, _ := marshalizer.Marshal(cnsMsg)
+	msg := createMessageFrom(0, buff)
+
+	delivered, _, _ := injector.HandleDelivery(msg, testPid(2))
+	assert.True(t, delivered == msg)
+
+	delivered, _, shouldDeliver := injector.HandleDelivery(msg, testPid(1))
+	require.True(t, shouldDeliver)
+	assert.Equal(t, msg.Peer(), delivered.Peer())
+
+	altered := &consensus.Message{}
+	err := marshalizer.Unmarshal(altered, delivered.Data())
+	require.Nil(t, err)
+	alteredHeader := &dataBlock.Header{}
+	err = marshalizer.Unmarshal(alteredHeader, altered.Header)
+	require.Nil(t, err)
+
+	assert.Equal(t, header.Nonce, alteredHeader.Nonce)
+	assert.Equal(t, header.Round, alteredHeader.Round)
+	assert.NotEqual(t, header.TimeStamp, alteredHeader.TimeStamp)
+	assert.False(t, bytes.Equal(headerHash, altered.BlockHeaderHash))
+	assert.Equal(t, injector.hasher.Compute(string(altered.Header)), altered.BlockHeaderHash)
+	assert.Equal(t, uint64(1), injector.equivocationsCount())
+
+	signatureMsg, _ := marshalizer.Marshal(&consensus.Message{MsgType: int64(bls.MtSignature)})
+	msg = createMessageFrom(0, signatureMsg)
+	delivered, _, _ = injector.HandleDelivery(msg, testPid(1))
+	assert.True(t, delivered == msg)
+}
+
+func TestVirtualSyncTimer_ShouldApplyOffsetOnSharedClock(t *testing.T) {
+	t.Parallel()
+
+	clock := newVirtualClock(simulationGenesisTime)
+	timer := newVirtualSyncTimer(clock, -time.Second)
+	assert.Equal(t, simulationGenesisTime.Add(-time.Second), timer.CurrentTime())
+	assert.Equal(t, -time.Second, timer.ClockOffset())
+
+	clock.advance(10 * time.Millisecond)
+	assert.Equal(t, simulationGenesisTime.Add(10*time.Millisecond), clock.now())
+	assert.Equal(t, time.Second, clock.now().Sub(timer.CurrentTime()))
+}
+
+func TestVirtualClock_AdvanceShouldCallTheDueHandlersInDeadlineOrder(t *testing.T) {
+	t.Parallel()
+
+	clock := newVirtualClock(simulationGenesisTime)
+	calls := make([]string, 0)
+	clock.AfterFunc(30*time.Millisecond, func() { calls = append(calls, "c") })
+	clock.AfterFunc(10*time.Millisecond, func() { calls = append(calls, "a") })
+	clock.AfterFunc(10*time.Millisecond, func() { calls = append(calls, "b") })
+	clock.AfterFunc(50*time.Millisecond, func() { calls = append(calls, "d") })
+
+	clock.advance(5 * time.Millisecond)
+	assert.Empty(t, calls)
+
+	clock.advance(25 * time.Millisecond)
+	assert.Equal(t, []string{"a", "b", "c"}, calls)
+
+	clock.AfterFunc(10*time.Millisecond, func() { calls = append(calls, "e") })
+	clock.advance(time.Second)
+	assert.Equal(t, []string{"a", "b", "c", "e", "d"}, calls)
+}
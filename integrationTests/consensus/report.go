@@ -0,0 +1,339 @@
+package consensus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// validatorReport holds the activity of one validator during the simulation and its rating impact
+type validatorReport struct {
+	Index            int    `json:"index"`
+	PubKey           string `json:"pubKey"`
+	ProposedBlocks   uint32 `json:"proposedBlocks"`
+	MissedProposals  uint32 `json:"missedProposals"`
+	SignedBlocks     uint32 `json:"signedBlocks"`
+	MissedSignatures uint32 `json:"missedSignatures"`
+	CommittedBlocks  uint32 `json:"committedBlocks"`
+	StartRating      uint32 `json:"startRating"`
+	FinalRating      uint32 `json:"finalRating"`
+	RatingDelta      int64  `json:"ratingDelta"`
+}
+
+// conflictingCommit signals that different blocks were committed for the same nonce
+type conflictingCommit struct {
+	Nonce  uint64   `json:"nonce"`
+	Hashes []string `json:"hashes"`
+}
+
+// simulationReport holds the liveness, safety and rating results of a simulation
+type simulationReport struct {
+	Scenario                         string               `json:"scenario"`
+	NumRounds                        int64                `json:"numRounds"`
+	CommittedBlocks                  uint64               `json:"committedBlocks"`
+	RoundsWithoutBlock               []int64              `json:"roundsWithoutBlock"`
+	MaxConsecutiveRoundsWithoutBlock uint64               `json:"maxConsecutiveRoundsWithoutBlock"`
+	ConflictingCommits               []*conflictingCommit `json:"conflictingCommits"`
+	NumEquivocatedMessages           uint64               `json:"numEquivocatedMessages"`
+	Validators                       []*validatorReport   `json:"validators"`
+}
+
+type canonicalBlock struct {
+	hash   []byte
+	header data.HeaderHandler
+}
+
+type validatorRating struct {
+	rating            uint32
+	consecutiveMisses uint32
+}
+
+func (cs *consensusSimulator) createReport() (*simulationReport, error) {
+	commits := cs.getCommits()
+
+	report := &simulationReport{
+		Scenario:               cs.scenario.Name,
+		NumRounds:              cs.scenario.NumRounds,
+		RoundsWithoutBlock:     make([]int64, 0),
+		ConflictingCommits:     findConflictingCommits(commits),
+		NumEquivocatedMessages: cs.injector.equivocationsCount(),
+		Validators:             make([]*validatorReport, len(cs.nodes)),
+	}
+
+	for idx, n := range cs.nodes {
+		pk, _ := n.pk.ToByteArray()
+		report.Validators[idx] = &validatorReport{
+			Index:  idx,
+			PubKey: hex.EncodeToString(pk),
+		}
+	}
+	for _, commit := range commits {
+		if commit.round >= 1 && commit.round <= cs.scenario.NumRounds {
+			report.Validators[commit.validator].CommittedBlocks++
+		}
+	}
+
+	blocks := selectCanonicalBlocks(commits)
+	consecutiveMisses := uint64(0)
+	for round := int64(1); round <= cs.scenario.NumRounds; round++ {
+		_, found := blocks[round]
+		if found {
+			report.CommittedBlocks++
+			consecutiveMisses = 0
+			continue
+		}
+
+		report.RoundsWithoutBlock = append(report.RoundsWithoutBlock, round)
+		consecutiveMisses++
+		if consecutiveMisses > report.MaxConsecutiveRoundsWithoutBlock {
+			report.MaxConsecutiveRoundsWithoutBlock = consecutiveMisses
+		}
+	}
+
+	err := cs.computeRatings(report, blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// selectCanonicalBlocks returns, for each round, the block committed by most validators
+func selectCanonicalBlocks(commits []*commitRecord) map[int64]*canonicalBlock {
+	votes := make(map[int64]map[string]int)
+	blocks := make(map[int64]*canonicalBlock)
+	for _, commit := range commits {
+		roundVotes, found := votes[commit.round]
+		if !found {
+			roundVotes = make(map[string]int)
+			votes[commit.round] = roundVotes
+		}
+		roundVotes[string(commit.hash)]++
+
+		current, found := blocks[commit.round]
+		if !found || roundVotes[string(commit.hash)] > roundVotes[string(current.hash)] {
+			blocks[commit.round] = &canonicalBlock{
+				hash:   commit.hash,
+				header: commit.header,
+			}
+		}
+	}
+
+	return blocks
+}
+
+func findConflictingCommits(commits []*commitRecord) []*conflictingCommit {
+	hashesByNonce := make(map[uint64][][]byte)
+	for _, commit := range commits {
+		hashes := hashesByNonce[commit.nonce]
+		if !containsHash(hashes, commit.hash) {
+			hashesByNonce[commit.nonce] = append(hashes, commit.hash)
+		}
+	}
+
+	conflicts := make([]*conflictingCommit, 0)
+	for nonce, hashes := range hashesByNonce {
+		if len(hashes) < 2 {
+			continue
+		}
+
+		conflict := &conflictingCommit{
+			Nonce: nonce,
+		}
+		for _, hash := range hashes {
+			conflict.Hashes = append(conflict.Hashes, hex.EncodeToString(hash))
+		}
+		conflicts = append(conflicts, conflict)
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Nonce < conflicts[j].Nonce
+	})
+
+	return conflicts
+}
+
+func containsHash(hashes [][]byte, hash []byte) bool {
+	for _, h := range hashes {
+		if bytes.Equal(h, hash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// computeRatings replays the rating rules on the canonical chain: the consensus group of each round is computed
+// from the randomness of the previous block, the leader is rewarded or penalized depending on the round outcome and
+// the group members depending on their presence in the signers bitmap
+func (cs *consensusSimulator) computeRatings(report *simulationReport, blocks map[int64]*canonicalBlock) error {
+	ratingsConfig, err := common.LoadRatingsConfig(cs.ratingsConfig)
+	if err != nil {
+		return err
+	}
+
+	ratingsData, err := rating.NewRatingsData(rating.RatingsDataArg{
+		Config:                   *ratingsConfig,
+		ShardConsensusSize:       uint32(cs.scenario.ConsensusSize),
+		MetaConsensusSize:        1,
+		ShardMinNodes:            uint32(cs.scenario.NumValidators),
+		MetaMinNodes:             1,
+		RoundDurationMiliseconds: cs.scenario.RoundDurationMs,
+	})
+	if err != nil {
+		return err
+	}
+	rater, err := rating.NewBlockSigningRater(ratingsData)
+	if err != nil {
+		return err
+	}
+
+	indexes := make(map[string]int)
+	ratings := make([]*validatorRating, len(cs.nodes))
+	for idx, n := range cs.nodes {
+		pk, _ := n.pk.ToByteArray()
+		indexes[string(pk)] = idx
+		ratings[idx] = &validatorRating{rating: rater.GetStartRating()}
+	}
+
+	shardID := cs.nodes[0].shardId
+	nodesCoordinator := cs.nodes[0].nodesCoordinator
+	lastHeader := cs.nodes[0].blkc.GetGenesisHeader()
+	for round := int64(1); round <= cs.scenario.NumRounds; round++ {
+		group, errCompute := nodesCoordinator.ComputeConsensusGroup(lastHeader.GetRandSeed(), uint64(round), shardID, lastHeader.GetEpoch())
+		if errCompute != nil {
+			return errCompute
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		leader, found := indexes[string(group[0].PubKey())]
+		if !found {
+			return fmt.Errorf("unknown leader in round %d", round)
+		}
+
+		block, found := blocks[round]
+		if !found {
+			leaderRating := ratings[leader]
+			leaderRating.consecutiveMisses++
+			leaderRating.rating = rater.ComputeDecreaseProposer(shardID, leaderRating.rating, leaderRating.consecutiveMisses)
+			report.Validators[leader].MissedProposals++
+			continue
+		}
+
+		ratings[leader].consecutiveMisses = 0
+		ratings[leader].rating = rater.ComputeIncreaseProposer(shardID, ratings[leader].rating)
+		report.Validators[leader].ProposedBlocks++
+		cs.rateSigners(report, ratings, indexes, rater, group, block.header)
+
+		lastHeader = block.header
+	}
+
+	for idx, r := range ratings {
+		validator := report.Validators[idx]
+		validator.StartRating = rater.GetStartRating()
+		validator.FinalRating = r.rating
+		validator.RatingDelta = int64(r.rating) - int64(validator.StartRating)
+	}
+
+	return nil
+}
+
+func (cs *consensusSimulator) rateSigners(
+	report *simulationReport,
+	ratings []*validatorRating,
+	indexes map[string]int,
+	rater sharding.PeerAccountListAndRatingHandler,
+	group []sharding.Validator,
+	header data.HeaderHandler,
+) {
+	if check.IfNil(header) {
+		return
+	}
+
+	bitmap := header.GetPubKeysBitmap()
+	shardID := header.GetShardID()
+	for i, member := range group {
+		idx, found := indexes[string(member.PubKey())]
+		if !found {
+			continue
+		}
+
+		if isBitSet(bitmap, i) {
+			ratings[idx].rating = rater.ComputeIncreaseValidator(shardID, ratings[idx].rating)
+			report.Validators[idx].SignedBlocks++
+			continue
+		}
+
+		ratings[idx].rating = rater.ComputeDecreaseValidator(shardID, ratings[idx].rating)
+		report.Validators[idx].MissedSignatures++
+	}
+}
+
+func isBitSet(bitmap []byte, index int) bool {
+	byteIndex := index / 8
+	if byteIndex >= len(bitmap) {
+		return false
+	}
+
+	return bitmap[byteIndex]&(1<<uint(index%8)) != 0
+}
+
+// checkExpectations returns the list of expectations from the scenario that are not met by the report
+func (sr *simulationReport) checkExpectations(expectations scenarioExpectations) []string {
+	violations := make([]string, 0)
+
+	if expectations.MinCommittedBlocks != nil && sr.CommittedBlocks < *expectations.MinCommittedBlocks {
+		violations = append(violations, fmt.Sprintf("committed blocks: expected at least %d, got %d",
+			*expectations.MinCommittedBlocks, sr.CommittedBlocks))
+	}
+	numRoundsWithoutBlock := uint64(len(sr.RoundsWithoutBlock))
+	if expectations.MinRoundsWithoutBlock != nil && numRoundsWithoutBlock < *expectations.MinRoundsWithoutBlock {
+		violations = append(violations, fmt.Sprintf("rounds without block: expected at least %d, got %d",
+			*expectations.MinRoundsWithoutBlock, numRoundsWithoutBlock))
+	}
+	if expectations.MaxRoundsWithoutBlock != nil && numRoundsWithoutBlock > *expectations.MaxRoundsWithoutBlock {
+		violations = append(violations, fmt.Sprintf("rounds without block: expected at most %d, got %d",
+			*expectations.MaxRoundsWithoutBlock, numRoundsWithoutBlock))
+	}
+	if expectations.MaxConsecutiveRoundsWithoutBlock != nil && sr.MaxConsecutiveRoundsWithoutBlock > *expectations.MaxConsecutiveRoundsWithoutBlock {
+		violations = append(violations, fmt.Sprintf("consecutive rounds without block: expected at most %d, got %d",
+			*expectations.MaxConsecutiveRoundsWithoutBlock, sr.MaxConsecutiveRoundsWithoutBlock))
+	}
+	if !expectations.AllowConflictingCommits && len(sr.ConflictingCommits) > 0 {
+		violations = append(violations, fmt.Sprintf("found %d conflicting commits", len(sr.ConflictingCommits)))
+	}
+	for _, idx := range expectations.RatingDecreased {
+		if sr.Validators[idx].RatingDelta >= 0 {
+			violations = append(violations, fmt.Sprintf("rating of validator %d: expected a decrease, got a delta of %d",
+				idx, sr.Validators[idx].RatingDelta))
+		}
+	}
+	for _, idx := range expectations.RatingNotDecreased {
+		if sr.Validators[idx].RatingDelta < 0 {
+			violations = append(violations, fmt.Sprintf("rating of validator %d: expected no decrease, got a delta of %d",
+				idx, sr.Validators[idx].RatingDelta))
+		}
+	}
+
+	return violations
+}
+
+// String returns the report in a human readable, indented JSON format
+func (sr *simulationReport) String() string {
+	buff, err := json.MarshalIndent(sr, "", "  ")
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(buff)
+}
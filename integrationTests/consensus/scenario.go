@@ -0,0 +1,208 @@
+package consensus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	faultDelay              = "delay"
+	faultDrop               = "drop"
+	faultPartition          = "partition"
+	faultOffline            = "offline"
+	faultEquivocatingLeader = "equivocatingLeader"
+)
+
+var errInvalidScenario = errors.New("invalid scenario")
+
+// simulationScenario describes a consensus simulation: the validators set, the rounds to be played and the faults
+// that are injected during those rounds. It can be loaded from JSON or YAML files
+type simulationScenario struct {
+	Name            string               `json:"name" yaml:"name"`
+	Seed            int64                `json:"seed" yaml:"seed"`
+	NumValidators   int                  `json:"numValidators" yaml:"numValidators"`
+	ConsensusSize   int                  `json:"consensusSize" yaml:"consensusSize"`
+	RoundDurationMs uint64               `json:"roundDurationMs" yaml:"roundDurationMs"`
+	NumRounds       int64                `json:"numRounds" yaml:"numRounds"`
+	SpeedUp         uint64               `json:"speedUp" yaml:"speedUp"`
+	ClockOffsetsMs  map[int]int64        `json:"clockOffsetsMs" yaml:"clockOffsetsMs"`
+	Faults          []faultDescription   `json:"faults" yaml:"faults"`
+	Expectations    scenarioExpectations `json:"expectations" yaml:"expectations"`
+}
+
+// faultDescription describes a fault active between FromRound and ToRound (inclusive). Validators are referred by
+// their index. An empty validators list means that the fault applies to all validators
+type faultDescription struct {
+	Type        string  `json:"type" yaml:"type"`
+	FromRound   int64   `json:"fromRound" yaml:"fromRound"`
+	ToRound     int64   `json:"toRound" yaml:"toRound"`
+	Validators  []int   `json:"validators" yaml:"validators"`
+	From        []int   `json:"from" yaml:"from"`
+	To          []int   `json:"to" yaml:"to"`
+	Probability float64 `json:"probability" yaml:"probability"`
+	DelayMs     uint64  `json:"delayMs" yaml:"delayMs"`
+	Groups      [][]int `json:"groups" yaml:"groups"`
+}
+
+// scenarioExpectations holds the conditions a simulation report has to meet. Nil values are not checked
+type scenarioExpectations struct {
+	MinCommittedBlocks               *uint64 `json:"minCommittedBlocks" yaml:"minCommittedBlocks"`
+	MinRoundsWithoutBlock            *uint64 `json:"minRoundsWithoutBlock" yaml:"minRoundsWithoutBlock"`
+	MaxRoundsWithoutBlock            *uint64 `json:"maxRoundsWithoutBlock" yaml:"maxRoundsWithoutBlock"`
+	MaxConsecutiveRoundsWithoutBlock *uint64 `json:"maxConsecutiveRoundsWithoutBlock" yaml:"maxConsecutiveRoundsWithoutBlock"`
+	AllowConflictingCommits          bool    `json:"allowConflictingCommits" yaml:"allowConflictingCommits"`
+	RatingDecreased                  []int   `json:"ratingDecreased" yaml:"ratingDecreased"`
+	RatingNotDecreased               []int   `json:"ratingNotDecreased" yaml:"ratingNotDecreased"`
+}
+
+func loadScenario(path string) (*simulationScenario, error) {
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return parseScenario(buff, json.Unmarshal)
+	case ".yaml", ".yml":
+		return parseScenario(buff, yaml.UnmarshalStrict)
+	default:
+		return nil, fmt.Errorf("%w: unknown scenario file extension for %s", errInvalidScenario, path)
+	}
+}
+
+func parseScenario(buff []byte, unmarshal func([]byte, interface{}) error) (*simulationScenario, error) {
+	scenario := &simulationScenario{}
+	err := unmarshal(buff, scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scenario.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return scenario, nil
+}
+
+// speedUp returns how many times faster than the wall clock the virtual clock is advanced. The consensus subrounds
+// still wait for their timeouts on the wall clock, so only scenarios without timeouts on the critical path of the
+// block production should be sped up. Unless configured, the scenario is played at the real time pace
+func (s *simulationScenario) speedUp() time.Duration {
+	if s.SpeedUp == 0 {
+		return 1
+	}
+
+	return time.Duration(s.SpeedUp)
+}
+
+func (s *simulationScenario) validate() error {
+	if s.NumValidators < 1 {
+		return fmt.Errorf("%w: numValidators should be positive", errInvalidScenario)
+	}
+	if s.ConsensusSize < 1 || s.ConsensusSize > s.NumValidators {
+		return fmt.Errorf("%w: consensusSize should be between 1 and numValidators", errInvalidScenario)
+	}
+	if s.RoundDurationMs == 0 {
+		return fmt.Errorf("%w: roundDurationMs should be positive", errInvalidScenario)
+	}
+	if s.NumRounds < 1 {
+		return fmt.Errorf("%w: numRounds should be positive", errInvalidScenario)
+	}
+	for idx := range s.ClockOffsetsMs {
+		if !s.isValidatorIndex(idx) {
+			return fmt.Errorf("%w: clock offset defined for unknown validator %d", errInvalidScenario, idx)
+		}
+	}
+
+	for i, fault := range s.Faults {
+		err := s.validateFault(fault)
+		if err != nil {
+			return fmt.Errorf("%w for fault %d", err, i)
+		}
+	}
+
+	err := s.checkValidatorIndexes(s.Expectations.RatingDecreased, s.Expectations.RatingNotDecreased)
+	if err != nil {
+		return fmt.Errorf("%w in expectations", err)
+	}
+
+	return nil
+}
+
+func (s *simulationScenario) validateFault(fault faultDescription) error {
+	if fault.FromRound < 1 || fault.ToRound < fault.FromRound {
+		return fmt.Errorf("%w: invalid rounds interval [%d, %d]", errInvalidScenario, fault.FromRound, fault.ToRound)
+	}
+
+	err := s.checkValidatorIndexes(fault.Validators)
+	if err != nil {
+		return err
+	}
+
+	switch fault.Type {
+	case faultDelay:
+		if fault.DelayMs == 0 {
+			return fmt.Errorf("%w: delayMs should be positive", errInvalidScenario)
+		}
+	case faultDrop:
+		if fault.Probability <= 0 || fault.Probability > 1 {
+			return fmt.Errorf("%w: probability should be in (0, 1]", errInvalidScenario)
+		}
+		err = s.checkValidatorIndexes(fault.From, fault.To)
+		if err != nil {
+			return err
+		}
+	case faultPartition:
+		if len(fault.Groups) < 2 {
+			return fmt.Errorf("%w: a partition needs at least 2 groups", errInvalidScenario)
+		}
+		seen := make(map[int]struct{})
+		for _, group := range fault.Groups {
+			err = s.checkValidatorIndexes(group)
+			if err != nil {
+				return err
+			}
+			for _, idx := range group {
+				_, found := seen[idx]
+				if found {
+					return fmt.Errorf("%w: validator %d is in more than one partition group", errInvalidScenario, idx)
+				}
+				seen[idx] = struct{}{}
+			}
+		}
+	case faultOffline:
+		if len(fault.Validators) == 0 {
+			return fmt.Errorf("%w: offline fault needs at least one validator", errInvalidScenario)
+		}
+	case faultEquivocatingLeader:
+	default:
+		return fmt.Errorf("%w: unknown fault type %s", errInvalidScenario, fault.Type)
+	}
+
+	return nil
+}
+
+func (s *simulationScenario) checkValidatorIndexes(indexesLists ...[]int) error {
+	for _, indexes := range indexesLists {
+		for _, idx := range indexes {
+			if !s.isValidatorIndex(idx) {
+				return fmt.Errorf("%w: unknown validator %d", errInvalidScenario, idx)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *simulationScenario) isValidatorIndex(idx int) bool {
+	return idx >= 0 && idx < s.NumValidators
+}
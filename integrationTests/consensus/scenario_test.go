@@ -0,0 +1,145 @@
+package consensus
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func createValidScenario() *simulationScenario {
+	return &simulationScenario{
+		Name:            "test",
+		NumValidators:   4,
+		ConsensusSize:   4,
+		RoundDurationMs: 1000,
+		NumRounds:       10,
+		Faults: []faultDescription{
+			{
+				Type:       faultOffline,
+				FromRound:  2,
+				ToRound:    3,
+				Validators: []int{1},
+			},
+		},
+	}
+}
+
+func TestParseScenario_YAMLAndJSONShouldBeEquivalent(t *testing.T) {
+	t.Parallel()
+
+	yamlScenario := `
+name: partition
+seed: 3
+numValidators: 4
+consensusSize: 3
+roundDurationMs: 500
+numRounds: 6
+clockOffsetsMs:
+  1: -20
+faults:
+  - type: partition
+    fromRound: 2
+    toRound: 4
+    groups: [[0, 1], [2, 3]]
+expectations:
+  minCommittedBlocks: 2
+  ratingDecreased: [2]
+`
+	jsonScenario := `{
+	"name": "partition",
+	"seed": 3,
+	"numValidators": 4,
+	"consensusSize": 3,
+	"roundDurationMs": 500,
+	"numRounds": 6,
+	"clockOffsetsMs": {"1": -20},
+	"faults": [{"type": "partition", "fromRound": 2, "toRound": 4, "groups": [[0, 1], [2, 3]]}],
+	"expectations": {"minCommittedBlocks": 2, "ratingDecreased": [2]}
+}`
+
+	fromYaml, err := parseScenario([]byte(yamlScenario), yaml.UnmarshalStrict)
+	require.Nil(t, err)
+	fromJson, err := parseScenario([]byte(jsonScenario), json.Unmarshal)
+	require.Nil(t, err)
+
+	assert.Equal(t, fromJson, fromYaml)
+	assert.Equal(t, int64(-20), fromYaml.ClockOffsetsMs[1])
+	assert.Equal(t, [][]int{{0, 1}, {2, 3}}, fromYaml.Faults[0].Groups)
+	assert.Equal(t, uint64(2), *fromYaml.Expectations.MinCommittedBlocks)
+	assert.Nil(t, fromYaml.Expectations.MaxRoundsWithoutBlock)
+}
+
+func TestParseScenario_UnknownYAMLFieldShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseScenario([]byte("numValidator: 4"), yaml.UnmarshalStrict)
+	assert.NotNil(t, err)
+}
+
+func TestLoadScenario_ShippedScenariosShouldBeValid(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{"scenarios/noFaults.yaml", "scenarios/delaysAndDrops.json"} {
+		scenario, err := loadScenario(path)
+		assert.Nil(t, err, path)
+		assert.NotEmpty(t, scenario.Name, path)
+	}
+
+	_, err := loadScenario("scenarios/missing.yaml")
+	assert.NotNil(t, err)
+}
+
+func TestSimulationScenario_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, createValidScenario().validate())
+
+	tests := map[string]func(s *simulationScenario){
+		"no validators":           func(s *simulationScenario) { s.NumValidators = 0 },
+		"consensus too large":     func(s *simulationScenario) { s.ConsensusSize = 5 },
+		"zero round duration":     func(s *simulationScenario) { s.RoundDurationMs = 0 },
+		"no rounds":               func(s *simulationScenario) { s.NumRounds = 0 },
+		"unknown clock offset":    func(s *simulationScenario) { s.ClockOffsetsMs = map[int]int64{4: 10} },
+		"unknown fault type":      func(s *simulationScenario) { s.Faults[0].Type = "meteor" },
+		"fault before round 1":    func(s *simulationScenario) { s.Faults[0].FromRound = 0 },
+		"inverted fault interval": func(s *simulationScenario) { s.Faults[0].ToRound = 1 },
+		"unknown validator":       func(s *simulationScenario) { s.Faults[0].Validators = []int{7} },
+		"offline without nodes":   func(s *simulationScenario) { s.Faults[0].Validators = nil },
+		"zero delay": func(s *simulationScenario) {
+			s.Faults[0] = faultDescription{Type: faultDelay, FromRound: 1, ToRound: 1}
+		},
+		"invalid drop probability": func(s *simulationScenario) {
+			s.Faults[0] = faultDescription{Type: faultDrop, FromRound: 1, ToRound: 1, Probability: 1.5}
+		},
+		"single partition group": func(s *simulationScenario) {
+			s.Faults[0] = faultDescription{Type: faultPartition, FromRound: 1, ToRound: 1, Groups: [][]int{{0, 1}}}
+		},
+		"overlapping partition groups": func(s *simulationScenario) {
+			s.Faults[0] = faultDescription{Type: faultPartition, FromRound: 1, ToRound: 1, Groups: [][]int{{0, 1}, {1, 2}}}
+		},
+		"unknown validator in expectations": func(s *simulationScenario) { s.Expectations.RatingDecreased = []int{-1} },
+	}
+
+	for name, alter := range tests {
+		scenario := createValidScenario()
+		alter(scenario)
+
+		err := scenario.validate()
+		assert.True(t, errors.Is(err, errInvalidScenario), name)
+	}
+}
+
+func TestSimulationScenario_SpeedUp(t *testing.T) {
+	t.Parallel()
+
+	scenario := createValidScenario()
+	assert.Equal(t, time.Duration(1), scenario.speedUp())
+
+	scenario.SpeedUp = 3
+	assert.Equal(t, time.Duration(3), scenario.speedUp())
+}
@@ -0,0 +1,261 @@
+package consensus
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var log = logger.GetOrCreate("integrationtests/consensus")
+
+// the virtual time does not depend on the moment the simulation is run, so the produced blocks are reproducible
+var simulationGenesisTime = time.Unix(1600000000, 0)
+
+const numClockTicksPerRound = 50
+
+type commitRecord struct {
+	validator int
+	round     int64
+	nonce     uint64
+	hash      []byte
+	header    data.HeaderHandler
+}
+
+// consensusSimulator runs a set of BLS validators over an in-memory network, using a virtual clock, while the
+// network faults described by a scenario are injected
+type consensusSimulator struct {
+	scenario      *simulationScenario
+	ratingsConfig string
+	clock         *virtualClock
+	roundDuration time.Duration
+	network       *memp2p.Network
+	injector      *faultInjector
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+	nodes         []*testNode
+	components    []mainFactory.ConsensusComponentsHandler
+
+	mutCommits sync.Mutex
+	commits    []*commitRecord
+}
+
+func newConsensusSimulator(scenario *simulationScenario, ratingsConfigPath string) (*consensusSimulator, error) {
+	err := scenario.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	roundDuration := time.Duration(scenario.RoundDurationMs) * time.Millisecond
+	clock := newVirtualClock(simulationGenesisTime)
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := createHasher(blsConsensusType)
+
+	injector := newFaultInjector(argsFaultInjector{
+		faults:        scenario.Faults,
+		seed:          scenario.Seed,
+		clock:         clock,
+		genesisTime:   simulationGenesisTime,
+		roundDuration: roundDuration,
+		marshalizer:   marshalizer,
+		hasher:        hasher,
+	})
+
+	network := memp2p.NewNetwork()
+	err = network.SetDeliveryHandler(injector)
+	if err != nil {
+		return nil, err
+	}
+	err = network.SetDelayScheduler(clock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consensusSimulator{
+		scenario:      scenario,
+		ratingsConfig: ratingsConfigPath,
+		clock:         clock,
+		roundDuration: roundDuration,
+		network:       network,
+		injector:      injector,
+		marshalizer:   marshalizer,
+		hasher:        hasher,
+		commits:       make([]*commitRecord, 0),
+	}, nil
+}
+
+func (cs *consensusSimulator) createEnvironment() *nodesEnvironment {
+	return &nodesEnvironment{
+		startTime: simulationGenesisTime.Unix(),
+		createMessenger: func(nodeIndex int) p2p.Messenger {
+			messenger, _ := memp2p.NewMessenger(cs.network)
+			cs.injector.registerValidator(messenger.ID(), nodeIndex)
+
+			return messenger
+		},
+		createSyncTimer: func(nodeIndex int) ntp.SyncTimer {
+			offset := time.Duration(cs.scenario.ClockOffsetsMs[nodeIndex]) * time.Millisecond
+			return newVirtualSyncTimer(cs.clock, offset)
+		},
+		connectMessengers: func(_ []p2p.Messenger) {
+			// all the peers from the in-memory network are connected to each other
+		},
+	}
+}
+
+// run plays the scenario and returns the simulation report. The clock is kept frozen at genesis while the nodes
+// are created, so the first round played by all validators is round 1, and is then advanced tick by tick
+func (cs *consensusSimulator) run() (*simulationReport, error) {
+	nodes := createNodesWithEnvironment(
+		cs.scenario.NumValidators,
+		cs.scenario.ConsensusSize,
+		cs.scenario.RoundDurationMs,
+		blsConsensusType,
+		cs.createEnvironment(),
+	)
+	cs.nodes = nodes[0]
+	defer cs.close()
+
+	for idx, n := range cs.nodes {
+		cs.setCommitRecorder(idx, n)
+
+		components, err := createConsensusComponents(n)
+		if err != nil {
+			return nil, fmt.Errorf("%w while starting consensus for validator %d", err, idx)
+		}
+		cs.components = append(cs.components, components)
+	}
+
+	// the validators run their consensus loops on their own go routines, so each clock tick is followed by a real
+	// time pause in which they can react
+	clockTick := cs.roundDuration / numClockTicksPerRound
+	realTimePause := clockTick / cs.scenario.speedUp()
+	lastSyncedRound := int64(0)
+	for {
+		cs.clock.advance(clockTick)
+
+		round := cs.injector.currentRound()
+		if round > cs.scenario.NumRounds {
+			break
+		}
+		if round > lastSyncedRound {
+			cs.syncLaggingValidators(round)
+			lastSyncedRound = round
+		}
+
+		time.Sleep(realTimePause)
+	}
+
+	return cs.createReport()
+}
+
+func (cs *consensusSimulator) setCommitRecorder(idx int, n *testNode) {
+	blkc := n.blkc
+	blkProcessor := n.blkProcessor
+	blkProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		blkProcessor.NrCommitBlockCalled++
+
+		hash, err := cs.computeProposedHeaderHash(header)
+		if err != nil {
+			return err
+		}
+
+		_ = blkc.SetCurrentBlockHeader(header)
+		blkc.SetCurrentBlockHeaderHash(hash)
+
+		cs.mutCommits.Lock()
+		cs.commits = append(cs.commits, &commitRecord{
+			validator: idx,
+			round:     int64(header.GetRound()),
+			nonce:     header.GetNonce(),
+			hash:      hash,
+			header:    header,
+		})
+		cs.mutCommits.Unlock()
+
+		return nil
+	}
+}
+
+// computeProposedHeaderHash computes the hash of the header as it was proposed, before the signatures were added
+func (cs *consensusSimulator) computeProposedHeaderHash(header data.HeaderHandler) ([]byte, error) {
+	proposedHeader := header.Clone()
+	proposedHeader.SetPubKeysBitmap(nil)
+	proposedHeader.SetSignature(nil)
+	proposedHeader.SetLeaderSignature(nil)
+
+	buff, err := cs.marshalizer.Marshal(proposedHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return cs.hasher.Compute(string(buff)), nil
+}
+
+// syncLaggingValidators brings the online validators that fell behind to the chain head of the most advanced
+// validator. Block synchronization is not part of the simulated protocol as the nodes do not have resolvers
+func (cs *consensusSimulator) syncLaggingValidators(round int64) {
+	var head data.HeaderHandler
+	var headHash []byte
+	for idx, n := range cs.nodes {
+		if cs.injector.isOffline(idx, round) {
+			continue
+		}
+
+		header := n.blkc.GetCurrentBlockHeader()
+		if check.IfNil(header) {
+			continue
+		}
+		if check.IfNil(head) || header.GetNonce() > head.GetNonce() {
+			head = header
+			headHash = n.blkc.GetCurrentBlockHeaderHash()
+		}
+	}
+	if check.IfNil(head) {
+		return
+	}
+
+	for idx, n := range cs.nodes {
+		if cs.injector.isOffline(idx, round) {
+			continue
+		}
+
+		header := n.blkc.GetCurrentBlockHeader()
+		isLagging := check.IfNil(header) || header.GetNonce() < head.GetNonce()
+		if !isLagging {
+			continue
+		}
+
+		log.Debug("simulator: syncing lagging validator", "validator", idx, "round", round, "nonce", head.GetNonce())
+		_ = n.blkc.SetCurrentBlockHeader(head.Clone())
+		n.blkc.SetCurrentBlockHeaderHash(headHash)
+	}
+}
+
+func (cs *consensusSimulator) getCommits() []*commitRecord {
+	cs.mutCommits.Lock()
+	defer cs.mutCommits.Unlock()
+
+	commits := make([]*commitRecord, len(cs.commits))
+	copy(commits, cs.commits)
+
+	return commits
+}
+
+func (cs *consensusSimulator) close() {
+	for _, components := range cs.components {
+		_ = components.Close()
+	}
+	for _, n := range cs.nodes {
+		_ = n.messenger.Close()
+	}
+}
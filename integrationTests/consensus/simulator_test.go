@@ -0,0 +1,42 @@
+package consensus
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ratingsConfigPath = "../../cmd/node/config/ratings.toml"
+
+func runScenarioFile(t *testing.T, path string) {
+	scenario, err := loadScenario(path)
+	require.Nil(t, err)
+
+	simulator, err := newConsensusSimulator(scenario, ratingsConfigPath)
+	require.Nil(t, err)
+
+	report, err := simulator.run()
+	require.Nil(t, err)
+	log.Info("consensus simulation done", "scenario", scenario.Name, "report", report.String())
+
+	assert.Empty(t, report.checkExpectations(scenario.Expectations))
+}
+
+func TestConsensusSimulator_Scenarios(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	scenarios, err := filepath.Glob("scenarios/*")
+	require.Nil(t, err)
+	require.NotEmpty(t, scenarios)
+
+	for _, path := range scenarios {
+		pathCopy := path
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			runScenarioFile(t, pathCopy)
+		})
+	}
+}
@@ -63,13 +63,45 @@ var p2pBootstrapDelay = time.Second * 5
 var testPubkeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(32)
 
 type testNode struct {
-	node         *node.Node
-	messenger    p2p.Messenger
-	blkc         data.ChainHandler
-	blkProcessor *mock.BlockProcessorMock
-	sk           crypto.PrivateKey
-	pk           crypto.PublicKey
-	shardId      uint32
+	node             *node.Node
+	messenger        p2p.Messenger
+	blkc             data.ChainHandler
+	blkProcessor     *mock.BlockProcessorMock
+	nodesCoordinator sharding.NodesCoordinator
+	sk               crypto.PrivateKey
+	pk               crypto.PublicKey
+	shardId          uint32
+}
+
+// nodesEnvironment provides the network and the clocks used by the consensus test nodes
+type nodesEnvironment struct {
+	startTime         int64
+	createMessenger   func(nodeIndex int) p2p.Messenger
+	createSyncTimer   func(nodeIndex int) ntp.SyncTimer
+	connectMessengers func(messengers []p2p.Messenger)
+}
+
+func createRealNodesEnvironment() *nodesEnvironment {
+	return &nodesEnvironment{
+		startTime: time.Now().Unix(),
+		createMessenger: func(_ int) p2p.Messenger {
+			return integrationTests.CreateMessengerWithNoDiscovery()
+		},
+		createSyncTimer: func(_ int) ntp.SyncTimer {
+			syncer := ntp.NewSyncTime(ntp.NewNTPGoogleConfig(), nil)
+			syncer.StartSyncingTime()
+
+			return syncer
+		},
+		connectMessengers: func(messengers []p2p.Messenger) {
+			connectableNodes := make([]integrationTests.Connectable, 0, len(messengers))
+			for _, mes := range messengers {
+				connectableNodes = append(connectableNodes, &messengerWrapper{mes})
+			}
+
+			integrationTests.ConnectNodes(connectableNodes)
+		},
+	}
 }
 
 type keyPair struct {
@@ -260,6 +292,9 @@ func createConsensusOnlyNode(
 	testKeyGen crypto.KeyGenerator,
 	consensusType string,
 	epochStartRegistrationHandler mainFactory.EpochStartNotifier,
+	messenger p2p.Messenger,
+	syncer ntp.SyncTimer,
+	startTime int64,
 ) (
 	*node.Node,
 	p2p.Messenger,
@@ -269,7 +304,6 @@ func createConsensusOnlyNode(
 	testHasher := createHasher(consensusType)
 	testMarshalizer := &marshal.GogoProtoMarshalizer{}
 
-	messenger := integrationTests.CreateMessengerWithNoDiscovery()
 	rootHash := []byte("roothash")
 
 	blockChain := createTestBlockChain()
@@ -318,14 +352,9 @@ func createConsensusOnlyNode(
 	hdrMarshalized, _ := testMarshalizer.Marshal(header)
 	blockChain.SetGenesisHeaderHash(testHasher.Compute(string(hdrMarshalized)))
 
-	startTime := time.Now().Unix()
-
 	singlesigner := &ed25519SingleSig.Ed25519Signer{}
 	singleBlsSigner := &mclsinglesig.BlsSingleSigner{}
 
-	syncer := ntp.NewSyncTime(ntp.NewNTPGoogleConfig(), nil)
-	syncer.StartSyncingTime()
-
 	roundHandler, _ := round.NewRound(
 		time.Unix(startTime, 0),
 		syncer.CurrentTime(),
@@ -481,6 +510,16 @@ func createNodes(
 	roundTime uint64,
 	consensusType string,
 ) map[uint32][]*testNode {
+	return createNodesWithEnvironment(nodesPerShard, consensusSize, roundTime, consensusType, createRealNodesEnvironment())
+}
+
+func createNodesWithEnvironment(
+	nodesPerShard int,
+	consensusSize int,
+	roundTime uint64,
+	consensusType string,
+	env *nodesEnvironment,
+) map[uint32][]*testNode {
 
 	nodes := make(map[uint32][]*testNode)
 	cp := createCryptoParams(nodesPerShard, 1, 1)
@@ -488,7 +527,7 @@ func createNodes(
 	eligibleMap := genValidatorsFromPubKeys(keysMap)
 	waitingMap := make(map[uint32][]sharding.Validator)
 	nodesList := make([]*testNode, nodesPerShard)
-	connectableNodes := make([]integrationTests.Connectable, 0)
+	messengers := make([]p2p.Messenger, 0, nodesPerShard)
 
 	nodeShuffler := &mock.NodeShufflerMock{}
 
@@ -541,6 +580,9 @@ func createNodes(
 			cp.keyGen,
 			consensusType,
 			epochStartRegistrationHandler,
+			env.createMessenger(i),
+			env.createSyncTimer(i),
+			env.startTime,
 		)
 
 		testNodeObject.node = n
@@ -549,13 +591,63 @@ func createNodes(
 		testNodeObject.pk = kp.pk
 		testNodeObject.blkProcessor = blkProcessor
 		testNodeObject.blkc = blkc
+		testNodeObject.nodesCoordinator = nodesCoordinator
 
 		nodesList[i] = testNodeObject
-		connectableNodes = append(connectableNodes, &messengerWrapper{mes})
+		messengers = append(messengers, mes)
 	}
 	nodes[0] = nodesList
 
-	integrationTests.ConnectNodes(connectableNodes)
+	env.connectMessengers(messengers)
 
 	return nodes
 }
+
+func createConsensusComponents(n *testNode) (mainFactory.ConsensusComponentsHandler, error) {
+	statusComponents := integrationTests.GetDefaultStatusComponents()
+
+	consensusArgs := mainFactory.ConsensusComponentsFactoryArgs{
+		Config: config.Config{
+			Consensus: config.ConsensusConfig{
+				Type: blsConsensusType,
+			},
+			ValidatorPubkeyConverter: config.PubkeyConfig{
+				Length:          96,
+				Type:            "bls",
+				SignatureLength: 48,
+			},
+			TrieSync: config.TrieSyncConfig{
+				NumConcurrentTrieSyncers:  5,
+				MaxHardCapForMissingNodes: 5,
+				TrieSyncerVersion:         2,
+			},
+		},
+		BootstrapRoundIndex: 0,
+		HardforkTrigger:     n.node.GetHardforkTrigger(),
+		CoreComponents:      n.node.GetCoreComponents(),
+		NetworkComponents:   n.node.GetNetworkComponents(),
+		CryptoComponents:    n.node.GetCryptoComponents(),
+		DataComponents:      n.node.GetDataComponents(),
+		ProcessComponents:   n.node.GetProcessComponents(),
+		StateComponents:     n.node.GetStateComponents(),
+		StatusComponents:    statusComponents,
+		IsInImportMode:      n.node.IsInImportMode(),
+	}
+
+	consensusFactory, err := mainFactory.NewConsensusComponentsFactory(consensusArgs)
+	if err != nil {
+		return nil, fmt.Errorf("NewConsensusComponentsFactory failed: %w", err)
+	}
+
+	managedConsensusComponents, err := mainFactory.NewManagedConsensusComponents(consensusFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = managedConsensusComponents.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	return managedConsensusComponents, nil
+}
@@ -0,0 +1,136 @@
+package consensus
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var _ ntp.SyncTimer = (*virtualSyncTimer)(nil)
+var _ memp2p.DelayScheduler = (*virtualClock)(nil)
+
+// virtualClock is a clock shared by all the simulated validators. It does not follow the wall clock: it only moves
+// when advanced by the simulator, so the nodes can be created without consuming rounds and the simulated timeline
+// does not depend on how fast the host runs. Handlers scheduled on the clock, such as the delayed network
+// deliveries, are called in deadline order when the clock reaches their deadline
+type virtualClock struct {
+	mut           sync.Mutex
+	referenceTime time.Time
+	elapsed       time.Duration
+	scheduled     []*scheduledHandler
+	numScheduled  uint64
+}
+
+type scheduledHandler struct {
+	deadline time.Duration
+	index    uint64
+	handler  func()
+}
+
+func newVirtualClock(referenceTime time.Time) *virtualClock {
+	return &virtualClock{
+		referenceTime: referenceTime,
+	}
+}
+
+func (vc *virtualClock) now() time.Time {
+	vc.mut.Lock()
+	defer vc.mut.Unlock()
+
+	return vc.referenceTime.Add(vc.elapsed)
+}
+
+// advance moves the clock forward and calls, on the caller's go routine, the handlers that became due
+func (vc *virtualClock) advance(duration time.Duration) {
+	vc.mut.Lock()
+	vc.elapsed += duration
+	dueHandlers := vc.extractDueHandlers()
+	vc.mut.Unlock()
+
+	for _, sh := range dueHandlers {
+		sh.handler()
+	}
+}
+
+func (vc *virtualClock) extractDueHandlers() []*scheduledHandler {
+	sort.Slice(vc.scheduled, func(i, j int) bool {
+		if vc.scheduled[i].deadline == vc.scheduled[j].deadline {
+			return vc.scheduled[i].index < vc.scheduled[j].index
+		}
+
+		return vc.scheduled[i].deadline < vc.scheduled[j].deadline
+	})
+
+	numDue := 0
+	for numDue < len(vc.scheduled) && vc.scheduled[numDue].deadline <= vc.elapsed {
+		numDue++
+	}
+
+	dueHandlers := vc.scheduled[:numDue]
+	vc.scheduled = vc.scheduled[numDue:]
+
+	return dueHandlers
+}
+
+// AfterFunc schedules the handler to be called when the clock will have advanced with the provided delay
+func (vc *virtualClock) AfterFunc(delay time.Duration, handler func()) {
+	vc.mut.Lock()
+	vc.scheduled = append(vc.scheduled, &scheduledHandler{
+		deadline: vc.elapsed + delay,
+		index:    vc.numScheduled,
+		handler:  handler,
+	})
+	vc.numScheduled++
+	vc.mut.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vc *virtualClock) IsInterfaceNil() bool {
+	return vc == nil
+}
+
+// virtualSyncTimer is a SyncTimer implementation that reads the time from a virtual clock, with an optional
+// per validator offset used to simulate clock skews
+type virtualSyncTimer struct {
+	clock  *virtualClock
+	offset time.Duration
+}
+
+func newVirtualSyncTimer(clock *virtualClock, offset time.Duration) *virtualSyncTimer {
+	return &virtualSyncTimer{
+		clock:  clock,
+		offset: offset,
+	}
+}
+
+// Close does nothing as there is no syncing go routine
+func (vst *virtualSyncTimer) Close() error {
+	return nil
+}
+
+// StartSyncingTime does nothing as the virtual clock does not need syncing
+func (vst *virtualSyncTimer) StartSyncingTime() {
+}
+
+// ClockOffset returns the configured offset of this timer
+func (vst *virtualSyncTimer) ClockOffset() time.Duration {
+	return vst.offset
+}
+
+// FormattedCurrentTime returns the current virtual time in a human readable format
+func (vst *virtualSyncTimer) FormattedCurrentTime() string {
+	return vst.CurrentTime().Format("2006-01-02 15:04:05.000000000")
+}
+
+// CurrentTime returns the current virtual time, including the offset
+func (vst *virtualSyncTimer) CurrentTime() time.Time {
+	return vst.clock.now().Add(vst.offset)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vst *virtualSyncTimer) IsInterfaceNil() bool {
+	return vst == nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -71,12 +72,25 @@ type Facade interface {
 	GetTotalStakedValue() (*dataApi.StakeValues, error)
 	GetDirectStakedList() ([]*dataApi.DirectStakedValue, error)
 	GetDelegatorsList() ([]*dataApi.Delegator, error)
+	GetGovernanceProposals() (*common.GovernanceProposalsResponse, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error)
+	GetDelegationContract(contract string) (*common.DelegationContractResponse, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetTokenSupply(token string) (string, error)
+	GetESDTTokenData(token string) (*common.ESDTTokenResponse, error)
+	GetESDTHolders(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error)
+	GetESDTSupplyHistory(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error)
+	GetEpochEconomicsReport(epoch uint32) (*common.EpochEconomicsResponse, error)
+	GetValidatorRewards(publicKey string) (*common.ValidatorRewardsResponse, error)
+	GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error)
+	GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRounds() ([]*consensus.RoundTrace, error)
+	GetSlashingEvidences() ([]*consensus.SlashingEvidence, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
@@ -4,8 +4,9 @@ import "github.com/ElrondNetwork/elrond-go-core/core"
 
 // GasScheduleNotifierMock -
 type GasScheduleNotifierMock struct {
-	GasSchedule map[string]map[string]uint64
-	Handlers    []core.GasScheduleSubscribeHandler
+	GasScheduleVersion string
+	GasSchedule        map[string]map[string]uint64
+	Handlers           []core.GasScheduleSubscribeHandler
 }
 
 // NewGasScheduleNotifierMock -
@@ -28,6 +29,11 @@ func (g *GasScheduleNotifierMock) LatestGasSchedule() map[string]map[string]uint
 	return g.GasSchedule
 }
 
+// LatestGasScheduleVersion -
+func (g *GasScheduleNotifierMock) LatestGasScheduleVersion() string {
+	return g.GasScheduleVersion
+}
+
 // UnRegisterAll -
 func (g *GasScheduleNotifierMock) UnRegisterAll() {
 }
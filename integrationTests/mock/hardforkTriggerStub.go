@@ -11,6 +11,7 @@ type HardforkTriggerStub struct {
 	CreateDataCalled             func() []byte
 	AddCloserCalled              func(closer update.Closer) error
 	NotifyTriggerReceivedCalled  func() <-chan struct{}
+	TriggerFromGovernanceCalled  func(epoch uint32) error
 }
 
 // Trigger -
@@ -22,6 +23,15 @@ func (hts *HardforkTriggerStub) Trigger(epoch uint32, withEarlyEndOfEpoch bool)
 	return nil
 }
 
+// TriggerFromGovernance -
+func (hts *HardforkTriggerStub) TriggerFromGovernance(epoch uint32) error {
+	if hts.TriggerFromGovernanceCalled != nil {
+		return hts.TriggerFromGovernanceCalled(epoch)
+	}
+
+	return nil
+}
+
 // IsSelfTrigger -
 func (hts *HardforkTriggerStub) IsSelfTrigger() bool {
 	if hts.IsSelfTriggerCalled != nil {
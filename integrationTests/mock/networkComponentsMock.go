@@ -14,6 +14,7 @@ type NetworkComponentsStub struct {
 	PeerBlackList        process.PeerBlackListCacher
 	PeerHonesty          factory.PeerHonestyHandler
 	PreferredPeersHolder factory.PreferredPeersHolderHandler
+	LoadMonitorField     process.LoadMonitor
 }
 
 // PubKeyCacher -
@@ -66,6 +67,11 @@ func (ncs *NetworkComponentsStub) PreferredPeersHolderHandler() factory.Preferre
 	return ncs.PreferredPeersHolder
 }
 
+// LoadMonitor -
+func (ncs *NetworkComponentsStub) LoadMonitor() process.LoadMonitor {
+	return ncs.LoadMonitorField
+}
+
 // String -
 func (ncs *NetworkComponentsStub) String() string {
 	return "NetworkComponentsStub"
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
@@ -42,6 +43,7 @@ type ProcessComponentsStub struct {
 	ImportStartHandlerInternal     update.ImportStartHandler
 	RequestedItemsHandlerInternal  dataRetriever.RequestedItemsHandler
 	NodeRedundancyHandlerInternal  consensus.NodeRedundancyHandler
+	ManagedPeersHolderInternal     common.ManagedPeersHolder
 	CurrentEpochProviderInternal   process.CurrentNetworkEpochProviderHandler
 }
 
@@ -205,6 +207,11 @@ func (pcs *ProcessComponentsStub) NodeRedundancyHandler() consensus.NodeRedundan
 	return pcs.NodeRedundancyHandlerInternal
 }
 
+// ManagedPeersHolder -
+func (pcs *ProcessComponentsStub) ManagedPeersHolder() common.ManagedPeersHolder {
+	return pcs.ManagedPeersHolderInternal
+}
+
 // CurrentEpochProvider -
 func (pcs *ProcessComponentsStub) CurrentEpochProvider() process.CurrentNetworkEpochProviderHandler {
 	return pcs.CurrentEpochProviderInternal
@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/storage"
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
 
 // UnitOpenerStub -
 type UnitOpenerStub struct {
@@ -11,6 +14,11 @@ func (u *UnitOpenerStub) GetMostRecentBootstrapStorageUnit() (storage.Storer, er
 	return NewStorerMock(), nil
 }
 
+// OpenDB -
+func (u *UnitOpenerStub) OpenDB(_ config.DBConfig, _ uint32, _ uint32) (storage.Storer, error) {
+	return NewStorerMock(), nil
+}
+
 // IsInterfaceNil -
 func (u *UnitOpenerStub) IsInterfaceNil() bool {
 	return u == nil
@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -223,6 +224,9 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 		HardforkTrigger:      &mock.HardforkTriggerStub{},
 		CurrentBlockProvider: &mock.BlockChainMock{},
 		RedundancyHandler:    &mock.RedundancyHandlerStub{},
+		ManagedPeersHolder:   &testscommon.ManagedPeersHolderStub{},
+
+		ManagedKeysSendInterval: time.Second,
 	}
 
 	sender, _ := process.NewSender(argSender)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
@@ -53,6 +54,7 @@ func CreateTopicsAndMockInterceptors(
 			Name:                      "test",
 			Cacher:                    antifloodPool,
 			StatusHandlers:            statusHandlers,
+			QuotaAdjuster:             &disabled.QuotaAdjuster{},
 			BaseMaxNumMessagesPerPeer: peerMaxNumMessages,
 			MaxTotalSizePerPeer:       peerMaxSize,
 			PercentReserved:           0,
@@ -134,6 +134,7 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
+		&testscommon.ManagedPeersHolderStub{},
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
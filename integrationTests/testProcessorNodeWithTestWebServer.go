@@ -175,6 +175,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		QueryService:       tpn.SCQueryService,
 		BlockChain:         tpn.BlockChain,
 		PublicKeyConverter: TestAddressPubkeyConverter,
+		Marshalizer:        TestMarshalizer,
 	}
 	totalStakedValueHandler, err := factory.CreateTotalStakedValueHandler(args)
 	log.LogIfError(err)
@@ -185,13 +186,30 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	governanceHandler, err := factory.CreateGovernanceHandler(args)
+	log.LogIfError(err)
+
+	delegationContractHandler, err := factory.CreateDelegationContractHandler(args)
+	log.LogIfError(err)
+
+	validatorLifecycleHandler, err := factory.CreateValidatorLifecycleHandler(trieIterators.ArgValidatorLifecycleProcessor{
+		ArgTrieIteratorProcessor: args,
+		ValidatorPubKeyConverter: TestValidatorPubkeyConverter,
+		ValidatorsProvider:       &mock.ValidatorsProviderStub{},
+		NodesCoordinator:         tpn.NodesCoordinator,
+	})
+	log.LogIfError(err)
+
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:          tpn.SCQueryService,
-		StatusMetricsHandler:    &mock.StatusMetricsStub{},
-		TxCostHandler:           txCostHandler,
-		TotalStakedValueHandler: totalStakedValueHandler,
-		DirectStakedListHandler: directStakedListHandler,
-		DelegatedListHandler:    delegatedListHandler,
+		SCQueryService:            tpn.SCQueryService,
+		StatusMetricsHandler:      &mock.StatusMetricsStub{},
+		TxCostHandler:             txCostHandler,
+		TotalStakedValueHandler:   totalStakedValueHandler,
+		DirectStakedListHandler:   directStakedListHandler,
+		DelegatedListHandler:      delegatedListHandler,
+		GovernanceHandler:         governanceHandler,
+		DelegationContractHandler: delegationContractHandler,
+		ValidatorLifecycleHandler: validatorLifecycleHandler,
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
@@ -205,6 +223,9 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		Marshalizer:               TestMarshalizer,
 		Hasher:                    TestHasher,
 		VMOutputCacher:            &testscommon.CacherMock{},
+		EconomicsFee:              tpn.EconomicsData,
+		BuiltInFunctionsCost:      &mock.BuiltInCostHandlerStub{},
+		GasScheduleVersion:        gasScheduleNotifier,
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
@@ -238,6 +259,11 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["block"] = blockGroup
 	}
 
+	delegationGroup, err := groups.NewDelegationGroup(facade)
+	if err == nil {
+		groupsMap["delegation"] = delegationGroup
+	}
+
 	hardforkGroup, err := groups.NewHardforkGroup(facade)
 	if err == nil {
 		groupsMap["hardfork"] = hardforkGroup
@@ -132,6 +132,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
+		&testscommon.ManagedPeersHolderStub{},
 	)
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
@@ -215,6 +216,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		HistoryRepository:  tpn.HistoryRepository,
 		EpochNotifier:      tpn.EpochNotifier,
 		GasHandler:         tpn.GasHandler,
+		LoadMonitor:        &testscommon.LoadMonitorStub{},
 	}
 
 	if tpn.ShardCoordinator.SelfId() == core.MetachainShardId {
@@ -907,12 +907,20 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		Type:     storageUnit.LRUCache,
 		Capacity: 10000,
 	})
+	gasScheduleNotifier := mock.NewGasScheduleNotifierMock(gasSchedule)
+	builtInCost, _ := economics.NewBuiltInFunctionsCost(&economics.ArgsBuiltInFunctionCost{
+		ArgsParser:  smartContract.NewArgumentParser(),
+		GasSchedule: gasScheduleNotifier,
+	})
 	txSimulatorProcessorArgs := txsimulator.ArgsTxSimulator{
 		AddressPubKeyConverter: pubkeyConv,
 		ShardCoordinator:       shardCoordinator,
 		VMOutputCacher:         vmOutputCacher,
 		Marshalizer:            testMarshalizer,
 		Hasher:                 testHasher,
+		EconomicsFee:           economicsData,
+		BuiltInFunctionsCost:   builtInCost,
+		GasScheduleVersion:     gasScheduleNotifier,
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
@@ -0,0 +1,30 @@
+package keysManagement
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrDuplicatedKey signals that a key is already managed by the node
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrMissingPublicKeyDefinition signals that a public key is not managed by the node
+var ErrMissingPublicKeyDefinition = errors.New("missing public key definition")
+
+// ErrEmptyPemFile signals that the provided pem file does not contain any key
+var ErrEmptyPemFile = errors.New("empty pem file")
+
+// ErrInvalidPemBlock signals that a block of the pem file is invalid
+var ErrInvalidPemBlock = errors.New("invalid pem block")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
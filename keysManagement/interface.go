@@ -0,0 +1,20 @@
+package keysManagement
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// P2PMessenger defines a subset of the p2p.Messenger interface
+type P2PMessenger interface {
+	ID() core.PeerID
+	IsInterfaceNil() bool
+}
+
+// ExternalPrivateKey defines a private key held by an external signing service. It can not be used for local signing,
+// the signing requests for its public key being forwarded to the returned keys signer
+type ExternalPrivateKey interface {
+	crypto.PrivateKey
+	KeysSigner() common.KeysSigner
+}
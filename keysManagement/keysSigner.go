@@ -0,0 +1,112 @@
+package keysManagement
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var _ common.KeysSigner = (*keysSigner)(nil)
+
+// ArgsKeysSigner represents the argument for the keys signer
+type ArgsKeysSigner struct {
+	MainPrivateKey     crypto.PrivateKey
+	ManagedPeersHolder common.ManagedPeersHolder
+	SingleSigner       crypto.SingleSigner
+}
+
+// keysSigner produces the signatures for the main key and the managed keys of the node. The keys held by an external
+// signing service are only references, the signing requests for them being forwarded to that service
+type keysSigner struct {
+	mainPrivateKey     crypto.PrivateKey
+	mainPublicKey      []byte
+	managedPeersHolder common.ManagedPeersHolder
+	singleSigner       crypto.SingleSigner
+}
+
+// NewKeysSigner creates a new instance of a keys signer
+func NewKeysSigner(args ArgsKeysSigner) (*keysSigner, error) {
+	if check.IfNil(args.MainPrivateKey) {
+		return nil, ErrNilPrivateKey
+	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return nil, ErrNilManagedPeersHolder
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+
+	mainPublicKey, err := args.MainPrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, fmt.Errorf("%w while generating the main public key", err)
+	}
+
+	return &keysSigner{
+		mainPrivateKey:     args.MainPrivateKey,
+		mainPublicKey:      mainPublicKey,
+		managedPeersHolder: args.ManagedPeersHolder,
+		singleSigner:       args.SingleSigner,
+	}, nil
+}
+
+// SignBlockSignatureShare returns the signature share of the provided key over the header hash
+func (ks *keysSigner) SignBlockSignatureShare(pkBytes []byte, round int64, headerHash []byte) ([]byte, error) {
+	return ks.sign(pkBytes, headerHash, func(externalSigner common.KeysSigner) ([]byte, error) {
+		return externalSigner.SignBlockSignatureShare(pkBytes, round, headerHash)
+	})
+}
+
+// SignBlockHeader returns the leader signature of the provided key over the marshalized header
+func (ks *keysSigner) SignBlockHeader(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
+	return ks.sign(pkBytes, marshalizedHeader, func(externalSigner common.KeysSigner) ([]byte, error) {
+		return externalSigner.SignBlockHeader(pkBytes, round, marshalizedHeader)
+	})
+}
+
+// SignRandSeed returns the signature of the provided key over the previous random seed
+func (ks *keysSigner) SignRandSeed(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error) {
+	return ks.sign(pkBytes, prevRandSeed, func(externalSigner common.KeysSigner) ([]byte, error) {
+		return externalSigner.SignRandSeed(pkBytes, round, prevRandSeed)
+	})
+}
+
+// SignPeerID returns the signature of the provided key over the peer ID
+func (ks *keysSigner) SignPeerID(pkBytes []byte, pid []byte) ([]byte, error) {
+	return ks.sign(pkBytes, pid, func(externalSigner common.KeysSigner) ([]byte, error) {
+		return externalSigner.SignPeerID(pkBytes, pid)
+	})
+}
+
+func (ks *keysSigner) sign(
+	pkBytes []byte,
+	message []byte,
+	externalSign func(externalSigner common.KeysSigner) ([]byte, error),
+) ([]byte, error) {
+	privateKey, err := ks.getPrivateKey(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	externalKey, isExternal := privateKey.(ExternalPrivateKey)
+	if isExternal {
+		return externalSign(externalKey.KeysSigner())
+	}
+
+	return ks.singleSigner.Sign(privateKey, message)
+}
+
+func (ks *keysSigner) getPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if bytes.Equal(pkBytes, ks.mainPublicKey) {
+		return ks.mainPrivateKey, nil
+	}
+
+	return ks.managedPeersHolder.GetPrivateKey(pkBytes)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ks *keysSigner) IsInterfaceNil() bool {
+	return ks == nil
+}
@@ -0,0 +1,166 @@
+package keysManagement_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type externalPrivateKeyStub struct {
+	cryptoMocks.PrivateKeyStub
+	keysSigner common.KeysSigner
+}
+
+func (stub *externalPrivateKeyStub) KeysSigner() common.KeysSigner {
+	return stub.keysSigner
+}
+
+func createMockArgsKeysSigner() keysManagement.ArgsKeysSigner {
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	mainPrivateKey, _ := keyGenerator.GeneratePair()
+
+	return keysManagement.ArgsKeysSigner{
+		MainPrivateKey:     mainPrivateKey,
+		ManagedPeersHolder: &testscommon.ManagedPeersHolderStub{},
+		SingleSigner:       &mclSig.BlsSingleSigner{},
+	}
+}
+
+func TestNewKeysSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil main private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeysSigner()
+		args.MainPrivateKey = nil
+		ks, err := keysManagement.NewKeysSigner(args)
+		assert.True(t, check.IfNil(ks))
+		assert.Equal(t, keysManagement.ErrNilPrivateKey, err)
+	})
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeysSigner()
+		args.ManagedPeersHolder = nil
+		ks, err := keysManagement.NewKeysSigner(args)
+		assert.True(t, check.IfNil(ks))
+		assert.Equal(t, keysManagement.ErrNilManagedPeersHolder, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeysSigner()
+		args.SingleSigner = nil
+		ks, err := keysManagement.NewKeysSigner(args)
+		assert.True(t, check.IfNil(ks))
+		assert.Equal(t, keysManagement.ErrNilSingleSigner, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ks, err := keysManagement.NewKeysSigner(createMockArgsKeysSigner())
+		assert.False(t, check.IfNil(ks))
+		assert.Nil(t, err)
+	})
+}
+
+func TestKeysSigner_SignWithLocalKeys(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsKeysSigner()
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	managedPrivateKey, managedPublicKey := keyGenerator.GeneratePair()
+	managedPkBytes, _ := managedPublicKey.ToByteArray()
+	args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			if string(pkBytes) == string(managedPkBytes) {
+				return managedPrivateKey, nil
+			}
+
+			return nil, keysManagement.ErrMissingPublicKeyDefinition
+		},
+	}
+	ks, _ := keysManagement.NewKeysSigner(args)
+
+	mainPublicKey := args.MainPrivateKey.GeneratePublic()
+	mainPkBytes, _ := mainPublicKey.ToByteArray()
+	message := []byte("message")
+
+	signature, err := ks.SignBlockHeader(mainPkBytes, 1, message)
+	require.Nil(t, err)
+	assert.Nil(t, args.SingleSigner.Verify(mainPublicKey, message, signature))
+
+	signature, err = ks.SignBlockSignatureShare(managedPkBytes, 1, message)
+	require.Nil(t, err)
+	assert.Nil(t, args.SingleSigner.Verify(managedPublicKey, message, signature))
+
+	_, err = ks.SignRandSeed([]byte("missing"), 1, message)
+	assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+}
+
+func TestKeysSigner_SignWithExternalKeyShouldForward(t *testing.T) {
+	t.Parallel()
+
+	mainPkBytes := []byte("main pk")
+	calls := make(map[string]int)
+	externalSigner := &testscommon.KeysSignerStub{
+		SignBlockSignatureShareCalled: func(pkBytes []byte, round int64, headerHash []byte) ([]byte, error) {
+			calls["share"]++
+			return []byte("share"), nil
+		},
+		SignBlockHeaderCalled: func(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
+			calls["header"]++
+			return []byte("header"), nil
+		},
+		SignRandSeedCalled: func(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error) {
+			calls["rand seed"]++
+			return []byte("rand seed"), nil
+		},
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
+			calls["pid"]++
+			return []byte("pid"), nil
+		},
+	}
+	args := createMockArgsKeysSigner()
+	args.MainPrivateKey = &externalPrivateKeyStub{
+		PrivateKeyStub: cryptoMocks.PrivateKeyStub{
+			GeneratePublicStub: func() crypto.PublicKey {
+				return &cryptoMocks.PublicKeyStub{
+					ToByteArrayStub: func() ([]byte, error) {
+						return mainPkBytes, nil
+					},
+				}
+			},
+		},
+		keysSigner: externalSigner,
+	}
+	args.SingleSigner = &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			assert.Fail(t, "should have not called the local signer")
+			return nil, nil
+		},
+	}
+	ks, _ := keysManagement.NewKeysSigner(args)
+
+	signature, _ := ks.SignBlockSignatureShare(mainPkBytes, 1, []byte("hash"))
+	assert.Equal(t, []byte("share"), signature)
+	signature, _ = ks.SignBlockHeader(mainPkBytes, 1, []byte("header"))
+	assert.Equal(t, []byte("header"), signature)
+	signature, _ = ks.SignRandSeed(mainPkBytes, 1, []byte("seed"))
+	assert.Equal(t, []byte("rand seed"), signature)
+	signature, _ = ks.SignPeerID(mainPkBytes, []byte("pid"))
+	assert.Equal(t, []byte("pid"), signature)
+	assert.Equal(t, map[string]int{"share": 1, "header": 1, "rand seed": 1, "pid": 1}, calls)
+}
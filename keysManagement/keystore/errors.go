@@ -0,0 +1,30 @@
+package keystore
+
+import "errors"
+
+// ErrEmptySecretKey signals that an empty secret key has been provided
+var ErrEmptySecretKey = errors.New("empty secret key")
+
+// ErrEmptyPassword signals that an empty password has been provided
+var ErrEmptyPassword = errors.New("empty password")
+
+// ErrNilKeyFile signals that a nil key file has been provided
+var ErrNilKeyFile = errors.New("nil key file")
+
+// ErrUnsupportedKeyFile signals that the key file format is not supported
+var ErrUnsupportedKeyFile = errors.New("unsupported key file")
+
+// ErrWrongPassword signals that the key file could not be decrypted with the provided password
+var ErrWrongPassword = errors.New("wrong password")
+
+// ErrInvalidKeyIndex signals that an invalid key index has been provided
+var ErrInvalidKeyIndex = errors.New("invalid key index")
+
+// ErrMissingPasswordFile signals that an encrypted key file was provided without a password file
+var ErrMissingPasswordFile = errors.New("the key file is encrypted, a password file should be provided")
+
+// ErrPublicKeyMismatch signals that the public key stored in a key file does not match its secret key
+var ErrPublicKeyMismatch = errors.New("the public key does not match the secret key")
+
+// ErrInvalidKDFParams signals that the key derivation function parameters of a key file are out of the accepted bounds
+var ErrInvalidKDFParams = errors.New("invalid key derivation function parameters")
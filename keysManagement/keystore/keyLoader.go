@@ -0,0 +1,48 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// keyLoader loads the validator key from a password encrypted key file, mirroring the PEM key loader output
+type keyLoader struct {
+	password string
+}
+
+// NewKeyLoader creates a key loader able to decrypt the key files with the password read from the provided file
+func NewKeyLoader(passwordFilename string) (*keyLoader, error) {
+	password, err := LoadPassword(passwordFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyLoader{
+		password: password,
+	}, nil
+}
+
+// LoadKey returns the hex encoded secret key and the public key held by the key file. As a key file holds a single
+// key, only the 0 index is accepted
+func (kl *keyLoader) LoadKey(filename string, skIndex int) ([]byte, string, error) {
+	if skIndex != 0 {
+		return nil, "", fmt.Errorf("%w: %d, a key file holds a single key", ErrInvalidKeyIndex, skIndex)
+	}
+
+	keyFile, err := LoadKeyFile(filename)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secretKey, err := Decrypt(keyFile, kl.password)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w while decrypting the key file %s", err, filename)
+	}
+
+	return []byte(hex.EncodeToString(secretKey)), keyFile.Address, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kl *keyLoader) IsInterfaceNil() bool {
+	return kl == nil
+}
@@ -0,0 +1,285 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keyFileVersion = 4
+	secretKeyKind  = "secretKey"
+	cipherName     = "aes-128-ctr"
+	kdfName        = "scrypt"
+
+	scryptN      = 4096
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 32
+
+	// the scrypt parameters read from a key file are bounded so that a crafted file can not exhaust the memory or the
+	// CPU of the process loading it. The upper bounds match the "standard" parameters used by the other wallets,
+	// scrypt allocating 128 * N * r bytes
+	maxScryptN = 1 << 18
+	maxScryptR = 8
+	maxScryptP = 4
+
+	keyFilePermissions = 0600
+)
+
+// KeyFile is the JSON representation of a password encrypted secret key. The format follows the one used by the
+// Elrond wallets, the validator keys having the hex encoded BLS public key as address and no bech32 address
+type KeyFile struct {
+	Version int         `json:"version"`
+	ID      string      `json:"id"`
+	Kind    string      `json:"kind"`
+	Address string      `json:"address"`
+	Bech32  string      `json:"bech32,omitempty"`
+	Crypto  CryptoGroup `json:"crypto"`
+}
+
+// CryptoGroup holds the encrypted secret key together with the parameters needed to decrypt it
+type CryptoGroup struct {
+	Ciphertext   string       `json:"ciphertext"`
+	CipherParams CipherParams `json:"cipherparams"`
+	Cipher       string       `json:"cipher"`
+	KDF          string       `json:"kdf"`
+	KDFParams    KDFParams    `json:"kdfparams"`
+	MAC          string       `json:"mac"`
+}
+
+// CipherParams holds the parameters of the cipher
+type CipherParams struct {
+	IV string `json:"iv"`
+}
+
+// KDFParams holds the parameters of the scrypt key derivation function
+type KDFParams struct {
+	DkLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+}
+
+// Encrypt encrypts the secret key with a key derived from the password. The address is the encoded public key
+// and the bech32 address is only provided for wallet keys
+func Encrypt(secretKey []byte, address string, bech32 string, password string) (*KeyFile, error) {
+	if len(secretKey) == 0 {
+		return nil, ErrEmptySecretKey
+	}
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	salt, err := randomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParams := KDFParams{
+		DkLen: scryptKeyLen,
+		Salt:  hex.EncodeToString(salt),
+		N:     scryptN,
+		R:     scryptR,
+		P:     scryptP,
+	}
+	derivedKey, err := deriveKey(password, salt, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := applyCipher(derivedKey[:16], iv, secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyFile{
+		Version: keyFileVersion,
+		ID:      id,
+		Kind:    secretKeyKind,
+		Address: address,
+		Bech32:  bech32,
+		Crypto: CryptoGroup{
+			Ciphertext:   hex.EncodeToString(ciphertext),
+			CipherParams: CipherParams{IV: hex.EncodeToString(iv)},
+			Cipher:       cipherName,
+			KDF:          kdfName,
+			KDFParams:    kdfParams,
+			MAC:          hex.EncodeToString(computeMAC(derivedKey[16:32], ciphertext)),
+		},
+	}, nil
+}
+
+// Decrypt returns the secret key held by the key file. An error is returned if the password is wrong
+func Decrypt(keyFile *KeyFile, password string) ([]byte, error) {
+	if keyFile == nil {
+		return nil, ErrNilKeyFile
+	}
+	if keyFile.Version != keyFileVersion || keyFile.Kind != secretKeyKind {
+		return nil, fmt.Errorf("%w: version %d, kind %s", ErrUnsupportedKeyFile, keyFile.Version, keyFile.Kind)
+	}
+	if keyFile.Crypto.Cipher != cipherName || keyFile.Crypto.KDF != kdfName {
+		return nil, fmt.Errorf("%w: cipher %s, kdf %s", ErrUnsupportedKeyFile, keyFile.Crypto.Cipher, keyFile.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(keyFile.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w for salt", err)
+	}
+	iv, err := hex.DecodeString(keyFile.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("%w for iv", err)
+	}
+	ciphertext, err := hex.DecodeString(keyFile.Crypto.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w for ciphertext", err)
+	}
+	mac, err := hex.DecodeString(keyFile.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("%w for mac", err)
+	}
+	err = checkKDFParams(keyFile.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := deriveKey(password, salt, keyFile.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, computeMAC(derivedKey[16:32], ciphertext)) {
+		return nil, ErrWrongPassword
+	}
+
+	return applyCipher(derivedKey[:16], iv, ciphertext)
+}
+
+// LoadKeyFile reads a key file from the disk
+func LoadKeyFile(filename string) (*KeyFile, error) {
+	buff, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	keyFile := &KeyFile{}
+	err = json.Unmarshal(buff, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the key file %s", err, filename)
+	}
+
+	return keyFile, nil
+}
+
+// SaveKeyFile writes the key file on the disk, readable only by the current user
+func SaveKeyFile(filename string, keyFile *KeyFile) error {
+	if keyFile == nil {
+		return ErrNilKeyFile
+	}
+
+	buff, err := json.MarshalIndent(keyFile, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, buff, keyFilePermissions)
+}
+
+// LoadPassword reads the password from the provided file. The trailing line endings are removed
+func LoadPassword(filename string) (string, error) {
+	buff, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	password := strings.TrimRight(string(buff), "\r\n")
+	if len(password) == 0 {
+		return "", fmt.Errorf("%w in file %s", ErrEmptyPassword, filename)
+	}
+
+	return password, nil
+}
+
+func checkKDFParams(params KDFParams) error {
+	if params.DkLen != scryptKeyLen {
+		return fmt.Errorf("%w: dklen %d", ErrUnsupportedKeyFile, params.DkLen)
+	}
+	if params.N <= 1 || params.N > maxScryptN {
+		return fmt.Errorf("%w: n %d, maximum %d", ErrInvalidKDFParams, params.N, maxScryptN)
+	}
+	if params.R <= 0 || params.R > maxScryptR {
+		return fmt.Errorf("%w: r %d, maximum %d", ErrInvalidKDFParams, params.R, maxScryptR)
+	}
+	if params.P <= 0 || params.P > maxScryptP {
+		return fmt.Errorf("%w: p %d, maximum %d", ErrInvalidKDFParams, params.P, maxScryptP)
+	}
+
+	return nil
+}
+
+func deriveKey(password string, salt []byte, params KDFParams) ([]byte, error) {
+	return scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.DkLen)
+}
+
+func applyCipher(key []byte, iv []byte, input []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("%w: invalid iv length %d", ErrUnsupportedKeyFile, len(iv))
+	}
+
+	output := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(output, input)
+
+	return output, nil
+}
+
+func computeMAC(key []byte, ciphertext []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write(ciphertext)
+
+	return h.Sum(nil)
+}
+
+func randomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+func newUUID() (string, error) {
+	buff, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+
+	// version 4 (random) and RFC 4122 variant
+	buff[6] = (buff[6] & 0x0f) | 0x40
+	buff[8] = (buff[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buff[0:4], buff[4:6], buff[6:8], buff[8:10], buff[10:16]), nil
+}
@@ -0,0 +1,166 @@
+package keystore_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secretKey = []byte("a secret key of 32 bytes length!")
+
+const (
+	testAddress  = "address"
+	testPassword = "password"
+)
+
+func TestEncrypt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty secret key should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, err := keystore.Encrypt(nil, testAddress, "", testPassword)
+		assert.Nil(t, keyFile)
+		assert.Equal(t, keystore.ErrEmptySecretKey, err)
+	})
+	t.Run("empty password should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, err := keystore.Encrypt(secretKey, testAddress, "", "")
+		assert.Nil(t, keyFile)
+		assert.Equal(t, keystore.ErrEmptyPassword, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, err := keystore.Encrypt(secretKey, testAddress, "erd1", testPassword)
+		require.Nil(t, err)
+		assert.Equal(t, 4, keyFile.Version)
+		assert.Equal(t, "secretKey", keyFile.Kind)
+		assert.Equal(t, testAddress, keyFile.Address)
+		assert.Equal(t, "erd1", keyFile.Bech32)
+		assert.Equal(t, 36, len(keyFile.ID))
+		assert.NotEqual(t, hex.EncodeToString(secretKey), keyFile.Crypto.Ciphertext)
+
+		// salt and iv are random
+		otherKeyFile, _ := keystore.Encrypt(secretKey, testAddress, "erd1", testPassword)
+		assert.NotEqual(t, keyFile.Crypto.Ciphertext, otherKeyFile.Crypto.Ciphertext)
+	})
+}
+
+func TestDecrypt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		recovered, err := keystore.Decrypt(nil, testPassword)
+		assert.Nil(t, recovered)
+		assert.Equal(t, keystore.ErrNilKeyFile, err)
+	})
+	t.Run("unsupported key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, _ := keystore.Encrypt(secretKey, testAddress, "", testPassword)
+		keyFile.Crypto.KDF = "pbkdf2"
+		recovered, err := keystore.Decrypt(keyFile, testPassword)
+		assert.Nil(t, recovered)
+		assert.True(t, errors.Is(err, keystore.ErrUnsupportedKeyFile))
+	})
+	t.Run("out of bounds scrypt parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, _ := keystore.Encrypt(secretKey, testAddress, "", testPassword)
+		keyFile.Crypto.KDFParams.N = 1 << 30
+		recovered, err := keystore.Decrypt(keyFile, testPassword)
+		assert.Nil(t, recovered)
+		assert.True(t, errors.Is(err, keystore.ErrInvalidKDFParams))
+
+		keyFile, _ = keystore.Encrypt(secretKey, testAddress, "", testPassword)
+		keyFile.Crypto.KDFParams.R = 1 << 20
+		recovered, err = keystore.Decrypt(keyFile, testPassword)
+		assert.Nil(t, recovered)
+		assert.True(t, errors.Is(err, keystore.ErrInvalidKDFParams))
+
+		keyFile, _ = keystore.Encrypt(secretKey, testAddress, "", testPassword)
+		keyFile.Crypto.KDFParams.P = 0
+		recovered, err = keystore.Decrypt(keyFile, testPassword)
+		assert.Nil(t, recovered)
+		assert.True(t, errors.Is(err, keystore.ErrInvalidKDFParams))
+	})
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, _ := keystore.Encrypt(secretKey, testAddress, "", testPassword)
+		recovered, err := keystore.Decrypt(keyFile, "wrong password")
+		assert.Nil(t, recovered)
+		assert.Equal(t, keystore.ErrWrongPassword, err)
+	})
+	t.Run("tampered ciphertext should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, _ := keystore.Encrypt(secretKey, testAddress, "", testPassword)
+		tampered := []byte(keyFile.Crypto.Ciphertext)
+		tampered[0] = '0'
+		if keyFile.Crypto.Ciphertext[0] == '0' {
+			tampered[0] = '1'
+		}
+		keyFile.Crypto.Ciphertext = string(tampered)
+		recovered, err := keystore.Decrypt(keyFile, testPassword)
+		assert.Nil(t, recovered)
+		assert.Equal(t, keystore.ErrWrongPassword, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, _ := keystore.Encrypt(secretKey, testAddress, "", testPassword)
+		recovered, err := keystore.Decrypt(keyFile, testPassword)
+		assert.Nil(t, err)
+		assert.Equal(t, secretKey, recovered)
+	})
+}
+
+func TestKeyLoader_LoadKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	require.Nil(t, ioutil.WriteFile(passwordFile, []byte(testPassword+"\n"), 0600))
+	keyFilename := filepath.Join(dir, "validatorKey.json")
+	keyFile, _ := keystore.Encrypt(secretKey, testAddress, "", testPassword)
+	require.Nil(t, keystore.SaveKeyFile(keyFilename, keyFile))
+
+	t.Run("empty password file should error", func(t *testing.T) {
+		t.Parallel()
+
+		emptyPasswordFile := filepath.Join(dir, "empty")
+		require.Nil(t, ioutil.WriteFile(emptyPasswordFile, []byte("\n"), 0600))
+		kl, err := keystore.NewKeyLoader(emptyPasswordFile)
+		assert.Nil(t, kl)
+		assert.True(t, errors.Is(err, keystore.ErrEmptyPassword))
+	})
+	t.Run("non zero index should error", func(t *testing.T) {
+		t.Parallel()
+
+		kl, _ := keystore.NewKeyLoader(passwordFile)
+		_, _, err := kl.LoadKey(keyFilename, 1)
+		assert.True(t, errors.Is(err, keystore.ErrInvalidKeyIndex))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		kl, err := keystore.NewKeyLoader(passwordFile)
+		require.Nil(t, err)
+
+		encodedSk, pk, err := kl.LoadKey(keyFilename, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(secretKey), string(encodedSk))
+		assert.Equal(t, testAddress, pk)
+	})
+}
@@ -0,0 +1,76 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// IsKeyFile returns true if the provided file name designates a password encrypted JSON key file
+func IsKeyFile(filename string) bool {
+	return strings.HasSuffix(strings.ToLower(filename), ".json")
+}
+
+// LoadPrivateKey loads the secret key from a password encrypted JSON key file or, otherwise, from a PEM file, checking
+// that it matches the public key stored next to it
+func LoadPrivateKey(
+	keyGenerator crypto.KeyGenerator,
+	pubKeyConverter core.PubkeyConverter,
+	filename string,
+	index int,
+	passwordFile string,
+) (crypto.PrivateKey, error) {
+	var skBytes []byte
+	var pkString string
+	var err error
+	if IsKeyFile(filename) {
+		if len(passwordFile) == 0 {
+			return nil, ErrMissingPasswordFile
+		}
+
+		loader, errCreate := NewKeyLoader(passwordFile)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		skBytes, pkString, err = loader.LoadKey(filename, index)
+	} else {
+		skBytes, pkString, err = core.LoadSkPkFromPemFile(filename, index)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sk, pkBytes, err := DecodePrivateKey(keyGenerator, skBytes)
+	if err != nil {
+		return nil, err
+	}
+	if pubKeyConverter.Encode(pkBytes) != pkString && hex.EncodeToString(pkBytes) != pkString {
+		return nil, fmt.Errorf("%w: %s", ErrPublicKeyMismatch, pkString)
+	}
+
+	return sk, nil
+}
+
+// DecodePrivateKey decodes the hex encoded secret key and returns it along with its public key bytes
+func DecodePrivateKey(keyGenerator crypto.KeyGenerator, encodedSk []byte) (crypto.PrivateKey, []byte, error) {
+	decodedSk, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for encoded secret key", err)
+	}
+
+	sk, err := keyGenerator.PrivateKeyFromByteArray(decodedSk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sk, pkBytes, nil
+}
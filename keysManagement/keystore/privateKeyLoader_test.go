@@ -0,0 +1,89 @@
+package keystore_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsKeyFile(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, keystore.IsKeyFile("walletKey.json"))
+	assert.True(t, keystore.IsKeyFile("walletKey.JSON"))
+	assert.False(t, keystore.IsKeyFile("walletKey.pem"))
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	keyGenerator := signing.NewKeyGenerator(ed25519.NewEd25519())
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+	sk, pk := keyGenerator.GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	pkBytes, _ := pk.ToByteArray()
+	_, otherPk := keyGenerator.GeneratePair()
+	otherPkBytes, _ := otherPk.ToByteArray()
+
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	require.Nil(t, ioutil.WriteFile(passwordFile, []byte(testPassword), 0600))
+
+	keyFilename := filepath.Join(dir, "walletKey.json")
+	keyFile, _ := keystore.Encrypt(skBytes, hex.EncodeToString(pkBytes), "", testPassword)
+	require.Nil(t, keystore.SaveKeyFile(keyFilename, keyFile))
+
+	mismatchFilename := filepath.Join(dir, "mismatch.json")
+	keyFile, _ = keystore.Encrypt(skBytes, hex.EncodeToString(otherPkBytes), "", testPassword)
+	require.Nil(t, keystore.SaveKeyFile(mismatchFilename, keyFile))
+
+	pemFilename := filepath.Join(dir, "walletKey.pem")
+	buff := bytes.NewBuffer(make([]byte, 0))
+	require.Nil(t, pem.Encode(buff, &pem.Block{
+		Type:  "PRIVATE KEY for " + hex.EncodeToString(pkBytes),
+		Bytes: []byte(hex.EncodeToString(skBytes)),
+	}))
+	require.Nil(t, ioutil.WriteFile(pemFilename, buff.Bytes(), 0600))
+
+	t.Run("key file without password file should error", func(t *testing.T) {
+		t.Parallel()
+
+		loaded, err := keystore.LoadPrivateKey(keyGenerator, converter, keyFilename, 0, "")
+		assert.Nil(t, loaded)
+		assert.Equal(t, keystore.ErrMissingPasswordFile, err)
+	})
+	t.Run("public key mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		loaded, err := keystore.LoadPrivateKey(keyGenerator, converter, mismatchFilename, 0, passwordFile)
+		assert.Nil(t, loaded)
+		assert.True(t, errors.Is(err, keystore.ErrPublicKeyMismatch))
+	})
+	t.Run("key file should work", func(t *testing.T) {
+		t.Parallel()
+
+		loaded, err := keystore.LoadPrivateKey(keyGenerator, converter, keyFilename, 0, passwordFile)
+		require.Nil(t, err)
+		loadedBytes, _ := loaded.ToByteArray()
+		assert.Equal(t, skBytes, loadedBytes)
+	})
+	t.Run("PEM file should work", func(t *testing.T) {
+		t.Parallel()
+
+		loaded, err := keystore.LoadPrivateKey(keyGenerator, converter, pemFilename, 0, "")
+		require.Nil(t, err)
+		loadedBytes, _ := loaded.ToByteArray()
+		assert.Equal(t, skBytes, loadedBytes)
+	})
+}
@@ -0,0 +1,206 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var log = logger.GetOrCreate("keysManagement")
+
+// maxRoundsOfInactivityAccepted defines the maximum rounds of inactivity accepted for a key, after which the main
+// or lower level redundancy machines will be considered inactive for that key
+const maxRoundsOfInactivityAccepted = 5
+
+var _ common.ManagedPeersHolder = (*managedPeersHolder)(nil)
+
+// ArgsManagedPeersHolder represents the argument for the managed peers holder
+type ArgsManagedPeersHolder struct {
+	KeyGenerator    crypto.KeyGenerator
+	Messenger       P2PMessenger
+	RedundancyLevel int64
+}
+
+type peerInfo struct {
+	privateKey          crypto.PrivateKey
+	roundsOfInactivity  uint64
+	lastRoundIndexCheck int64
+}
+
+// managedPeersHolder holds the extra validator keys hosted by the current node. Each key has its own redundancy
+// state: on a backup machine a key is managed only after the main or lower level redundancy machines did not send
+// consensus messages signed with that key for a number of rounds
+type managedPeersHolder struct {
+	mut             sync.RWMutex
+	peers           map[string]*peerInfo
+	keyGenerator    crypto.KeyGenerator
+	messenger       P2PMessenger
+	redundancyLevel int64
+}
+
+// NewManagedPeersHolder creates a new instance of a managed peers holder
+func NewManagedPeersHolder(args ArgsManagedPeersHolder) (*managedPeersHolder, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+
+	return &managedPeersHolder{
+		peers:           make(map[string]*peerInfo),
+		keyGenerator:    args.KeyGenerator,
+		messenger:       args.Messenger,
+		redundancyLevel: args.RedundancyLevel,
+	}, nil
+}
+
+// AddManagedPeer adds the key built from the provided private key bytes to the managed keys
+func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
+	privateKey, err := holder.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return fmt.Errorf("%w for provided bytes %s", err, hex.EncodeToString(privateKeyBytes))
+	}
+
+	pkBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return fmt.Errorf("%w while generating the public key", err)
+	}
+
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	_, found := holder.peers[string(pkBytes)]
+	if found {
+		return fmt.Errorf("%w for public key %s", ErrDuplicatedKey, hex.EncodeToString(pkBytes))
+	}
+
+	holder.peers[string(pkBytes)] = &peerInfo{
+		privateKey: privateKey,
+	}
+
+	log.Debug("added managed key", "public key", pkBytes)
+
+	return nil
+}
+
+// GetPrivateKey returns the private key of the provided managed public key
+func (holder *managedPeersHolder) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	pInfo, found := holder.peers[string(pkBytes)]
+	if !found {
+		return nil, fmt.Errorf("%w for public key %s", ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+
+	return pInfo.privateKey, nil
+}
+
+// IsKeyRegistered returns true if the provided public key was added to the managed keys
+func (holder *managedPeersHolder) IsKeyRegistered(pkBytes []byte) bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	_, found := holder.peers[string(pkBytes)]
+
+	return found
+}
+
+// IsKeyManagedByCurrentNode returns true if the provided public key is registered and the current node has to sign
+// with it: either the node is the main machine or the machines before it were inactive for that key
+func (holder *managedPeersHolder) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	pInfo, found := holder.peers[string(pkBytes)]
+	if !found {
+		return false
+	}
+
+	return holder.isKeyManagedByCurrentNode(pInfo)
+}
+
+func (holder *managedPeersHolder) isKeyManagedByCurrentNode(pInfo *peerInfo) bool {
+	if holder.redundancyLevel == 0 {
+		return true
+	}
+	if holder.redundancyLevel < 0 {
+		return false
+	}
+
+	return int64(pInfo.roundsOfInactivity) >= maxRoundsOfInactivityAccepted*holder.redundancyLevel
+}
+
+// IncrementRoundsOfInactivity increments the rounds of inactivity of the provided key, if the key is registered.
+// It should be called once for each round in which the key is part of the consensus group, further calls for the
+// same round being ignored
+func (holder *managedPeersHolder) IncrementRoundsOfInactivity(pkBytes []byte, roundIndex int64) {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	pInfo, found := holder.peers[string(pkBytes)]
+	if !found {
+		return
+	}
+	if roundIndex <= pInfo.lastRoundIndexCheck {
+		return
+	}
+
+	pInfo.roundsOfInactivity++
+	pInfo.lastRoundIndexCheck = roundIndex
+}
+
+// ResetRoundsOfInactivity resets the rounds of inactivity of the provided key, if the key is registered and the
+// consensus message signed with it was sent by another peer
+func (holder *managedPeersHolder) ResetRoundsOfInactivity(pkBytes []byte, pid core.PeerID) {
+	if pid == holder.messenger.ID() {
+		return
+	}
+
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	pInfo, found := holder.peers[string(pkBytes)]
+	if !found {
+		return
+	}
+
+	pInfo.roundsOfInactivity = 0
+}
+
+// GetManagedKeysByCurrentNode returns the private keys, mapped by their public keys, the current node has to sign with
+func (holder *managedPeersHolder) GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	managedKeys := make(map[string]crypto.PrivateKey)
+	for pk, pInfo := range holder.peers {
+		if !holder.isKeyManagedByCurrentNode(pInfo) {
+			continue
+		}
+
+		managedKeys[pk] = pInfo.privateKey
+	}
+
+	return managedKeys
+}
+
+// IsMultiKeyMode returns true if the node hosts other keys besides its main key
+func (holder *managedPeersHolder) IsMultiKeyMode() bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	return len(holder.peers) > 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *managedPeersHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
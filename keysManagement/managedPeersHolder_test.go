@@ -0,0 +1,188 @@
+package keysManagement_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ownPid = core.PeerID("own pid")
+
+func createMockArgsManagedPeersHolder(redundancyLevel int64) keysManagement.ArgsManagedPeersHolder {
+	return keysManagement.ArgsManagedPeersHolder{
+		KeyGenerator: signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		Messenger: &p2pmocks.MessengerStub{
+			IDCalled: func() core.PeerID {
+				return ownPid
+			},
+		},
+		RedundancyLevel: redundancyLevel,
+	}
+}
+
+func generateKey(t *testing.T, keyGenerator crypto.KeyGenerator) ([]byte, []byte) {
+	sk, pk := keyGenerator.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	require.Nil(t, err)
+	pkBytes, err := pk.ToByteArray()
+	require.Nil(t, err)
+
+	return skBytes, pkBytes
+}
+
+func TestNewManagedPeersHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder(0)
+		args.KeyGenerator = nil
+		holder, err := keysManagement.NewManagedPeersHolder(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, keysManagement.ErrNilKeyGenerator, err)
+	})
+	t.Run("nil messenger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder(0)
+		args.Messenger = nil
+		holder, err := keysManagement.NewManagedPeersHolder(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, keysManagement.ErrNilMessenger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder, err := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder(0))
+		assert.False(t, check.IfNil(holder))
+		assert.Nil(t, err)
+		assert.False(t, holder.IsMultiKeyMode())
+	})
+}
+
+func TestManagedPeersHolder_AddManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder(0))
+		err := holder.AddManagedPeer([]byte("invalid"))
+		assert.NotNil(t, err)
+		assert.False(t, holder.IsMultiKeyMode())
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder(0)
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		skBytes, _ := generateKey(t, args.KeyGenerator)
+		err := holder.AddManagedPeer(skBytes)
+		assert.Nil(t, err)
+
+		err = holder.AddManagedPeer(skBytes)
+		assert.True(t, errors.Is(err, keysManagement.ErrDuplicatedKey))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder(0)
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		skBytes, pkBytes := generateKey(t, args.KeyGenerator)
+		err := holder.AddManagedPeer(skBytes)
+		assert.Nil(t, err)
+
+		assert.True(t, holder.IsMultiKeyMode())
+		assert.True(t, holder.IsKeyRegistered(pkBytes))
+		assert.False(t, holder.IsKeyRegistered([]byte("missing")))
+
+		privateKey, err := holder.GetPrivateKey(pkBytes)
+		assert.Nil(t, err)
+		recoveredSkBytes, _ := privateKey.ToByteArray()
+		assert.Equal(t, skBytes, recoveredSkBytes)
+
+		_, err = holder.GetPrivateKey([]byte("missing"))
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+	})
+}
+
+func TestManagedPeersHolder_MainMachineManagesAllKeys(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedPeersHolder(0)
+	holder, _ := keysManagement.NewManagedPeersHolder(args)
+	skBytes1, pkBytes1 := generateKey(t, args.KeyGenerator)
+	skBytes2, pkBytes2 := generateKey(t, args.KeyGenerator)
+	_ = holder.AddManagedPeer(skBytes1)
+	_ = holder.AddManagedPeer(skBytes2)
+
+	assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes1))
+	assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes2))
+	assert.False(t, holder.IsKeyManagedByCurrentNode([]byte("missing")))
+
+	managedKeys := holder.GetManagedKeysByCurrentNode()
+	assert.Equal(t, 2, len(managedKeys))
+	assert.NotNil(t, managedKeys[string(pkBytes1)])
+	assert.NotNil(t, managedKeys[string(pkBytes2)])
+}
+
+func TestManagedPeersHolder_BackupMachineManagesOnlyInactiveKeys(t *testing.T) {
+	t.Parallel()
+
+	redundancyLevel := int64(2)
+	args := createMockArgsManagedPeersHolder(redundancyLevel)
+	holder, _ := keysManagement.NewManagedPeersHolder(args)
+	skBytes1, pkBytes1 := generateKey(t, args.KeyGenerator)
+	skBytes2, pkBytes2 := generateKey(t, args.KeyGenerator)
+	_ = holder.AddManagedPeer(skBytes1)
+	_ = holder.AddManagedPeer(skBytes2)
+
+	assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes1))
+	assert.Equal(t, 0, len(holder.GetManagedKeysByCurrentNode()))
+
+	maxRoundsOfInactivity := int64(5) * redundancyLevel
+	for round := int64(1); round < maxRoundsOfInactivity; round++ {
+		holder.IncrementRoundsOfInactivity(pkBytes1, round)
+		// further calls in the same round are ignored
+		holder.IncrementRoundsOfInactivity(pkBytes1, round)
+	}
+	assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes1))
+
+	holder.IncrementRoundsOfInactivity(pkBytes1, maxRoundsOfInactivity)
+	assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes1))
+	assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes2))
+	managedKeys := holder.GetManagedKeysByCurrentNode()
+	assert.Equal(t, 1, len(managedKeys))
+	assert.NotNil(t, managedKeys[string(pkBytes1)])
+
+	// messages sent by the current node do not reset the counter
+	holder.ResetRoundsOfInactivity(pkBytes1, ownPid)
+	assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes1))
+
+	holder.ResetRoundsOfInactivity(pkBytes1, "other pid")
+	assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes1))
+}
+
+func TestManagedPeersHolder_NegativeRedundancyLevelNeverManagesKeys(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedPeersHolder(-1)
+	holder, _ := keysManagement.NewManagedPeersHolder(args)
+	skBytes, pkBytes := generateKey(t, args.KeyGenerator)
+	_ = holder.AddManagedPeer(skBytes)
+
+	for round := int64(1); round < 100; round++ {
+		holder.IncrementRoundsOfInactivity(pkBytes, round)
+	}
+	assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+}
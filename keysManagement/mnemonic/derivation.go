@@ -0,0 +1,133 @@
+package mnemonic
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	seedIterations   = 2048
+	seedLen          = 64
+	saltPrefix       = "mnemonic"
+	ed25519SeedKey   = "ed25519 seed"
+	hardenedOffset   = uint32(0x80000000)
+	purposeIndex     = 44
+	elrondCoinType   = 508
+	changeIndex      = 0
+	minNumWords      = 12
+	maxNumWords      = 24
+	numWordsMultiple = 3
+	bitsPerWord      = 11
+)
+
+var englishWordIndexes = createWordIndexes(englishWords)
+
+func createWordIndexes(wordList string) map[string]int {
+	words := strings.Fields(wordList)
+	indexes := make(map[string]int, len(words))
+	for index, word := range words {
+		indexes[word] = index
+	}
+
+	return indexes
+}
+
+// Normalize applies the NFKD normalization BIP39 requires, lowercases the mnemonic words and joins them with single
+// spaces
+func Normalize(mnemonic string) string {
+	return strings.Join(strings.Fields(strings.ToLower(norm.NFKD.String(mnemonic))), " ")
+}
+
+// CheckFormat checks that the mnemonic is made of words from the BIP39 English word list and that its checksum is
+// valid, so that a mistyped word does not silently derive another wallet
+func CheckFormat(mnemonic string) error {
+	words := strings.Fields(mnemonic)
+	numWords := len(words)
+	if numWords < minNumWords || numWords > maxNumWords || numWords%numWordsMultiple != 0 {
+		return fmt.Errorf("%w: %d words", ErrInvalidMnemonic, numWords)
+	}
+
+	// each word holds 11 bits, the entropy being followed by a checksum of one bit for each 32 bits of entropy
+	bits := make([]bool, 0, numWords*bitsPerWord)
+	for _, word := range words {
+		index, found := englishWordIndexes[word]
+		if !found {
+			return fmt.Errorf("%w: unknown word %s", ErrInvalidMnemonic, word)
+		}
+		for i := bitsPerWord - 1; i >= 0; i-- {
+			bits = append(bits, (index>>uint(i))&1 == 1)
+		}
+	}
+
+	numChecksumBits := len(bits) / 33
+	numEntropyBits := len(bits) - numChecksumBits
+	entropy := make([]byte, numEntropyBits/8)
+	for i := 0; i < numEntropyBits; i++ {
+		if bits[i] {
+			entropy[i/8] |= 1 << uint(7-i%8)
+		}
+	}
+
+	hash := sha256.Sum256(entropy)
+	for i := 0; i < numChecksumBits; i++ {
+		expectedBit := (hash[i/8]>>uint(7-i%8))&1 == 1
+		if bits[numEntropyBits+i] != expectedBit {
+			return fmt.Errorf("%w: invalid checksum", ErrInvalidMnemonic)
+		}
+	}
+
+	return nil
+}
+
+// DerivationPath returns the Elrond derivation path of the wallet key with the provided account and address index
+func DerivationPath(account uint32, addressIndex uint32) string {
+	return fmt.Sprintf("m/%d'/%d'/%d'/%d'/%d'", purposeIndex, elrondCoinType, account, changeIndex, addressIndex)
+}
+
+// DeriveWalletSecretKey derives the ed25519 wallet secret key seed from the mnemonic, following BIP39 for the seed
+// and SLIP-0010 for the m/44'/508'/account'/0'/addressIndex' derivation path
+func DeriveWalletSecretKey(mnemonic string, passphrase string, account uint32, addressIndex uint32) ([]byte, error) {
+	normalized := Normalize(mnemonic)
+	err := CheckFormat(normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := saltPrefix + norm.NFKD.String(passphrase)
+	seed := pbkdf2.Key([]byte(normalized), []byte(salt), seedIterations, seedLen, sha512.New)
+
+	key, chainCode := hmacSHA512([]byte(ed25519SeedKey), seed)
+	path := []uint32{purposeIndex, elrondCoinType, account, changeIndex, addressIndex}
+	for _, index := range path {
+		key, chainCode = deriveHardenedChild(key, chainCode, index)
+	}
+
+	return key, nil
+}
+
+// deriveHardenedChild computes the SLIP-0010 ed25519 child key, where only hardened derivation is defined
+func deriveHardenedChild(key []byte, chainCode []byte, index uint32) ([]byte, []byte) {
+	data := make([]byte, 0, 1+len(key)+4)
+	data = append(data, 0)
+	data = append(data, key...)
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, index+hardenedOffset)
+	data = append(data, indexBytes...)
+
+	return hmacSHA512(chainCode, data)
+}
+
+func hmacSHA512(key []byte, data []byte) ([]byte, []byte) {
+	h := hmac.New(sha512.New, key)
+	_, _ = h.Write(data)
+	sum := h.Sum(nil)
+
+	return sum[:32], sum[32:]
+}
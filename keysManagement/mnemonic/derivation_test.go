@@ -0,0 +1,87 @@
+package mnemonic_test
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/mnemonic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMnemonic is the public mnemonic of the Elrond test wallets
+const testMnemonic = "moral volcano peasant pass circle pen over picture flat shop clap goat never lyrics gather " +
+	"prepare woman film husband gravity behind test tiger improve"
+
+func TestCheckFormat(t *testing.T) {
+	t.Parallel()
+
+	err := mnemonic.CheckFormat("moral volcano peasant")
+	assert.True(t, errors.Is(err, mnemonic.ErrInvalidMnemonic))
+
+	err = mnemonic.CheckFormat("moral volcano peasant pass circle pen over picture flat shop clap g0at")
+	assert.True(t, errors.Is(err, mnemonic.ErrInvalidMnemonic))
+
+	// a word outside the BIP39 English word list
+	err = mnemonic.CheckFormat(strings.Replace(testMnemonic, "volcano", "volcanoes", 1))
+	assert.True(t, errors.Is(err, mnemonic.ErrInvalidMnemonic))
+
+	// a mistyped, but valid, word breaks the checksum
+	err = mnemonic.CheckFormat(strings.Replace(testMnemonic, "volcano", "voice", 1))
+	assert.True(t, errors.Is(err, mnemonic.ErrInvalidMnemonic))
+
+	// BIP39 test vectors
+	err = mnemonic.CheckFormat(strings.Repeat("abandon ", 11) + "about")
+	assert.Nil(t, err)
+	err = mnemonic.CheckFormat(strings.Repeat("abandon ", 12))
+	assert.True(t, errors.Is(err, mnemonic.ErrInvalidMnemonic))
+
+	assert.Nil(t, mnemonic.CheckFormat(testMnemonic))
+}
+
+func TestDerivationPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "m/44'/508'/0'/0'/0'", mnemonic.DerivationPath(0, 0))
+	assert.Equal(t, "m/44'/508'/1'/0'/7'", mnemonic.DerivationPath(1, 7))
+}
+
+func TestDeriveWalletSecretKey(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, logger.GetOrCreate("test"))
+	expectedAddresses := []string{
+		"erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+		"erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx",
+	}
+
+	for index, expectedAddress := range expectedAddresses {
+		sk, err := mnemonic.DeriveWalletSecretKey(testMnemonic, "", 0, uint32(index))
+		require.Nil(t, err)
+
+		pk := ed25519.NewKeyFromSeed(sk).Public().(ed25519.PublicKey)
+		assert.Equal(t, expectedAddress, converter.Encode(pk))
+	}
+
+	// the words are normalized before derivation
+	sk1, _ := mnemonic.DeriveWalletSecretKey(testMnemonic, "", 0, 0)
+	sk2, _ := mnemonic.DeriveWalletSecretKey("  MORAL "+testMnemonic[6:]+"\n", "", 0, 0)
+	assert.Equal(t, sk1, sk2)
+
+	// the passphrase changes the keys
+	sk3, _ := mnemonic.DeriveWalletSecretKey(testMnemonic, "passphrase", 0, 0)
+	assert.NotEqual(t, sk1, sk3)
+
+	// the passphrase is NFKD normalized, so the composed and the decomposed forms derive the same key
+	sk4, _ := mnemonic.DeriveWalletSecretKey(testMnemonic, "caf\u00e9", 0, 0)
+	sk5, _ := mnemonic.DeriveWalletSecretKey(testMnemonic, "cafe\u0301", 0, 0)
+	assert.Equal(t, sk4, sk5)
+
+	// a mistyped word is rejected instead of deriving another wallet
+	_, err := mnemonic.DeriveWalletSecretKey(strings.Replace(testMnemonic, "volcano", "voice", 1), "", 0, 0)
+	assert.True(t, errors.Is(err, mnemonic.ErrInvalidMnemonic))
+}
@@ -0,0 +1,16 @@
+package mnemonic
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnglishWords(t *testing.T) {
+	t.Parallel()
+
+	// crc32 of the english.txt file published in the BIP39 repository
+	assert.Equal(t, uint32(0xc1dbd296), crc32.ChecksumIEEE([]byte(englishWords)))
+	assert.Equal(t, 2048, len(englishWordIndexes))
+}
@@ -0,0 +1,6 @@
+package mnemonic
+
+import "errors"
+
+// ErrInvalidMnemonic signals that an invalid mnemonic has been provided
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
@@ -0,0 +1,43 @@
+package keysManagement
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// peerIDSigner is a single signer used for the peer signatures. The private keys held by an external signing service
+// are routed to that service, while all other keys and the verification use the wrapped single signer
+type peerIDSigner struct {
+	crypto.SingleSigner
+}
+
+// NewPeerIDSigner creates a new peer ID signer wrapping the provided single signer
+func NewPeerIDSigner(singleSigner crypto.SingleSigner) (*peerIDSigner, error) {
+	if check.IfNil(singleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+
+	return &peerIDSigner{
+		SingleSigner: singleSigner,
+	}, nil
+}
+
+// Sign signs the provided peer ID with the provided private key
+func (pis *peerIDSigner) Sign(privateKey crypto.PrivateKey, pid []byte) ([]byte, error) {
+	externalKey, isExternal := privateKey.(ExternalPrivateKey)
+	if !isExternal {
+		return pis.SingleSigner.Sign(privateKey, pid)
+	}
+
+	pkBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return externalKey.KeysSigner().SignPeerID(pkBytes, pid)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pis *peerIDSigner) IsInterfaceNil() bool {
+	return pis == nil
+}
@@ -0,0 +1,76 @@
+package keysManagement_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerIDSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		pis, err := keysManagement.NewPeerIDSigner(nil)
+		assert.True(t, check.IfNil(pis))
+		assert.Equal(t, keysManagement.ErrNilSingleSigner, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pis, err := keysManagement.NewPeerIDSigner(&cryptoMocks.SingleSignerStub{})
+		assert.False(t, check.IfNil(pis))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPeerIDSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	singleSigner := &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return []byte("local signature"), nil
+		},
+	}
+	pis, _ := keysManagement.NewPeerIDSigner(singleSigner)
+
+	t.Run("local key should use the single signer", func(t *testing.T) {
+		t.Parallel()
+
+		signature, err := pis.Sign(&cryptoMocks.PrivateKeyStub{}, []byte("pid"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("local signature"), signature)
+	})
+	t.Run("external key should use its keys signer", func(t *testing.T) {
+		t.Parallel()
+
+		externalKey := &externalPrivateKeyStub{
+			PrivateKeyStub: cryptoMocks.PrivateKeyStub{
+				GeneratePublicStub: func() crypto.PublicKey {
+					return &cryptoMocks.PublicKeyStub{
+						ToByteArrayStub: func() ([]byte, error) {
+							return []byte("pk"), nil
+						},
+					}
+				},
+			},
+			keysSigner: &testscommon.KeysSignerStub{
+				SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
+					assert.Equal(t, []byte("pk"), pkBytes)
+					assert.Equal(t, []byte("pid"), pid)
+					return []byte("external signature"), nil
+				},
+			},
+		}
+
+		signature, err := pis.Sign(externalKey, []byte("pid"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("external signature"), signature)
+	})
+}
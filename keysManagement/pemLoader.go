@@ -0,0 +1,48 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+const pemBlockTypeHeader = "PRIVATE KEY for "
+
+// LoadAllKeysFromPemFile returns all the private keys stored in the provided pem file, along with the public keys
+// found in the header of each block. The file has the same format as the validator key file, with one block per key
+func LoadAllKeysFromPemFile(filename string) ([][]byte, []string, error) {
+	buff, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKeys := make([][]byte, 0)
+	publicKeys := make([]string, 0)
+	for index := 0; len(strings.TrimSpace(string(buff))) > 0; index++ {
+		var block *pem.Block
+		block, buff = pem.Decode(buff)
+		if block == nil {
+			return nil, nil, fmt.Errorf("%w in file %s at index %d, error decoding", ErrInvalidPemBlock, filename, index)
+		}
+		if !strings.HasPrefix(block.Type, pemBlockTypeHeader) {
+			return nil, nil, fmt.Errorf("%w in file %s at index %d, missing '%s' in block type",
+				ErrInvalidPemBlock, filename, index, pemBlockTypeHeader)
+		}
+
+		privateKey, errDecode := hex.DecodeString(string(block.Bytes))
+		if errDecode != nil {
+			return nil, nil, fmt.Errorf("%w in file %s at index %d, %s", ErrInvalidPemBlock, filename, index, errDecode.Error())
+		}
+
+		privateKeys = append(privateKeys, privateKey)
+		publicKeys = append(publicKeys, block.Type[len(pemBlockTypeHeader):])
+	}
+
+	if len(privateKeys) == 0 {
+		return nil, nil, fmt.Errorf("%w %s", ErrEmptyPemFile, filename)
+	}
+
+	return privateKeys, publicKeys, nil
+}
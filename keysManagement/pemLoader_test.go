@@ -0,0 +1,84 @@
+package keysManagement_test
+
+import (
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePemFile(t *testing.T, blocks ...*pem.Block) string {
+	buff := make([]byte, 0)
+	for _, block := range blocks {
+		buff = append(buff, pem.EncodeToMemory(block)...)
+	}
+
+	filename := filepath.Join(t.TempDir(), "allValidatorsKeys.pem")
+	require.Nil(t, ioutil.WriteFile(filename, buff, 0600))
+
+	return filename
+}
+
+func createPemBlock(pk string, sk []byte) *pem.Block {
+	return &pem.Block{
+		Type:  "PRIVATE KEY for " + pk,
+		Bytes: []byte(hex.EncodeToString(sk)),
+	}
+}
+
+func TestLoadAllKeysFromPemFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(filepath.Join(t.TempDir(), "missing.pem"))
+		assert.NotNil(t, err)
+		assert.Nil(t, privateKeys)
+		assert.Nil(t, publicKeys)
+	})
+	t.Run("empty file should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(writePemFile(t))
+		assert.True(t, errors.Is(err, keysManagement.ErrEmptyPemFile))
+		assert.Nil(t, privateKeys)
+		assert.Nil(t, publicKeys)
+	})
+	t.Run("invalid block type should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := writePemFile(t,
+			createPemBlock("pk0", []byte("sk0")),
+			&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("00")},
+		)
+		_, _, err := keysManagement.LoadAllKeysFromPemFile(filename)
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidPemBlock))
+	})
+	t.Run("invalid hex content should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := writePemFile(t, &pem.Block{Type: "PRIVATE KEY for pk0", Bytes: []byte("not hex")})
+		_, _, err := keysManagement.LoadAllKeysFromPemFile(filename)
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidPemBlock))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		filename := writePemFile(t,
+			createPemBlock("pk0", []byte("sk0")),
+			createPemBlock("pk1", []byte("sk1")),
+			createPemBlock("pk2", []byte("sk2")),
+		)
+		privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(filename)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("sk0"), []byte("sk1"), []byte("sk2")}, privateKeys)
+		assert.Equal(t, []string{"pk0", "pk1", "pk2"}, publicKeys)
+	})
+}
@@ -0,0 +1,93 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// SignatureType defines the purpose of a signature requested from the remote signer
+type SignatureType string
+
+const (
+	// BlockSignatureShare is the signature share of a consensus group member over the block header hash
+	BlockSignatureShare SignatureType = "blockSignatureShare"
+	// BlockHeader is the leader signature over the marshalized block header
+	BlockHeader SignatureType = "blockHeader"
+	// RandSeed is the signature over the previous random seed, used as the random seed of the proposed block
+	RandSeed SignatureType = "randSeed"
+	// PeerID is the signature over the peer ID, used on consensus and heartbeat messages
+	PeerID SignatureType = "peerID"
+)
+
+const (
+	signEndpoint = "/v1/sign"
+	keysEndpoint = "/v1/keys"
+)
+
+// blsSignatureLength is the length in bytes of a BLS signature, as the previous random seed is the signature of the
+// previous block proposer
+const blsSignatureLength = 48
+
+// hashLength is the length in bytes of the hashes signed as block signature shares
+const hashLength = 32
+
+// maxRequestSize defines the maximum size in bytes of a request accepted by the signing handler
+const maxRequestSize = 1 << 20
+
+// SignRequest holds the data of a signing request sent to the remote signer
+type SignRequest struct {
+	PublicKey string        `json:"publicKey"`
+	Type      SignatureType `json:"type"`
+	Round     int64         `json:"round"`
+	Message   []byte        `json:"message"`
+}
+
+// SignResponse holds the response of the remote signer for a signing request
+type SignResponse struct {
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// KeysResponse holds the hex encoded public keys held by the remote signer
+type KeysResponse struct {
+	PublicKeys []string `json:"publicKeys"`
+	Error      string   `json:"error,omitempty"`
+}
+
+func isSignatureTypeKnown(signatureType SignatureType) bool {
+	switch signatureType {
+	case BlockSignatureShare, BlockHeader, RandSeed, PeerID:
+		return true
+	default:
+		return false
+	}
+}
+
+// isSlashable returns true if signing two different messages of the provided type in the same round with the same key
+// can lead to slashing
+func isSlashable(signatureType SignatureType) bool {
+	return signatureType == BlockSignatureShare || signatureType == BlockHeader
+}
+
+// checkMessageShape checks that the message of a not slashable signature type has the shape of that type, so that a
+// header hash, for example, can not be signed as a peer ID or as a random seed without passing the slashing protection
+func checkMessageShape(signatureType SignatureType, message []byte) error {
+	switch signatureType {
+	case PeerID:
+		if len(message) == hashLength {
+			return fmt.Errorf("%w: %s message has the length of a hash", ErrInvalidMessageShape, signatureType)
+		}
+		_, err := peer.IDFromBytes(message)
+		if err != nil {
+			return fmt.Errorf("%w: %s message is not a peer ID, %s", ErrInvalidMessageShape, signatureType, err.Error())
+		}
+	case RandSeed:
+		if len(message) != blsSignatureLength {
+			return fmt.Errorf("%w: %s message of length %d, expected %d",
+				ErrInvalidMessageShape, signatureType, len(message), blsSignatureLength)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package remote
+
+import "errors"
+
+// ErrEmptyURL signals that an empty URL has been provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrNilTLSConfig signals that a nil TLS configuration has been provided
+var ErrNilTLSConfig = errors.New("nil TLS config")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrNilSlashingProtector signals that a nil slashing protector has been provided
+var ErrNilSlashingProtector = errors.New("nil slashing protector")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPublicKey signals that a nil public key has been provided
+var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrNilKeysSigner signals that a nil keys signer has been provided
+var ErrNilKeysSigner = errors.New("nil keys signer")
+
+// ErrNoPrivateKeys signals that no private keys have been provided
+var ErrNoPrivateKeys = errors.New("no private keys")
+
+// ErrSlashingProtection signals that the signing request was refused as it could lead to slashing
+var ErrSlashingProtection = errors.New("slashing protection")
+
+// ErrUnknownSignatureType signals that an unknown signature type has been requested
+var ErrUnknownSignatureType = errors.New("unknown signature type")
+
+// ErrUnknownPublicKey signals that the requested public key is not held by the remote signer
+var ErrUnknownPublicKey = errors.New("unknown public key")
+
+// ErrEmptyMessage signals that an empty message was requested to be signed
+var ErrEmptyMessage = errors.New("empty message")
+
+// ErrRemoteSigner signals that the remote signer returned an error
+var ErrRemoteSigner = errors.New("remote signer error")
+
+// ErrInvalidCACertificate signals that the CA certificate file does not contain any valid certificate
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
+
+// ErrInvalidMessageShape signals that the message does not have the shape of the requested signature type
+var ErrInvalidMessageShape = errors.New("invalid message shape")
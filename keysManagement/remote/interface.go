@@ -0,0 +1,7 @@
+package remote
+
+// SlashingProtector defines the operations of an entity that refuses the signing requests that could lead to slashing
+type SlashingProtector interface {
+	CheckAndRecord(pkBytes []byte, signatureType SignatureType, round int64, message []byte) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,70 @@
+package remote
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// remoteKeyReferencePrefix prefixes the byte representation of a remote private key, making it an invalid private key
+const remoteKeyReferencePrefix = "remote signer key "
+
+// remotePrivateKey is a reference to a private key held by the remote signer. It does not contain any key material,
+// the signing requests being routed to the remote signer
+type remotePrivateKey struct {
+	publicKey  crypto.PublicKey
+	reference  []byte
+	keysSigner common.KeysSigner
+}
+
+// NewRemotePrivateKey creates a reference to the private key held by the remote signer for the provided public key
+func NewRemotePrivateKey(publicKey crypto.PublicKey, keysSigner common.KeysSigner) (*remotePrivateKey, error) {
+	if check.IfNil(publicKey) {
+		return nil, ErrNilPublicKey
+	}
+	if check.IfNil(keysSigner) {
+		return nil, ErrNilKeysSigner
+	}
+
+	pkBytes, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &remotePrivateKey{
+		publicKey:  publicKey,
+		reference:  append([]byte(remoteKeyReferencePrefix), pkBytes...),
+		keysSigner: keysSigner,
+	}, nil
+}
+
+// ToByteArray returns the reference of the remote key, usable as an identifier. The reference can not be loaded back
+// as a private key
+func (rpk *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return rpk.reference, nil
+}
+
+// GeneratePublic returns the public key of the remote private key
+func (rpk *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return rpk.publicKey
+}
+
+// Suite returns the suite of the public key
+func (rpk *remotePrivateKey) Suite() crypto.Suite {
+	return rpk.publicKey.Suite()
+}
+
+// Scalar returns nil as the private key is not available locally
+func (rpk *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// KeysSigner returns the signer able to produce the signatures of this key
+func (rpk *remotePrivateKey) KeysSigner() common.KeysSigner {
+	return rpk.keysSigner
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rpk *remotePrivateKey) IsInterfaceNil() bool {
+	return rpk == nil
+}
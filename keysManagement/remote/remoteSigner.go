@@ -0,0 +1,183 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var log = logger.GetOrCreate("keysManagement/remote")
+
+var _ common.KeysSigner = (*remoteSigner)(nil)
+
+// ArgsRemoteSigner represents the argument for the remote signer client
+type ArgsRemoteSigner struct {
+	URL               string
+	TLSConfig         *tls.Config
+	RequestTimeout    time.Duration
+	SlashingProtector SlashingProtector
+}
+
+// remoteSigner is the client of a remote signer, used by the node when the validator keys are not held locally.
+// Before sending a request, the node side slashing protection is checked as well, so a compromised or misconfigured
+// remote signer can not make the node sign conflicting blocks
+type remoteSigner struct {
+	url               string
+	httpClient        *http.Client
+	requestTimeout    time.Duration
+	slashingProtector SlashingProtector
+}
+
+// NewRemoteSigner creates a new remote signer client
+func NewRemoteSigner(args ArgsRemoteSigner) (*remoteSigner, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if args.TLSConfig == nil {
+		return nil, ErrNilTLSConfig
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+	if check.IfNil(args.SlashingProtector) {
+		return nil, ErrNilSlashingProtector
+	}
+
+	return &remoteSigner{
+		url: strings.TrimSuffix(args.URL, "/"),
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: args.TLSConfig,
+			},
+		},
+		requestTimeout:    args.RequestTimeout,
+		slashingProtector: args.SlashingProtector,
+	}, nil
+}
+
+// SignBlockSignatureShare requests the signature share of the provided key over the header hash
+func (rs *remoteSigner) SignBlockSignatureShare(pkBytes []byte, round int64, headerHash []byte) ([]byte, error) {
+	return rs.sign(pkBytes, BlockSignatureShare, round, headerHash)
+}
+
+// SignBlockHeader requests the leader signature of the provided key over the marshalized header
+func (rs *remoteSigner) SignBlockHeader(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
+	return rs.sign(pkBytes, BlockHeader, round, marshalizedHeader)
+}
+
+// SignRandSeed requests the signature of the provided key over the previous random seed
+func (rs *remoteSigner) SignRandSeed(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error) {
+	return rs.sign(pkBytes, RandSeed, round, prevRandSeed)
+}
+
+// SignPeerID requests the signature of the provided key over the peer ID
+func (rs *remoteSigner) SignPeerID(pkBytes []byte, pid []byte) ([]byte, error) {
+	return rs.sign(pkBytes, PeerID, 0, pid)
+}
+
+func (rs *remoteSigner) sign(pkBytes []byte, signatureType SignatureType, round int64, message []byte) ([]byte, error) {
+	err := checkMessageShape(signatureType, message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rs.slashingProtector.CheckAndRecord(pkBytes, signatureType, round, message)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &SignRequest{
+		PublicKey: hex.EncodeToString(pkBytes),
+		Type:      signatureType,
+		Round:     round,
+		Message:   message,
+	}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignResponse{}
+	err = rs.doRequest(http.MethodPost, signEndpoint, requestBytes, response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Error) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigner, response.Error)
+	}
+
+	log.Trace("remoteSigner.sign", "type", signatureType, "round", round, "pk", request.PublicKey)
+
+	return response.Signature, nil
+}
+
+// GetPublicKeys returns the public keys held by the remote signer
+func (rs *remoteSigner) GetPublicKeys() ([][]byte, error) {
+	response := &KeysResponse{}
+	err := rs.doRequest(http.MethodGet, keysEndpoint, nil, response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Error) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigner, response.Error)
+	}
+
+	publicKeys := make([][]byte, 0, len(response.PublicKeys))
+	for _, hexPublicKey := range response.PublicKeys {
+		pkBytes, errDecode := hex.DecodeString(hexPublicKey)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w while decoding the public key %s", errDecode, hexPublicKey)
+		}
+
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	return publicKeys, nil
+}
+
+func (rs *remoteSigner) doRequest(method string, endpoint string, body []byte, response interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.requestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, method, rs.url+endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := rs.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrRemoteSigner, err.Error())
+	}
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+
+	responseBytes, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(responseBytes, response)
+	if err != nil {
+		return fmt.Errorf("%w: status %s, %s", ErrRemoteSigner, httpResponse.Status, err.Error())
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *remoteSigner) IsInterfaceNil() bool {
+	return rs == nil
+}
@@ -0,0 +1,366 @@
+package remote_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/remote"
+	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type remoteSignerHandler interface {
+	common.KeysSigner
+	GetPublicKeys() ([][]byte, error)
+}
+
+type certificateFiles struct {
+	caCertificate     string
+	serverCertificate string
+	serverKey         string
+	clientCertificate string
+	clientKey         string
+}
+
+func writePem(t *testing.T, filename string, blockType string, bytes []byte) {
+	buff := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes})
+	require.Nil(t, ioutil.WriteFile(filename, buff, 0600))
+}
+
+func createCertificate(
+	t *testing.T,
+	dir string,
+	name string,
+	template *x509.Certificate,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	if parent == nil {
+		parent = template
+		parentKey = key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	writePem(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	writePem(t, filepath.Join(dir, name+".key"), "EC PRIVATE KEY", keyBytes)
+
+	return certificate, key
+}
+
+func createCertificates(t *testing.T) certificateFiles {
+	dir := t.TempDir()
+	notBefore := time.Now().Add(-time.Hour)
+	notAfter := time.Now().Add(time.Hour)
+
+	caCertificate, caKey := createCertificate(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+	createCertificate(t, dir, "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "remote signer"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}, caCertificate, caKey)
+	createCertificate(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "node"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caCertificate, caKey)
+
+	return certificateFiles{
+		caCertificate:     filepath.Join(dir, "ca.crt"),
+		serverCertificate: filepath.Join(dir, "server.crt"),
+		serverKey:         filepath.Join(dir, "server.key"),
+		clientCertificate: filepath.Join(dir, "client.crt"),
+		clientKey:         filepath.Join(dir, "client.key"),
+	}
+}
+
+func startRemoteSigner(t *testing.T, certificates certificateFiles, privateKey crypto.PrivateKey) *httptest.Server {
+	pkBytes, _ := privateKey.GeneratePublic().ToByteArray()
+	slashingProtector, _ := remote.NewSlashingProtector("")
+	handler, err := remote.NewSigningHandler(remote.ArgsSigningHandler{
+		PrivateKeys:       map[string]crypto.PrivateKey{string(pkBytes): privateKey},
+		SingleSigner:      &mclSig.BlsSingleSigner{},
+		SlashingProtector: slashingProtector,
+	})
+	require.Nil(t, err)
+
+	serverTLSConfig, err := remote.NewServerTLSConfig(remote.ArgsTLSConfig{
+		CertificateFile:   certificates.serverCertificate,
+		KeyFile:           certificates.serverKey,
+		CACertificateFile: certificates.caCertificate,
+	})
+	require.Nil(t, err)
+
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = serverTLSConfig
+	server.StartTLS()
+
+	return server
+}
+
+func createRemoteSigner(t *testing.T, certificates certificateFiles, url string) remoteSignerHandler {
+	clientTLSConfig, err := remote.NewClientTLSConfig(remote.ArgsTLSConfig{
+		CertificateFile:   certificates.clientCertificate,
+		KeyFile:           certificates.clientKey,
+		CACertificateFile: certificates.caCertificate,
+	})
+	require.Nil(t, err)
+
+	slashingProtector, _ := remote.NewSlashingProtector("")
+	remoteSigner, err := remote.NewRemoteSigner(remote.ArgsRemoteSigner{
+		URL:               url,
+		TLSConfig:         clientTLSConfig,
+		RequestTimeout:    time.Second,
+		SlashingProtector: slashingProtector,
+	})
+	require.Nil(t, err)
+
+	return remoteSigner
+}
+
+func TestNewRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func() remote.ArgsRemoteSigner {
+		slashingProtector, _ := remote.NewSlashingProtector("")
+		return remote.ArgsRemoteSigner{
+			URL:               "https://127.0.0.1:9443",
+			TLSConfig:         &tls.Config{},
+			RequestTimeout:    time.Second,
+			SlashingProtector: slashingProtector,
+		}
+	}
+
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.URL = ""
+		rs, err := remote.NewRemoteSigner(args)
+		assert.True(t, check.IfNil(rs))
+		assert.Equal(t, remote.ErrEmptyURL, err)
+	})
+	t.Run("nil TLS config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.TLSConfig = nil
+		rs, err := remote.NewRemoteSigner(args)
+		assert.True(t, check.IfNil(rs))
+		assert.Equal(t, remote.ErrNilTLSConfig, err)
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.RequestTimeout = 0
+		rs, err := remote.NewRemoteSigner(args)
+		assert.True(t, check.IfNil(rs))
+		assert.Equal(t, remote.ErrInvalidRequestTimeout, err)
+	})
+	t.Run("nil slashing protector should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.SlashingProtector = nil
+		rs, err := remote.NewRemoteSigner(args)
+		assert.True(t, check.IfNil(rs))
+		assert.Equal(t, remote.ErrNilSlashingProtector, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := remote.NewRemoteSigner(createArgs())
+		assert.False(t, check.IfNil(rs))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRemoteSigner_EndToEnd(t *testing.T) {
+	t.Parallel()
+
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	singleSigner := &mclSig.BlsSingleSigner{}
+	privateKey, publicKey := keyGenerator.GeneratePair()
+	pkBytes, _ := publicKey.ToByteArray()
+	certificates := createCertificates(t)
+	server := startRemoteSigner(t, certificates, privateKey)
+	defer server.Close()
+
+	remoteSigner := createRemoteSigner(t, certificates, server.URL)
+	pid := createPeerID(t)
+	prevRandSeed, _ := singleSigner.Sign(privateKey, []byte("prev rand seed"))
+
+	t.Run("should list the held keys", func(t *testing.T) {
+		publicKeys, err := remoteSigner.GetPublicKeys()
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{pkBytes}, publicKeys)
+	})
+	t.Run("should produce valid signatures", func(t *testing.T) {
+		signature, err := remoteSigner.SignBlockSignatureShare(pkBytes, 10, []byte("header hash"))
+		require.Nil(t, err)
+		assert.Nil(t, singleSigner.Verify(publicKey, []byte("header hash"), signature))
+
+		signature, err = remoteSigner.SignBlockHeader(pkBytes, 10, []byte("header"))
+		require.Nil(t, err)
+		assert.Nil(t, singleSigner.Verify(publicKey, []byte("header"), signature))
+
+		signature, err = remoteSigner.SignRandSeed(pkBytes, 10, prevRandSeed)
+		require.Nil(t, err)
+		assert.Nil(t, singleSigner.Verify(publicKey, prevRandSeed, signature))
+
+		signature, err = remoteSigner.SignPeerID(pkBytes, pid)
+		require.Nil(t, err)
+		assert.Nil(t, singleSigner.Verify(publicKey, pid, signature))
+	})
+	t.Run("conflicting requests should be refused", func(t *testing.T) {
+		_, err := remoteSigner.SignBlockHeader(pkBytes, 20, []byte("header A"))
+		require.Nil(t, err)
+
+		_, err = remoteSigner.SignBlockHeader(pkBytes, 20, []byte("header B"))
+		assert.True(t, errors.Is(err, remote.ErrSlashingProtection))
+
+		// a second node using the same key is refused by the remote signer protection
+		otherNodeSigner := createRemoteSigner(t, certificates, server.URL)
+		_, err = otherNodeSigner.SignBlockHeader(pkBytes, 20, []byte("header B"))
+		assert.True(t, errors.Is(err, remote.ErrRemoteSigner))
+		assert.Contains(t, err.Error(), remote.ErrSlashingProtection.Error())
+	})
+	t.Run("unknown key should error", func(t *testing.T) {
+		_, err := remoteSigner.SignRandSeed([]byte("unknown pk"), 30, prevRandSeed)
+		assert.True(t, errors.Is(err, remote.ErrRemoteSigner))
+		assert.Contains(t, err.Error(), remote.ErrUnknownPublicKey.Error())
+	})
+	t.Run("remote private key should route the peer signatures", func(t *testing.T) {
+		remotePrivateKey, err := remote.NewRemotePrivateKey(publicKey, remoteSigner)
+		require.Nil(t, err)
+
+		peerIDSigner, _ := keysManagement.NewPeerIDSigner(singleSigner)
+		signature, err := peerIDSigner.Sign(remotePrivateKey, pid)
+		require.Nil(t, err)
+		assert.Nil(t, peerIDSigner.Verify(publicKey, pid, signature))
+	})
+	t.Run("header hash sent as peer ID or rand seed should be refused", func(t *testing.T) {
+		headerHash := make([]byte, 32)
+		_, _ = rand.Read(headerHash)
+
+		_, err := remoteSigner.SignPeerID(pkBytes, headerHash)
+		assert.True(t, errors.Is(err, remote.ErrInvalidMessageShape))
+
+		_, err = remoteSigner.SignRandSeed(pkBytes, 40, headerHash)
+		assert.True(t, errors.Is(err, remote.ErrInvalidMessageShape))
+	})
+}
+
+func TestSigningHandler_HeaderHashSentAsPeerIDShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, publicKey := keyGenerator.GeneratePair()
+	pkBytes, _ := publicKey.ToByteArray()
+	slashingProtector, _ := remote.NewSlashingProtector("")
+	handler, err := remote.NewSigningHandler(remote.ArgsSigningHandler{
+		PrivateKeys:       map[string]crypto.PrivateKey{string(pkBytes): privateKey},
+		SingleSigner:      &mclSig.BlsSingleSigner{},
+		SlashingProtector: slashingProtector,
+	})
+	require.Nil(t, err)
+
+	headerHash := make([]byte, 32)
+	_, _ = rand.Read(headerHash)
+	requests := map[remote.SignatureType][]byte{
+		remote.PeerID:   headerHash,
+		remote.RandSeed: headerHash,
+	}
+	for signatureType, message := range requests {
+		requestBytes, _ := json.Marshal(&remote.SignRequest{
+			PublicKey: hex.EncodeToString(pkBytes),
+			Type:      signatureType,
+			Round:     10,
+			Message:   message,
+		})
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/sign", bytes.NewReader(requestBytes)))
+
+		response := &remote.SignResponse{}
+		_ = json.Unmarshal(recorder.Body.Bytes(), response)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, string(signatureType))
+		assert.Empty(t, response.Signature)
+		assert.Contains(t, response.Error, remote.ErrInvalidMessageShape.Error())
+	}
+}
+
+func createPeerID(t *testing.T) []byte {
+	_, publicKey, err := libp2pCrypto.GenerateSecp256k1Key(rand.Reader)
+	require.Nil(t, err)
+	pid, err := peer.IDFromPublicKey(publicKey)
+	require.Nil(t, err)
+
+	return []byte(pid)
+}
+
+func TestRemoteSigner_UntrustedClientShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, publicKey := keyGenerator.GeneratePair()
+	pkBytes, _ := publicKey.ToByteArray()
+	certificates := createCertificates(t)
+	server := startRemoteSigner(t, certificates, privateKey)
+	defer server.Close()
+
+	untrustedCertificates := createCertificates(t)
+	untrustedCertificates.caCertificate = certificates.caCertificate
+	remoteSigner := createRemoteSigner(t, untrustedCertificates, server.URL)
+
+	_, err := remoteSigner.SignBlockHeader(pkBytes, 10, []byte("header"))
+	assert.True(t, errors.Is(err, remote.ErrRemoteSigner))
+}
@@ -0,0 +1,155 @@
+package remote
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// ArgsSigningHandler represents the argument for the signing handler of the remote signer
+type ArgsSigningHandler struct {
+	PrivateKeys       map[string]crypto.PrivateKey
+	SingleSigner      crypto.SingleSigner
+	SlashingProtector SlashingProtector
+}
+
+// signingHandler is the server side of the remote signer. It holds the private keys and signs the requests that pass
+// the slashing protection
+type signingHandler struct {
+	privateKeys       map[string]crypto.PrivateKey
+	singleSigner      crypto.SingleSigner
+	slashingProtector SlashingProtector
+	mux               *http.ServeMux
+}
+
+// NewSigningHandler creates a new signing handler. The private keys map is indexed by the public key bytes
+func NewSigningHandler(args ArgsSigningHandler) (*signingHandler, error) {
+	if len(args.PrivateKeys) == 0 {
+		return nil, ErrNoPrivateKeys
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.SlashingProtector) {
+		return nil, ErrNilSlashingProtector
+	}
+
+	sh := &signingHandler{
+		privateKeys:       args.PrivateKeys,
+		singleSigner:      args.SingleSigner,
+		slashingProtector: args.SlashingProtector,
+		mux:               http.NewServeMux(),
+	}
+	sh.mux.HandleFunc(signEndpoint, sh.handleSign)
+	sh.mux.HandleFunc(keysEndpoint, sh.handleKeys)
+
+	return sh, nil
+}
+
+// ServeHTTP dispatches the request to the handler of the requested endpoint
+func (sh *signingHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	sh.mux.ServeHTTP(writer, request)
+}
+
+func (sh *signingHandler) handleSign(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writeResponse(writer, http.StatusMethodNotAllowed, &SignResponse{Error: "method not allowed"})
+		return
+	}
+
+	buff, err := ioutil.ReadAll(http.MaxBytesReader(writer, request.Body, maxRequestSize))
+	if err != nil {
+		writeResponse(writer, http.StatusBadRequest, &SignResponse{Error: err.Error()})
+		return
+	}
+
+	signRequest := &SignRequest{}
+	err = json.Unmarshal(buff, signRequest)
+	if err != nil {
+		writeResponse(writer, http.StatusBadRequest, &SignResponse{Error: err.Error()})
+		return
+	}
+
+	signature, status, err := sh.sign(signRequest)
+	if err != nil {
+		log.Debug("signingHandler refused request",
+			"type", signRequest.Type, "round", signRequest.Round, "pk", signRequest.PublicKey, "error", err)
+		writeResponse(writer, status, &SignResponse{Error: err.Error()})
+		return
+	}
+
+	writeResponse(writer, http.StatusOK, &SignResponse{Signature: signature})
+}
+
+func (sh *signingHandler) sign(signRequest *SignRequest) ([]byte, int, error) {
+	if !isSignatureTypeKnown(signRequest.Type) {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w: %s", ErrUnknownSignatureType, signRequest.Type)
+	}
+	if len(signRequest.Message) == 0 {
+		return nil, http.StatusBadRequest, ErrEmptyMessage
+	}
+	err := checkMessageShape(signRequest.Type, signRequest.Message)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	pkBytes, err := hex.DecodeString(signRequest.PublicKey)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	privateKey, found := sh.privateKeys[string(pkBytes)]
+	if !found {
+		return nil, http.StatusNotFound, fmt.Errorf("%w: %s", ErrUnknownPublicKey, signRequest.PublicKey)
+	}
+
+	err = sh.slashingProtector.CheckAndRecord(pkBytes, signRequest.Type, signRequest.Round, signRequest.Message)
+	if err != nil {
+		return nil, http.StatusConflict, err
+	}
+
+	signature, err := sh.singleSigner.Sign(privateKey, signRequest.Message)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return signature, http.StatusOK, nil
+}
+
+func (sh *signingHandler) handleKeys(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		writeResponse(writer, http.StatusMethodNotAllowed, &KeysResponse{Error: "method not allowed"})
+		return
+	}
+
+	publicKeys := make([]string, 0, len(sh.privateKeys))
+	for pkBytes := range sh.privateKeys {
+		publicKeys = append(publicKeys, hex.EncodeToString([]byte(pkBytes)))
+	}
+	sort.Strings(publicKeys)
+
+	writeResponse(writer, http.StatusOK, &KeysResponse{PublicKeys: publicKeys})
+}
+
+func writeResponse(writer http.ResponseWriter, status int, response interface{}) {
+	buff, err := json.Marshal(response)
+	if err != nil {
+		log.Warn("signingHandler: can not marshal response", "error", err)
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_, _ = writer.Write(buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *signingHandler) IsInterfaceNil() bool {
+	return sh == nil
+}
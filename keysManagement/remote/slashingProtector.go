@@ -0,0 +1,141 @@
+package remote
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+const slashingProtectionFilePermissions = 0600
+
+type signedRecord struct {
+	Round       int64  `json:"round"`
+	MessageHash string `json:"messageHash"`
+}
+
+// slashingProtector guards against signing two different blocks for the same round with the same key. For each key
+// and slashable signature type it remembers the last signed round together with the hash of the signed message and
+// refuses to sign a different message for that round or any message for an older round. When a file is provided,
+// the records are persisted after each new signature, so the protection survives restarts
+type slashingProtector struct {
+	mut      sync.Mutex
+	filename string
+	records  map[string]*signedRecord
+}
+
+// NewSlashingProtector creates a new slashing protector. If the filename is empty, the records are only kept in memory
+func NewSlashingProtector(filename string) (*slashingProtector, error) {
+	sp := &slashingProtector{
+		filename: filename,
+		records:  make(map[string]*signedRecord),
+	}
+
+	err := sp.loadRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	return sp, nil
+}
+
+func (sp *slashingProtector) loadRecords() error {
+	if len(sp.filename) == 0 {
+		return nil
+	}
+
+	buff, err := ioutil.ReadFile(sp.filename)
+	if os.IsNotExist(err) {
+		log.Info("slashing protection file not found, starting with no signed records", "file", sp.filename)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(buff, &sp.records)
+	if err != nil {
+		return fmt.Errorf("%w while loading the slashing protection file %s", err, sp.filename)
+	}
+
+	log.Debug("loaded slashing protection records", "file", sp.filename, "num records", len(sp.records))
+
+	return nil
+}
+
+// CheckAndRecord returns an error if signing the provided message could lead to slashing, otherwise it records the
+// message as signed. Signing again the same message for the last signed round is allowed, so requests can be retried
+func (sp *slashingProtector) CheckAndRecord(pkBytes []byte, signatureType SignatureType, round int64, message []byte) error {
+	if !isSlashable(signatureType) {
+		return nil
+	}
+
+	messageHash := sha256.Sum256(message)
+	newRecord := &signedRecord{
+		Round:       round,
+		MessageHash: hex.EncodeToString(messageHash[:]),
+	}
+	recordKey := hex.EncodeToString(pkBytes) + "_" + string(signatureType)
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	lastRecord, found := sp.records[recordKey]
+	if found {
+		if round < lastRecord.Round {
+			return fmt.Errorf("%w: %s requested for round %d, lower than the last signed round %d",
+				ErrSlashingProtection, signatureType, round, lastRecord.Round)
+		}
+		if round == lastRecord.Round {
+			if newRecord.MessageHash != lastRecord.MessageHash {
+				return fmt.Errorf("%w: %s requested for round %d over a different message than the signed one",
+					ErrSlashingProtection, signatureType, round)
+			}
+
+			return nil
+		}
+	}
+
+	sp.records[recordKey] = newRecord
+	err := sp.saveRecords()
+	if err != nil {
+		if found {
+			sp.records[recordKey] = lastRecord
+		} else {
+			delete(sp.records, recordKey)
+		}
+
+		return fmt.Errorf("%w while saving the slashing protection file %s", err, sp.filename)
+	}
+
+	return nil
+}
+
+// saveRecords writes all records in a temporary file which then replaces the slashing protection file, so a crash
+// while writing does not corrupt the existing records. Should be called under mutex
+func (sp *slashingProtector) saveRecords() error {
+	if len(sp.filename) == 0 {
+		return nil
+	}
+
+	buff, err := json.MarshalIndent(sp.records, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpFilename := sp.filename + ".tmp"
+	err = ioutil.WriteFile(tmpFilename, buff, slashingProtectionFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFilename, sp.filename)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProtector) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,99 @@
+package remote
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSlashingProtector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("in memory should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewSlashingProtector("")
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sp))
+	})
+	t.Run("missing file should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewSlashingProtector(filepath.Join(t.TempDir(), "slashingProtection.json"))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sp))
+	})
+	t.Run("corrupted file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "slashingProtection.json")
+		require.Nil(t, ioutil.WriteFile(filename, []byte("not a json"), 0600))
+
+		sp, err := NewSlashingProtector(filename)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(sp))
+	})
+}
+
+func TestSlashingProtector_CheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	pk := []byte("pk")
+
+	t.Run("non slashable types should always be signed", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector("")
+		assert.Nil(t, sp.CheckAndRecord(pk, RandSeed, 10, []byte("seed 1")))
+		assert.Nil(t, sp.CheckAndRecord(pk, RandSeed, 10, []byte("seed 2")))
+		assert.Nil(t, sp.CheckAndRecord(pk, RandSeed, 9, []byte("seed 3")))
+		assert.Nil(t, sp.CheckAndRecord(pk, PeerID, 0, []byte("pid 1")))
+		assert.Nil(t, sp.CheckAndRecord(pk, PeerID, 0, []byte("pid 2")))
+	})
+	t.Run("conflicting requests should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector("")
+		assert.Nil(t, sp.CheckAndRecord(pk, BlockHeader, 10, []byte("header A")))
+		// retrying the same request is allowed
+		assert.Nil(t, sp.CheckAndRecord(pk, BlockHeader, 10, []byte("header A")))
+
+		err := sp.CheckAndRecord(pk, BlockHeader, 10, []byte("header B"))
+		assert.True(t, errors.Is(err, ErrSlashingProtection))
+		err = sp.CheckAndRecord(pk, BlockHeader, 9, []byte("header C"))
+		assert.True(t, errors.Is(err, ErrSlashingProtection))
+
+		// other signature types and keys are tracked separately
+		assert.Nil(t, sp.CheckAndRecord(pk, BlockSignatureShare, 10, []byte("hash B")))
+		assert.Nil(t, sp.CheckAndRecord([]byte("other pk"), BlockHeader, 10, []byte("header B")))
+
+		assert.Nil(t, sp.CheckAndRecord(pk, BlockHeader, 11, []byte("header D")))
+	})
+	t.Run("records should survive restarts", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "slashingProtection.json")
+		sp, _ := NewSlashingProtector(filename)
+		assert.Nil(t, sp.CheckAndRecord(pk, BlockSignatureShare, 10, []byte("hash A")))
+
+		reloaded, err := NewSlashingProtector(filename)
+		require.Nil(t, err)
+		err = reloaded.CheckAndRecord(pk, BlockSignatureShare, 10, []byte("hash B"))
+		assert.True(t, errors.Is(err, ErrSlashingProtection))
+		assert.Nil(t, reloaded.CheckAndRecord(pk, BlockSignatureShare, 10, []byte("hash A")))
+	})
+	t.Run("save failure should refuse and not record", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "missing directory", "slashingProtection.json")
+		sp, _ := NewSlashingProtector(filename)
+		err := sp.CheckAndRecord(pk, BlockHeader, 10, []byte("header A"))
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, len(sp.records))
+	})
+}
@@ -0,0 +1,65 @@
+package remote
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// ArgsTLSConfig represents the argument for creating the mutual TLS configuration of the remote signer client and server
+type ArgsTLSConfig struct {
+	CertificateFile   string
+	KeyFile           string
+	CACertificateFile string
+}
+
+// NewClientTLSConfig creates the TLS configuration used by the node to connect to the remote signer. The node presents
+// its own certificate and only accepts a remote signer certificate issued by the provided CA
+func NewClientTLSConfig(args ArgsTLSConfig) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewServerTLSConfig creates the TLS configuration used by the remote signer. Only the clients presenting a
+// certificate issued by the provided CA are accepted
+func NewServerTLSConfig(args ArgsTLSConfig) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadCertificates(args ArgsTLSConfig) (tls.Certificate, *x509.CertPool, error) {
+	certificate, err := tls.LoadX509KeyPair(args.CertificateFile, args.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("%w while loading the certificate %s", err, args.CertificateFile)
+	}
+
+	caCertificate, err := ioutil.ReadFile(args.CACertificateFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("%w while loading the CA certificate", err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCertificate) {
+		return tls.Certificate{}, nil, fmt.Errorf("%w in file %s", ErrInvalidCACertificate, args.CACertificateFile)
+	}
+
+	return certificate, caPool, nil
+}
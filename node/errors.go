@@ -147,3 +147,19 @@ var ErrInvalidESDTRole = errors.New("invalid ESDT role")
 
 // ErrMetachainOnlyEndpoint signals that an endpoint was called, but it is only available for metachain nodes
 var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on metachain nodes")
+
+// ErrNilConsensusComponents signals that a nil consensus components instance has been provided
+var ErrNilConsensusComponents = errors.New("nil consensus components")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrESDTTokenNotFound signals that the requested ESDT token is not registered in the ESDT system smart contract
+var ErrESDTTokenNotFound = errors.New("esdt token not found")
+
+// ErrGovernanceHardforkTriggerUnreachable signals that the governance hardfork trigger is enabled while the trigger
+// message that starts the hardfork on the shards can not be broadcast
+var ErrGovernanceHardforkTriggerUnreachable = errors.New("governance hardfork trigger can not reach the shards")
@@ -20,8 +20,17 @@ var ErrNilDirectStakeListHandler = errors.New("nil direct stake list handler")
 // ErrNilDelegatedListHandler signals that a nil delegated list handler has been provided
 var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 
+// ErrNilGovernanceHandler signals that a nil governance handler has been provided
+var ErrNilGovernanceHandler = errors.New("nil governance handler")
+
+// ErrNilDelegationContractHandler signals that a nil delegation contract handler has been provided
+var ErrNilDelegationContractHandler = errors.New("nil delegation contract handler")
+
 // ErrNilVmContainer signals that a nil vm container has been provided
 var ErrNilVmContainer = errors.New("nil vm container")
 
 // ErrNilVmFactory signals that a nil vm factory has been provided
 var ErrNilVmFactory = errors.New("nil vm factory")
+
+// ErrNilValidatorLifecycleHandler signals that a nil validator lifecycle handler has been provided
+var ErrNilValidatorLifecycleHandler = errors.New("nil validator lifecycle handler")
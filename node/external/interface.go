@@ -3,6 +3,7 @@ package external
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -50,3 +51,26 @@ type DelegatedListHandler interface {
 	GetDelegatorsList() ([]*api.Delegator, error)
 	IsInterfaceNil() bool
 }
+
+// GovernanceHandler defines the behavior of a component able to decode the governance system smart contract state
+type GovernanceHandler interface {
+	GetGovernanceProposals() (*common.GovernanceProposalsResponse, error)
+	GetHardforkProposals() (*common.GovernanceProposalsResponse, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error)
+	IsInterfaceNil() bool
+}
+
+// DelegationContractHandler defines the behavior of a component able to decode the state of a delegation contract
+type DelegationContractHandler interface {
+	GetDelegationContract(contract string) (*common.DelegationContractResponse, error)
+	IsInterfaceNil() bool
+}
+
+// ValidatorLifecycleHandler defines the behavior of a component able to aggregate the staking, rating and shuffling
+// status of validators and to list the staking queue
+type ValidatorLifecycleHandler interface {
+	GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error)
+	GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error)
+	IsInterfaceNil() bool
+}
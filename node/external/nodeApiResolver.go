@@ -4,28 +4,35 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // ArgNodeApiResolver represents the DTO structure used in the NewNodeApiResolver constructor
 type ArgNodeApiResolver struct {
-	SCQueryService          SCQueryService
-	StatusMetricsHandler    StatusMetricsHandler
-	TxCostHandler           TransactionCostHandler
-	TotalStakedValueHandler TotalStakedValueHandler
-	DirectStakedListHandler DirectStakedListHandler
-	DelegatedListHandler    DelegatedListHandler
+	SCQueryService            SCQueryService
+	StatusMetricsHandler      StatusMetricsHandler
+	TxCostHandler             TransactionCostHandler
+	TotalStakedValueHandler   TotalStakedValueHandler
+	DirectStakedListHandler   DirectStakedListHandler
+	DelegatedListHandler      DelegatedListHandler
+	GovernanceHandler         GovernanceHandler
+	DelegationContractHandler DelegationContractHandler
+	ValidatorLifecycleHandler ValidatorLifecycleHandler
 }
 
 // nodeApiResolver can resolve API requests
 type nodeApiResolver struct {
-	scQueryService          SCQueryService
-	statusMetricsHandler    StatusMetricsHandler
-	txCostHandler           TransactionCostHandler
-	totalStakedValueHandler TotalStakedValueHandler
-	directStakedListHandler DirectStakedListHandler
-	delegatedListHandler    DelegatedListHandler
+	scQueryService            SCQueryService
+	statusMetricsHandler      StatusMetricsHandler
+	txCostHandler             TransactionCostHandler
+	totalStakedValueHandler   TotalStakedValueHandler
+	directStakedListHandler   DirectStakedListHandler
+	delegatedListHandler      DelegatedListHandler
+	governanceHandler         GovernanceHandler
+	delegationContractHandler DelegationContractHandler
+	validatorLifecycleHandler ValidatorLifecycleHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -48,14 +55,26 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
+	if check.IfNil(arg.DelegationContractHandler) {
+		return nil, ErrNilDelegationContractHandler
+	}
+	if check.IfNil(arg.ValidatorLifecycleHandler) {
+		return nil, ErrNilValidatorLifecycleHandler
+	}
 
 	return &nodeApiResolver{
-		scQueryService:          arg.SCQueryService,
-		statusMetricsHandler:    arg.StatusMetricsHandler,
-		txCostHandler:           arg.TxCostHandler,
-		totalStakedValueHandler: arg.TotalStakedValueHandler,
-		directStakedListHandler: arg.DirectStakedListHandler,
-		delegatedListHandler:    arg.DelegatedListHandler,
+		scQueryService:            arg.SCQueryService,
+		statusMetricsHandler:      arg.StatusMetricsHandler,
+		txCostHandler:             arg.TxCostHandler,
+		totalStakedValueHandler:   arg.TotalStakedValueHandler,
+		directStakedListHandler:   arg.DirectStakedListHandler,
+		delegatedListHandler:      arg.DelegatedListHandler,
+		governanceHandler:         arg.GovernanceHandler,
+		delegationContractHandler: arg.DelegationContractHandler,
+		validatorLifecycleHandler: arg.ValidatorLifecycleHandler,
 	}, nil
 }
 
@@ -94,6 +113,41 @@ func (nar *nodeApiResolver) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nar.delegatedListHandler.GetDelegatorsList()
 }
 
+// GetGovernanceProposals will return the governance proposals
+func (nar *nodeApiResolver) GetGovernanceProposals() (*common.GovernanceProposalsResponse, error) {
+	return nar.governanceHandler.GetGovernanceProposals()
+}
+
+// GetHardforkProposals will return the hardfork governance proposals
+func (nar *nodeApiResolver) GetHardforkProposals() (*common.GovernanceProposalsResponse, error) {
+	return nar.governanceHandler.GetHardforkProposals()
+}
+
+// GetGovernanceProposal will return the governance proposal identified by the provided reference
+func (nar *nodeApiResolver) GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error) {
+	return nar.governanceHandler.GetGovernanceProposal(reference)
+}
+
+// GetGovernanceVotes will return the governance votes of the provided address
+func (nar *nodeApiResolver) GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error) {
+	return nar.governanceHandler.GetGovernanceVotes(address)
+}
+
+// GetDelegationContract will return the decoded state of the provided delegation contract
+func (nar *nodeApiResolver) GetDelegationContract(contract string) (*common.DelegationContractResponse, error) {
+	return nar.delegationContractHandler.GetDelegationContract(contract)
+}
+
+// GetValidatorLifecycle will return the aggregated lifecycle status of the provided BLS key
+func (nar *nodeApiResolver) GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+	return nar.validatorLifecycleHandler.GetValidatorLifecycle(blsKey)
+}
+
+// GetStakingQueue will return the staking queue entries matching the provided filter
+func (nar *nodeApiResolver) GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+	return nar.validatorLifecycleHandler.GetStakingQueue(filter)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *nodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
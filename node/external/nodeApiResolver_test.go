@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -14,12 +15,15 @@ import (
 
 func createMockAgrs() external.ArgNodeApiResolver {
 	return external.ArgNodeApiResolver{
-		SCQueryService:          &mock.SCQueryServiceStub{},
-		StatusMetricsHandler:    &mock.StatusMetricsStub{},
-		TxCostHandler:           &mock.TransactionCostEstimatorMock{},
-		TotalStakedValueHandler: &mock.StakeValuesProcessorStub{},
-		DirectStakedListHandler: &mock.DirectStakedListProcessorStub{},
-		DelegatedListHandler:    &mock.DelegatedListProcessorStub{},
+		SCQueryService:            &mock.SCQueryServiceStub{},
+		StatusMetricsHandler:      &mock.StatusMetricsStub{},
+		TxCostHandler:             &mock.TransactionCostEstimatorMock{},
+		TotalStakedValueHandler:   &mock.StakeValuesProcessorStub{},
+		DirectStakedListHandler:   &mock.DirectStakedListProcessorStub{},
+		DelegatedListHandler:      &mock.DelegatedListProcessorStub{},
+		GovernanceHandler:         &mock.GovernanceProcessorStub{},
+		DelegationContractHandler: &mock.DelegationContractProcessorStub{},
+		ValidatorLifecycleHandler: &mock.ValidatorLifecycleProcessorStub{},
 	}
 }
 
@@ -89,6 +93,39 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
+func TestNewNodeApiResolver_NilDelegationContractHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.DelegationContractHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilDelegationContractHandler, err)
+}
+
+func TestNewNodeApiResolver_NilValidatorLifecycleHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.ValidatorLifecycleHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilValidatorLifecycleHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -249,3 +286,94 @@ func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	assert.Equal(t, recoveredDirectStakedValueList, directStakedValueList)
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GovernanceMethodsShouldCallTheHandler(t *testing.T) {
+	t.Parallel()
+
+	proposals := &common.GovernanceProposalsResponse{}
+	proposal := &common.GovernanceProposalResponse{}
+	votes := &common.GovernanceVotesResponse{}
+	arg := createMockAgrs()
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetGovernanceProposalsCalled: func() (*common.GovernanceProposalsResponse, error) {
+			return proposals, nil
+		},
+		GetGovernanceProposalCalled: func(reference string) (*common.GovernanceProposalResponse, error) {
+			assert.Equal(t, "reference", reference)
+			return proposal, nil
+		},
+		GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesResponse, error) {
+			assert.Equal(t, "address", address)
+			return votes, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	recoveredProposals, err := nar.GetGovernanceProposals()
+	assert.Nil(t, err)
+	assert.True(t, recoveredProposals == proposals) //pointer testing
+
+	recoveredProposal, err := nar.GetGovernanceProposal("reference")
+	assert.Nil(t, err)
+	assert.True(t, recoveredProposal == proposal) //pointer testing
+
+	recoveredVotes, err := nar.GetGovernanceVotes("address")
+	assert.Nil(t, err)
+	assert.True(t, recoveredVotes == votes) //pointer testing
+}
+
+func TestNodeApiResolver_GetDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	delegationContract := &common.DelegationContractResponse{}
+	arg := createMockAgrs()
+	arg.DelegationContractHandler = &mock.DelegationContractProcessorStub{
+		GetDelegationContractCalled: func(contract string) (*common.DelegationContractResponse, error) {
+			assert.Equal(t, "contract", contract)
+			return delegationContract, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredDelegationContract, err := nar.GetDelegationContract("contract")
+	assert.Nil(t, err)
+	assert.True(t, recoveredDelegationContract == delegationContract) //pointer testing
+}
+
+func TestNodeApiResolver_GetValidatorLifecycle(t *testing.T) {
+	t.Parallel()
+
+	lifecycle := &common.ValidatorLifecycleResponse{}
+	arg := createMockAgrs()
+	arg.ValidatorLifecycleHandler = &mock.ValidatorLifecycleProcessorStub{
+		GetValidatorLifecycleCalled: func(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+			assert.Equal(t, "blsKey", blsKey)
+			return lifecycle, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredLifecycle, err := nar.GetValidatorLifecycle("blsKey")
+	assert.Nil(t, err)
+	assert.True(t, recoveredLifecycle == lifecycle) //pointer testing
+}
+
+func TestNodeApiResolver_GetStakingQueue(t *testing.T) {
+	t.Parallel()
+
+	queue := &common.StakingQueueResponse{}
+	filter := common.StakingQueueFilter{Owner: "owner", Offset: 2, Limit: 10}
+	arg := createMockAgrs()
+	arg.ValidatorLifecycleHandler = &mock.ValidatorLifecycleProcessorStub{
+		GetStakingQueueCalled: func(providedFilter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+			assert.Equal(t, filter, providedFilter)
+			return queue, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredQueue, err := nar.GetStakingQueue(filter)
+	assert.Nil(t, err)
+	assert.True(t, recoveredQueue == queue) //pointer testing
+}
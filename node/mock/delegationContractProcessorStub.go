@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// DelegationContractProcessorStub -
+type DelegationContractProcessorStub struct {
+	GetDelegationContractCalled func(contract string) (*common.DelegationContractResponse, error)
+}
+
+// GetDelegationContract -
+func (dcps *DelegationContractProcessorStub) GetDelegationContract(contract string) (*common.DelegationContractResponse, error) {
+	if dcps.GetDelegationContractCalled != nil {
+		return dcps.GetDelegationContractCalled(contract)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (dcps *DelegationContractProcessorStub) IsInterfaceNil() bool {
+	return dcps == nil
+}
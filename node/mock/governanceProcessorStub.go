@@ -0,0 +1,52 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// GovernanceProcessorStub -
+type GovernanceProcessorStub struct {
+	GetGovernanceProposalsCalled func() (*common.GovernanceProposalsResponse, error)
+	GetHardforkProposalsCalled   func() (*common.GovernanceProposalsResponse, error)
+	GetGovernanceProposalCalled  func(reference string) (*common.GovernanceProposalResponse, error)
+	GetGovernanceVotesCalled     func(address string) (*common.GovernanceVotesResponse, error)
+}
+
+// GetGovernanceProposals -
+func (gps *GovernanceProcessorStub) GetGovernanceProposals() (*common.GovernanceProposalsResponse, error) {
+	if gps.GetGovernanceProposalsCalled != nil {
+		return gps.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetHardforkProposals -
+func (gps *GovernanceProcessorStub) GetHardforkProposals() (*common.GovernanceProposalsResponse, error) {
+	if gps.GetHardforkProposalsCalled != nil {
+		return gps.GetHardforkProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (gps *GovernanceProcessorStub) GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error) {
+	if gps.GetGovernanceProposalCalled != nil {
+		return gps.GetGovernanceProposalCalled(reference)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (gps *GovernanceProcessorStub) GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error) {
+	if gps.GetGovernanceVotesCalled != nil {
+		return gps.GetGovernanceVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (gps *GovernanceProcessorStub) IsInterfaceNil() bool {
+	return gps == nil
+}
@@ -11,10 +11,16 @@ type NodesCoordinatorMock struct {
 	GetValidatorsPublicKeysCalled            func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
 	GetValidatorsRewardsAddressesCalled      func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
 	GetAllEligibleValidatorsPublicKeysCalled func() (map[uint32][][]byte, error)
+	GetAllWaitingValidatorsPublicKeysCalled  func() (map[uint32][][]byte, error)
+	GetAllLeavingValidatorsPublicKeysCalled  func() (map[uint32][][]byte, error)
+	GetValidatorWithPublicKeyCalled          func(publicKey []byte) (sharding.Validator, uint32, error)
 }
 
 // GetAllLeavingValidatorsPublicKeys -
 func (ncm *NodesCoordinatorMock) GetAllLeavingValidatorsPublicKeys(_ uint32) (map[uint32][][]byte, error) {
+	if ncm.GetAllLeavingValidatorsPublicKeysCalled != nil {
+		return ncm.GetAllLeavingValidatorsPublicKeysCalled()
+	}
 	return nil, nil
 }
 
@@ -28,6 +34,9 @@ func (ncm *NodesCoordinatorMock) GetAllEligibleValidatorsPublicKeys(_ uint32) (m
 
 // GetAllWaitingValidatorsPublicKeys -
 func (ncm *NodesCoordinatorMock) GetAllWaitingValidatorsPublicKeys(_ uint32) (map[uint32][][]byte, error) {
+	if ncm.GetAllWaitingValidatorsPublicKeysCalled != nil {
+		return ncm.GetAllWaitingValidatorsPublicKeysCalled()
+	}
 	return nil, nil
 }
 
@@ -146,7 +155,11 @@ func (ncm *NodesCoordinatorMock) GetSelectedPublicKeys(_ []byte, _ uint32, _ uin
 }
 
 // GetValidatorWithPublicKey -
-func (ncm *NodesCoordinatorMock) GetValidatorWithPublicKey(_ []byte) (sharding.Validator, uint32, error) {
+func (ncm *NodesCoordinatorMock) GetValidatorWithPublicKey(publicKey []byte) (sharding.Validator, uint32, error) {
+	if ncm.GetValidatorWithPublicKeyCalled != nil {
+		return ncm.GetValidatorWithPublicKeyCalled(publicKey)
+	}
+
 	panic("implement me")
 }
 
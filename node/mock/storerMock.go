@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // StorerMock -
@@ -46,7 +48,7 @@ func (sm *StorerMock) Get(key []byte) ([]byte, error) {
 
 	val, ok := sm.data[string(key)]
 	if !ok {
-		return nil, fmt.Errorf("key: %s not found", base64.StdEncoding.EncodeToString(key))
+		return nil, fmt.Errorf("%w: %s", storage.ErrKeyNotFound, base64.StdEncoding.EncodeToString(key))
 	}
 
 	return val, nil
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// ValidatorLifecycleProcessorStub -
+type ValidatorLifecycleProcessorStub struct {
+	GetValidatorLifecycleCalled func(blsKey string) (*common.ValidatorLifecycleResponse, error)
+	GetStakingQueueCalled       func(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error)
+}
+
+// GetValidatorLifecycle -
+func (vlps *ValidatorLifecycleProcessorStub) GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+	if vlps.GetValidatorLifecycleCalled != nil {
+		return vlps.GetValidatorLifecycleCalled(blsKey)
+	}
+
+	return nil, nil
+}
+
+// GetStakingQueue -
+func (vlps *ValidatorLifecycleProcessorStub) GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+	if vlps.GetStakingQueueCalled != nil {
+		return vlps.GetStakingQueueCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (vlps *ValidatorLifecycleProcessorStub) IsInterfaceNil() bool {
+	return vlps == nil
+}
@@ -23,7 +23,9 @@ import (
 	disabledSig "github.com/ElrondNetwork/elrond-go-crypto/signing/disabled/singlesig"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
@@ -486,6 +488,182 @@ func (n *Node) GetTokenSupply(token string) (string, error) {
 	return n.processComponents.HistoryRepository().GetESDTSupply(token)
 }
 
+// GetESDTTokenData returns the decoded ESDT system smart contract entry of the given token together with its role
+// holders, works only on metachain. The supply is not part of the response as the local mints and burns happen in the
+// shards: each shard serves its own supply through GetTokenSupply
+func (n *Node) GetESDTTokenData(token string) (*common.ESDTTokenResponse, error) {
+	if n.processComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+	if !strings.Contains(token, "-") {
+		return nil, ErrESDTTokenNotFound
+	}
+
+	account, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	marshaledData, err := userAccount.DataTrieTracker().RetrieveValue([]byte(token))
+	if err != nil {
+		return nil, err
+	}
+	if len(marshaledData) == 0 {
+		return nil, ErrESDTTokenNotFound
+	}
+
+	esdtToken := &systemSmartContracts.ESDTDataV2{}
+	err = n.coreComponents.InternalMarshalizer().Unmarshal(esdtToken, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.createESDTTokenResponse(token, esdtToken), nil
+}
+
+func (n *Node) createESDTTokenResponse(token string, esdtToken *systemSmartContracts.ESDTDataV2) *common.ESDTTokenResponse {
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+	response := &common.ESDTTokenResponse{
+		Identifier:               token,
+		Name:                     string(esdtToken.TokenName),
+		Ticker:                   string(esdtToken.TickerName),
+		Type:                     string(esdtToken.TokenType),
+		Owner:                    pubKeyConverter.Encode(esdtToken.OwnerAddress),
+		Decimals:                 esdtToken.NumDecimals,
+		MintedValue:              bigIntToString(esdtToken.MintedValue),
+		BurntValue:               bigIntToString(esdtToken.BurntValue),
+		NumWiped:                 esdtToken.NumWiped,
+		IsPaused:                 esdtToken.IsPaused,
+		CanUpgrade:               esdtToken.Upgradable,
+		CanMint:                  esdtToken.Mintable,
+		CanBurn:                  esdtToken.Burnable,
+		CanPause:                 esdtToken.CanPause,
+		CanFreeze:                esdtToken.CanFreeze,
+		CanWipe:                  esdtToken.CanWipe,
+		CanChangeOwner:           esdtToken.CanChangeOwner,
+		CanAddSpecialRoles:       esdtToken.CanAddSpecialRoles,
+		CanTransferNFTCreateRole: esdtToken.CanTransferNFTCreateRole,
+		CanCreateMultiShard:      esdtToken.CanCreateMultiShard,
+		NFTCreateStopped:         esdtToken.NFTCreateStopped,
+		Roles:                    make([]*common.ESDTRolesResponse, 0, len(esdtToken.SpecialRoles)),
+	}
+
+	for _, specialRoles := range esdtToken.SpecialRoles {
+		if len(specialRoles.Roles) == 0 {
+			continue
+		}
+
+		address := pubKeyConverter.Encode(specialRoles.Address)
+		roles := make([]string, 0, len(specialRoles.Roles))
+		for _, role := range specialRoles.Roles {
+			roles = append(roles, string(role))
+			if string(role) == core.ESDTRoleNFTCreate {
+				response.NFTCreateRoleOwner = address
+			}
+		}
+
+		response.Roles = append(response.Roles, &common.ESDTRolesResponse{
+			Address: address,
+			Roles:   roles,
+		})
+	}
+
+	return response
+}
+
+// GetESDTHolders returns a page of the self shard holders of the given token, as recorded by the holders index
+func (n *Node) GetESDTHolders(token string, offset uint32, limit uint32) (*common.ESDTHoldersResponse, error) {
+	page, err := n.processComponents.HistoryRepository().GetESDTHolders(token, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+	response := &common.ESDTHoldersResponse{
+		Token:      token,
+		NumHolders: page.NumHolders,
+		Offset:     offset,
+		Limit:      limit,
+		Holders:    make([]*common.ESDTHolderResponse, 0, len(page.Holders)),
+	}
+	for _, holder := range page.Holders {
+		response.Holders = append(response.Holders, &common.ESDTHolderResponse{
+			Address: pubKeyConverter.Encode(holder.Address),
+			Balance: bigIntToString(holder.Balance),
+		})
+	}
+
+	return response, nil
+}
+
+// GetESDTSupplyHistory returns the supply of the given token together with its minted and burned breakdown, the
+// per-epoch snapshots and a page of the supply changing events matching the provided filter
+func (n *Node) GetESDTSupplyHistory(token string, filter common.ESDTSupplyHistoryFilter) (*common.ESDTSupplyHistoryResponse, error) {
+	history, err := n.processComponents.HistoryRepository().GetESDTSupplyHistory(token, esdtSupply.SupplyHistoryFilter{
+		StartEpoch:     filter.StartEpoch,
+		EndEpoch:       filter.EndEpoch,
+		StartTimestamp: filter.StartTimestamp,
+		EndTimestamp:   filter.EndTimestamp,
+		Offset:         filter.Offset,
+		Limit:          filter.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.ESDTSupplyHistoryResponse{
+		Token:     token,
+		Supply:    bigIntToString(history.Supply),
+		Minted:    bigIntToString(history.Minted),
+		Burned:    bigIntToString(history.Burned),
+		Snapshots: make([]*common.ESDTSupplySnapshotResponse, 0, len(history.Snapshots)),
+		NumEvents: history.NumEvents,
+		Offset:    filter.Offset,
+		Limit:     filter.Limit,
+		Events:    make([]*common.ESDTSupplyEventResponse, 0, len(history.Events)),
+	}
+	for _, snapshot := range history.Snapshots {
+		response.Snapshots = append(response.Snapshots, &common.ESDTSupplySnapshotResponse{
+			Epoch:          snapshot.Epoch,
+			Supply:         bigIntToString(snapshot.Supply),
+			Minted:         bigIntToString(snapshot.Minted),
+			Burned:         bigIntToString(snapshot.Burned),
+			FirstTimestamp: snapshot.FirstTimestamp,
+			LastTimestamp:  snapshot.LastTimestamp,
+			LastBlockNonce: snapshot.LastBlockNonce,
+			NumEvents:      snapshot.NumEvents,
+		})
+	}
+	for _, event := range history.Events {
+		eventResponse := &common.ESDTSupplyEventResponse{
+			TxHash:     hex.EncodeToString(event.TxHash),
+			BlockNonce: event.BlockNonce,
+			Epoch:      event.Epoch,
+			Timestamp:  event.Timestamp,
+			Identifier: event.Identifier,
+		}
+		if event.Amount != nil {
+			eventResponse.Amount = event.Amount.String()
+		}
+		response.Events = append(response.Events, eventResponse)
+	}
+
+	return response, nil
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
 // GetAllESDTTokens returns all the ESDTs that the given address interacted with
 func (n *Node) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
 	account, err := n.getAccountHandlerAPIAccounts(address)
@@ -1140,6 +1318,32 @@ func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return peerInfoSlice, nil
 }
 
+// GetConsensusRounds returns the timelines of the last consensus rounds traced by the node
+func (n *Node) GetConsensusRounds() ([]*consensus.RoundTrace, error) {
+	if check.IfNil(n.consensusComponents) {
+		return nil, ErrNilConsensusComponents
+	}
+	roundTracer := n.consensusComponents.RoundTracer()
+	if check.IfNil(roundTracer) {
+		return nil, ErrNilRoundTracer
+	}
+
+	return roundTracer.GetRounds(), nil
+}
+
+// GetSlashingEvidences returns the slashing evidences detected by the node
+func (n *Node) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	if check.IfNil(n.consensusComponents) {
+		return nil, ErrNilConsensusComponents
+	}
+	detector := n.consensusComponents.EquivocationDetector()
+	if check.IfNil(detector) {
+		return nil, ErrNilEquivocationDetector
+	}
+
+	return detector.GetEvidences(), nil
+}
+
 // GetHardforkTrigger returns the hardfork trigger
 func (n *Node) GetHardforkTrigger() HardforkTrigger {
 	return n.hardforkTrigger
@@ -0,0 +1,165 @@
+package node
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
+)
+
+// GetEpochEconomicsReport returns the economics and rewards breakdown computed at the start of the provided epoch.
+// Works only on metachain
+func (n *Node) GetEpochEconomicsReport(epoch uint32) (*common.EpochEconomicsResponse, error) {
+	reportStorer, err := n.createEconomicsReportStorer()
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := reportStorer.GetEpochReport(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter := n.coreComponents.AddressPubKeyConverter()
+	validatorConverter := n.coreComponents.ValidatorPubKeyConverter()
+	response := &common.EpochEconomicsResponse{
+		Epoch:                         report.Epoch,
+		MetaBlockRound:                report.MetaBlockRound,
+		MetaBlockNonce:                report.MetaBlockNonce,
+		PrevEpochStartRound:           report.PrevEpochStartRound,
+		RewardsCreatorVersion:         report.RewardsCreatorVersion,
+		InflationRate:                 report.InflationRate,
+		TotalSupply:                   bigIntToString(report.TotalSupply),
+		NewlyMinted:                   bigIntToString(report.NewlyMinted),
+		AccumulatedFees:               bigIntToString(report.AccumulatedFees),
+		DeveloperFees:                 bigIntToString(report.DeveloperFees),
+		LeaderFees:                    bigIntToString(report.LeaderFees),
+		TotalToDistribute:             bigIntToString(report.TotalToDistribute),
+		RewardsPerBlock:               bigIntToString(report.RewardsPerBlock),
+		RewardsForBlocks:              bigIntToString(report.RewardsForBlocks),
+		BaseRewards:                   bigIntToString(report.BaseRewards),
+		TopUpRewards:                  bigIntToString(report.TopUpRewards),
+		ProtocolSustainabilityAddress: encodeIfNotEmpty(addressConverter, report.ProtocolSustainabilityAddress),
+		ProtocolSustainabilityRewards: bigIntToString(report.ProtocolSustainabilityRewards),
+		NodePrice:                     bigIntToString(report.NodePrice),
+		NumBlocks:                     report.NumBlocks,
+		Shards:                        make([]*common.ShardEconomicsResponse, 0, len(report.Shards)),
+		Nodes:                         make([]*common.NodeEconomicsResponse, 0, len(report.Nodes)),
+		RewardAddresses:               make([]*common.RewardAddressEconomicsResponse, 0, len(report.RewardAddresses)),
+	}
+	for _, shard := range report.Shards {
+		response.Shards = append(response.Shards, &common.ShardEconomicsResponse{
+			ShardID:                    shard.ShardID,
+			NumBlocks:                  shard.NumBlocks,
+			NumEligibleNodes:           shard.NumEligibleNodes,
+			BaseRewardsPerBlockPerNode: bigIntToString(shard.BaseRewardsPerBlockPerNode),
+			BaseRewards:                bigIntToString(shard.BaseRewards),
+			TopUpRewards:               bigIntToString(shard.TopUpRewards),
+			LeaderFees:                 bigIntToString(shard.LeaderFees),
+		})
+	}
+	for _, node := range report.Nodes {
+		response.Nodes = append(response.Nodes, &common.NodeEconomicsResponse{
+			PublicKey:                  encodeIfNotEmpty(validatorConverter, node.PublicKey),
+			ShardID:                    node.ShardID,
+			RewardAddress:              encodeIfNotEmpty(addressConverter, node.RewardAddress),
+			NumSelectedInSuccessBlocks: node.NumSelectedInSuccessBlocks,
+			LeaderSuccess:              node.LeaderSuccess,
+			ValidatorSuccess:           node.ValidatorSuccess,
+			TopUpStake:                 bigIntToString(node.TopUpStake),
+			BaseReward:                 bigIntToString(node.BaseReward),
+			TopUpReward:                bigIntToString(node.TopUpReward),
+			LeaderFees:                 bigIntToString(node.LeaderFees),
+			Distributed:                node.Distributed,
+		})
+	}
+	for _, address := range report.RewardAddresses {
+		response.RewardAddresses = append(response.RewardAddresses, &common.RewardAddressEconomicsResponse{
+			Address:      encodeIfNotEmpty(addressConverter, address.Address),
+			NumNodes:     address.NumNodes,
+			BaseRewards:  bigIntToString(address.BaseRewards),
+			TopUpRewards: bigIntToString(address.TopUpRewards),
+			LeaderFees:   bigIntToString(address.LeaderFees),
+			Total:        bigIntToString(address.Total),
+		})
+	}
+
+	return response, nil
+}
+
+// GetValidatorRewards returns the per-epoch rewards history of the validator with the provided BLS key. The total of
+// an epoch is zero when the rewards of the validator were not distributed. Works only on metachain
+func (n *Node) GetValidatorRewards(publicKey string) (*common.ValidatorRewardsResponse, error) {
+	reportStorer, err := n.createEconomicsReportStorer()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, err := n.coreComponents.ValidatorPubKeyConverter().Decode(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := reportStorer.GetNodeRewards(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter := n.coreComponents.AddressPubKeyConverter()
+	response := &common.ValidatorRewardsResponse{
+		PublicKey: publicKey,
+		Epochs:    make([]*common.ValidatorEpochRewardsResponse, 0, len(history.Epochs)),
+	}
+	for _, epochRewards := range history.Epochs {
+		total := big.NewInt(0)
+		if epochRewards.Distributed {
+			addIfNotNil(total, epochRewards.BaseReward)
+			addIfNotNil(total, epochRewards.TopUpReward)
+			addIfNotNil(total, epochRewards.LeaderFees)
+		}
+
+		response.Epochs = append(response.Epochs, &common.ValidatorEpochRewardsResponse{
+			Epoch:         epochRewards.Epoch,
+			ShardID:       epochRewards.ShardID,
+			RewardAddress: encodeIfNotEmpty(addressConverter, epochRewards.RewardAddress),
+			BaseReward:    bigIntToString(epochRewards.BaseReward),
+			TopUpReward:   bigIntToString(epochRewards.TopUpReward),
+			LeaderFees:    bigIntToString(epochRewards.LeaderFees),
+			Total:         total.String(),
+			Distributed:   epochRewards.Distributed,
+		})
+	}
+
+	return response, nil
+}
+
+func (n *Node) createEconomicsReportStorer() (economicsReportHandler, error) {
+	if n.processComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	reportStorer, err := economicsReport.NewReportStorer(n.dataComponents.StorageService().GetStorer(dataRetriever.EpochEconomicsUnit))
+	if err != nil {
+		return nil, err
+	}
+
+	return reportStorer, nil
+}
+
+func addIfNotNil(sum *big.Int, value *big.Int) {
+	if value == nil {
+		return
+	}
+
+	sum.Add(sum, value)
+}
+
+func encodeIfNotEmpty(converter core.PubkeyConverter, value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+
+	return converter.Encode(value)
+}
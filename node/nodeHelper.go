@@ -117,6 +117,36 @@ func CreateHardForkTrigger(
 	return hardforkTrigger, nil
 }
 
+// CheckGovernanceHardforkTrigger returns an error if the governance hardfork trigger is enabled while the shards can
+// not follow it. Only the metachain nodes read the governance proposals, so the shard nodes start the hardfork from
+// the trigger message, which is broadcast by the trigger node once armed from governance. The trigger node has to be a
+// metachain validator and all the nodes have to accept the trigger message from the network
+func CheckGovernanceHardforkTrigger(
+	hardforkConfig config.HardforkConfig,
+	triggerPubKeyBytes []byte,
+	nodesCoordinator sharding.NodesCoordinator,
+) error {
+	if !hardforkConfig.EnableTriggerFromGovernance {
+		return nil
+	}
+	if !hardforkConfig.EnableTrigger || !hardforkConfig.EnableTriggerFromP2P {
+		return fmt.Errorf("%w: EnableTriggerFromGovernance requires EnableTrigger and EnableTriggerFromP2P",
+			ErrGovernanceHardforkTriggerUnreachable)
+	}
+
+	_, shardID, err := nodesCoordinator.GetValidatorWithPublicKey(triggerPubKeyBytes)
+	if err != nil {
+		return fmt.Errorf("%w: the trigger public key is not a validator, %s",
+			ErrGovernanceHardforkTriggerUnreachable, err.Error())
+	}
+	if shardID != core.MetachainShardId {
+		return fmt.Errorf("%w: the trigger public key is a validator in shard %d instead of the metachain",
+			ErrGovernanceHardforkTriggerUnreachable, shardID)
+	}
+
+	return nil
+}
+
 // prepareOpenTopics will set to the anti flood handler the topics for which
 // the node can receive messages from others than validators
 func prepareOpenTopics(
@@ -0,0 +1,66 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckGovernanceHardforkTrigger(t *testing.T) {
+	t.Parallel()
+
+	triggerPubKey := []byte("trigger public key")
+	enabledConfig := config.HardforkConfig{
+		EnableTrigger:               true,
+		EnableTriggerFromP2P:        true,
+		EnableTriggerFromGovernance: true,
+	}
+	createNodesCoordinator := func(shardID uint32, err error) *mock.NodesCoordinatorMock {
+		return &mock.NodesCoordinatorMock{
+			GetValidatorWithPublicKeyCalled: func(publicKey []byte) (sharding.Validator, uint32, error) {
+				assert.Equal(t, triggerPubKey, publicKey)
+				return nil, shardID, err
+			},
+		}
+	}
+
+	t.Run("disabled governance trigger should not check", func(t *testing.T) {
+		t.Parallel()
+
+		err := node.CheckGovernanceHardforkTrigger(config.HardforkConfig{}, triggerPubKey, &mock.NodesCoordinatorMock{})
+		assert.Nil(t, err)
+	})
+	t.Run("trigger from P2P disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		hardforkConfig := enabledConfig
+		hardforkConfig.EnableTriggerFromP2P = false
+
+		err := node.CheckGovernanceHardforkTrigger(hardforkConfig, triggerPubKey, createNodesCoordinator(core.MetachainShardId, nil))
+		assert.True(t, errors.Is(err, node.ErrGovernanceHardforkTriggerUnreachable))
+	})
+	t.Run("trigger key not a validator should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := node.CheckGovernanceHardforkTrigger(enabledConfig, triggerPubKey, createNodesCoordinator(0, errors.New("not found")))
+		assert.True(t, errors.Is(err, node.ErrGovernanceHardforkTriggerUnreachable))
+	})
+	t.Run("trigger key in a shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := node.CheckGovernanceHardforkTrigger(enabledConfig, triggerPubKey, createNodesCoordinator(1, nil))
+		assert.True(t, errors.Is(err, node.ErrGovernanceHardforkTriggerUnreachable))
+	})
+	t.Run("trigger key on the metachain should work", func(t *testing.T) {
+		t.Parallel()
+
+		err := node.CheckGovernanceHardforkTrigger(enabledConfig, triggerPubKey, createNodesCoordinator(core.MetachainShardId, nil))
+		assert.Nil(t, err)
+	})
+}
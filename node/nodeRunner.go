@@ -32,6 +32,7 @@ import (
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/health"
+	"github.com/ElrondNetwork/elrond-go/keysManagement/keystore"
 	"github.com/ElrondNetwork/elrond-go/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -471,6 +472,11 @@ func (nr *nodeRunner) createApiFacade(
 		return nil, err
 	}
 
+	err = nr.createGovernanceWatcher(currentNode, apiResolver)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debug("creating elrond node facade")
 
 	flagsConfig := configs.FlagsConfig
@@ -510,6 +516,42 @@ func (nr *nodeRunner) createApiFacade(
 	return ef, nil
 }
 
+func (nr *nodeRunner) createGovernanceWatcher(currentNode *Node, proposalsProvider update.HardforkProposalsProvider) error {
+	hardforkConfig := nr.configs.GeneralConfig.Hardfork
+	if !hardforkConfig.EnableTriggerFromGovernance {
+		log.Debug("hardfork trigger from governance is disabled")
+		return nil
+	}
+
+	triggerPubKeyBytes, err := currentNode.coreComponents.ValidatorPubKeyConverter().Decode(hardforkConfig.PublicKeyToListenFrom)
+	if err != nil {
+		return fmt.Errorf("%w while decoding HardforkConfig.PublicKeyToListenFrom", err)
+	}
+	err = CheckGovernanceHardforkTrigger(hardforkConfig, triggerPubKeyBytes, currentNode.processComponents.NodesCoordinator())
+	if err != nil {
+		return err
+	}
+
+	// the shard nodes can not read the governance state, they will receive the trigger message from the trigger node
+	if currentNode.bootstrapComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return nil
+	}
+
+	governanceWatcher, err := trigger.NewGovernanceWatcher(trigger.ArgsGovernanceWatcher{
+		ProposalsProvider:      proposalsProvider,
+		HardforkTrigger:        currentNode.consensusComponents.HardforkTrigger(),
+		EpochConfirmedNotifier: currentNode.coreComponents.EpochStartNotifierWithConfirm(),
+		AppStatusHandler:       currentNode.coreComponents.StatusHandler(),
+		SoftwareVersion:        nr.configs.FlagsConfig.Version,
+	})
+	if err != nil {
+		return fmt.Errorf("%w while creating the governance hardfork watcher", err)
+	}
+	currentNode.closableComponents = append(currentNode.closableComponents, governanceWatcher)
+
+	return nil
+}
+
 func (nr *nodeRunner) createHttpServer() (shared.UpgradeableHttpServerHandler, error) {
 	httpServerArgs := gin.ArgsNewWebServer{
 		Facade:          initial.NewInitialNodeFacade(nr.configs.FlagsConfig.RestApiInterface, nr.configs.FlagsConfig.EnablePprof),
@@ -867,7 +909,7 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 	managedStateComponents mainFactory.StateComponentsHandler,
 	managedDataComponents mainFactory.DataComponentsHandler,
 	managedStatusComponents mainFactory.StatusComponentsHandler,
-	gasScheduleNotifier core.GasScheduleNotifier,
+	gasScheduleNotifier common.GasScheduleNotifierAPI,
 	nodesCoordinator sharding.NodesCoordinator,
 ) (mainFactory.ProcessComponentsHandler, error) {
 	configs := nr.configs
@@ -909,6 +951,7 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		Marshalizer:              managedCoreComponents.InternalMarshalizer(),
 		Store:                    managedDataComponents.StorageService(),
 		Uint64ByteSliceConverter: managedCoreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         managedBootstrapComponents.ShardCoordinator(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -939,30 +982,31 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		time.Duration(uint64(time.Millisecond) * managedCoreComponents.GenesisNodesSetup().GetRoundDuration()))
 
 	processArgs := mainFactory.ProcessComponentsFactoryArgs{
-		Config:                 *configs.GeneralConfig,
-		EpochConfig:            *configs.EpochConfig,
-		PrefConfigs:            configs.PreferencesConfig.Preferences,
-		ImportDBConfig:         *configs.ImportDbConfig,
-		AccountsParser:         accountsParser,
-		SmartContractParser:    smartContractParser,
-		GasSchedule:            gasScheduleNotifier,
-		NodesCoordinator:       nodesCoordinator,
-		Data:                   managedDataComponents,
-		CoreData:               managedCoreComponents,
-		Crypto:                 managedCryptoComponents,
-		State:                  managedStateComponents,
-		Network:                managedNetworkComponents,
-		BootstrapComponents:    managedBootstrapComponents,
-		StatusComponents:       managedStatusComponents,
-		RequestedItemsHandler:  requestedItemsHandler,
-		WhiteListHandler:       whiteListRequest,
-		WhiteListerVerifiedTxs: whiteListerVerifiedTxs,
-		MaxRating:              configs.RatingsConfig.General.MaxRating,
-		SystemSCConfig:         configs.SystemSCConfig,
-		Version:                configs.FlagsConfig.Version,
-		ImportStartHandler:     importStartHandler,
-		WorkingDir:             configs.FlagsConfig.WorkingDir,
-		HistoryRepo:            historyRepository,
+		Config:                      *configs.GeneralConfig,
+		EpochConfig:                 *configs.EpochConfig,
+		PrefConfigs:                 configs.PreferencesConfig.Preferences,
+		ImportDBConfig:              *configs.ImportDbConfig,
+		AccountsParser:              accountsParser,
+		SmartContractParser:         smartContractParser,
+		GasSchedule:                 gasScheduleNotifier,
+		NodesCoordinator:            nodesCoordinator,
+		Data:                        managedDataComponents,
+		CoreData:                    managedCoreComponents,
+		Crypto:                      managedCryptoComponents,
+		State:                       managedStateComponents,
+		Network:                     managedNetworkComponents,
+		BootstrapComponents:         managedBootstrapComponents,
+		StatusComponents:            managedStatusComponents,
+		RequestedItemsHandler:       requestedItemsHandler,
+		WhiteListHandler:            whiteListRequest,
+		WhiteListerVerifiedTxs:      whiteListerVerifiedTxs,
+		MaxRating:                   configs.RatingsConfig.General.MaxRating,
+		SystemSCConfig:              configs.SystemSCConfig,
+		Version:                     configs.FlagsConfig.Version,
+		ImportStartHandler:          importStartHandler,
+		WorkingDir:                  configs.FlagsConfig.WorkingDir,
+		HistoryRepo:                 historyRepository,
+		AllValidatorKeysPemFileName: configs.ConfigurationPathsHolder.AllValidatorKeys,
 	}
 	processComponentsFactory, err := mainFactory.NewProcessComponentsFactory(processArgs)
 	if err != nil {
@@ -1184,16 +1228,21 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 	managedCoreComponents mainFactory.CoreComponentsHandler,
 ) (mainFactory.CryptoComponentsHandler, error) {
 	configs := nr.configs
-	validatorKeyPemFileName := configs.ConfigurationPathsHolder.ValidatorKey
+	validatorKeyFileName, keyLoader, err := createValidatorKeyLoader(configs.ConfigurationPathsHolder)
+	if err != nil {
+		return nil, err
+	}
+
 	cryptoComponentsHandlerArgs := mainFactory.CryptoComponentsFactoryArgs{
-		ValidatorKeyPemFileName:              validatorKeyPemFileName,
+		ValidatorKeyPemFileName:              validatorKeyFileName,
 		SkIndex:                              configs.FlagsConfig.ValidatorKeyIndex,
 		Config:                               *configs.GeneralConfig,
 		CoreComponentsHolder:                 managedCoreComponents,
 		ActivateBLSPubKeyMessageVerification: configs.SystemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
-		KeyLoader:                            &core.KeyLoader{},
+		KeyLoader:                            keyLoader,
 		ImportModeNoSigCheck:                 configs.ImportDbConfig.ImportDbNoSigCheckFlag,
 		IsInImportMode:                       configs.ImportDbConfig.IsImportDBMode,
+		RemoteSignerConfig:                   configs.PreferencesConfig.RemoteSigner,
 	}
 
 	cryptoComponentsFactory, err := mainFactory.NewCryptoComponentsFactory(cryptoComponentsHandlerArgs)
@@ -1379,6 +1428,22 @@ func enableGopsIfNeeded(gopsEnabled bool) {
 	log.Trace("gops", "enabled", gopsEnabled)
 }
 
+// createValidatorKeyLoader returns the validator key file and its loader. The password encrypted key file is used
+// when a password file is provided, otherwise the PEM file
+func createValidatorKeyLoader(paths *config.ConfigurationPathsHolder) (string, mainFactory.KeyLoaderHandler, error) {
+	if len(paths.ValidatorKeyPassword) == 0 {
+		return paths.ValidatorKey, &core.KeyLoader{}, nil
+	}
+
+	log.Info("loading the password encrypted validator key", "file", paths.ValidatorKeystore)
+	keyLoader, err := keystore.NewKeyLoader(paths.ValidatorKeyPassword)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w while loading the validator key password", err)
+	}
+
+	return paths.ValidatorKeystore, keyLoader, nil
+}
+
 func decodeValidatorPubKeys(prefConfig config.Preferences, validatorPubKeyConverter core.PubkeyConverter) ([][]byte, error) {
 	decodedPublicKeys := make([][]byte, 0)
 	for _, pubKey := range prefConfig.Preferences.PreferredConnections {
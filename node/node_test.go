@@ -30,6 +30,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/epochStart/economicsReport"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
@@ -38,11 +40,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -810,6 +814,332 @@ func TestNode_GetESDTsRoles(t *testing.T) {
 	}, tokenResult)
 }
 
+func TestNode_GetESDTTokenData(t *testing.T) {
+	t.Parallel()
+
+	ownerBytes := bytes.Repeat([]byte("o"), 32)
+	creatorBytes := bytes.Repeat([]byte("c"), 32)
+	acc, _ := state.NewUserAccount(vm.ESDTSCAddress)
+	esdtToken := []byte("NFT-abcdef")
+
+	coreComponents := getDefaultCoreComponents()
+	esdtData := &systemSmartContracts.ESDTDataV2{
+		OwnerAddress: ownerBytes,
+		TokenName:    []byte("non fungible"),
+		TickerName:   []byte("NFT"),
+		TokenType:    []byte(core.NonFungibleESDT),
+		CanFreeze:    true,
+		MintedValue:  big.NewInt(10),
+		SpecialRoles: []*systemSmartContracts.ESDTRoles{
+			{
+				Address: creatorBytes,
+				Roles:   [][]byte{[]byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)},
+			},
+			{
+				Address: ownerBytes,
+			},
+		},
+	}
+	marshalledData, _ := coreComponents.IntMarsh.Marshal(esdtData)
+	acc.DataTrieTracker().SetDataTrie(&trieMock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, nil
+		},
+	})
+	_ = acc.DataTrieTracker().SaveKeyValue(esdtToken, marshalledData)
+
+	accDB := &stateMock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = accDB
+	dataComponents := getDefaultDataComponents()
+	dataComponents.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{}
+		},
+	}
+	processComponents := getDefaultProcessComponents()
+	processComponents.ShardCoord = &mock.ShardCoordinatorMock{
+		SelfShardId: core.MetachainShardId,
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithProcessComponents(processComponents),
+	)
+
+	tokenData, err := n.GetESDTTokenData(string(esdtToken))
+	require.Nil(t, err)
+	assert.Equal(t, string(esdtToken), tokenData.Identifier)
+	assert.Equal(t, "NFT", tokenData.Ticker)
+	assert.Equal(t, core.NonFungibleESDT, tokenData.Type)
+	assert.Equal(t, hex.EncodeToString(ownerBytes), tokenData.Owner)
+	assert.True(t, tokenData.CanFreeze)
+	assert.False(t, tokenData.CanWipe)
+	assert.Equal(t, "10", tokenData.MintedValue)
+	assert.Equal(t, "0", tokenData.BurntValue)
+	assert.Equal(t, hex.EncodeToString(creatorBytes), tokenData.NFTCreateRoleOwner)
+	require.Equal(t, 1, len(tokenData.Roles))
+	assert.Equal(t, []string{core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn}, tokenData.Roles[0].Roles)
+
+	tokenData, err = n.GetESDTTokenData("MISSING-abcdef")
+	assert.Nil(t, tokenData)
+	assert.Equal(t, node.ErrESDTTokenNotFound, err)
+
+	tokenData, err = n.GetESDTTokenData("invalid")
+	assert.Nil(t, tokenData)
+	assert.Equal(t, node.ErrESDTTokenNotFound, err)
+
+	processComponents.ShardCoord = &mock.ShardCoordinatorMock{}
+	tokenData, err = n.GetESDTTokenData(string(esdtToken))
+	assert.Nil(t, tokenData)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+}
+
+func TestNode_GetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	holderBytes := bytes.Repeat([]byte("h"), 32)
+	expectedErr := errors.New("expected error")
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		GetESDTHoldersCalled: func(token string, offset uint32, limit uint32) (*esdtSupply.HoldersPage, error) {
+			if token != "TKN-abcdef" {
+				return nil, expectedErr
+			}
+
+			return &esdtSupply.HoldersPage{
+				NumHolders: 5,
+				Holders: []*esdtSupply.HolderESDT{
+					{
+						Address: holderBytes,
+						Balance: big.NewInt(37),
+					},
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponents),
+	)
+
+	holders, err := n.GetESDTHolders("TKN-abcdef", 4, 1)
+	require.Nil(t, err)
+	assert.Equal(t, &common.ESDTHoldersResponse{
+		Token:      "TKN-abcdef",
+		NumHolders: 5,
+		Offset:     4,
+		Limit:      1,
+		Holders: []*common.ESDTHolderResponse{
+			{
+				Address: hex.EncodeToString(holderBytes),
+				Balance: "37",
+			},
+		},
+	}, holders)
+
+	holders, err = n.GetESDTHolders("OTHER-abcdef", 0, 1)
+	assert.Nil(t, holders)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetESDTSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		GetESDTSupplyHistoryCalled: func(token string, filter esdtSupply.SupplyHistoryFilter) (*esdtSupply.SupplyHistoryResult, error) {
+			if token != "TKN-abcdef" {
+				return nil, expectedErr
+			}
+
+			assert.Equal(t, esdtSupply.SupplyHistoryFilter{StartEpoch: 1, EndEpoch: 4, StartTimestamp: 10, EndTimestamp: 20, Offset: 5, Limit: 2}, filter)
+			return &esdtSupply.SupplyHistoryResult{
+				Supply: big.NewInt(90),
+				Minted: big.NewInt(100),
+				Burned: big.NewInt(10),
+				Snapshots: []*esdtSupply.SupplySnapshotESDT{
+					{
+						Epoch:          2,
+						Supply:         big.NewInt(90),
+						Minted:         big.NewInt(100),
+						Burned:         big.NewInt(10),
+						FirstTimestamp: 11,
+						LastTimestamp:  15,
+						LastBlockNonce: 7,
+						NumEvents:      9,
+					},
+				},
+				NumEvents: 9,
+				Events: []*esdtSupply.SupplyEventESDT{
+					{
+						TxHash:     []byte("tx1"),
+						BlockNonce: 7,
+						Epoch:      2,
+						Timestamp:  15,
+						Identifier: core.BuiltInFunctionESDTLocalBurn,
+						Amount:     big.NewInt(10),
+					},
+					{
+						TxHash:     []byte("tx2"),
+						BlockNonce: 7,
+						Epoch:      2,
+						Timestamp:  15,
+						Identifier: core.BuiltInFunctionESDTWipe,
+					},
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponents),
+	)
+
+	filter := common.ESDTSupplyHistoryFilter{StartEpoch: 1, EndEpoch: 4, StartTimestamp: 10, EndTimestamp: 20, Offset: 5, Limit: 2}
+	history, err := n.GetESDTSupplyHistory("TKN-abcdef", filter)
+	require.Nil(t, err)
+	assert.Equal(t, &common.ESDTSupplyHistoryResponse{
+		Token:  "TKN-abcdef",
+		Supply: "90",
+		Minted: "100",
+		Burned: "10",
+		Snapshots: []*common.ESDTSupplySnapshotResponse{
+			{
+				Epoch:          2,
+				Supply:         "90",
+				Minted:         "100",
+				Burned:         "10",
+				FirstTimestamp: 11,
+				LastTimestamp:  15,
+				LastBlockNonce: 7,
+				NumEvents:      9,
+			},
+		},
+		NumEvents: 9,
+		Offset:    5,
+		Limit:     2,
+		Events: []*common.ESDTSupplyEventResponse{
+			{
+				TxHash:     hex.EncodeToString([]byte("tx1")),
+				BlockNonce: 7,
+				Epoch:      2,
+				Timestamp:  15,
+				Identifier: core.BuiltInFunctionESDTLocalBurn,
+				Amount:     "10",
+			},
+			{
+				TxHash:     hex.EncodeToString([]byte("tx2")),
+				BlockNonce: 7,
+				Epoch:      2,
+				Timestamp:  15,
+				Identifier: core.BuiltInFunctionESDTWipe,
+			},
+		},
+	}, history)
+
+	history, err = n.GetESDTSupplyHistory("OTHER-abcdef", filter)
+	assert.Nil(t, history)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetEpochEconomicsReportAndValidatorRewards(t *testing.T) {
+	t.Parallel()
+
+	pubKey := bytes.Repeat([]byte("k"), 32)
+	rewardAddress := bytes.Repeat([]byte("a"), 32)
+	storer := mock.NewStorerMock()
+	reportStorer, _ := economicsReport.NewReportStorer(storer)
+	err := reportStorer.SaveReport(&economicsReport.EpochReport{
+		Epoch:       5,
+		BaseRewards: big.NewInt(70),
+		Nodes: []*economicsReport.NodeReport{
+			{
+				PublicKey:     pubKey,
+				RewardAddress: rewardAddress,
+				BaseReward:    big.NewInt(70),
+				TopUpReward:   big.NewInt(20),
+				LeaderFees:    big.NewInt(10),
+				Distributed:   true,
+			},
+		},
+		RewardAddresses: []*economicsReport.RewardAddressReport{
+			{
+				Address:  rewardAddress,
+				NumNodes: 1,
+				Total:    big.NewInt(100),
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	coreComponents := getDefaultCoreComponents()
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return storer
+		},
+	}
+	processComponents := getDefaultProcessComponents()
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(processComponents),
+	)
+
+	report, err := n.GetEpochEconomicsReport(5)
+	assert.Nil(t, report)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+
+	processComponents.ShardCoord = &mock.ShardCoordinatorMock{
+		SelfShardId: core.MetachainShardId,
+	}
+
+	report, err = n.GetEpochEconomicsReport(5)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(5), report.Epoch)
+	assert.Equal(t, "70", report.BaseRewards)
+	assert.Equal(t, "0", report.TopUpRewards)
+	require.Equal(t, 1, len(report.Nodes))
+	assert.Equal(t, coreComponents.ValPubKeyConv.Encode(pubKey), report.Nodes[0].PublicKey)
+	require.Equal(t, 1, len(report.RewardAddresses))
+	assert.Equal(t, coreComponents.AddrPubKeyConv.Encode(rewardAddress), report.RewardAddresses[0].Address)
+	assert.Equal(t, "100", report.RewardAddresses[0].Total)
+
+	_, err = n.GetEpochEconomicsReport(6)
+	assert.Equal(t, economicsReport.ErrReportNotFound, err)
+
+	encodedPubKey := coreComponents.ValPubKeyConv.Encode(pubKey)
+	rewards, err := n.GetValidatorRewards(encodedPubKey)
+	require.Nil(t, err)
+	assert.Equal(t, &common.ValidatorRewardsResponse{
+		PublicKey: encodedPubKey,
+		Epochs: []*common.ValidatorEpochRewardsResponse{
+			{
+				Epoch:         5,
+				RewardAddress: coreComponents.AddrPubKeyConv.Encode(rewardAddress),
+				BaseReward:    "70",
+				TopUpReward:   "20",
+				LeaderFees:    "10",
+				Total:         "100",
+				Distributed:   true,
+			},
+		},
+	}, rewards)
+
+	_, err = n.GetValidatorRewards(coreComponents.ValPubKeyConv.Encode(bytes.Repeat([]byte("z"), 32)))
+	assert.Equal(t, economicsReport.ErrNodeRewardsNotFound, err)
+}
+
 func TestNode_GetNFTTokenIDsRegisteredByAddress(t *testing.T) {
 	addrBytes := []byte("newaddress")
 	acc, _ := state.NewUserAccount(addrBytes)
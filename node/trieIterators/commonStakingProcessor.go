@@ -64,6 +64,11 @@ func (csp *commonStakingProcessor) getAccount(scAddress []byte) (state.UserAccou
 		return nil, err
 	}
 
+	return csp.getUserAccount(scAddress)
+}
+
+// getUserAccount returns the account from the already recreated trie
+func (csp *commonStakingProcessor) getUserAccount(scAddress []byte) (state.UserAccountHandler, error) {
 	accountHandler, err := csp.accounts.GetExistingAccount(scAddress)
 	if err != nil {
 		return nil, err
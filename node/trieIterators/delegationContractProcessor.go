@@ -0,0 +1,483 @@
+package trieIterators
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+// the storage keys used by the delegation and delegation manager system smart contracts
+const (
+	delegationManagementKey = "delegationManagement"
+	delegationConfigKey     = "delegationConfig"
+	delegationStatusKey     = "delegationStatus"
+	delegationMetaDataKey   = "delegationMetaData"
+	delegationOwnerKey      = "owner"
+	globalFundKey           = "globalFund"
+	serviceFeeKey           = "serviceFee"
+	rewardKeyPrefix         = "reward"
+	fundKeyPrefix           = "fund"
+)
+
+const (
+	nodeStatusStaked    = "staked"
+	nodeStatusNotStaked = "notStaked"
+	nodeStatusUnStaked  = "unStaked"
+)
+
+type delegationContractState struct {
+	address        []byte
+	currentEpoch   uint32
+	maxServiceFee  uint64
+	owner          []byte
+	serviceFee     uint64
+	leaves         map[string][]byte
+	config         *systemSmartContracts.DelegationConfig
+	rewards        []*common.DelegationRewardResponse
+	rewardsByEpoch map[uint32]*systemSmartContracts.RewardComputationData
+}
+
+type delegationContractProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+	marshalizer        marshal.Marshalizer
+}
+
+// NewDelegationContractProcessor will create a new instance of delegationContractProcessor, able to decode the whole
+// state of a delegation contract
+func NewDelegationContractProcessor(arg ArgTrieIteratorProcessor) (*delegationContractProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &delegationContractProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			blockChain:   arg.BlockChain,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+		marshalizer:        arg.Marshalizer,
+	}, nil
+}
+
+// GetDelegationContract will return the configuration, the nodes, the rewards history and the delegators positions
+// of the provided delegation contract. All the values are read from the state of the current block
+func (dcp *delegationContractProcessor) GetDelegationContract(contract string) (*common.DelegationContractResponse, error) {
+	contractAddress, err := dcp.publicKeyConverter.Decode(contract)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, contract)
+	}
+
+	dcp.accounts.Lock()
+	defer dcp.accounts.Unlock()
+
+	currentHeader := dcp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return nil, ErrNodeNotInitialized
+	}
+
+	err = dcp.accounts.RecreateTrie(currentHeader.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	contractState, err := dcp.readContractState(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	contractState.currentEpoch = currentHeader.GetEpoch()
+
+	response := &common.DelegationContractResponse{
+		Address:      contract,
+		BlockNonce:   currentHeader.GetNonce(),
+		RootHash:     hex.EncodeToString(currentHeader.GetRootHash()),
+		CurrentEpoch: contractState.currentEpoch,
+	}
+
+	err = dcp.fillContractData(response, contractState)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Delegators, err = dcp.createDelegators(contractState)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (dcp *delegationContractProcessor) readContractState(contractAddress []byte) (*delegationContractState, error) {
+	managerAccount, err := dcp.getUserAccount(vm.DelegationManagerSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaledManagement, err := managerAccount.RetrieveValueFromDataTrieTracker([]byte(delegationManagementKey))
+	if err != nil {
+		return nil, err
+	}
+	management := &systemSmartContracts.DelegationManagement{}
+	err = dcp.marshalizer.Unmarshal(management, marshaledManagement)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAccount, err := dcp.getUserAccount(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves, err := dcp.getAllLeaves(contractAccount, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(leaves[core.DelegationSystemSCKey], []byte(core.DelegationSystemSCKey)) {
+		return nil, ErrNotADelegationContract
+	}
+
+	config := &systemSmartContracts.DelegationConfig{}
+	err = dcp.unmarshalLeaf(leaves, delegationConfigKey, config)
+	if err != nil {
+		return nil, err
+	}
+
+	contractState := &delegationContractState{
+		address:       contractAddress,
+		maxServiceFee: management.MaxServiceFee,
+		owner:         leaves[delegationOwnerKey],
+		serviceFee:    big.NewInt(0).SetBytes(leaves[serviceFeeKey]).Uint64(),
+		leaves:        leaves,
+		config:        config,
+	}
+
+	err = dcp.readRewards(contractState)
+	if err != nil {
+		return nil, err
+	}
+
+	return contractState, nil
+}
+
+func (dcp *delegationContractProcessor) getAllLeaves(account state.UserAccountHandler, address []byte) (map[string][]byte, error) {
+	rootHash, err := account.DataTrie().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves, err := account.DataTrie().GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := make(map[string][]byte)
+	for leaf := range chLeaves {
+		suffix := append(leaf.Key(), address...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			log.Warn("delegationContractProcessor: cannot get value without suffix", "error", errVal, "key", leaf.Key())
+			continue
+		}
+
+		leaves[string(leaf.Key())] = value
+	}
+
+	return leaves, nil
+}
+
+func (dcp *delegationContractProcessor) unmarshalLeaf(leaves map[string][]byte, key string, obj interface{}) error {
+	marshaledData, found := leaves[key]
+	if !found {
+		return fmt.Errorf("%w: missing %s", ErrInvalidDelegationData, key)
+	}
+
+	return dcp.marshalizer.Unmarshal(obj, marshaledData)
+}
+
+func (dcp *delegationContractProcessor) readRewards(contractState *delegationContractState) error {
+	contractState.rewards = make([]*common.DelegationRewardResponse, 0)
+	contractState.rewardsByEpoch = make(map[uint32]*systemSmartContracts.RewardComputationData)
+	for key, value := range contractState.leaves {
+		isRewardKey := len(key) > len(rewardKeyPrefix) &&
+			len(key) <= len(rewardKeyPrefix)+4 &&
+			key[:len(rewardKeyPrefix)] == rewardKeyPrefix
+		if !isRewardKey {
+			continue
+		}
+
+		rewardData := &systemSmartContracts.RewardComputationData{}
+		err := dcp.marshalizer.Unmarshal(rewardData, value)
+		if err != nil {
+			return err
+		}
+
+		epoch := uint32(big.NewInt(0).SetBytes([]byte(key[len(rewardKeyPrefix):])).Uint64())
+		contractState.rewardsByEpoch[epoch] = rewardData
+		contractState.rewards = append(contractState.rewards, &common.DelegationRewardResponse{
+			Epoch:               epoch,
+			RewardsToDistribute: bigIntToString(rewardData.RewardsToDistribute),
+			TotalActive:         bigIntToString(rewardData.TotalActive),
+			ServiceFee:          rewardData.ServiceFee,
+		})
+	}
+
+	sort.Slice(contractState.rewards, func(i, j int) bool {
+		return contractState.rewards[i].Epoch < contractState.rewards[j].Epoch
+	})
+
+	return nil
+}
+
+func (dcp *delegationContractProcessor) fillContractData(
+	response *common.DelegationContractResponse,
+	contractState *delegationContractState,
+) error {
+	config := contractState.config
+	response.Config = &common.DelegationContractConfigResponse{
+		Owner:                       dcp.publicKeyConverter.Encode(contractState.owner),
+		ServiceFee:                  contractState.serviceFee,
+		MaxServiceFee:               contractState.maxServiceFee,
+		MaxDelegationCap:            bigIntToString(config.MaxDelegationCap),
+		InitialOwnerFunds:           bigIntToString(config.InitialOwnerFunds),
+		AutomaticActivation:         config.AutomaticActivation,
+		WithDelegationCap:           bigIntOrZero(config.MaxDelegationCap).Sign() != 0,
+		ChangeableServiceFee:        config.ChangeableServiceFee,
+		CheckCapOnReDelegateRewards: config.CheckCapOnReDelegateRewards,
+		CreatedNonce:                config.CreatedNonce,
+		UnBondPeriodInEpochs:        config.UnBondPeriodInEpochs,
+	}
+
+	metaData := &systemSmartContracts.DelegationMetaData{}
+	_, found := contractState.leaves[delegationMetaDataKey]
+	if found {
+		err := dcp.unmarshalLeaf(contractState.leaves, delegationMetaDataKey, metaData)
+		if err != nil {
+			return err
+		}
+	}
+	response.MetaData = &common.DelegationContractMetaDataResponse{
+		Name:       string(metaData.Name),
+		Website:    string(metaData.Website),
+		Identifier: string(metaData.Identifier),
+	}
+
+	globalFund := &systemSmartContracts.GlobalFundData{}
+	err := dcp.unmarshalLeaf(contractState.leaves, globalFundKey, globalFund)
+	if err != nil {
+		return err
+	}
+	response.TotalActive = bigIntToString(globalFund.TotalActive)
+	response.TotalUnStaked = bigIntToString(globalFund.TotalUnStaked)
+
+	status := &systemSmartContracts.DelegationContractStatus{}
+	err = dcp.unmarshalLeaf(contractState.leaves, delegationStatusKey, status)
+	if err != nil {
+		return err
+	}
+	response.NumUsers = status.NumUsers
+	response.Nodes = make([]*common.DelegationNodeResponse, 0)
+	response.Nodes = appendNodes(response.Nodes, status.StakedKeys, nodeStatusStaked)
+	response.Nodes = appendNodes(response.Nodes, status.NotStakedKeys, nodeStatusNotStaked)
+	response.Nodes = appendNodes(response.Nodes, status.UnStakedKeys, nodeStatusUnStaked)
+
+	response.Rewards = contractState.rewards
+	response.ServiceFeeHistory = createServiceFeeHistory(contractState)
+
+	return nil
+}
+
+func appendNodes(nodes []*common.DelegationNodeResponse, nodesData []*systemSmartContracts.NodesData, status string) []*common.DelegationNodeResponse {
+	for _, nodeData := range nodesData {
+		nodes = append(nodes, &common.DelegationNodeResponse{
+			BLSKey: hex.EncodeToString(nodeData.BLSKey),
+			Status: status,
+		})
+	}
+
+	return nodes
+}
+
+// createServiceFeeHistory rebuilds the service fee changes from the fee recorded each time rewards were distributed,
+// ending with the current service fee
+func createServiceFeeHistory(contractState *delegationContractState) []*common.DelegationServiceFeeResponse {
+	history := make([]*common.DelegationServiceFeeResponse, 0)
+	addFee := func(epoch uint32, serviceFee uint64) {
+		if len(history) > 0 && history[len(history)-1].ServiceFee == serviceFee {
+			return
+		}
+
+		history = append(history, &common.DelegationServiceFeeResponse{
+			Epoch:      epoch,
+			ServiceFee: serviceFee,
+		})
+	}
+
+	for _, reward := range contractState.rewards {
+		addFee(reward.Epoch, reward.ServiceFee)
+	}
+	addFee(contractState.currentEpoch, contractState.serviceFee)
+
+	return history
+}
+
+func (dcp *delegationContractProcessor) createDelegators(contractState *delegationContractState) ([]*common.DelegationDelegatorResponse, error) {
+	addresses := make([]string, 0)
+	for key := range contractState.leaves {
+		if len(key) == dcp.publicKeyConverter.Len() {
+			addresses = append(addresses, key)
+		}
+	}
+	sort.Strings(addresses)
+
+	delegators := make([]*common.DelegationDelegatorResponse, 0, len(addresses))
+	for _, address := range addresses {
+		delegatorData := &systemSmartContracts.DelegatorData{}
+		err := dcp.marshalizer.Unmarshal(delegatorData, contractState.leaves[address])
+		if err != nil {
+			log.Debug("delegationContractProcessor: cannot decode delegator", "error", err, "key", []byte(address))
+			continue
+		}
+
+		delegator, err := dcp.createDelegator([]byte(address), delegatorData, contractState)
+		if err != nil {
+			return nil, err
+		}
+
+		delegators = append(delegators, delegator)
+	}
+
+	return delegators, nil
+}
+
+func (dcp *delegationContractProcessor) createDelegator(
+	address []byte,
+	delegatorData *systemSmartContracts.DelegatorData,
+	contractState *delegationContractState,
+) (*common.DelegationDelegatorResponse, error) {
+	activeStake := big.NewInt(0)
+	if len(delegatorData.ActiveFund) > 0 {
+		activeFund, err := dcp.getFund(contractState, delegatorData.ActiveFund)
+		if err != nil {
+			return nil, err
+		}
+		activeStake = bigIntOrZero(activeFund.Value)
+	}
+
+	unStaked := big.NewInt(0)
+	unBondable := big.NewInt(0)
+	unDelegatedList := make([]*common.DelegationUnDelegatedFundResponse, 0, len(delegatorData.UnStakedFunds))
+	for _, fundKey := range delegatorData.UnStakedFunds {
+		fund, err := dcp.getFund(contractState, fundKey)
+		if err != nil {
+			return nil, err
+		}
+
+		value := bigIntOrZero(fund.Value)
+		unStaked.Add(unStaked, value)
+
+		remainingEpochs := uint32(0)
+		elapsedEpochs := contractState.currentEpoch - fund.Epoch
+		if elapsedEpochs < contractState.config.UnBondPeriodInEpochs {
+			remainingEpochs = contractState.config.UnBondPeriodInEpochs - elapsedEpochs
+		} else {
+			unBondable.Add(unBondable, value)
+		}
+
+		unDelegatedList = append(unDelegatedList, &common.DelegationUnDelegatedFundResponse{
+			Value:           value.String(),
+			Epoch:           fund.Epoch,
+			RemainingEpochs: remainingEpochs,
+		})
+	}
+
+	isOwner := bytes.Equal(address, contractState.owner)
+	claimableRewards := dcp.computeClaimableRewards(delegatorData, activeStake, isOwner, contractState)
+
+	return &common.DelegationDelegatorResponse{
+		Address:               dcp.publicKeyConverter.Encode(address),
+		ActiveStake:           activeStake.String(),
+		UnStakedStake:         unStaked.String(),
+		UnBondableStake:       unBondable.String(),
+		UnDelegatedList:       unDelegatedList,
+		ClaimableRewards:      claimableRewards.String(),
+		TotalCumulatedRewards: bigIntToString(delegatorData.TotalCumulatedRewards),
+		RewardsCheckpoint:     delegatorData.RewardsCheckpoint,
+	}, nil
+}
+
+func (dcp *delegationContractProcessor) getFund(contractState *delegationContractState, fundKey []byte) (*systemSmartContracts.Fund, error) {
+	if !bytes.HasPrefix(fundKey, []byte(fundKeyPrefix)) {
+		return nil, fmt.Errorf("%w: invalid fund key %s", ErrInvalidDelegationData, hex.EncodeToString(fundKey))
+	}
+
+	fund := &systemSmartContracts.Fund{}
+	err := dcp.unmarshalLeaf(contractState.leaves, string(fundKey), fund)
+	if err != nil {
+		return nil, err
+	}
+
+	return fund, nil
+}
+
+// computeClaimableRewards adds to the unclaimed rewards the rewards not yet computed since the delegator's checkpoint,
+// in the same way the delegation system smart contract does
+func (dcp *delegationContractProcessor) computeClaimableRewards(
+	delegatorData *systemSmartContracts.DelegatorData,
+	activeStake *big.Int,
+	isOwner bool,
+	contractState *delegationContractState,
+) *big.Int {
+	claimableRewards := big.NewInt(0).Set(bigIntOrZero(delegatorData.UnClaimedRewards))
+	if len(delegatorData.ActiveFund) == 0 || contractState.maxServiceFee == 0 {
+		return claimableRewards
+	}
+
+	for epoch := delegatorData.RewardsCheckpoint; epoch <= contractState.currentEpoch; epoch++ {
+		rewardData, found := contractState.rewardsByEpoch[epoch]
+		if !found {
+			continue
+		}
+
+		rewardsToDistribute := bigIntOrZero(rewardData.RewardsToDistribute)
+		totalActive := bigIntOrZero(rewardData.TotalActive)
+		if totalActive.Sign() == 0 {
+			if isOwner {
+				claimableRewards.Add(claimableRewards, rewardsToDistribute)
+			}
+			continue
+		}
+
+		percentage := float64(rewardData.ServiceFee) / float64(contractState.maxServiceFee)
+		rewardsForOwner := core.GetIntTrimmedPercentageOfValue(rewardsToDistribute, percentage)
+		rewardForDelegator := big.NewInt(0).Sub(rewardsToDistribute, rewardsForOwner)
+		rewardForDelegator.Mul(rewardForDelegator, activeStake)
+		rewardForDelegator.Div(rewardForDelegator, totalActive)
+
+		if isOwner {
+			claimableRewards.Add(claimableRewards, rewardsForOwner)
+		}
+		claimableRewards.Add(claimableRewards, rewardForDelegator)
+	}
+
+	return claimableRewards
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcp *delegationContractProcessor) IsInterfaceNil() bool {
+	return dcp == nil
+}
@@ -0,0 +1,292 @@
+package trieIterators
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testDelegationContract = bytes.Repeat([]byte("c"), testAddressLength)
+	testDelegationOwner    = bytes.Repeat([]byte("o"), testAddressLength)
+)
+
+func createDelegationContractLeaves(t *testing.T, marshalizer *testscommon.MarshalizerMock) map[string][]byte {
+	leaves := make(map[string][]byte)
+	addLeaf := func(key []byte, value []byte) {
+		value = append(value, key...)
+		leaves[string(key)] = append(value, testDelegationContract...)
+	}
+	addMarshaledLeaf := func(key []byte, obj interface{}) {
+		buff, err := marshalizer.Marshal(obj)
+		require.Nil(t, err)
+		addLeaf(key, buff)
+	}
+	fundKey := func(index int64) []byte {
+		return append([]byte(fundKeyPrefix), big.NewInt(index).Bytes()...)
+	}
+	rewardKey := func(epoch int64) []byte {
+		return append([]byte(rewardKeyPrefix), big.NewInt(epoch).Bytes()...)
+	}
+
+	addLeaf([]byte(core.DelegationSystemSCKey), []byte(core.DelegationSystemSCKey))
+	addLeaf([]byte(delegationOwnerKey), testDelegationOwner)
+	addLeaf([]byte(serviceFeeKey), big.NewInt(1000).Bytes())
+	addMarshaledLeaf([]byte(delegationConfigKey), &systemSmartContracts.DelegationConfig{
+		MaxDelegationCap:     big.NewInt(0),
+		InitialOwnerFunds:    big.NewInt(500),
+		AutomaticActivation:  true,
+		CreatedNonce:         3,
+		UnBondPeriodInEpochs: 10,
+	})
+	addMarshaledLeaf([]byte(delegationMetaDataKey), &systemSmartContracts.DelegationMetaData{
+		Name:       []byte("staking provider"),
+		Website:    []byte("provider.com"),
+		Identifier: []byte("provider"),
+	})
+	addMarshaledLeaf([]byte(globalFundKey), &systemSmartContracts.GlobalFundData{
+		TotalActive:   big.NewInt(1000),
+		TotalUnStaked: big.NewInt(80),
+	})
+	addMarshaledLeaf([]byte(delegationStatusKey), &systemSmartContracts.DelegationContractStatus{
+		StakedKeys:    []*systemSmartContracts.NodesData{{BLSKey: []byte("bls1")}},
+		NotStakedKeys: []*systemSmartContracts.NodesData{{BLSKey: []byte("bls2")}},
+		NumUsers:      2,
+	})
+	addMarshaledLeaf(rewardKey(5), &systemSmartContracts.RewardComputationData{
+		RewardsToDistribute: big.NewInt(100),
+		TotalActive:         big.NewInt(1000),
+		ServiceFee:          2500,
+	})
+	addMarshaledLeaf(rewardKey(15), &systemSmartContracts.RewardComputationData{
+		RewardsToDistribute: big.NewInt(200),
+		TotalActive:         big.NewInt(1000),
+		ServiceFee:          5000,
+	})
+
+	addMarshaledLeaf(fundKey(1), &systemSmartContracts.Fund{Value: big.NewInt(500), Address: testDelegationOwner})
+	addMarshaledLeaf(fundKey(2), &systemSmartContracts.Fund{Value: big.NewInt(500), Address: testDelegator, Epoch: 4})
+	addMarshaledLeaf(fundKey(3), &systemSmartContracts.Fund{Value: big.NewInt(50), Address: testDelegator, Epoch: 8, Type: 1})
+	addMarshaledLeaf(fundKey(4), &systemSmartContracts.Fund{Value: big.NewInt(30), Address: testDelegator, Epoch: 15, Type: 1})
+	addMarshaledLeaf(testDelegationOwner, &systemSmartContracts.DelegatorData{
+		ActiveFund:            fundKey(1),
+		UnClaimedRewards:      big.NewInt(0),
+		TotalCumulatedRewards: big.NewInt(0),
+	})
+	addMarshaledLeaf(testDelegator, &systemSmartContracts.DelegatorData{
+		ActiveFund:            fundKey(2),
+		UnStakedFunds:         [][]byte{fundKey(3), fundKey(4)},
+		UnClaimedRewards:      big.NewInt(7),
+		TotalCumulatedRewards: big.NewInt(3),
+	})
+
+	return leaves
+}
+
+func createDelegationManagerAccount(t *testing.T, marshalizer *testscommon.MarshalizerMock) state.UserAccountHandler {
+	buff, err := marshalizer.Marshal(&systemSmartContracts.DelegationManagement{MaxServiceFee: 10000})
+	require.Nil(t, err)
+
+	acc, _ := state.NewUserAccount(vm.DelegationManagerSCAddress)
+	acc.SetDataTrie(&trieMock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			if string(key) != delegationManagementKey {
+				return nil, nil
+			}
+
+			value := append(buff, key...)
+			return append(value, vm.DelegationManagerSCAddress...), nil
+		},
+	})
+
+	return acc
+}
+
+func createDelegationContractAccount(leaves map[string][]byte) state.UserAccountHandler {
+	acc, _ := state.NewUserAccount(testDelegationContract)
+	acc.SetDataTrie(&trieMock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetAllLeavesOnChannelCalled: func(hash []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder)
+
+			go func() {
+				for key, value := range leaves {
+					ch <- keyValStorage.NewKeyValStorage([]byte(key), value)
+				}
+
+				close(ch)
+			}()
+
+			return ch, nil
+		},
+	})
+
+	return acc
+}
+
+func createDelegationContractProcessorWithState(t *testing.T, leaves map[string][]byte) *delegationContractProcessor {
+	arg := createMockGovernanceArgs()
+	marshalizer := arg.Marshalizer.(*testscommon.MarshalizerMock)
+	arg.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Nonce: 100, Epoch: 20, RootHash: []byte("state root hash")}
+		},
+	}
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			if bytes.Equal(addressContainer, vm.DelegationManagerSCAddress) {
+				return createDelegationManagerAccount(t, marshalizer), nil
+			}
+
+			return createDelegationContractAccount(leaves), nil
+		},
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+	}
+
+	dcp, err := NewDelegationContractProcessor(arg)
+	require.Nil(t, err)
+
+	return dcp
+}
+
+func TestNewDelegationContractProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockGovernanceArgs()
+	arg.BlockChain = nil
+	dcp, err := NewDelegationContractProcessor(arg)
+	assert.True(t, check.IfNil(dcp))
+	assert.Equal(t, ErrNilBlockChain, err)
+
+	arg = createMockGovernanceArgs()
+	arg.Marshalizer = nil
+	dcp, err = NewDelegationContractProcessor(arg)
+	assert.True(t, check.IfNil(dcp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	dcp, err = NewDelegationContractProcessor(createMockGovernanceArgs())
+	assert.False(t, check.IfNil(dcp))
+	assert.Nil(t, err)
+}
+
+func TestDelegationContractProcessor_GetDelegationContractInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dcp, _ := NewDelegationContractProcessor(createMockGovernanceArgs())
+
+	response, err := dcp.GetDelegationContract("not hex")
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+
+	response, err = dcp.GetDelegationContract(hex.EncodeToString(testDelegationContract))
+	assert.Nil(t, response)
+	assert.Equal(t, ErrNodeNotInitialized, err)
+}
+
+func TestDelegationContractProcessor_GetDelegationContractNotADelegationContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	leaves := createDelegationContractLeaves(t, &testscommon.MarshalizerMock{})
+	delete(leaves, core.DelegationSystemSCKey)
+	dcp := createDelegationContractProcessorWithState(t, leaves)
+
+	response, err := dcp.GetDelegationContract(hex.EncodeToString(testDelegationContract))
+	assert.Nil(t, response)
+	assert.Equal(t, ErrNotADelegationContract, err)
+}
+
+func TestDelegationContractProcessor_GetDelegationContractMissingFundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	leaves := createDelegationContractLeaves(t, &testscommon.MarshalizerMock{})
+	delete(leaves, string(append([]byte(fundKeyPrefix), 3)))
+	dcp := createDelegationContractProcessorWithState(t, leaves)
+
+	response, err := dcp.GetDelegationContract(hex.EncodeToString(testDelegationContract))
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, ErrInvalidDelegationData))
+}
+
+func TestDelegationContractProcessor_GetDelegationContractShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter := mock.NewPubkeyConverterMock(testAddressLength)
+	dcp := createDelegationContractProcessorWithState(t, createDelegationContractLeaves(t, &testscommon.MarshalizerMock{}))
+
+	response, err := dcp.GetDelegationContract(converter.Encode(testDelegationContract))
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(100), response.BlockNonce)
+	assert.Equal(t, uint32(20), response.CurrentEpoch)
+	assert.Equal(t, hex.EncodeToString([]byte("state root hash")), response.RootHash)
+	assert.Equal(t, &common.DelegationContractConfigResponse{
+		Owner:                converter.Encode(testDelegationOwner),
+		ServiceFee:           1000,
+		MaxServiceFee:        10000,
+		MaxDelegationCap:     "0",
+		InitialOwnerFunds:    "500",
+		AutomaticActivation:  true,
+		CreatedNonce:         3,
+		UnBondPeriodInEpochs: 10,
+	}, response.Config)
+	assert.Equal(t, "staking provider", response.MetaData.Name)
+	assert.Equal(t, "1000", response.TotalActive)
+	assert.Equal(t, "80", response.TotalUnStaked)
+	assert.Equal(t, uint64(2), response.NumUsers)
+	assert.Equal(t, []*common.DelegationNodeResponse{
+		{BLSKey: hex.EncodeToString([]byte("bls1")), Status: nodeStatusStaked},
+		{BLSKey: hex.EncodeToString([]byte("bls2")), Status: nodeStatusNotStaked},
+	}, response.Nodes)
+	assert.Equal(t, []*common.DelegationRewardResponse{
+		{Epoch: 5, RewardsToDistribute: "100", TotalActive: "1000", ServiceFee: 2500},
+		{Epoch: 15, RewardsToDistribute: "200", TotalActive: "1000", ServiceFee: 5000},
+	}, response.Rewards)
+	assert.Equal(t, []*common.DelegationServiceFeeResponse{
+		{Epoch: 5, ServiceFee: 2500},
+		{Epoch: 15, ServiceFee: 5000},
+		{Epoch: 20, ServiceFee: 1000},
+	}, response.ServiceFeeHistory)
+
+	require.Equal(t, 2, len(response.Delegators))
+	delegator := response.Delegators[0]
+	assert.Equal(t, converter.Encode(testDelegator), delegator.Address)
+	assert.Equal(t, "500", delegator.ActiveStake)
+	assert.Equal(t, "80", delegator.UnStakedStake)
+	assert.Equal(t, "50", delegator.UnBondableStake)
+	assert.Equal(t, []*common.DelegationUnDelegatedFundResponse{
+		{Value: "50", Epoch: 8, RemainingEpochs: 0},
+		{Value: "30", Epoch: 15, RemainingEpochs: 5},
+	}, delegator.UnDelegatedList)
+	// 7 unclaimed + 75 * 500 / 1000 for epoch 5 + 100 * 500 / 1000 for epoch 15
+	assert.Equal(t, "94", delegator.ClaimableRewards)
+	assert.Equal(t, "3", delegator.TotalCumulatedRewards)
+
+	owner := response.Delegators[1]
+	assert.Equal(t, converter.Encode(testDelegationOwner), owner.Address)
+	// the owner also receives the service fee: 25 + 37 for epoch 5 and 100 + 50 for epoch 15
+	assert.Equal(t, "212", owner.ClaimableRewards)
+	assert.Equal(t, 0, len(owner.UnDelegatedList))
+}
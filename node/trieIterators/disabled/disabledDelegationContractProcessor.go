@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnDelegationContractFromShardNode = errors.New("delegation contract cannot be returned by a shard node")
+
+type delegationContractProcessor struct{}
+
+// NewDisabledDelegationContractProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledDelegationContractProcessor() *delegationContractProcessor {
+	return &delegationContractProcessor{}
+}
+
+// GetDelegationContract returns the errCannotReturnDelegationContractFromShardNode error
+func (dcp *delegationContractProcessor) GetDelegationContract(_ string) (*common.DelegationContractResponse, error) {
+	return nil, errCannotReturnDelegationContractFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcp *delegationContractProcessor) IsInterfaceNil() bool {
+	return dcp == nil
+}
@@ -0,0 +1,41 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnGovernanceStateFromShardNode = errors.New("governance state cannot be returned by a shard node")
+
+type governanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceProcessor() *governanceProcessor {
+	return &governanceProcessor{}
+}
+
+// GetGovernanceProposals returns the errCannotReturnGovernanceStateFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposals() (*common.GovernanceProposalsResponse, error) {
+	return nil, errCannotReturnGovernanceStateFromShardNode
+}
+
+// GetHardforkProposals returns the errCannotReturnGovernanceStateFromShardNode error
+func (gp *governanceProcessor) GetHardforkProposals() (*common.GovernanceProposalsResponse, error) {
+	return nil, errCannotReturnGovernanceStateFromShardNode
+}
+
+// GetGovernanceProposal returns the errCannotReturnGovernanceStateFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposal(_ string) (*common.GovernanceProposalResponse, error) {
+	return nil, errCannotReturnGovernanceStateFromShardNode
+}
+
+// GetGovernanceVotes returns the errCannotReturnGovernanceStateFromShardNode error
+func (gp *governanceProcessor) GetGovernanceVotes(_ string) (*common.GovernanceVotesResponse, error) {
+	return nil, errCannotReturnGovernanceStateFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnValidatorLifecycleFromShardNode = errors.New("validator lifecycle cannot be returned by a shard node")
+
+type validatorLifecycleProcessor struct{}
+
+// NewDisabledValidatorLifecycleProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledValidatorLifecycleProcessor() *validatorLifecycleProcessor {
+	return &validatorLifecycleProcessor{}
+}
+
+// GetValidatorLifecycle returns the errCannotReturnValidatorLifecycleFromShardNode error
+func (vlp *validatorLifecycleProcessor) GetValidatorLifecycle(_ string) (*common.ValidatorLifecycleResponse, error) {
+	return nil, errCannotReturnValidatorLifecycleFromShardNode
+}
+
+// GetStakingQueue returns the errCannotReturnValidatorLifecycleFromShardNode error
+func (vlp *validatorLifecycleProcessor) GetStakingQueue(_ common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+	return nil, errCannotReturnValidatorLifecycleFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vlp *validatorLifecycleProcessor) IsInterfaceNil() bool {
+	return vlp == nil
+}
@@ -22,3 +22,30 @@ var ErrNodeNotInitialized = errors.New("the node is not fully initialized")
 
 // ErrNilMutex signals that a nil mutex has been provided
 var ErrNilMutex = errors.New("nil mutex")
+
+// ErrNilMarshalizer signals that an operation has been attempted to or with a nil marshalizer implementation
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrProposalNotFound signals that the governance proposal was not found
+var ErrProposalNotFound = errors.New("proposal not found")
+
+// ErrInvalidGovernanceData signals that the governance system smart contract storage contains invalid data
+var ErrInvalidGovernanceData = errors.New("invalid governance data")
+
+// ErrNotADelegationContract signals that the provided address does not belong to a delegation contract
+var ErrNotADelegationContract = errors.New("not a delegation contract")
+
+// ErrInvalidDelegationData signals that the delegation contract storage contains invalid data
+var ErrInvalidDelegationData = errors.New("invalid delegation data")
+
+// ErrNilValidatorsProvider signals that a nil validators provider has been provided
+var ErrNilValidatorsProvider = errors.New("nil validators provider")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrValidatorNotFound signals that the provided BLS key was not found in the staking system smart contract
+var ErrValidatorNotFound = errors.New("validator not found")
+
+// ErrInvalidStakingData signals that the staking system smart contract storage contains invalid data
+var ErrInvalidStakingData = errors.New("invalid staking data")
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateDelegationContractHandler will create a new instance of DelegationContractHandler
+func CreateDelegationContractHandler(args trieIterators.ArgTrieIteratorProcessor) (external.DelegationContractHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledDelegationContractProcessor(), nil
+	}
+
+	return trieIterators.NewDelegationContractProcessor(args)
+}
@@ -0,0 +1,47 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDelegationContractHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: 0,
+	}
+
+	delegationContractHandler, err := CreateDelegationContractHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.delegationContractProcessor", fmt.Sprintf("%T", delegationContractHandler))
+}
+
+func TestCreateDelegationContractHandler_DelegationContractProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: core.MetachainShardId,
+		Accounts: &trieIterators.AccountsWrapper{
+			Mutex:           &sync.Mutex{},
+			AccountsAdapter: &stateMock.AccountsStub{},
+		},
+		PublicKeyConverter: &mock.PubkeyConverterMock{},
+		BlockChain:         &mock.BlockChainMock{},
+		QueryService:       &mock.SCQueryServiceStub{},
+		Marshalizer:        &testscommon.MarshalizerMock{},
+	}
+
+	delegationContractHandler, err := CreateDelegationContractHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.delegationContractProcessor", fmt.Sprintf("%T", delegationContractHandler))
+}
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateGovernanceHandler will create a new instance of GovernanceHandler
+func CreateGovernanceHandler(args trieIterators.ArgTrieIteratorProcessor) (external.GovernanceHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledGovernanceProcessor(), nil
+	}
+
+	return trieIterators.NewGovernanceProcessor(args)
+}
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateValidatorLifecycleHandler will create a new instance of ValidatorLifecycleHandler
+func CreateValidatorLifecycleHandler(args trieIterators.ArgValidatorLifecycleProcessor) (external.ValidatorLifecycleHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledValidatorLifecycleProcessor(), nil
+	}
+
+	return trieIterators.NewValidatorLifecycleProcessor(args)
+}
@@ -0,0 +1,54 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateValidatorLifecycleHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgValidatorLifecycleProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: 0,
+		},
+	}
+
+	validatorLifecycleHandler, err := CreateValidatorLifecycleHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.validatorLifecycleProcessor", fmt.Sprintf("%T", validatorLifecycleHandler))
+}
+
+func TestCreateValidatorLifecycleHandler_ValidatorLifecycleProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgValidatorLifecycleProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+			Accounts: &trieIterators.AccountsWrapper{
+				Mutex:           &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{},
+			},
+			PublicKeyConverter: &mock.PubkeyConverterMock{},
+			BlockChain:         &mock.BlockChainMock{},
+			QueryService:       &mock.SCQueryServiceStub{},
+			Marshalizer:        &testscommon.MarshalizerMock{},
+		},
+		ValidatorPubKeyConverter: &mock.PubkeyConverterMock{},
+		ValidatorsProvider:       &mock.ValidatorsProviderStub{},
+		NodesCoordinator:         &mock.NodesCoordinatorMock{},
+	}
+
+	validatorLifecycleHandler, err := CreateValidatorLifecycleHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.validatorLifecycleProcessor", fmt.Sprintf("%T", validatorLifecycleHandler))
+}
@@ -0,0 +1,513 @@
+package trieIterators
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+var log = logger.GetOrCreate("node/trieIterators")
+
+// the storage keys used by the governance system smart contract
+const (
+	governanceConfigKey = "governanceConfig"
+	proposalPrefix      = "proposal_"
+	hardForkPrefix      = "hardFork_"
+	fundsLockPrefix     = "foundsLock_"
+	commitHashLength    = 40
+)
+
+const (
+	proposalTypeGeneral   = "general"
+	proposalTypeWhiteList = "whitelist"
+	proposalTypeHardFork  = "hardfork"
+)
+
+const (
+	proposalStatusPending = "pending"
+	proposalStatusActive  = "active"
+	proposalStatusEnded   = "ended"
+	proposalStatusClosed  = "closed"
+)
+
+type proposalEntry struct {
+	reference []byte
+	proposal  *systemSmartContracts.GeneralProposal
+}
+
+type voteSetEntry struct {
+	reference []byte
+	voter     []byte
+	withFunds bool
+	voteSet   *systemSmartContracts.VoteSet
+}
+
+type governanceState struct {
+	config       *systemSmartContracts.GovernanceConfigV2
+	currentNonce uint64
+	proposals    []*proposalEntry
+	hardForks    map[string]*systemSmartContracts.HardForkProposal
+	voteSets     []*voteSetEntry
+}
+
+type governanceProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+	marshalizer        marshal.Marshalizer
+}
+
+// NewGovernanceProcessor will create a new instance of governanceProcessor, able to decode the state of the
+// governance system smart contract
+func NewGovernanceProcessor(arg ArgTrieIteratorProcessor) (*governanceProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &governanceProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			blockChain:   arg.BlockChain,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+		marshalizer:        arg.Marshalizer,
+	}, nil
+}
+
+// GetGovernanceProposals will return all the governance proposals together with the governance thresholds
+func (gp *governanceProcessor) GetGovernanceProposals() (*common.GovernanceProposalsResponse, error) {
+	govState, err := gp.getGovernanceState()
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*common.GovernanceProposalResponse, 0, len(govState.proposals))
+	for _, entry := range govState.proposals {
+		proposals = append(proposals, gp.createProposalResponse(entry, govState))
+	}
+
+	return &common.GovernanceProposalsResponse{
+		Config:       gp.createConfigResponse(govState.config),
+		CurrentNonce: govState.currentNonce,
+		Proposals:    proposals,
+	}, nil
+}
+
+// GetHardforkProposals will return only the hardfork governance proposals. The leaves of the governance system smart
+// contract are still walked in order to find the hardFork_ keys, but only those are decoded, together with their
+// proposals, read by key, so the vote sets are skipped
+func (gp *governanceProcessor) GetHardforkProposals() (*common.GovernanceProposalsResponse, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash, err := governanceAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves, err := governanceAccount.DataTrie().GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	govState := &governanceState{
+		currentNonce: gp.blockChain.GetCurrentBlockHeader().GetNonce(),
+		proposals:    make([]*proposalEntry, 0),
+		hardForks:    make(map[string]*systemSmartContracts.HardForkProposal),
+	}
+	for leaf := range chLeaves {
+		if !bytes.HasPrefix(leaf.Key(), []byte(hardForkPrefix)) {
+			continue
+		}
+
+		suffix := append(leaf.Key(), vm.GovernanceSCAddress...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			log.Warn("governanceProcessor: cannot get value without suffix", "error", errVal, "key", leaf.Key())
+			continue
+		}
+
+		errDecode := gp.decodeLeaf(leaf.Key(), value, govState)
+		if errDecode != nil {
+			log.Debug("governanceProcessor: cannot decode leaf", "error", errDecode, "key", leaf.Key())
+		}
+	}
+
+	for reference := range govState.hardForks {
+		key := append([]byte(proposalPrefix), reference...)
+		value, errGet := governanceAccount.DataTrieTracker().RetrieveValue(key)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		errDecode := gp.decodeLeaf(key, value, govState)
+		if errDecode != nil {
+			log.Debug("governanceProcessor: cannot decode hardfork proposal", "error", errDecode, "key", key)
+		}
+	}
+
+	gp.sortGovernanceState(govState)
+
+	proposals := make([]*common.GovernanceProposalResponse, 0, len(govState.proposals))
+	for _, entry := range govState.proposals {
+		proposals = append(proposals, gp.createProposalResponse(entry, govState))
+	}
+
+	return &common.GovernanceProposalsResponse{
+		CurrentNonce: govState.currentNonce,
+		Proposals:    proposals,
+	}, nil
+}
+
+// GetGovernanceProposal will return the governance proposal identified by the provided reference: the commit hash
+// or, for the white list proposals, the address
+func (gp *governanceProcessor) GetGovernanceProposal(reference string) (*common.GovernanceProposalResponse, error) {
+	govState, err := gp.getGovernanceState()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range govState.proposals {
+		if gp.encodeReference(entry.reference) == reference {
+			return gp.createProposalResponse(entry, govState), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrProposalNotFound, reference)
+}
+
+// GetGovernanceVotes will return the votes cast by the provided address and the votes cast by the delegation
+// contracts on its behalf
+func (gp *governanceProcessor) GetGovernanceVotes(address string) (*common.GovernanceVotesResponse, error) {
+	addressBytes, err := gp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, address)
+	}
+
+	govState, err := gp.getGovernanceState()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.GovernanceVotesResponse{
+		Address:        address,
+		Votes:          make([]*common.GovernanceVoteSetResponse, 0),
+		DelegatedVotes: make([]*common.GovernanceVoteSetResponse, 0),
+	}
+	for _, entry := range govState.voteSets {
+		if bytes.Equal(entry.voter, addressBytes) {
+			response.Votes = append(response.Votes, gp.createVoteSetResponse(entry, entry.voteSet.VoteItems))
+		}
+
+		delegatedVotes := make([]*systemSmartContracts.VoteDetails, 0)
+		for _, vote := range entry.voteSet.VoteItems {
+			if bytes.Equal(vote.DelegatedTo, addressBytes) {
+				delegatedVotes = append(delegatedVotes, vote)
+			}
+		}
+		if len(delegatedVotes) > 0 {
+			response.DelegatedVotes = append(response.DelegatedVotes, gp.createVoteSetResponse(entry, delegatedVotes))
+		}
+	}
+
+	return response, nil
+}
+
+func (gp *governanceProcessor) getGovernanceState() (*governanceState, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash, err := governanceAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves, err := governanceAccount.DataTrie().GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	govState := &governanceState{
+		currentNonce: gp.blockChain.GetCurrentBlockHeader().GetNonce(),
+		proposals:    make([]*proposalEntry, 0),
+		hardForks:    make(map[string]*systemSmartContracts.HardForkProposal),
+		voteSets:     make([]*voteSetEntry, 0),
+	}
+	for leaf := range chLeaves {
+		suffix := append(leaf.Key(), vm.GovernanceSCAddress...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			log.Warn("governanceProcessor: cannot get value without suffix", "error", errVal, "key", leaf.Key())
+			continue
+		}
+
+		errDecode := gp.decodeLeaf(leaf.Key(), value, govState)
+		if errDecode != nil {
+			log.Debug("governanceProcessor: cannot decode leaf", "error", errDecode, "key", leaf.Key())
+		}
+	}
+
+	gp.sortGovernanceState(govState)
+
+	return govState, nil
+}
+
+func (gp *governanceProcessor) decodeLeaf(key []byte, value []byte, govState *governanceState) error {
+	switch {
+	case string(key) == governanceConfigKey:
+		config := &systemSmartContracts.GovernanceConfigV2{}
+		err := gp.marshalizer.Unmarshal(config, value)
+		if err != nil {
+			return err
+		}
+		govState.config = config
+
+	case bytes.HasPrefix(key, []byte(hardForkPrefix)):
+		hardFork := &systemSmartContracts.HardForkProposal{}
+		err := gp.marshalizer.Unmarshal(hardFork, value)
+		if err != nil {
+			return err
+		}
+		govState.hardForks[string(key[len(hardForkPrefix):])] = hardFork
+
+	case bytes.HasPrefix(key, []byte(fundsLockPrefix)):
+		return gp.decodeVoteSet(key[len(fundsLockPrefix):], value, true, govState)
+
+	case bytes.HasPrefix(key, []byte(proposalPrefix)):
+		reference := key[len(proposalPrefix):]
+		isProposalReference := len(reference) == commitHashLength || len(reference) == gp.publicKeyConverter.Len()
+		if !isProposalReference {
+			return gp.decodeVoteSet(reference, value, false, govState)
+		}
+
+		proposal := &systemSmartContracts.GeneralProposal{}
+		err := gp.marshalizer.Unmarshal(proposal, value)
+		if err != nil {
+			return err
+		}
+		govState.proposals = append(govState.proposals, &proposalEntry{
+			reference: reference,
+			proposal:  proposal,
+		})
+	}
+
+	return nil
+}
+
+// decodeVoteSet decodes the vote set stored under the proposal reference concatenated with the voter address
+func (gp *governanceProcessor) decodeVoteSet(key []byte, value []byte, withFunds bool, govState *governanceState) error {
+	if len(key) <= gp.publicKeyConverter.Len() {
+		return fmt.Errorf("%w: vote set key too short", ErrInvalidGovernanceData)
+	}
+
+	voteSet := &systemSmartContracts.VoteSet{}
+	err := gp.marshalizer.Unmarshal(voteSet, value)
+	if err != nil {
+		return err
+	}
+
+	referenceLength := len(key) - gp.publicKeyConverter.Len()
+	govState.voteSets = append(govState.voteSets, &voteSetEntry{
+		reference: key[:referenceLength],
+		voter:     key[referenceLength:],
+		withFunds: withFunds,
+		voteSet:   voteSet,
+	})
+
+	return nil
+}
+
+func (gp *governanceProcessor) sortGovernanceState(govState *governanceState) {
+	sort.Slice(govState.proposals, func(i, j int) bool {
+		if govState.proposals[i].proposal.StartVoteNonce != govState.proposals[j].proposal.StartVoteNonce {
+			return govState.proposals[i].proposal.StartVoteNonce < govState.proposals[j].proposal.StartVoteNonce
+		}
+
+		return bytes.Compare(govState.proposals[i].reference, govState.proposals[j].reference) < 0
+	})
+	sort.Slice(govState.voteSets, func(i, j int) bool {
+		cmp := bytes.Compare(govState.voteSets[i].reference, govState.voteSets[j].reference)
+		if cmp != 0 {
+			return cmp < 0
+		}
+
+		return bytes.Compare(govState.voteSets[i].voter, govState.voteSets[j].voter) < 0
+	})
+}
+
+func (gp *governanceProcessor) createProposalResponse(entry *proposalEntry, govState *governanceState) *common.GovernanceProposalResponse {
+	proposal := entry.proposal
+	response := &common.GovernanceProposalResponse{
+		Reference:      gp.encodeReference(entry.reference),
+		Type:           proposalTypeGeneral,
+		Issuer:         gp.publicKeyConverter.Encode(proposal.IssuerAddress),
+		CommitHash:     string(proposal.CommitHash),
+		StartVoteNonce: proposal.StartVoteNonce,
+		EndVoteNonce:   proposal.EndVoteNonce,
+		Yes:            bigIntToString(proposal.Yes),
+		No:             bigIntToString(proposal.No),
+		Veto:           bigIntToString(proposal.Veto),
+		Status:         computeProposalStatus(proposal, govState.currentNonce),
+		Passed:         proposal.Passed,
+		Closed:         proposal.Closed,
+		Voters:         make([]string, 0, len(proposal.Votes)),
+	}
+
+	if len(entry.reference) == gp.publicKeyConverter.Len() {
+		response.Type = proposalTypeWhiteList
+	}
+	hardFork, isHardFork := govState.hardForks[string(entry.reference)]
+	if isHardFork {
+		response.Type = proposalTypeHardFork
+		response.EpochToHardFork = hardFork.EpochToHardFork
+		response.NewSoftwareVersion = string(hardFork.NewSoftwareVersion)
+	}
+
+	for _, voter := range proposal.Votes {
+		response.Voters = append(response.Voters, gp.publicKeyConverter.Encode(voter))
+	}
+
+	gp.computeThresholds(response, proposal, govState.config)
+
+	return response
+}
+
+// computeThresholds checks the current tallies against the governance thresholds, in the same way the governance
+// system smart contract does when closing the proposal
+func (gp *governanceProcessor) computeThresholds(
+	response *common.GovernanceProposalResponse,
+	proposal *systemSmartContracts.GeneralProposal,
+	config *systemSmartContracts.GovernanceConfigV2,
+) {
+	if config == nil || config.MinQuorum == nil || config.MinPassThreshold == nil || config.MinVetoThreshold == nil {
+		return
+	}
+
+	yes, no, veto := bigIntOrZero(proposal.Yes), bigIntOrZero(proposal.No), bigIntOrZero(proposal.Veto)
+	totalVotes := big.NewInt(0).Add(yes, no)
+	totalVotes.Add(totalVotes, veto)
+
+	response.QuorumReached = totalVotes.Cmp(config.MinQuorum) >= 0
+	response.VetoThresholdReached = veto.Cmp(config.MinVetoThreshold) >= 0
+	response.PassThresholdReached = yes.Cmp(config.MinPassThreshold) >= 0 && yes.Cmp(no) > 0
+}
+
+func (gp *governanceProcessor) createConfigResponse(config *systemSmartContracts.GovernanceConfigV2) *common.GovernanceConfigResponse {
+	if config == nil {
+		return nil
+	}
+
+	return &common.GovernanceConfigResponse{
+		MinQuorum:        bigIntToString(config.MinQuorum),
+		MinPassThreshold: bigIntToString(config.MinPassThreshold),
+		MinVetoThreshold: bigIntToString(config.MinVetoThreshold),
+		ProposalFee:      bigIntToString(config.ProposalFee),
+	}
+}
+
+func (gp *governanceProcessor) createVoteSetResponse(
+	entry *voteSetEntry,
+	votes []*systemSmartContracts.VoteDetails,
+) *common.GovernanceVoteSetResponse {
+	response := &common.GovernanceVoteSetResponse{
+		Proposal:    gp.encodeReference(entry.reference),
+		Voter:       gp.publicKeyConverter.Encode(entry.voter),
+		WithFunds:   entry.withFunds,
+		UsedPower:   bigIntToString(entry.voteSet.UsedPower),
+		UsedBalance: bigIntToString(entry.voteSet.UsedBalance),
+		TotalYes:    bigIntToString(entry.voteSet.TotalYes),
+		TotalNo:     bigIntToString(entry.voteSet.TotalNo),
+		TotalVeto:   bigIntToString(entry.voteSet.TotalVeto),
+		Votes:       make([]*common.GovernanceVoteResponse, 0, len(votes)),
+	}
+
+	for _, vote := range votes {
+		voteResponse := &common.GovernanceVoteResponse{
+			Value:   voteValueToString(vote.Value),
+			Power:   bigIntToString(vote.Power),
+			Balance: bigIntToString(vote.Balance),
+		}
+		if len(vote.DelegatedTo) > 0 {
+			voteResponse.DelegatedTo = gp.publicKeyConverter.Encode(vote.DelegatedTo)
+		}
+
+		response.Votes = append(response.Votes, voteResponse)
+	}
+
+	return response
+}
+
+// encodeReference returns the commit hash or, for the white list proposals, the encoded address
+func (gp *governanceProcessor) encodeReference(reference []byte) string {
+	if len(reference) == gp.publicKeyConverter.Len() {
+		return gp.publicKeyConverter.Encode(reference)
+	}
+
+	return string(reference)
+}
+
+func computeProposalStatus(proposal *systemSmartContracts.GeneralProposal, currentNonce uint64) string {
+	switch {
+	case proposal.Closed:
+		return proposalStatusClosed
+	case currentNonce < proposal.StartVoteNonce:
+		return proposalStatusPending
+	case currentNonce <= proposal.EndVoteNonce:
+		return proposalStatusActive
+	default:
+		return proposalStatusEnded
+	}
+}
+
+func voteValueToString(value systemSmartContracts.VoteValueType) string {
+	switch value {
+	case systemSmartContracts.Yes:
+		return "yes"
+	case systemSmartContracts.No:
+		return "no"
+	case systemSmartContracts.Veto:
+		return "veto"
+	default:
+		return value.String()
+	}
+}
+
+func bigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
+
+func bigIntToString(value *big.Int) string {
+	return bigIntOrZero(value).String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
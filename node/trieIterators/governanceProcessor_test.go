@@ -0,0 +1,280 @@
+package trieIterators
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddressLength = 32
+
+var (
+	testCommitHash1 = []byte("1111111111111111111111111111111111111111")
+	testCommitHash2 = []byte("2222222222222222222222222222222222222222")
+	testIssuer      = bytes.Repeat([]byte("i"), testAddressLength)
+	testVoter       = bytes.Repeat([]byte("v"), testAddressLength)
+	testDelegator   = bytes.Repeat([]byte("d"), testAddressLength)
+)
+
+func createMockGovernanceArgs() ArgTrieIteratorProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(testAddressLength)
+	arg.Marshalizer = &testscommon.MarshalizerMock{}
+
+	return arg
+}
+
+func createGovernanceLeaves(t *testing.T, marshalizer *testscommon.MarshalizerMock) map[string][]byte {
+	leaves := make(map[string][]byte)
+	addLeaf := func(key []byte, obj interface{}) {
+		buff, err := marshalizer.Marshal(obj)
+		require.Nil(t, err)
+
+		value := append(buff, key...)
+		leaves[string(key)] = append(value, vm.GovernanceSCAddress...)
+	}
+
+	addLeaf([]byte(governanceConfigKey), &systemSmartContracts.GovernanceConfigV2{
+		MinQuorum:        big.NewInt(100),
+		MinPassThreshold: big.NewInt(50),
+		MinVetoThreshold: big.NewInt(30),
+		ProposalFee:      big.NewInt(10),
+	})
+	addLeaf(append([]byte(proposalPrefix), testCommitHash2...), &systemSmartContracts.GeneralProposal{
+		IssuerAddress:  testIssuer,
+		CommitHash:     testCommitHash2,
+		StartVoteNonce: 20,
+		EndVoteNonce:   30,
+		Yes:            big.NewInt(0),
+		No:             big.NewInt(0),
+		Veto:           big.NewInt(0),
+	})
+	addLeaf(append([]byte(proposalPrefix), testCommitHash1...), &systemSmartContracts.GeneralProposal{
+		IssuerAddress:  testIssuer,
+		CommitHash:     testCommitHash1,
+		StartVoteNonce: 5,
+		EndVoteNonce:   15,
+		Yes:            big.NewInt(80),
+		No:             big.NewInt(20),
+		Veto:           big.NewInt(10),
+		Votes:          [][]byte{testVoter},
+	})
+	addLeaf(append([]byte(hardForkPrefix), testCommitHash1...), &systemSmartContracts.HardForkProposal{
+		EpochToHardFork:    7,
+		NewSoftwareVersion: []byte("v2"),
+	})
+
+	voteKey := append([]byte(proposalPrefix), testCommitHash1...)
+	addLeaf(append(voteKey, testVoter...), &systemSmartContracts.VoteSet{
+		UsedPower: big.NewInt(80),
+		TotalYes:  big.NewInt(80),
+		VoteItems: []*systemSmartContracts.VoteDetails{
+			{
+				Value:       systemSmartContracts.Yes,
+				Power:       big.NewInt(80),
+				Balance:     big.NewInt(80),
+				DelegatedTo: testDelegator,
+			},
+		},
+	})
+
+	return leaves
+}
+
+func createGovernanceScAccount(leaves map[string][]byte) state.UserAccountHandler {
+	acc, _ := state.NewUserAccount(vm.GovernanceSCAddress)
+	acc.SetDataTrie(&trieMock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetAllLeavesOnChannelCalled: func(hash []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder)
+
+			go func() {
+				for key, value := range leaves {
+					ch <- keyValStorage.NewKeyValStorage([]byte(key), value)
+				}
+
+				close(ch)
+			}()
+
+			return ch, nil
+		},
+		GetCalled: func(key []byte) ([]byte, error) {
+			return leaves[string(key)], nil
+		},
+	})
+
+	return acc
+}
+
+func createGovernanceProcessorWithState(t *testing.T, currentNonce uint64) *governanceProcessor {
+	arg := createMockGovernanceArgs()
+	leaves := createGovernanceLeaves(t, arg.Marshalizer.(*testscommon.MarshalizerMock))
+	arg.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Nonce: currentNonce}
+		},
+	}
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return createGovernanceScAccount(leaves), nil
+		},
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+	}
+
+	gp, err := NewGovernanceProcessor(arg)
+	require.Nil(t, err)
+
+	return gp
+}
+
+func TestNewGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockGovernanceArgs()
+	arg.Accounts = nil
+	gp, err := NewGovernanceProcessor(arg)
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	arg = createMockGovernanceArgs()
+	arg.Marshalizer = nil
+	gp, err = NewGovernanceProcessor(arg)
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	gp, err = NewGovernanceProcessor(createMockGovernanceArgs())
+	assert.False(t, check.IfNil(gp))
+	assert.Nil(t, err)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalsNodeNotInitializedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGovernanceProcessor(createMockGovernanceArgs())
+
+	proposals, err := gp.GetGovernanceProposals()
+	assert.Nil(t, proposals)
+	assert.Equal(t, ErrNodeNotInitialized, err)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gp := createGovernanceProcessorWithState(t, 25)
+
+	response, err := gp.GetGovernanceProposals()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(25), response.CurrentNonce)
+	assert.Equal(t, &common.GovernanceConfigResponse{
+		MinQuorum:        "100",
+		MinPassThreshold: "50",
+		MinVetoThreshold: "30",
+		ProposalFee:      "10",
+	}, response.Config)
+	require.Equal(t, 2, len(response.Proposals))
+
+	ended := response.Proposals[0]
+	assert.Equal(t, string(testCommitHash1), ended.Reference)
+	assert.Equal(t, proposalTypeHardFork, ended.Type)
+	assert.Equal(t, uint32(7), ended.EpochToHardFork)
+	assert.Equal(t, "v2", ended.NewSoftwareVersion)
+	assert.Equal(t, proposalStatusEnded, ended.Status)
+	assert.Equal(t, "80", ended.Yes)
+	assert.Equal(t, "20", ended.No)
+	assert.Equal(t, "10", ended.Veto)
+	assert.True(t, ended.QuorumReached)
+	assert.True(t, ended.PassThresholdReached)
+	assert.False(t, ended.VetoThresholdReached)
+	assert.Equal(t, []string{mock.NewPubkeyConverterMock(testAddressLength).Encode(testVoter)}, ended.Voters)
+
+	active := response.Proposals[1]
+	assert.Equal(t, string(testCommitHash2), active.Reference)
+	assert.Equal(t, proposalTypeGeneral, active.Type)
+	assert.Equal(t, proposalStatusActive, active.Status)
+	assert.False(t, active.QuorumReached)
+	assert.Equal(t, 0, len(active.Voters))
+}
+
+func TestGovernanceProcessor_GetHardforkProposalsShouldReturnOnlyTheHardforkProposals(t *testing.T) {
+	t.Parallel()
+
+	gp := createGovernanceProcessorWithState(t, 25)
+
+	response, err := gp.GetHardforkProposals()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(25), response.CurrentNonce)
+	assert.Nil(t, response.Config)
+	require.Equal(t, 1, len(response.Proposals))
+
+	hardFork := response.Proposals[0]
+	assert.Equal(t, string(testCommitHash1), hardFork.Reference)
+	assert.Equal(t, proposalTypeHardFork, hardFork.Type)
+	assert.Equal(t, uint32(7), hardFork.EpochToHardFork)
+	assert.Equal(t, "v2", hardFork.NewSoftwareVersion)
+	assert.Equal(t, proposalStatusEnded, hardFork.Status)
+	assert.Equal(t, "80", hardFork.Yes)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	gp := createGovernanceProcessorWithState(t, 1)
+
+	proposal, err := gp.GetGovernanceProposal(string(testCommitHash2))
+	require.Nil(t, err)
+	assert.Equal(t, string(testCommitHash2), proposal.Reference)
+	assert.Equal(t, proposalStatusPending, proposal.Status)
+
+	proposal, err = gp.GetGovernanceProposal("missing")
+	assert.Nil(t, proposal)
+	assert.True(t, errors.Is(err, ErrProposalNotFound))
+}
+
+func TestGovernanceProcessor_GetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	converter := mock.NewPubkeyConverterMock(testAddressLength)
+	gp := createGovernanceProcessorWithState(t, 10)
+
+	votes, err := gp.GetGovernanceVotes(converter.Encode(testVoter))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(votes.Votes))
+	assert.Equal(t, 0, len(votes.DelegatedVotes))
+	assert.Equal(t, string(testCommitHash1), votes.Votes[0].Proposal)
+	assert.Equal(t, "80", votes.Votes[0].UsedPower)
+	require.Equal(t, 1, len(votes.Votes[0].Votes))
+	assert.Equal(t, "yes", votes.Votes[0].Votes[0].Value)
+	assert.Equal(t, converter.Encode(testDelegator), votes.Votes[0].Votes[0].DelegatedTo)
+
+	votes, err = gp.GetGovernanceVotes(converter.Encode(testDelegator))
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(votes.Votes))
+	require.Equal(t, 1, len(votes.DelegatedVotes))
+	assert.Equal(t, converter.Encode(testVoter), votes.DelegatedVotes[0].Voter)
+
+	votes, err = gp.GetGovernanceVotes("not hex")
+	assert.Nil(t, votes)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,528 @@
+package trieIterators
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+// stakingQueueHeadKey is the storage key used by the staking system smart contract for the head of its waiting
+// list (the staking queue)
+const stakingQueueHeadKey = "waitingList"
+
+const (
+	blsKeyStatusJailed   = "jailed"
+	blsKeyStatusQueued   = "queued"
+	blsKeyStatusStaked   = "staked"
+	blsKeyStatusUnStaked = "unStaked"
+)
+
+const defaultStakingQueueLimit = 100
+
+// ArgValidatorLifecycleProcessor represents the arguments DTO used in the validator lifecycle processor constructor
+type ArgValidatorLifecycleProcessor struct {
+	ArgTrieIteratorProcessor
+	ValidatorPubKeyConverter  core.PubkeyConverter
+	ValidatorsProvider        process.ValidatorsProvider
+	NodesCoordinator          process.NodesCoordinator
+	UnBondPeriod              uint64
+	RoundsPerEpoch            uint64
+	MaxNodesChangeEnableEpoch []config.MaxNodesChangeConfig
+}
+
+type validatorLifecycleProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter        core.PubkeyConverter
+	validatorPubKeyConverter  core.PubkeyConverter
+	marshalizer               marshal.Marshalizer
+	validatorsProvider        process.ValidatorsProvider
+	nodesCoordinator          process.NodesCoordinator
+	unBondPeriod              uint64
+	roundsPerEpoch            uint64
+	maxNodesChangeEnableEpoch []config.MaxNodesChangeConfig
+}
+
+// NewValidatorLifecycleProcessor will create a new instance of validatorLifecycleProcessor, able to aggregate the
+// staking, rating and shuffling status of a BLS key
+func NewValidatorLifecycleProcessor(arg ArgValidatorLifecycleProcessor) (*validatorLifecycleProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(arg.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for validator public keys", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arg.ValidatorsProvider) {
+		return nil, ErrNilValidatorsProvider
+	}
+	if check.IfNil(arg.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+
+	return &validatorLifecycleProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			blockChain:   arg.BlockChain,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter:        arg.PublicKeyConverter,
+		validatorPubKeyConverter:  arg.ValidatorPubKeyConverter,
+		marshalizer:               arg.Marshalizer,
+		validatorsProvider:        arg.ValidatorsProvider,
+		nodesCoordinator:          arg.NodesCoordinator,
+		unBondPeriod:              arg.UnBondPeriod,
+		roundsPerEpoch:            arg.RoundsPerEpoch,
+		maxNodesChangeEnableEpoch: arg.MaxNodesChangeEnableEpoch,
+	}, nil
+}
+
+// GetValidatorLifecycle will return the staking data, the owner stake, the queue position, the remaining unbond
+// period, the rating and the shuffling expectations of the provided BLS key
+func (vlp *validatorLifecycleProcessor) GetValidatorLifecycle(blsKey string) (*common.ValidatorLifecycleResponse, error) {
+	decodedKey, err := vlp.validatorPubKeyConverter.Decode(blsKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for BLS key %s", err, blsKey)
+	}
+
+	vlp.accounts.Lock()
+	defer vlp.accounts.Unlock()
+
+	currentHeader, stakingAccount, err := vlp.getStakingAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	stakedData, err := vlp.getStakedData(stakingAccount, decodedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedKey := vlp.validatorPubKeyConverter.Encode(decodedKey)
+	response := &common.ValidatorLifecycleResponse{
+		PublicKey:    encodedKey,
+		BlockNonce:   currentHeader.GetNonce(),
+		CurrentEpoch: currentHeader.GetEpoch(),
+		Peer:         vlp.createPeerResponse(encodedKey),
+	}
+	response.Staking = vlp.createStakingResponse(stakedData, response.Peer)
+	response.UnBond = vlp.createUnBondResponse(stakedData, currentHeader.GetNonce())
+
+	if stakedData.Waiting {
+		response.Queue, err = vlp.computeQueuePosition(stakingAccount, decodedKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(stakedData.OwnerAddress) > 0 {
+		response.Owner, err = vlp.createOwnerResponse(stakedData.OwnerAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response.Shuffling, err = vlp.createShufflingResponse(decodedKey, currentHeader.GetEpoch())
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetStakingQueue will return the page of the staking queue entries matching the provided filter
+func (vlp *validatorLifecycleProcessor) GetStakingQueue(filter common.StakingQueueFilter) (*common.StakingQueueResponse, error) {
+	var owner []byte
+	var err error
+	if len(filter.Owner) > 0 {
+		owner, err = vlp.publicKeyConverter.Decode(filter.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s", err, filter.Owner)
+		}
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultStakingQueueLimit
+	}
+
+	vlp.accounts.Lock()
+	defer vlp.accounts.Unlock()
+
+	currentHeader, stakingAccount, err := vlp.getStakingAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := vlp.getStakingQueue(stakingAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.StakingQueueResponse{
+		BlockNonce:   currentHeader.GetNonce(),
+		CurrentEpoch: currentHeader.GetEpoch(),
+		Length:       queue.Length,
+		Entries:      make([]*common.StakingQueueEntryResponse, 0),
+	}
+
+	position := uint32(0)
+	err = vlp.iterateStakingQueue(stakingAccount, queue, func(blsKey []byte) error {
+		position++
+
+		stakedData, errGet := vlp.getStakedData(stakingAccount, blsKey)
+		if errGet != nil {
+			return errGet
+		}
+		if len(owner) > 0 && !bytes.Equal(owner, stakedData.OwnerAddress) {
+			return nil
+		}
+
+		response.NumMatching++
+		if response.NumMatching <= filter.Offset || len(response.Entries) >= int(limit) {
+			return nil
+		}
+
+		response.Entries = append(response.Entries, &common.StakingQueueEntryResponse{
+			Position:      position,
+			BLSKey:        vlp.validatorPubKeyConverter.Encode(blsKey),
+			OwnerAddress:  vlp.encodeAddress(stakedData.OwnerAddress),
+			RewardAddress: vlp.encodeAddress(stakedData.RewardAddress),
+			RegisterNonce: stakedData.RegisterNonce,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (vlp *validatorLifecycleProcessor) getStakingAccount() (data.HeaderHandler, state.UserAccountHandler, error) {
+	currentHeader := vlp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return nil, nil, ErrNodeNotInitialized
+	}
+
+	err := vlp.accounts.RecreateTrie(currentHeader.GetRootHash())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stakingAccount, err := vlp.getUserAccount(vm.StakingSCAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return currentHeader, stakingAccount, nil
+}
+
+func (vlp *validatorLifecycleProcessor) getStakedData(stakingAccount state.UserAccountHandler, blsKey []byte) (*systemSmartContracts.StakedDataV2_0, error) {
+	marshaledData, err := stakingAccount.RetrieveValueFromDataTrieTracker(blsKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(marshaledData) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrValidatorNotFound, hex.EncodeToString(blsKey))
+	}
+
+	stakedData := &systemSmartContracts.StakedDataV2_0{
+		StakeValue: big.NewInt(0),
+		SlashValue: big.NewInt(0),
+	}
+	err = vlp.marshalizer.Unmarshal(stakedData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return stakedData, nil
+}
+
+func (vlp *validatorLifecycleProcessor) getStakingQueue(stakingAccount state.UserAccountHandler) (*systemSmartContracts.WaitingList, error) {
+	queue := &systemSmartContracts.WaitingList{}
+	marshaledData, err := stakingAccount.RetrieveValueFromDataTrieTracker([]byte(stakingQueueHeadKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(marshaledData) == 0 {
+		return queue, nil
+	}
+
+	err = vlp.marshalizer.Unmarshal(queue, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+// iterateStakingQueue walks the staking queue linked list from its head, calling the handler for every BLS key
+func (vlp *validatorLifecycleProcessor) iterateStakingQueue(
+	stakingAccount state.UserAccountHandler,
+	queue *systemSmartContracts.WaitingList,
+	handler func(blsKey []byte) error,
+) error {
+	nextKey := queue.FirstKey
+	for index := uint32(0); index < queue.Length && len(nextKey) > 0; index++ {
+		marshaledElement, err := stakingAccount.RetrieveValueFromDataTrieTracker(nextKey)
+		if err != nil {
+			return err
+		}
+		if len(marshaledElement) == 0 {
+			return fmt.Errorf("%w: missing staking queue element %s", ErrInvalidStakingData, hex.EncodeToString(nextKey))
+		}
+
+		element := &systemSmartContracts.ElementInList{}
+		err = vlp.marshalizer.Unmarshal(element, marshaledElement)
+		if err != nil {
+			return err
+		}
+
+		err = handler(element.BLSPublicKey)
+		if err != nil {
+			return err
+		}
+
+		nextKey = element.NextKey
+	}
+
+	return nil
+}
+
+func (vlp *validatorLifecycleProcessor) computeQueuePosition(
+	stakingAccount state.UserAccountHandler,
+	blsKey []byte,
+) (*common.ValidatorQueuePositionResponse, error) {
+	queue, err := vlp.getStakingQueue(stakingAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.ValidatorQueuePositionResponse{
+		Length: queue.Length,
+	}
+	position := uint32(0)
+	err = vlp.iterateStakingQueue(stakingAccount, queue, func(key []byte) error {
+		position++
+		if response.Position == 0 && bytes.Equal(key, blsKey) {
+			response.Position = position
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (vlp *validatorLifecycleProcessor) createStakingResponse(
+	stakedData *systemSmartContracts.StakedDataV2_0,
+	peer *common.ValidatorPeerResponse,
+) *common.ValidatorStakingResponse {
+	return &common.ValidatorStakingResponse{
+		Status:        computeBLSKeyStatus(stakedData, peer),
+		RegisterNonce: stakedData.RegisterNonce,
+		StakedNonce:   stakedData.StakedNonce,
+		Staked:        stakedData.Staked,
+		UnStakedNonce: stakedData.UnStakedNonce,
+		UnStakedEpoch: stakedData.UnStakedEpoch,
+		RewardAddress: vlp.encodeAddress(stakedData.RewardAddress),
+		OwnerAddress:  vlp.encodeAddress(stakedData.OwnerAddress),
+		StakeValue:    bigIntToString(stakedData.StakeValue),
+		Jailed:        stakedData.Jailed,
+		JailedRound:   stakedData.JailedRound,
+		JailedNonce:   stakedData.JailedNonce,
+		UnJailedNonce: stakedData.UnJailedNonce,
+		NumJailed:     stakedData.NumJailed,
+		SlashValue:    bigIntToString(stakedData.SlashValue),
+		Waiting:       stakedData.Waiting,
+	}
+}
+
+// computeBLSKeyStatus mirrors the getBLSKeyStatus function of the staking system smart contract, where a key that
+// can be unjailed is one found in the jailed list of the peer accounts trie
+func computeBLSKeyStatus(stakedData *systemSmartContracts.StakedDataV2_0, peer *common.ValidatorPeerResponse) string {
+	isInJailedList := peer != nil && peer.List == string(common.JailedList)
+	if stakedData.Jailed || isInJailedList {
+		return blsKeyStatusJailed
+	}
+	if stakedData.Waiting {
+		return blsKeyStatusQueued
+	}
+	if stakedData.Staked {
+		return blsKeyStatusStaked
+	}
+
+	return blsKeyStatusUnStaked
+}
+
+func (vlp *validatorLifecycleProcessor) createUnBondResponse(stakedData *systemSmartContracts.StakedDataV2_0, currentNonce uint64) *common.ValidatorUnBondResponse {
+	if stakedData.UnStakedNonce == 0 || stakedData.Staked {
+		return nil
+	}
+
+	response := &common.ValidatorUnBondResponse{
+		UnBondPeriod: vlp.unBondPeriod,
+		CanUnBond:    true,
+	}
+	passedNonces := uint64(0)
+	if currentNonce > stakedData.UnStakedNonce {
+		passedNonces = currentNonce - stakedData.UnStakedNonce
+	}
+	if passedNonces >= vlp.unBondPeriod {
+		return response
+	}
+
+	response.CanUnBond = false
+	response.RemainingNonces = vlp.unBondPeriod - passedNonces
+	if vlp.roundsPerEpoch > 0 {
+		response.EstimatedRemainingEpochs = uint32((response.RemainingNonces + vlp.roundsPerEpoch - 1) / vlp.roundsPerEpoch)
+	}
+
+	return response
+}
+
+func (vlp *validatorLifecycleProcessor) createOwnerResponse(ownerAddress []byte) (*common.ValidatorOwnerResponse, error) {
+	info, err := vlp.getValidatorInfoFromSC(ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.ValidatorOwnerResponse{
+		Address:     vlp.encodeAddress(ownerAddress),
+		TotalStaked: info.totalStakedValue.String(),
+		TopUp:       info.topUpValue.String(),
+	}, nil
+}
+
+func (vlp *validatorLifecycleProcessor) createPeerResponse(encodedKey string) *common.ValidatorPeerResponse {
+	validatorInfo, found := vlp.validatorsProvider.GetLatestValidators()[encodedKey]
+	if !found || validatorInfo == nil {
+		return nil
+	}
+
+	return &common.ValidatorPeerResponse{
+		ShardID:        validatorInfo.ShardId,
+		List:           validatorInfo.ValidatorStatus,
+		Rating:         validatorInfo.Rating,
+		TempRating:     validatorInfo.TempRating,
+		RatingModifier: validatorInfo.RatingModifier,
+	}
+}
+
+func (vlp *validatorLifecycleProcessor) createShufflingResponse(blsKey []byte, epoch uint32) (*common.ValidatorShufflingResponse, error) {
+	eligible, err := vlp.nodesCoordinator.GetAllEligibleValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+	waiting, err := vlp.nodesCoordinator.GetAllWaitingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+	leaving, err := vlp.nodesCoordinator.GetAllLeavingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.ValidatorShufflingResponse{
+		Epoch:                  epoch,
+		NodesToShufflePerShard: vlp.nodesToShufflePerShard(epoch),
+	}
+	found := false
+	lists := []struct {
+		peerType common.PeerType
+		keys     map[uint32][][]byte
+	}{
+		{peerType: common.EligibleList, keys: eligible},
+		{peerType: common.WaitingList, keys: waiting},
+		{peerType: common.LeavingList, keys: leaving},
+	}
+	for _, list := range lists {
+		response.ShardID, response.Index, found = findKeyInShards(list.keys, blsKey)
+		if found {
+			response.List = string(list.peerType)
+			break
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	response.NumEligibleInShard = len(eligible[response.ShardID])
+	response.NumWaitingInShard = len(waiting[response.ShardID])
+	if response.NodesToShufflePerShard == 0 {
+		return response, nil
+	}
+
+	switch response.List {
+	case string(common.EligibleList):
+		response.ShuffleOutProbability = float64(response.NodesToShufflePerShard) / float64(response.NumEligibleInShard)
+		if response.ShuffleOutProbability > 1 {
+			response.ShuffleOutProbability = 1
+		}
+	case string(common.WaitingList):
+		response.EstimatedEpochsUntilEligible = uint32(response.Index)/response.NodesToShufflePerShard + 1
+	}
+
+	return response, nil
+}
+
+// nodesToShufflePerShard returns the number of nodes shuffled out of each shard at the end of the provided epoch,
+// as configured by the last max nodes change config activated until that epoch
+func (vlp *validatorLifecycleProcessor) nodesToShufflePerShard(epoch uint32) uint32 {
+	nodesToShuffle := uint32(0)
+	lastEnableEpoch := uint32(0)
+	for _, maxNodesConfig := range vlp.maxNodesChangeEnableEpoch {
+		if maxNodesConfig.EpochEnable > epoch || maxNodesConfig.EpochEnable < lastEnableEpoch {
+			continue
+		}
+
+		lastEnableEpoch = maxNodesConfig.EpochEnable
+		nodesToShuffle = maxNodesConfig.NodesToShufflePerShard
+	}
+
+	return nodesToShuffle
+}
+
+func findKeyInShards(keysPerShard map[uint32][][]byte, blsKey []byte) (uint32, int, bool) {
+	for shardID, keys := range keysPerShard {
+		for index, key := range keys {
+			if bytes.Equal(key, blsKey) {
+				return shardID, index, true
+			}
+		}
+	}
+
+	return 0, 0, false
+}
+
+func (vlp *validatorLifecycleProcessor) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return vlp.publicKeyConverter.Encode(address)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vlp *validatorLifecycleProcessor) IsInterfaceNil() bool {
+	return vlp == nil
+}
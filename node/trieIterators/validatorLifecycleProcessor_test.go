@@ -0,0 +1,377 @@
+package trieIterators
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testBLSEligible = bytes.Repeat([]byte("e"), 8)
+	testBLSWaiting  = bytes.Repeat([]byte("w"), 8)
+	testBLSQueued1  = bytes.Repeat([]byte("1"), 8)
+	testBLSQueued2  = bytes.Repeat([]byte("2"), 8)
+	testBLSUnStaked = bytes.Repeat([]byte("u"), 8)
+	testBLSJailed   = bytes.Repeat([]byte("j"), 8)
+	testNodeOwner1  = bytes.Repeat([]byte("a"), testAddressLength)
+	testNodeOwner2  = bytes.Repeat([]byte("b"), testAddressLength)
+)
+
+func createStakingQueueKey(blsKey []byte) []byte {
+	return append([]byte("w_"), blsKey...)
+}
+
+func createStakingSCValues(t *testing.T, marshalizer *testscommon.MarshalizerMock) map[string][]byte {
+	values := make(map[string][]byte)
+	addValue := func(key []byte, obj interface{}) {
+		buff, err := marshalizer.Marshal(obj)
+		require.Nil(t, err)
+		values[string(key)] = buff
+	}
+	addStakedData := func(blsKey []byte, owner []byte, stakedData *systemSmartContracts.StakedDataV2_0) {
+		stakedData.OwnerAddress = owner
+		stakedData.RewardAddress = owner
+		stakedData.StakeValue = big.NewInt(2500)
+		stakedData.SlashValue = big.NewInt(0)
+		addValue(blsKey, stakedData)
+	}
+
+	addStakedData(testBLSEligible, testNodeOwner1, &systemSmartContracts.StakedDataV2_0{RegisterNonce: 1, StakedNonce: 1, Staked: true})
+	addStakedData(testBLSWaiting, testNodeOwner1, &systemSmartContracts.StakedDataV2_0{RegisterNonce: 2, StakedNonce: 2, Staked: true})
+	addStakedData(testBLSQueued1, testNodeOwner2, &systemSmartContracts.StakedDataV2_0{RegisterNonce: 3, Waiting: true})
+	addStakedData(testBLSQueued2, testNodeOwner1, &systemSmartContracts.StakedDataV2_0{RegisterNonce: 4, Waiting: true})
+	addStakedData(testBLSUnStaked, testNodeOwner1, &systemSmartContracts.StakedDataV2_0{RegisterNonce: 5, UnStakedNonce: 80, UnStakedEpoch: 16})
+	addStakedData(testBLSJailed, testNodeOwner2, &systemSmartContracts.StakedDataV2_0{RegisterNonce: 6, Staked: true, Jailed: true, NumJailed: 1})
+
+	addValue([]byte(stakingQueueHeadKey), &systemSmartContracts.WaitingList{
+		FirstKey: createStakingQueueKey(testBLSQueued1),
+		LastKey:  createStakingQueueKey(testBLSQueued2),
+		Length:   2,
+	})
+	addValue(createStakingQueueKey(testBLSQueued1), &systemSmartContracts.ElementInList{
+		BLSPublicKey: testBLSQueued1,
+		NextKey:      createStakingQueueKey(testBLSQueued2),
+	})
+	addValue(createStakingQueueKey(testBLSQueued2), &systemSmartContracts.ElementInList{
+		BLSPublicKey: testBLSQueued2,
+		PreviousKey:  createStakingQueueKey(testBLSQueued1),
+	})
+
+	return values
+}
+
+func createStakingSCAccount(values map[string][]byte) state.UserAccountHandler {
+	acc, _ := state.NewUserAccount(vm.StakingSCAddress)
+	acc.SetDataTrie(&trieMock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			value, found := values[string(key)]
+			if !found {
+				return nil, nil
+			}
+
+			value = append(value, key...)
+			return append(value, vm.StakingSCAddress...), nil
+		},
+	})
+
+	return acc
+}
+
+func createMockValidatorLifecycleArgs() ArgValidatorLifecycleProcessor {
+	return ArgValidatorLifecycleProcessor{
+		ArgTrieIteratorProcessor: createMockGovernanceArgs(),
+		ValidatorPubKeyConverter: mock.NewPubkeyConverterMock(8),
+		ValidatorsProvider:       &mock.ValidatorsProviderStub{},
+		NodesCoordinator:         &mock.NodesCoordinatorMock{},
+		UnBondPeriod:             50,
+		RoundsPerEpoch:           20,
+		MaxNodesChangeEnableEpoch: []config.MaxNodesChangeConfig{
+			{EpochEnable: 0, MaxNumNodes: 36, NodesToShufflePerShard: 4},
+			{EpochEnable: 10, MaxNumNodes: 56, NodesToShufflePerShard: 2},
+			{EpochEnable: 30, MaxNumNodes: 56, NodesToShufflePerShard: 1},
+		},
+	}
+}
+
+func createValidatorLifecycleProcessorWithState(t *testing.T) *validatorLifecycleProcessor {
+	arg := createMockValidatorLifecycleArgs()
+	values := createStakingSCValues(t, arg.Marshalizer.(*testscommon.MarshalizerMock))
+	arg.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Nonce: 100, Epoch: 20, RootHash: []byte("state root hash")}
+		},
+	}
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return createStakingSCAccount(values), nil
+		},
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+	}
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{big.NewInt(300).Bytes(), big.NewInt(5300).Bytes(), {}},
+			}, nil
+		},
+	}
+	arg.ValidatorsProvider = &mock.ValidatorsProviderStub{
+		GetLatestValidatorsCalled: func() map[string]*state.ValidatorApiResponse {
+			return map[string]*state.ValidatorApiResponse{
+				hex.EncodeToString(testBLSEligible): {ShardId: 1, ValidatorStatus: string(common.EligibleList), Rating: 70, TempRating: 72},
+				hex.EncodeToString(testBLSJailed):   {ShardId: 0, ValidatorStatus: string(common.JailedList), Rating: 10},
+			}
+		},
+	}
+	arg.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetAllEligibleValidatorsPublicKeysCalled: func() (map[uint32][][]byte, error) {
+			return map[uint32][][]byte{
+				0: {[]byte("x"), []byte("y")},
+				1: {[]byte("x"), testBLSEligible, []byte("y"), []byte("z")},
+			}, nil
+		},
+		GetAllWaitingValidatorsPublicKeysCalled: func() (map[uint32][][]byte, error) {
+			return map[uint32][][]byte{
+				0: {[]byte("x"), []byte("y"), testBLSWaiting},
+			}, nil
+		},
+	}
+
+	vlp, err := NewValidatorLifecycleProcessor(arg)
+	require.Nil(t, err)
+
+	return vlp
+}
+
+func TestNewValidatorLifecycleProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockValidatorLifecycleArgs()
+	arg.BlockChain = nil
+	vlp, err := NewValidatorLifecycleProcessor(arg)
+	assert.True(t, check.IfNil(vlp))
+	assert.Equal(t, ErrNilBlockChain, err)
+
+	arg = createMockValidatorLifecycleArgs()
+	arg.Marshalizer = nil
+	vlp, err = NewValidatorLifecycleProcessor(arg)
+	assert.True(t, check.IfNil(vlp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	arg = createMockValidatorLifecycleArgs()
+	arg.ValidatorPubKeyConverter = nil
+	vlp, err = NewValidatorLifecycleProcessor(arg)
+	assert.True(t, check.IfNil(vlp))
+	assert.True(t, errors.Is(err, ErrNilPubkeyConverter))
+
+	arg = createMockValidatorLifecycleArgs()
+	arg.ValidatorsProvider = nil
+	vlp, err = NewValidatorLifecycleProcessor(arg)
+	assert.True(t, check.IfNil(vlp))
+	assert.Equal(t, ErrNilValidatorsProvider, err)
+
+	arg = createMockValidatorLifecycleArgs()
+	arg.NodesCoordinator = nil
+	vlp, err = NewValidatorLifecycleProcessor(arg)
+	assert.True(t, check.IfNil(vlp))
+	assert.Equal(t, ErrNilNodesCoordinator, err)
+
+	vlp, err = NewValidatorLifecycleProcessor(createMockValidatorLifecycleArgs())
+	assert.False(t, check.IfNil(vlp))
+	assert.Nil(t, err)
+}
+
+func TestValidatorLifecycleProcessor_GetValidatorLifecycleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vlp, _ := NewValidatorLifecycleProcessor(createMockValidatorLifecycleArgs())
+
+	response, err := vlp.GetValidatorLifecycle("not hex")
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+
+	response, err = vlp.GetValidatorLifecycle(hex.EncodeToString(testBLSEligible))
+	assert.Nil(t, response)
+	assert.Equal(t, ErrNodeNotInitialized, err)
+
+	vlp = createValidatorLifecycleProcessorWithState(t)
+	response, err = vlp.GetValidatorLifecycle(hex.EncodeToString([]byte("missing!")))
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, ErrValidatorNotFound))
+}
+
+func TestValidatorLifecycleProcessor_GetValidatorLifecycleEligibleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter := mock.NewPubkeyConverterMock(testAddressLength)
+	vlp := createValidatorLifecycleProcessorWithState(t)
+
+	response, err := vlp.GetValidatorLifecycle(hex.EncodeToString(testBLSEligible))
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString(testBLSEligible), response.PublicKey)
+	assert.Equal(t, uint64(100), response.BlockNonce)
+	assert.Equal(t, uint32(20), response.CurrentEpoch)
+	assert.Equal(t, blsKeyStatusStaked, response.Staking.Status)
+	assert.Equal(t, "2500", response.Staking.StakeValue)
+	assert.Equal(t, converter.Encode(testNodeOwner1), response.Staking.RewardAddress)
+	assert.Equal(t, &common.ValidatorOwnerResponse{
+		Address:     converter.Encode(testNodeOwner1),
+		TotalStaked: "5300",
+		TopUp:       "300",
+	}, response.Owner)
+	assert.Nil(t, response.Queue)
+	assert.Nil(t, response.UnBond)
+	assert.Equal(t, &common.ValidatorPeerResponse{
+		ShardID:    1,
+		List:       string(common.EligibleList),
+		Rating:     70,
+		TempRating: 72,
+	}, response.Peer)
+	assert.Equal(t, &common.ValidatorShufflingResponse{
+		Epoch:                  20,
+		ShardID:                1,
+		List:                   string(common.EligibleList),
+		Index:                  1,
+		NumEligibleInShard:     4,
+		NumWaitingInShard:      0,
+		NodesToShufflePerShard: 2,
+		ShuffleOutProbability:  0.5,
+	}, response.Shuffling)
+}
+
+func TestValidatorLifecycleProcessor_GetValidatorLifecycleWaitingShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vlp := createValidatorLifecycleProcessorWithState(t)
+
+	response, err := vlp.GetValidatorLifecycle(hex.EncodeToString(testBLSWaiting))
+	require.Nil(t, err)
+
+	assert.Nil(t, response.Peer)
+	require.NotNil(t, response.Shuffling)
+	assert.Equal(t, string(common.WaitingList), response.Shuffling.List)
+	assert.Equal(t, uint32(0), response.Shuffling.ShardID)
+	assert.Equal(t, 2, response.Shuffling.Index)
+	assert.Equal(t, 3, response.Shuffling.NumWaitingInShard)
+	assert.Equal(t, uint32(2), response.Shuffling.EstimatedEpochsUntilEligible)
+}
+
+func TestValidatorLifecycleProcessor_GetValidatorLifecycleQueuedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vlp := createValidatorLifecycleProcessorWithState(t)
+
+	response, err := vlp.GetValidatorLifecycle(hex.EncodeToString(testBLSQueued2))
+	require.Nil(t, err)
+
+	assert.Equal(t, blsKeyStatusQueued, response.Staking.Status)
+	assert.Equal(t, &common.ValidatorQueuePositionResponse{Position: 2, Length: 2}, response.Queue)
+	assert.Nil(t, response.Shuffling)
+}
+
+func TestValidatorLifecycleProcessor_GetValidatorLifecycleUnStakedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vlp := createValidatorLifecycleProcessorWithState(t)
+
+	response, err := vlp.GetValidatorLifecycle(hex.EncodeToString(testBLSUnStaked))
+	require.Nil(t, err)
+
+	assert.Equal(t, blsKeyStatusUnStaked, response.Staking.Status)
+	assert.Equal(t, &common.ValidatorUnBondResponse{
+		UnBondPeriod:             50,
+		RemainingNonces:          30,
+		EstimatedRemainingEpochs: 2,
+		CanUnBond:                false,
+	}, response.UnBond)
+}
+
+func TestValidatorLifecycleProcessor_GetValidatorLifecycleJailedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vlp := createValidatorLifecycleProcessorWithState(t)
+
+	response, err := vlp.GetValidatorLifecycle(hex.EncodeToString(testBLSJailed))
+	require.Nil(t, err)
+
+	assert.Equal(t, blsKeyStatusJailed, response.Staking.Status)
+	assert.Equal(t, uint32(1), response.Staking.NumJailed)
+	assert.Equal(t, string(common.JailedList), response.Peer.List)
+}
+
+func TestValidatorLifecycleProcessor_GetStakingQueue(t *testing.T) {
+	t.Parallel()
+
+	converter := mock.NewPubkeyConverterMock(testAddressLength)
+	vlp := createValidatorLifecycleProcessorWithState(t)
+
+	response, err := vlp.GetStakingQueue(common.StakingQueueFilter{})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), response.Length)
+	assert.Equal(t, uint32(2), response.NumMatching)
+	assert.Equal(t, []*common.StakingQueueEntryResponse{
+		{
+			Position:      1,
+			BLSKey:        hex.EncodeToString(testBLSQueued1),
+			OwnerAddress:  converter.Encode(testNodeOwner2),
+			RewardAddress: converter.Encode(testNodeOwner2),
+			RegisterNonce: 3,
+		},
+		{
+			Position:      2,
+			BLSKey:        hex.EncodeToString(testBLSQueued2),
+			OwnerAddress:  converter.Encode(testNodeOwner1),
+			RewardAddress: converter.Encode(testNodeOwner1),
+			RegisterNonce: 4,
+		},
+	}, response.Entries)
+
+	response, err = vlp.GetStakingQueue(common.StakingQueueFilter{Owner: converter.Encode(testNodeOwner1)})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), response.NumMatching)
+	require.Equal(t, 1, len(response.Entries))
+	assert.Equal(t, uint32(2), response.Entries[0].Position)
+
+	response, err = vlp.GetStakingQueue(common.StakingQueueFilter{Offset: 1, Limit: 1})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), response.NumMatching)
+	require.Equal(t, 1, len(response.Entries))
+	assert.Equal(t, hex.EncodeToString(testBLSQueued2), response.Entries[0].BLSKey)
+
+	response, err = vlp.GetStakingQueue(common.StakingQueueFilter{Owner: "not hex"})
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+}
+
+func TestValidatorLifecycleProcessor_NodesToShufflePerShard(t *testing.T) {
+	t.Parallel()
+
+	vlp, _ := NewValidatorLifecycleProcessor(createMockValidatorLifecycleArgs())
+
+	assert.Equal(t, uint32(4), vlp.nodesToShufflePerShard(0))
+	assert.Equal(t, uint32(4), vlp.nodesToShufflePerShard(9))
+	assert.Equal(t, uint32(2), vlp.nodesToShufflePerShard(10))
+	assert.Equal(t, uint32(1), vlp.nodesToShufflePerShard(100))
+}
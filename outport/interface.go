@@ -3,6 +3,7 @@ package outport
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 )
 
 // Driver is an interface for saving node specific data to other storage.
@@ -19,6 +20,12 @@ type Driver interface {
 	IsInterfaceNil() bool
 }
 
+// SlashingEvidenceDriver is an optional interface that can be implemented by the drivers interested in the slashing
+// evidences detected by the node. Drivers not implementing it will not be notified
+type SlashingEvidenceDriver interface {
+	SaveSlashingEvidence(evidence *consensus.SlashingEvidence) error
+}
+
 // OutportHandler is interface that defines what a proxy implementation should be able to do
 // The node is able to talk only with this interface
 type OutportHandler interface {
@@ -29,6 +36,7 @@ type OutportHandler interface {
 	SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo)
 	SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler)
 	FinalizedBlock(headerHash []byte)
+	SaveSlashingEvidence(evidence *consensus.SlashingEvidence)
 	SubscribeDriver(driver Driver) error
 	HasDrivers() bool
 	Close() error
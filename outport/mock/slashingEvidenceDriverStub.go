@@ -0,0 +1,18 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
+// SlashingEvidenceDriverStub -
+type SlashingEvidenceDriverStub struct {
+	DriverStub
+	SaveSlashingEvidenceCalled func(evidence *consensus.SlashingEvidence) error
+}
+
+// SaveSlashingEvidence -
+func (s *SlashingEvidenceDriverStub) SaveSlashingEvidence(evidence *consensus.SlashingEvidence) error {
+	if s.SaveSlashingEvidenceCalled != nil {
+		return s.SaveSlashingEvidenceCalled(evidence)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/outport/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -255,6 +256,39 @@ func TestOutport_FinalizedBlock(t *testing.T) {
 	assert.Equal(t, 1, numCalled2)
 }
 
+func TestOutport_SaveSlashingEvidence(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	numCalled1 := 0
+	numCalled2 := 0
+	driver1 := &mock.SlashingEvidenceDriverStub{
+		SaveSlashingEvidenceCalled: func(evidence *consensus.SlashingEvidence) error {
+			numCalled1++
+			if numCalled1 < 10 {
+				return expectedError
+			}
+
+			return nil
+		},
+	}
+	driver2 := &mock.SlashingEvidenceDriverStub{
+		SaveSlashingEvidenceCalled: func(evidence *consensus.SlashingEvidence) error {
+			numCalled2++
+			return nil
+		},
+	}
+	outportHandler, _ := NewOutport(minimumRetrialInterval)
+	outportHandler.SaveSlashingEvidence(nil)
+	_ = outportHandler.SubscribeDriver(driver1)
+	_ = outportHandler.SubscribeDriver(&mock.DriverStub{})
+	_ = outportHandler.SubscribeDriver(driver2)
+
+	outportHandler.SaveSlashingEvidence(&consensus.SlashingEvidence{})
+	assert.Equal(t, 10, numCalled1)
+	assert.Equal(t, 1, numCalled2)
+}
+
 func TestOutport_SubscribeDriver(t *testing.T) {
 	t.Parallel()
 
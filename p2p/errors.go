@@ -152,3 +152,21 @@ var ErrMessageProcessorAlreadyDefined = errors.New("message processor already de
 
 // ErrMessageProcessorDoesNotExists signals that a message processor does not exist on the provided topic and identifier
 var ErrMessageProcessorDoesNotExists = errors.New("message processor does not exists")
+
+// ErrEmptyPeersList signals that an empty peers list has been provided
+var ErrEmptyPeersList = errors.New("empty peers list")
+
+// ErrEmptyDomainsList signals that an empty domains list has been provided
+var ErrEmptyDomainsList = errors.New("empty domains list")
+
+// ErrNilTXTResolver signals that a nil TXT records resolver has been provided
+var ErrNilTXTResolver = errors.New("nil TXT resolver")
+
+// ErrEmptyServiceTag signals that an empty service tag has been provided
+var ErrEmptyServiceTag = errors.New("empty service tag")
+
+// ErrNoPeerDiscoverers signals that no peer discoverer has been provided
+var ErrNoPeerDiscoverers = errors.New("no peer discoverers")
+
+// ErrNilPeerDiscoverer signals that a nil peer discoverer has been provided
+var ErrNilPeerDiscoverer = errors.New("nil peer discoverer")
@@ -0,0 +1,143 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/network"
+)
+
+const minReconnectInterval = time.Second
+
+type connectionAttempt struct {
+	nextAttempt time.Time
+	backoff     time.Duration
+}
+
+// backoffConnector keeps the host connected to a set of addresses. A failing address is retried after an interval
+// that doubles after each failure, from the minimum up to the maximum backoff. It is not concurrent safe, all calls
+// are done from the discovery loop go routine
+type backoffConnector struct {
+	host           ConnectableHost
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+	addresses      []string
+	attempts       map[string]*connectionAttempt
+	getTimeHandler func() time.Time
+}
+
+func newBackoffConnector(host ConnectableHost, minBackoff time.Duration, maxBackoff time.Duration) *backoffConnector {
+	return &backoffConnector{
+		host:           host,
+		minBackoff:     minBackoff,
+		maxBackoff:     maxBackoff,
+		addresses:      make([]string, 0),
+		attempts:       make(map[string]*connectionAttempt),
+		getTimeHandler: time.Now,
+	}
+}
+
+func checkBackoffIntervals(minBackoff time.Duration, maxBackoff time.Duration) error {
+	if minBackoff < minReconnectInterval {
+		return fmt.Errorf("%w, ReconnectInterval should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+	if maxBackoff < minBackoff {
+		return fmt.Errorf("%w, MaxReconnectInterval should have been at least ReconnectInterval", p2p.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// setAddresses replaces the addresses set, keeping the backoff state of the addresses already known
+func (bc *backoffConnector) setAddresses(addresses []string) {
+	attempts := make(map[string]*connectionAttempt, len(addresses))
+	uniqueAddresses := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		_, found := attempts[address]
+		if found {
+			continue
+		}
+
+		attempt, found := bc.attempts[address]
+		if !found {
+			attempt = &connectionAttempt{
+				backoff: bc.minBackoff,
+			}
+		}
+
+		attempts[address] = attempt
+		uniqueAddresses = append(uniqueAddresses, address)
+	}
+
+	bc.attempts = attempts
+	bc.addresses = uniqueAddresses
+}
+
+// resetBackoff makes all addresses eligible for an immediate connection attempt
+func (bc *backoffConnector) resetBackoff() {
+	for _, attempt := range bc.attempts {
+		attempt.nextAttempt = time.Time{}
+		attempt.backoff = bc.minBackoff
+	}
+}
+
+// connect tries to connect to all the addresses that are not connected and whose backoff interval elapsed.
+// Returns the number of addresses the host is connected to
+func (bc *backoffConnector) connect(ctx context.Context) int {
+	numConnected := 0
+	for _, address := range bc.addresses {
+		select {
+		case <-ctx.Done():
+			return numConnected
+		default:
+		}
+
+		err := bc.connectToAddress(ctx, address)
+		if err != nil {
+			log.Trace("backoffConnector.connect", "address", address, "error", err.Error())
+			continue
+		}
+
+		numConnected++
+	}
+
+	log.Debug("backoffConnector.connect", "num addresses", len(bc.addresses), "num connected", numConnected)
+
+	return numConnected
+}
+
+func (bc *backoffConnector) connectToAddress(ctx context.Context, address string) error {
+	pInfo, err := bc.host.AddressToPeerInfo(address)
+	if err != nil {
+		return err
+	}
+
+	attempt := bc.attempts[address]
+	if bc.host.Network().Connectedness(pInfo.ID) == network.Connected {
+		attempt.backoff = bc.minBackoff
+		return nil
+	}
+
+	now := bc.getTimeHandler()
+	if now.Before(attempt.nextAttempt) {
+		return fmt.Errorf("backoff interval not elapsed, next attempt at %v", attempt.nextAttempt)
+	}
+
+	err = bc.host.ConnectToPeer(ctx, address)
+	if err != nil {
+		attempt.nextAttempt = now.Add(attempt.backoff)
+		attempt.backoff *= 2
+		if attempt.backoff > bc.maxBackoff {
+			attempt.backoff = bc.maxBackoff
+		}
+
+		return err
+	}
+
+	attempt.backoff = bc.minBackoff
+	attempt.nextAttempt = time.Time{}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package discovery_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPeerAddress(t *testing.T, port int) (string, peer.ID) {
+	_, pubKey, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.Nil(t, err)
+	pid, err := peer.IDFromPublicKey(pubKey)
+	require.Nil(t, err)
+
+	return fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", port, pid.Pretty()), pid
+}
+
+func TestBackoffConnector_ConnectFailingAddressShouldBackoff(t *testing.T) {
+	t.Parallel()
+
+	address, _ := createTestPeerAddress(t, 10000)
+	numDials := 0
+	host := &mock.ConnectableHostStub{
+		ConnectToPeerCalled: func(ctx context.Context, address string) error {
+			numDials++
+			return errors.New("unreachable")
+		},
+	}
+
+	now := time.Unix(1000, 0)
+	bc := discovery.NewBackoffConnector(host, time.Second, 3*time.Second)
+	bc.SetTimeHandler(func() time.Time {
+		return now
+	})
+	bc.SetAddresses([]string{address})
+
+	assert.Equal(t, 0, bc.Connect(context.Background()))
+	assert.Equal(t, 1, numDials)
+
+	// next attempt after 1 second
+	now = now.Add(time.Millisecond * 999)
+	bc.Connect(context.Background())
+	assert.Equal(t, 1, numDials)
+	now = now.Add(time.Millisecond)
+	bc.Connect(context.Background())
+	assert.Equal(t, 2, numDials)
+
+	// next attempt after 2 seconds
+	now = now.Add(time.Second)
+	bc.Connect(context.Background())
+	assert.Equal(t, 2, numDials)
+	now = now.Add(time.Second)
+	bc.Connect(context.Background())
+	assert.Equal(t, 3, numDials)
+
+	// capped at 3 seconds
+	now = now.Add(3 * time.Second)
+	bc.Connect(context.Background())
+	assert.Equal(t, 4, numDials)
+	now = now.Add(3 * time.Second)
+	bc.Connect(context.Background())
+	assert.Equal(t, 5, numDials)
+
+	bc.ResetBackoff()
+	bc.Connect(context.Background())
+	assert.Equal(t, 6, numDials)
+}
+
+func TestBackoffConnector_ConnectShouldNotDialConnectedPeers(t *testing.T) {
+	t.Parallel()
+
+	connectedAddress, connectedPid := createTestPeerAddress(t, 10000)
+	notConnectedAddress, _ := createTestPeerAddress(t, 10001)
+	dialed := make([]string, 0)
+	host := &mock.ConnectableHostStub{
+		ConnectToPeerCalled: func(ctx context.Context, address string) error {
+			dialed = append(dialed, address)
+			return nil
+		},
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{
+				ConnectednessCalled: func(pid peer.ID) network.Connectedness {
+					if pid == connectedPid {
+						return network.Connected
+					}
+
+					return network.NotConnected
+				},
+			}
+		},
+	}
+
+	bc := discovery.NewBackoffConnector(host, time.Second, time.Second)
+	bc.SetAddresses([]string{connectedAddress, notConnectedAddress})
+
+	assert.Equal(t, 2, bc.Connect(context.Background()))
+	assert.Equal(t, []string{notConnectedAddress}, dialed)
+}
+
+func TestBackoffConnector_SetAddressesShouldRemoveDuplicatesAndKeepBackoff(t *testing.T) {
+	t.Parallel()
+
+	address1, _ := createTestPeerAddress(t, 10000)
+	address2, _ := createTestPeerAddress(t, 10001)
+	numDials := 0
+	host := &mock.ConnectableHostStub{
+		ConnectToPeerCalled: func(ctx context.Context, address string) error {
+			numDials++
+			return errors.New("unreachable")
+		},
+	}
+
+	bc := discovery.NewBackoffConnector(host, time.Hour, time.Hour)
+	bc.SetAddresses([]string{address1, address1})
+	assert.Equal(t, []string{address1}, bc.Addresses())
+
+	bc.Connect(context.Background())
+	assert.Equal(t, 1, numDials)
+
+	bc.SetAddresses([]string{address2, address1})
+	assert.Equal(t, []string{address2, address1}, bc.Addresses())
+
+	// only the new address is dialed, the old one is still in backoff
+	bc.Connect(context.Background())
+	assert.Equal(t, 2, numDials)
+}
@@ -0,0 +1,105 @@
+package discovery
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// discoveryLoop carries, on a single go routine, the bootstrap, the periodic processing and the reconnect requests
+// of a peer discoverer
+type discoveryLoop struct {
+	name             string
+	interval         time.Duration
+	status           discovererStatus
+	chanInit         chan struct{}
+	errChanInit      chan error
+	chanReconnect    chan struct{}
+	initHandler      func(ctx context.Context) error
+	processHandler   func(ctx context.Context)
+	reconnectHandler func(ctx context.Context)
+	closeHandler     func()
+}
+
+func newDiscoveryLoop(name string, interval time.Duration) *discoveryLoop {
+	return &discoveryLoop{
+		name:             name,
+		interval:         interval,
+		status:           statNotInitialized,
+		chanInit:         make(chan struct{}),
+		errChanInit:      make(chan error),
+		chanReconnect:    make(chan struct{}),
+		initHandler:      func(_ context.Context) error { return nil },
+		processHandler:   func(_ context.Context) {},
+		reconnectHandler: func(_ context.Context) {},
+		closeHandler:     func() {},
+	}
+}
+
+func (dl *discoveryLoop) processLoop(ctx context.Context) {
+	chTimeProcess := time.After(dl.interval)
+
+	for {
+		select {
+		case <-dl.chanInit:
+			dl.processInit(ctx)
+
+		case <-chTimeProcess:
+			if dl.status == statInitialized {
+				dl.processHandler(ctx)
+			}
+			chTimeProcess = time.After(dl.interval)
+
+		case <-dl.chanReconnect:
+			if dl.status == statInitialized {
+				dl.reconnectHandler(ctx)
+			}
+
+		case <-ctx.Done():
+			log.Debug("closing the peer discovery process", "discoverer", dl.name)
+
+			dl.finishMainLoopProcessing(ctx)
+			return
+		}
+	}
+}
+
+func (dl *discoveryLoop) processInit(ctx context.Context) {
+	if dl.status != statNotInitialized {
+		dl.errChanInit <- p2p.ErrPeerDiscoveryProcessAlreadyStarted
+		return
+	}
+
+	err := dl.initHandler(ctx)
+	dl.errChanInit <- err
+	if err != nil {
+		return
+	}
+
+	dl.status = statInitialized
+	dl.processHandler(ctx)
+}
+
+func (dl *discoveryLoop) finishMainLoopProcessing(ctx context.Context) {
+	select {
+	case dl.errChanInit <- ctx.Err():
+	default:
+	}
+
+	if dl.status == statInitialized {
+		dl.closeHandler()
+	}
+}
+
+func (dl *discoveryLoop) bootstrap() error {
+	dl.chanInit <- struct{}{}
+	return <-dl.errChanInit
+}
+
+func (dl *discoveryLoop) reconnect() {
+	select {
+	case dl.chanReconnect <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,167 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var _ p2p.PeerDiscoverer = (*dnsSeedsDiscoverer)(nil)
+var _ p2p.Reconnecter = (*dnsSeedsDiscoverer)(nil)
+
+const dnsSeedsName = "dns seeds discovery"
+const dnsAddrDomainPrefix = "_dnsaddr."
+const dnsAddrRecordPrefix = "dnsaddr="
+
+// ArgDNSSeeds represents the DNS seeds discoverer argument DTO
+type ArgDNSSeeds struct {
+	Context              context.Context
+	Host                 ConnectableHost
+	Resolver             TXTResolver
+	Domains              []string
+	ResolveInterval      time.Duration
+	ReconnectInterval    time.Duration
+	MaxReconnectInterval time.Duration
+}
+
+type dnsSeedsDiscoverer struct {
+	loop            *discoveryLoop
+	connector       *backoffConnector
+	host            ConnectableHost
+	resolver        TXTResolver
+	domains         []string
+	resolveInterval time.Duration
+	lastResolve     time.Time
+}
+
+// NewDNSSeedsDiscoverer creates a peer discoverer that fetches the seed addresses from the _dnsaddr TXT records of
+// the provided domains and keeps the host connected to them. The seeds that can not be reached are retried with an
+// exponential backoff and the DNS records are queried again each resolve interval
+func NewDNSSeedsDiscoverer(arg ArgDNSSeeds) (*dnsSeedsDiscoverer, error) {
+	err := checkDNSSeedsArgument(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	dsd := &dnsSeedsDiscoverer{
+		loop:            newDiscoveryLoop(dnsSeedsName, arg.ReconnectInterval),
+		connector:       newBackoffConnector(arg.Host, arg.ReconnectInterval, arg.MaxReconnectInterval),
+		host:            arg.Host,
+		resolver:        arg.Resolver,
+		domains:         arg.Domains,
+		resolveInterval: arg.ResolveInterval,
+	}
+	dsd.loop.processHandler = dsd.connectToSeeds
+	dsd.loop.reconnectHandler = dsd.reconnectToSeeds
+
+	go dsd.loop.processLoop(arg.Context)
+
+	return dsd, nil
+}
+
+func checkDNSSeedsArgument(arg ArgDNSSeeds) error {
+	if check.IfNilReflect(arg.Context) {
+		return p2p.ErrNilContext
+	}
+	if check.IfNilReflect(arg.Host) {
+		return p2p.ErrNilHost
+	}
+	if check.IfNilReflect(arg.Resolver) {
+		return p2p.ErrNilTXTResolver
+	}
+	if len(arg.Domains) == 0 {
+		return p2p.ErrEmptyDomainsList
+	}
+	if arg.ResolveInterval < time.Second {
+		return fmt.Errorf("%w, ResolveInterval should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+
+	return checkBackoffIntervals(arg.ReconnectInterval, arg.MaxReconnectInterval)
+}
+
+func (dsd *dnsSeedsDiscoverer) connectToSeeds(ctx context.Context) {
+	if time.Since(dsd.lastResolve) >= dsd.resolveInterval {
+		dsd.resolveSeeds(ctx)
+	}
+
+	dsd.connector.connect(ctx)
+}
+
+func (dsd *dnsSeedsDiscoverer) reconnectToSeeds(ctx context.Context) {
+	dsd.resolveSeeds(ctx)
+	dsd.connector.resetBackoff()
+	dsd.connector.connect(ctx)
+}
+
+// resolveSeeds replaces the seeds with the ones found in the DNS records. If no domain could be resolved, the
+// previously known seeds are kept
+func (dsd *dnsSeedsDiscoverer) resolveSeeds(ctx context.Context) {
+	dsd.lastResolve = time.Now()
+
+	seeds := make([]string, 0)
+	numResolved := 0
+	for _, domain := range dsd.domains {
+		records, err := dsd.resolver.LookupTXT(ctx, dnsAddrDomainPrefix+domain)
+		if err != nil {
+			log.Debug("dnsSeedsDiscoverer.resolveSeeds", "domain", domain, "error", err.Error())
+			continue
+		}
+
+		numResolved++
+		seeds = append(seeds, extractPeerAddresses(dsd.host, records)...)
+	}
+
+	log.Debug("dnsSeedsDiscoverer.resolveSeeds", "num domains", len(dsd.domains),
+		"num resolved", numResolved, "num seeds", len(seeds))
+
+	if numResolved == 0 {
+		return
+	}
+
+	dsd.connector.setAddresses(seeds)
+}
+
+// extractPeerAddresses returns the valid peer addresses from the TXT records in the form dnsaddr=<address>
+func extractPeerAddresses(host ConnectableHost, records []string) []string {
+	addresses := make([]string, 0, len(records))
+	for _, record := range records {
+		if !strings.HasPrefix(record, dnsAddrRecordPrefix) {
+			continue
+		}
+
+		address := strings.TrimPrefix(record, dnsAddrRecordPrefix)
+		_, err := host.AddressToPeerInfo(address)
+		if err != nil {
+			log.Trace("extractPeerAddresses: invalid address", "address", address, "error", err.Error())
+			continue
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
+
+// Bootstrap will start the seeds resolving and connecting process
+func (dsd *dnsSeedsDiscoverer) Bootstrap() error {
+	return dsd.loop.bootstrap()
+}
+
+// Name returns the name of the DNS seeds discovery implementation
+func (dsd *dnsSeedsDiscoverer) Name() string {
+	return dnsSeedsName
+}
+
+// ReconnectToNetwork will resolve the seeds again and will try to connect immediately to all of them
+func (dsd *dnsSeedsDiscoverer) ReconnectToNetwork(_ context.Context) {
+	dsd.loop.reconnect()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsd *dnsSeedsDiscoverer) IsInterfaceNil() bool {
+	return dsd == nil
+}
@@ -0,0 +1,155 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestDNSSeedsArgument() discovery.ArgDNSSeeds {
+	return discovery.ArgDNSSeeds{
+		Context:              context.Background(),
+		Host:                 &mock.ConnectableHostStub{},
+		Resolver:             &mock.TXTResolverStub{},
+		Domains:              []string{"seeds.example.com"},
+		ResolveInterval:      time.Minute,
+		ReconnectInterval:    time.Second,
+		MaxReconnectInterval: time.Minute,
+	}
+}
+
+func TestNewDNSSeedsDiscoverer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createTestDNSSeedsArgument()
+	arg.Context = nil
+	dsd, err := discovery.NewDNSSeedsDiscoverer(arg)
+	assert.Equal(t, p2p.ErrNilContext, err)
+	assert.True(t, check.IfNil(dsd))
+
+	arg = createTestDNSSeedsArgument()
+	arg.Host = nil
+	dsd, err = discovery.NewDNSSeedsDiscoverer(arg)
+	assert.Equal(t, p2p.ErrNilHost, err)
+	assert.True(t, check.IfNil(dsd))
+
+	arg = createTestDNSSeedsArgument()
+	arg.Resolver = nil
+	dsd, err = discovery.NewDNSSeedsDiscoverer(arg)
+	assert.Equal(t, p2p.ErrNilTXTResolver, err)
+	assert.True(t, check.IfNil(dsd))
+
+	arg = createTestDNSSeedsArgument()
+	arg.Domains = nil
+	dsd, err = discovery.NewDNSSeedsDiscoverer(arg)
+	assert.Equal(t, p2p.ErrEmptyDomainsList, err)
+	assert.True(t, check.IfNil(dsd))
+
+	arg = createTestDNSSeedsArgument()
+	arg.ResolveInterval = time.Millisecond
+	dsd, err = discovery.NewDNSSeedsDiscoverer(arg)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+	assert.True(t, check.IfNil(dsd))
+
+	arg = createTestDNSSeedsArgument()
+	arg.ReconnectInterval = 0
+	dsd, err = discovery.NewDNSSeedsDiscoverer(arg)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+	assert.True(t, check.IfNil(dsd))
+}
+
+func TestNewDNSSeedsDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dsd, err := discovery.NewDNSSeedsDiscoverer(createTestDNSSeedsArgument())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(dsd))
+	assert.Equal(t, discovery.DNSSeedsName, dsd.Name())
+}
+
+func TestDNSSeedsDiscoverer_BootstrapShouldConnectToTheValidSeeds(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	seed1, _ := createTestPeerAddress(t, 10000)
+	seed2, _ := createTestPeerAddress(t, 10001)
+	chDials := make(chan string, 10)
+	chQueries := make(chan string, 10)
+	arg := createTestDNSSeedsArgument()
+	arg.Context = ctx
+	arg.Domains = []string{"seeds1.example.com", "seeds2.example.com"}
+	arg.Resolver = &mock.TXTResolverStub{
+		LookupTXTCalled: func(ctx context.Context, name string) ([]string, error) {
+			chQueries <- name
+			if name == "_dnsaddr.seeds2.example.com" {
+				return nil, errors.New("no such host")
+			}
+
+			return []string{"dnsaddr=" + seed1, "v=spf1 -all", "dnsaddr=invalid address", "dnsaddr=" + seed2}, nil
+		},
+	}
+	arg.Host = &mock.ConnectableHostStub{
+		ConnectToPeerCalled: func(ctx context.Context, address string) error {
+			chDials <- address
+			return nil
+		},
+	}
+	dsd, _ := discovery.NewDNSSeedsDiscoverer(arg)
+
+	err := dsd.Bootstrap()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"_dnsaddr.seeds1.example.com", "_dnsaddr.seeds2.example.com"}, readFromChannel(t, chQueries, 2))
+	assert.Equal(t, []string{seed1, seed2}, readFromChannel(t, chDials, 2))
+}
+
+func TestDNSSeedsDiscoverer_FailedResolveShouldKeepTheKnownSeeds(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	seed, _ := createTestPeerAddress(t, 10000)
+	numLookups := 0
+	chDials := make(chan string, 10)
+	arg := createTestDNSSeedsArgument()
+	arg.Context = ctx
+	arg.ReconnectInterval = time.Hour
+	arg.MaxReconnectInterval = time.Hour
+	arg.Resolver = &mock.TXTResolverStub{
+		LookupTXTCalled: func(ctx context.Context, name string) ([]string, error) {
+			numLookups++
+			if numLookups > 1 {
+				return nil, errors.New("dns server unreachable")
+			}
+
+			return []string{"dnsaddr=" + seed}, nil
+		},
+	}
+	arg.Host = &mock.ConnectableHostStub{
+		ConnectToPeerCalled: func(ctx context.Context, address string) error {
+			chDials <- address
+			return errors.New("unreachable")
+		},
+	}
+	dsd, _ := discovery.NewDNSSeedsDiscoverer(arg)
+
+	_ = dsd.Bootstrap()
+	assert.Equal(t, seed, <-chDials)
+
+	dsd.ReconnectToNetwork(ctx)
+	select {
+	case address := <-chDials:
+		assert.Equal(t, seed, address)
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "reconnect should have dialed the previously resolved seed")
+	}
+}
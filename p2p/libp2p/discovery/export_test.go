@@ -66,3 +66,57 @@ func NewOptimizedKadDhtDiscovererWithInitFunc(
 
 	return okdd, nil
 }
+
+//------- backoffConnector
+
+const StaticPeersName = staticPeersName
+const DNSSeedsName = dnsSeedsName
+const MdnsName = mdnsName
+
+// NewBackoffConnector -
+func NewBackoffConnector(host ConnectableHost, minBackoff time.Duration, maxBackoff time.Duration) *backoffConnector {
+	return newBackoffConnector(host, minBackoff, maxBackoff)
+}
+
+// SetAddresses -
+func (bc *backoffConnector) SetAddresses(addresses []string) {
+	bc.setAddresses(addresses)
+}
+
+// ResetBackoff -
+func (bc *backoffConnector) ResetBackoff() {
+	bc.resetBackoff()
+}
+
+// Connect -
+func (bc *backoffConnector) Connect(ctx context.Context) int {
+	return bc.connect(ctx)
+}
+
+// SetTimeHandler -
+func (bc *backoffConnector) SetTimeHandler(handler func() time.Time) {
+	bc.getTimeHandler = handler
+}
+
+// Addresses -
+func (bc *backoffConnector) Addresses() []string {
+	return bc.addresses
+}
+
+//------- mdnsDiscoverer
+
+// NewMdnsDiscovererWithService -
+func NewMdnsDiscovererWithService(arg ArgMdns, service MdnsService) (*mdnsDiscoverer, error) {
+	md, err := createMdnsDiscoverer(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	md.createService = func(_ context.Context) (MdnsService, error) {
+		return service, nil
+	}
+
+	go md.loop.processLoop(arg.Context)
+
+	return md, nil
+}
@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 	"fmt"
+	"net"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -25,12 +26,101 @@ func NewPeerDiscoverer(
 	sharder p2p.Sharder,
 	p2pConfig config.P2PConfig,
 ) (p2p.PeerDiscoverer, error) {
+	discoverers := make([]p2p.PeerDiscoverer, 0)
 	if p2pConfig.KadDhtPeerDiscovery.Enabled {
-		return createKadDhtPeerDiscoverer(context, host, sharder, p2pConfig)
+		kadDhtDiscoverer, err := createKadDhtPeerDiscoverer(context, host, sharder, p2pConfig)
+		if err != nil {
+			return nil, err
+		}
+		discoverers = append(discoverers, kadDhtDiscoverer)
+	}
+	if p2pConfig.StaticPeerDiscovery.Enabled {
+		staticPeersDiscoverer, err := createStaticPeersDiscoverer(context, host, p2pConfig.StaticPeerDiscovery)
+		if err != nil {
+			return nil, err
+		}
+		discoverers = append(discoverers, staticPeersDiscoverer)
+	}
+	if p2pConfig.DNSSeedPeerDiscovery.Enabled {
+		dnsSeedsDiscoverer, err := createDNSSeedsDiscoverer(context, host, p2pConfig.DNSSeedPeerDiscovery)
+		if err != nil {
+			return nil, err
+		}
+		discoverers = append(discoverers, dnsSeedsDiscoverer)
+	}
+	if p2pConfig.MdnsPeerDiscovery.Enabled {
+		mdnsDiscoverer, err := createMdnsDiscoverer(context, host, p2pConfig.MdnsPeerDiscovery)
+		if err != nil {
+			return nil, err
+		}
+		discoverers = append(discoverers, mdnsDiscoverer)
+	}
+
+	switch len(discoverers) {
+	case 0:
+		log.Debug("using nil discoverer")
+		return discovery.NewNilDiscoverer(), nil
+	case 1:
+		return discoverers[0], nil
+	default:
+		log.Debug("using multiple discoverers", "num", len(discoverers))
+		return discovery.NewMultipleDiscoverers(discoverers...)
+	}
+}
+
+func createStaticPeersDiscoverer(
+	context context.Context,
+	host discovery.ConnectableHost,
+	cfg config.StaticPeerDiscoveryConfig,
+) (p2p.PeerDiscoverer, error) {
+	log.Debug("using static peers discoverer", "num peers", len(cfg.PeersList))
+
+	arg := discovery.ArgStaticPeers{
+		Context:              context,
+		Host:                 host,
+		PeersList:            cfg.PeersList,
+		ReconnectInterval:    time.Second * time.Duration(cfg.ReconnectIntervalInSec),
+		MaxReconnectInterval: time.Second * time.Duration(cfg.MaxReconnectIntervalInSec),
+	}
+
+	return discovery.NewStaticPeersDiscoverer(arg)
+}
+
+func createDNSSeedsDiscoverer(
+	context context.Context,
+	host discovery.ConnectableHost,
+	cfg config.DNSSeedPeerDiscoveryConfig,
+) (p2p.PeerDiscoverer, error) {
+	log.Debug("using dns seeds discoverer", "domains", cfg.Domains)
+
+	arg := discovery.ArgDNSSeeds{
+		Context:              context,
+		Host:                 host,
+		Resolver:             net.DefaultResolver,
+		Domains:              cfg.Domains,
+		ResolveInterval:      time.Second * time.Duration(cfg.ResolveIntervalInSec),
+		ReconnectInterval:    time.Second * time.Duration(cfg.ReconnectIntervalInSec),
+		MaxReconnectInterval: time.Second * time.Duration(cfg.MaxReconnectIntervalInSec),
+	}
+
+	return discovery.NewDNSSeedsDiscoverer(arg)
+}
+
+func createMdnsDiscoverer(
+	context context.Context,
+	host discovery.ConnectableHost,
+	cfg config.MdnsPeerDiscoveryConfig,
+) (p2p.PeerDiscoverer, error) {
+	log.Debug("using mdns discoverer", "service tag", cfg.ServiceTag)
+
+	arg := discovery.ArgMdns{
+		Context:       context,
+		Host:          host,
+		ServiceTag:    cfg.ServiceTag,
+		QueryInterval: time.Second * time.Duration(cfg.QueryIntervalInSec),
 	}
 
-	log.Debug("using nil discoverer")
-	return discovery.NewNilDiscoverer(), nil
+	return discovery.NewMdnsDiscoverer(arg)
 }
 
 func createKadDhtPeerDiscoverer(
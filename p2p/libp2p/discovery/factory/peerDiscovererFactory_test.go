@@ -139,3 +139,95 @@ func TestNewPeerDiscoverer_UnknownKadDhtShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
 	assert.True(t, check.IfNil(pDiscoverer))
 }
+
+func TestNewPeerDiscoverer_StaticPeersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		StaticPeerDiscovery: config.StaticPeerDiscoveryConfig{
+			Enabled:                   true,
+			PeersList:                 []string{"/ip4/127.0.0.1/tcp/9999/p2p/16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"},
+			ReconnectIntervalInSec:    1,
+			MaxReconnectIntervalInSec: 10,
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "static peers discovery", pDiscoverer.Name())
+}
+
+func TestNewPeerDiscoverer_InvalidStaticPeersConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled:                          true,
+			RefreshIntervalInSec:             1,
+			RoutingTableRefreshIntervalInSec: 300,
+			Type:                             "optimized",
+		},
+		StaticPeerDiscovery: config.StaticPeerDiscoveryConfig{
+			Enabled: true,
+		},
+		Sharding: config.ShardingConfig{
+			Type: p2p.ListsSharder,
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.KadSharderStub{},
+		p2pConfig,
+	)
+
+	assert.Equal(t, p2p.ErrEmptyPeersList, err)
+	assert.True(t, check.IfNil(pDiscoverer))
+}
+
+func TestNewPeerDiscoverer_MoreDiscoveriesEnabledShouldCombineThem(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled:                          true,
+			RefreshIntervalInSec:             1,
+			RoutingTableRefreshIntervalInSec: 300,
+			Type:                             "optimized",
+		},
+		DNSSeedPeerDiscovery: config.DNSSeedPeerDiscoveryConfig{
+			Enabled:                   true,
+			Domains:                   []string{"seeds.example.com"},
+			ResolveIntervalInSec:      600,
+			ReconnectIntervalInSec:    1,
+			MaxReconnectIntervalInSec: 10,
+		},
+		MdnsPeerDiscovery: config.MdnsPeerDiscoveryConfig{
+			Enabled:            true,
+			ServiceTag:         "test",
+			QueryIntervalInSec: 10,
+		},
+		Sharding: config.ShardingConfig{
+			Type: p2p.ListsSharder,
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.KadSharderStub{},
+		p2pConfig,
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "optimized kad-dht discovery, dns seeds discovery, mdns discovery", pDiscoverer.Name())
+	_, ok := pDiscoverer.(p2p.Reconnecter)
+	assert.True(t, ok)
+}
@@ -29,3 +29,8 @@ type Sharder interface {
 type KadDhtHandler interface {
 	Bootstrap(ctx context.Context) error
 }
+
+// TXTResolver defines the behavior of a component able to fetch the TXT records of a domain name
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
@@ -0,0 +1,146 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2pDiscovery "github.com/libp2p/go-libp2p/p2p/discovery"
+)
+
+var _ p2p.PeerDiscoverer = (*mdnsDiscoverer)(nil)
+var _ p2p.Reconnecter = (*mdnsDiscoverer)(nil)
+
+const mdnsName = "mdns discovery"
+
+// MdnsService defines the subset of the libp2p mdns service used by the mdns discoverer
+type MdnsService interface {
+	RegisterNotifee(notifee libp2pDiscovery.Notifee)
+	Close() error
+}
+
+// ArgMdns represents the mdns discoverer argument DTO
+type ArgMdns struct {
+	Context       context.Context
+	Host          ConnectableHost
+	ServiceTag    string
+	QueryInterval time.Duration
+}
+
+// mdnsDiscoverer finds the peers from the local area network through the libp2p multicast DNS service, advertised
+// under the _<service tag>._udp service name. The libp2p service queries the local area network each query interval
+// and the discoverer connects to each found peer
+type mdnsDiscoverer struct {
+	loop          *discoveryLoop
+	host          ConnectableHost
+	ctx           context.Context
+	service       MdnsService
+	createService func(ctx context.Context) (MdnsService, error)
+}
+
+// NewMdnsDiscoverer creates a peer discoverer that finds the peers from the local area network.
+// Should only be used in private networks
+func NewMdnsDiscoverer(arg ArgMdns) (*mdnsDiscoverer, error) {
+	md, err := createMdnsDiscoverer(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := fmt.Sprintf("_%s._udp", arg.ServiceTag)
+	md.createService = func(ctx context.Context) (MdnsService, error) {
+		return libp2pDiscovery.NewMdnsService(ctx, arg.Host, arg.QueryInterval, serviceName)
+	}
+
+	go md.loop.processLoop(arg.Context)
+
+	return md, nil
+}
+
+func createMdnsDiscoverer(arg ArgMdns) (*mdnsDiscoverer, error) {
+	if check.IfNilReflect(arg.Context) {
+		return nil, p2p.ErrNilContext
+	}
+	if check.IfNilReflect(arg.Host) {
+		return nil, p2p.ErrNilHost
+	}
+	if len(arg.ServiceTag) == 0 {
+		return nil, p2p.ErrEmptyServiceTag
+	}
+	if strings.ContainsAny(arg.ServiceTag, ". ") {
+		return nil, fmt.Errorf("%w, ServiceTag %s can not be used as a domain name label", p2p.ErrInvalidValue, arg.ServiceTag)
+	}
+	if arg.QueryInterval < time.Second {
+		return nil, fmt.Errorf("%w, QueryInterval should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+
+	md := &mdnsDiscoverer{
+		loop: newDiscoveryLoop(mdnsName, arg.QueryInterval),
+		host: arg.Host,
+	}
+	md.loop.initHandler = md.init
+	md.loop.closeHandler = md.close
+
+	return md, nil
+}
+
+func (md *mdnsDiscoverer) init(ctx context.Context) error {
+	service, err := md.createService(ctx)
+	if err != nil {
+		return err
+	}
+
+	md.ctx = ctx
+	md.service = service
+	md.service.RegisterNotifee(md)
+
+	return nil
+}
+
+func (md *mdnsDiscoverer) close() {
+	err := md.service.Close()
+	if err != nil {
+		log.Debug("mdnsDiscoverer.close", "error", err.Error())
+	}
+}
+
+// HandlePeerFound is called by the libp2p mdns service for each peer found on the local area network
+func (md *mdnsDiscoverer) HandlePeerFound(pInfo peer.AddrInfo) {
+	if pInfo.ID == md.host.ID() {
+		return
+	}
+	if md.host.Network().Connectedness(pInfo.ID) == network.Connected {
+		return
+	}
+
+	err := md.host.Connect(md.ctx, pInfo)
+	if err != nil {
+		log.Trace("mdnsDiscoverer.HandlePeerFound", "peer", pInfo.ID.Pretty(), "error", err.Error())
+		return
+	}
+
+	log.Debug("mdnsDiscoverer: connected to a LAN peer", "peer", pInfo.ID.Pretty())
+}
+
+// Bootstrap will start the local area network discovery process
+func (md *mdnsDiscoverer) Bootstrap() error {
+	return md.loop.bootstrap()
+}
+
+// Name returns the name of the mdns discovery implementation
+func (md *mdnsDiscoverer) Name() string {
+	return mdnsName
+}
+
+// ReconnectToNetwork does nothing as the libp2p mdns service queries the local area network on each query interval
+func (md *mdnsDiscoverer) ReconnectToNetwork(_ context.Context) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (md *mdnsDiscoverer) IsInterfaceNil() bool {
+	return md == nil
+}
@@ -0,0 +1,175 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2pDiscovery "github.com/libp2p/go-libp2p/p2p/discovery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mdnsServiceStub struct {
+	mutNotifees sync.Mutex
+	notifees    []libp2pDiscovery.Notifee
+	chClosed    chan struct{}
+}
+
+func newMdnsServiceStub() *mdnsServiceStub {
+	return &mdnsServiceStub{
+		chClosed: make(chan struct{}),
+	}
+}
+
+func (mss *mdnsServiceStub) RegisterNotifee(notifee libp2pDiscovery.Notifee) {
+	mss.mutNotifees.Lock()
+	mss.notifees = append(mss.notifees, notifee)
+	mss.mutNotifees.Unlock()
+}
+
+func (mss *mdnsServiceStub) Close() error {
+	close(mss.chClosed)
+	return nil
+}
+
+func (mss *mdnsServiceStub) peerFound(pInfo peer.AddrInfo) {
+	mss.mutNotifees.Lock()
+	defer mss.mutNotifees.Unlock()
+
+	for _, notifee := range mss.notifees {
+		notifee.HandlePeerFound(pInfo)
+	}
+}
+
+func createTestMdnsArgument() discovery.ArgMdns {
+	return discovery.ArgMdns{
+		Context:       context.Background(),
+		Host:          &mock.ConnectableHostStub{},
+		ServiceTag:    "test-network",
+		QueryInterval: time.Second,
+	}
+}
+
+func TestNewMdnsDiscoverer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createTestMdnsArgument()
+	arg.Context = nil
+	md, err := discovery.NewMdnsDiscoverer(arg)
+	assert.Equal(t, p2p.ErrNilContext, err)
+	assert.True(t, check.IfNil(md))
+
+	arg = createTestMdnsArgument()
+	arg.Host = nil
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.Equal(t, p2p.ErrNilHost, err)
+	assert.True(t, check.IfNil(md))
+
+	arg = createTestMdnsArgument()
+	arg.ServiceTag = ""
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.Equal(t, p2p.ErrEmptyServiceTag, err)
+	assert.True(t, check.IfNil(md))
+
+	arg = createTestMdnsArgument()
+	arg.ServiceTag = "a.b"
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+	assert.True(t, check.IfNil(md))
+
+	arg = createTestMdnsArgument()
+	arg.QueryInterval = time.Millisecond
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+	assert.True(t, check.IfNil(md))
+}
+
+func TestNewMdnsDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	md, err := discovery.NewMdnsDiscoverer(createTestMdnsArgument())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(md))
+	assert.Equal(t, discovery.MdnsName, md.Name())
+}
+
+func TestMdnsDiscoverer_ShouldConnectToTheFoundPeers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, selfPid := createTestPeerAddress(t, 10001)
+	_, connectedPid := createTestPeerAddress(t, 10002)
+	_, newPid := createTestPeerAddress(t, 10003)
+	chConnects := make(chan peer.ID, 10)
+	host := &mock.ConnectableHostStub{
+		IDCalled: func() peer.ID {
+			return selfPid
+		},
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{
+				ConnectednessCalled: func(pid peer.ID) network.Connectedness {
+					if pid == connectedPid {
+						return network.Connected
+					}
+					return network.NotConnected
+				},
+			}
+		},
+		ConnectCalled: func(_ context.Context, pInfo peer.AddrInfo) error {
+			chConnects <- pInfo.ID
+			return nil
+		},
+	}
+
+	arg := createTestMdnsArgument()
+	arg.Context = ctx
+	arg.Host = host
+	service := newMdnsServiceStub()
+	md, _ := discovery.NewMdnsDiscovererWithService(arg, service)
+	require.Nil(t, md.Bootstrap())
+
+	service.peerFound(peer.AddrInfo{ID: selfPid})
+	service.peerFound(peer.AddrInfo{ID: connectedPid})
+	service.peerFound(peer.AddrInfo{ID: newPid})
+
+	select {
+	case pid := <-chConnects:
+		assert.Equal(t, newPid, pid)
+	case <-time.After(time.Second):
+		require.Fail(t, "the found peer was not connected")
+	}
+	assert.Equal(t, 0, len(chConnects))
+
+	err := md.Bootstrap()
+	assert.Equal(t, p2p.ErrPeerDiscoveryProcessAlreadyStarted, err)
+}
+
+func TestMdnsDiscoverer_ShouldCloseTheServiceWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	arg := createTestMdnsArgument()
+	arg.Context = ctx
+	service := newMdnsServiceStub()
+	md, _ := discovery.NewMdnsDiscovererWithService(arg, service)
+	require.Nil(t, md.Bootstrap())
+
+	cancel()
+
+	select {
+	case <-service.chClosed:
+	case <-time.After(time.Second):
+		require.Fail(t, "the mdns service was not closed")
+	}
+}
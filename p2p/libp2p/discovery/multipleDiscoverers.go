@@ -0,0 +1,73 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var _ p2p.PeerDiscoverer = (*multipleDiscoverers)(nil)
+var _ p2p.Reconnecter = (*multipleDiscoverers)(nil)
+
+// multipleDiscoverers runs more peer discovery mechanisms at the same time
+type multipleDiscoverers struct {
+	discoverers []p2p.PeerDiscoverer
+}
+
+// NewMultipleDiscoverers creates a peer discoverer that bootstraps all the provided discoverers
+func NewMultipleDiscoverers(discoverers ...p2p.PeerDiscoverer) (*multipleDiscoverers, error) {
+	if len(discoverers) == 0 {
+		return nil, p2p.ErrNoPeerDiscoverers
+	}
+	for i, discoverer := range discoverers {
+		if check.IfNil(discoverer) {
+			return nil, fmt.Errorf("%w at index %d", p2p.ErrNilPeerDiscoverer, i)
+		}
+	}
+
+	return &multipleDiscoverers{
+		discoverers: discoverers,
+	}, nil
+}
+
+// Bootstrap will start all the contained discoverers. Returns the first encountered error
+func (md *multipleDiscoverers) Bootstrap() error {
+	for _, discoverer := range md.discoverers {
+		err := discoverer.Bootstrap()
+		if err != nil {
+			return fmt.Errorf("%w for the %s", err, discoverer.Name())
+		}
+	}
+
+	return nil
+}
+
+// Name returns the names of all the contained discoverers
+func (md *multipleDiscoverers) Name() string {
+	names := make([]string, 0, len(md.discoverers))
+	for _, discoverer := range md.discoverers {
+		names = append(names, discoverer.Name())
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// ReconnectToNetwork will call the reconnect on all the contained discoverers that are able to reconnect
+func (md *multipleDiscoverers) ReconnectToNetwork(ctx context.Context) {
+	for _, discoverer := range md.discoverers {
+		reconnecter, ok := discoverer.(p2p.Reconnecter)
+		if !ok {
+			continue
+		}
+
+		reconnecter.ReconnectToNetwork(ctx)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (md *multipleDiscoverers) IsInterfaceNil() bool {
+	return md == nil
+}
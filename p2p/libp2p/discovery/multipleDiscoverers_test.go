@@ -0,0 +1,94 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type reconnectablePeerDiscovererStub struct {
+	mock.PeerDiscovererStub
+	reconnectToNetworkCalled func(ctx context.Context)
+}
+
+func (rpds *reconnectablePeerDiscovererStub) ReconnectToNetwork(ctx context.Context) {
+	rpds.reconnectToNetworkCalled(ctx)
+}
+
+func (rpds *reconnectablePeerDiscovererStub) IsInterfaceNil() bool {
+	return rpds == nil
+}
+
+func TestNewMultipleDiscoverers_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	md, err := discovery.NewMultipleDiscoverers()
+	assert.Equal(t, p2p.ErrNoPeerDiscoverers, err)
+	assert.True(t, check.IfNil(md))
+
+	md, err = discovery.NewMultipleDiscoverers(&mock.PeerDiscovererStub{}, nil)
+	assert.True(t, errors.Is(err, p2p.ErrNilPeerDiscoverer))
+	assert.True(t, check.IfNil(md))
+}
+
+func TestMultipleDiscoverers_BootstrapShouldBootstrapAll(t *testing.T) {
+	t.Parallel()
+
+	numBootstraps := 0
+	bootstrapHandler := func() error {
+		numBootstraps++
+		return nil
+	}
+	md, err := discovery.NewMultipleDiscoverers(
+		&mock.PeerDiscovererStub{BootstrapCalled: bootstrapHandler},
+		discovery.NewNilDiscoverer(),
+		&mock.PeerDiscovererStub{BootstrapCalled: bootstrapHandler},
+	)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(md))
+
+	err = md.Bootstrap()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numBootstraps)
+	assert.Equal(t, "PeerDiscovererStub, no peer discovery, PeerDiscovererStub", md.Name())
+}
+
+func TestMultipleDiscoverers_BootstrapErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	md, _ := discovery.NewMultipleDiscoverers(
+		discovery.NewNilDiscoverer(),
+		&mock.PeerDiscovererStub{
+			BootstrapCalled: func() error {
+				return expectedErr
+			},
+		},
+	)
+
+	err := md.Bootstrap()
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestMultipleDiscoverers_ReconnectToNetworkShouldCallTheReconnecters(t *testing.T) {
+	t.Parallel()
+
+	numReconnects := 0
+	md, _ := discovery.NewMultipleDiscoverers(
+		&mock.PeerDiscovererStub{},
+		&reconnectablePeerDiscovererStub{
+			reconnectToNetworkCalled: func(ctx context.Context) {
+				numReconnects++
+			},
+		},
+	)
+
+	md.ReconnectToNetwork(context.Background())
+	assert.Equal(t, 1, numReconnects)
+}
@@ -0,0 +1,99 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var _ p2p.PeerDiscoverer = (*staticPeersDiscoverer)(nil)
+var _ p2p.Reconnecter = (*staticPeersDiscoverer)(nil)
+
+const staticPeersName = "static peers discovery"
+
+// ArgStaticPeers represents the static peers discoverer argument DTO
+type ArgStaticPeers struct {
+	Context              context.Context
+	Host                 ConnectableHost
+	PeersList            []string
+	ReconnectInterval    time.Duration
+	MaxReconnectInterval time.Duration
+}
+
+type staticPeersDiscoverer struct {
+	loop      *discoveryLoop
+	connector *backoffConnector
+}
+
+// NewStaticPeersDiscoverer creates a peer discoverer that keeps the host connected to a known list of peers.
+// The peers that can not be reached are retried with an exponential backoff
+func NewStaticPeersDiscoverer(arg ArgStaticPeers) (*staticPeersDiscoverer, error) {
+	err := checkStaticPeersArgument(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	spd := &staticPeersDiscoverer{
+		loop:      newDiscoveryLoop(staticPeersName, arg.ReconnectInterval),
+		connector: newBackoffConnector(arg.Host, arg.ReconnectInterval, arg.MaxReconnectInterval),
+	}
+	spd.connector.setAddresses(arg.PeersList)
+	spd.loop.processHandler = spd.connectToPeers
+	spd.loop.reconnectHandler = spd.reconnectToPeers
+
+	go spd.loop.processLoop(arg.Context)
+
+	return spd, nil
+}
+
+func checkStaticPeersArgument(arg ArgStaticPeers) error {
+	if check.IfNilReflect(arg.Context) {
+		return p2p.ErrNilContext
+	}
+	if check.IfNilReflect(arg.Host) {
+		return p2p.ErrNilHost
+	}
+	if len(arg.PeersList) == 0 {
+		return p2p.ErrEmptyPeersList
+	}
+	for _, address := range arg.PeersList {
+		_, err := arg.Host.AddressToPeerInfo(address)
+		if err != nil {
+			return fmt.Errorf("%w for static peer address %s", err, address)
+		}
+	}
+
+	return checkBackoffIntervals(arg.ReconnectInterval, arg.MaxReconnectInterval)
+}
+
+func (spd *staticPeersDiscoverer) connectToPeers(ctx context.Context) {
+	spd.connector.connect(ctx)
+}
+
+func (spd *staticPeersDiscoverer) reconnectToPeers(ctx context.Context) {
+	spd.connector.resetBackoff()
+	spd.connector.connect(ctx)
+}
+
+// Bootstrap will start the connecting to the static peers process
+func (spd *staticPeersDiscoverer) Bootstrap() error {
+	return spd.loop.bootstrap()
+}
+
+// Name returns the name of the static peers discovery implementation
+func (spd *staticPeersDiscoverer) Name() string {
+	return staticPeersName
+}
+
+// ReconnectToNetwork will try to connect immediately to all the static peers that are not connected
+func (spd *staticPeersDiscoverer) ReconnectToNetwork(_ context.Context) {
+	spd.loop.reconnect()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (spd *staticPeersDiscoverer) IsInterfaceNil() bool {
+	return spd == nil
+}
@@ -0,0 +1,148 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestStaticPeersArgument(t *testing.T) discovery.ArgStaticPeers {
+	address1, _ := createTestPeerAddress(t, 10000)
+	address2, _ := createTestPeerAddress(t, 10001)
+
+	return discovery.ArgStaticPeers{
+		Context:              context.Background(),
+		Host:                 &mock.ConnectableHostStub{},
+		PeersList:            []string{address1, address2},
+		ReconnectInterval:    time.Second,
+		MaxReconnectInterval: time.Minute,
+	}
+}
+
+func TestNewStaticPeersDiscoverer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createTestStaticPeersArgument(t)
+	arg.Context = nil
+	spd, err := discovery.NewStaticPeersDiscoverer(arg)
+	assert.Equal(t, p2p.ErrNilContext, err)
+	assert.True(t, check.IfNil(spd))
+
+	arg = createTestStaticPeersArgument(t)
+	arg.Host = nil
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.Equal(t, p2p.ErrNilHost, err)
+	assert.True(t, check.IfNil(spd))
+
+	arg = createTestStaticPeersArgument(t)
+	arg.PeersList = nil
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.Equal(t, p2p.ErrEmptyPeersList, err)
+	assert.True(t, check.IfNil(spd))
+
+	arg = createTestStaticPeersArgument(t)
+	arg.PeersList = append(arg.PeersList, "invalid address")
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.NotNil(t, err)
+	assert.True(t, check.IfNil(spd))
+
+	arg = createTestStaticPeersArgument(t)
+	arg.ReconnectInterval = time.Millisecond
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+	assert.True(t, check.IfNil(spd))
+
+	arg = createTestStaticPeersArgument(t)
+	arg.MaxReconnectInterval = arg.ReconnectInterval - 1
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+	assert.True(t, check.IfNil(spd))
+}
+
+func TestNewStaticPeersDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	spd, err := discovery.NewStaticPeersDiscoverer(createTestStaticPeersArgument(t))
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(spd))
+	assert.Equal(t, discovery.StaticPeersName, spd.Name())
+}
+
+func TestStaticPeersDiscoverer_BootstrapShouldConnectToAllPeers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chDials := make(chan string, 10)
+	arg := createTestStaticPeersArgument(t)
+	arg.Context = ctx
+	arg.Host = &mock.ConnectableHostStub{
+		ConnectToPeerCalled: func(ctx context.Context, address string) error {
+			chDials <- address
+			return nil
+		},
+	}
+	spd, _ := discovery.NewStaticPeersDiscoverer(arg)
+
+	err := spd.Bootstrap()
+	assert.Nil(t, err)
+	assert.Equal(t, arg.PeersList, readFromChannel(t, chDials, len(arg.PeersList)))
+
+	err = spd.Bootstrap()
+	assert.Equal(t, p2p.ErrPeerDiscoveryProcessAlreadyStarted, err)
+}
+
+func readFromChannel(t *testing.T, ch chan string, numValues int) []string {
+	values := make([]string, 0, numValues)
+	for i := 0; i < numValues; i++ {
+		select {
+		case value := <-ch:
+			values = append(values, value)
+		case <-time.After(time.Second * 5):
+			assert.Fail(t, "timeout reading from channel")
+			return values
+		}
+	}
+
+	return values
+}
+
+func TestStaticPeersDiscoverer_ReconnectToNetworkShouldRetryImmediately(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chDials := make(chan string, 10)
+	arg := createTestStaticPeersArgument(t)
+	arg.Context = ctx
+	arg.PeersList = arg.PeersList[:1]
+	arg.MaxReconnectInterval = time.Hour
+	arg.ReconnectInterval = time.Hour
+	arg.Host = &mock.ConnectableHostStub{
+		ConnectToPeerCalled: func(ctx context.Context, address string) error {
+			chDials <- address
+			return errors.New("unreachable")
+		},
+	}
+	spd, _ := discovery.NewStaticPeersDiscoverer(arg)
+
+	_ = spd.Bootstrap()
+	assert.Equal(t, arg.PeersList[0], <-chDials)
+
+	spd.ReconnectToNetwork(ctx)
+	select {
+	case address := <-chDials:
+		assert.Equal(t, arg.PeersList[0], address)
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "reconnect should have dialed the static peer")
+	}
+}
@@ -10,3 +10,9 @@ var ErrNotConnectedToNetwork = errors.New("not connected to network")
 
 // ErrReceivingPeerNotConnected signals that the receiving peer of a sending operation is not connected to the network
 var ErrReceivingPeerNotConnected = errors.New("receiving peer not connected to network")
+
+// ErrNilDeliveryHandler signals that a nil delivery handler has been provided
+var ErrNilDeliveryHandler = errors.New("nil delivery handler")
+
+// ErrNilDelayScheduler signals that a nil delay scheduler has been provided
+var ErrNilDelayScheduler = errors.New("nil delay scheduler")
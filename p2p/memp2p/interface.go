@@ -0,0 +1,22 @@
+package memp2p
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// DeliveryHandler decides if, when and in which form a message sent by a peer reaches the destination peer.
+// It can be used to simulate network faults on top of the in-memory network
+type DeliveryHandler interface {
+	HandleDelivery(message p2p.MessageP2P, destination core.PeerID) (deliveredMessage p2p.MessageP2P, delay time.Duration, shouldDeliver bool)
+	IsInterfaceNil() bool
+}
+
+// DelayScheduler calls a handler once the provided delay has elapsed. It allows the delayed deliveries to run on a
+// simulated time instead of the wall clock
+type DelayScheduler interface {
+	AfterFunc(delay time.Duration, handler func())
+	IsInterfaceNil() bool
+}
@@ -15,6 +15,8 @@ import (
 
 const maxQueueSize = 1000
 
+var _ p2p.Messenger = (*Messenger)(nil)
+
 var log = logger.GetOrCreate("p2p/memp2p")
 
 // Messenger is an implementation of the p2p.Messenger interface that
@@ -167,13 +169,18 @@ func (messenger *Messenger) ConnectedPeersOnTopic(topic string) []core.PeerID {
 	return filteredPeers
 }
 
+// ConnectedFullHistoryPeersOnTopic returns an empty slice as the in-memory network has no full history peers
+func (messenger *Messenger) ConnectedFullHistoryPeersOnTopic(_ string) []core.PeerID {
+	return make([]core.PeerID, 0)
+}
+
 // TrimConnections does nothing, as it is not applicable to the in-memory
 // messenger.
 func (messenger *Messenger) TrimConnections() {
 }
 
 // Bootstrap does nothing, as it is not applicable to the in-memory messenger.
-func (messenger *Messenger) Bootstrap(_ uint32) error {
+func (messenger *Messenger) Bootstrap() error {
 	return nil
 }
 
@@ -247,6 +254,32 @@ func (messenger *Messenger) UnregisterMessageProcessor(topic string, _ string) e
 	return nil
 }
 
+// UnregisterAllMessageProcessors unsets the message processors for all the topics
+func (messenger *Messenger) UnregisterAllMessageProcessors() error {
+	messenger.topicsMutex.Lock()
+	for topic := range messenger.topicValidators {
+		messenger.topicValidators[topic] = nil
+	}
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
+// UnjoinAllTopics removes all the topics of interest for this Messenger
+func (messenger *Messenger) UnjoinAllTopics() error {
+	messenger.topicsMutex.Lock()
+	messenger.topics = make(map[string]struct{})
+	messenger.topicValidators = make(map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
+// Port returns 0 as the in-memory messenger does not bind to any port
+func (messenger *Messenger) Port() int {
+	return 0
+}
+
 // OutgoingChannelLoadBalancer does nothing, as it is not applicable to the in-memory network.
 func (messenger *Messenger) OutgoingChannelLoadBalancer() p2p.ChannelLoadBalancer {
 	return nil
@@ -291,7 +324,7 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 
 	peers := messenger.network.Peers()
 	for _, peer := range peers {
-		peer.receiveMessage(messageObject)
+		messenger.network.deliver(messageObject, peer)
 	}
 
 	return nil
@@ -340,7 +373,7 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 			return ErrReceivingPeerNotConnected
 		}
 
-		receivingPeer.receiveMessage(messageObject)
+		messenger.network.deliver(messageObject, receivingPeer)
 
 		return nil
 	}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
@@ -184,3 +185,112 @@ func TestSendingDirectMessages(t *testing.T) {
 	// Peer1 got the message
 	assert.Equal(t, uint64(1), peer1.NumMessagesReceived())
 }
+
+func TestNetwork_SetDeliveryHandlerNilShouldErr(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	err := network.SetDeliveryHandler(nil)
+	assert.Equal(t, memp2p.ErrNilDeliveryHandler, err)
+}
+
+func TestDeliveryHandlerCanDropDelayAndAlterMessages(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	numPeers := 3
+	peers := make([]*memp2p.Messenger, numPeers)
+	for i := 0; i < numPeers; i++ {
+		peer, _ := memp2p.NewMessenger(network)
+		_ = peer.CreateTopic("rocket", false)
+		peers[i] = peer
+	}
+
+	receivedData := make(chan []byte, 10)
+	_ = peers[2].RegisterMessageProcessor("rocket", "", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, _ core.PeerID) error {
+			receivedData <- message.Data()
+			return nil
+		},
+	})
+
+	delay := time.Millisecond * 200
+	err := network.SetDeliveryHandler(&mock.DeliveryHandlerStub{
+		HandleDeliveryCalled: func(message p2p.MessageP2P, destination core.PeerID) (p2p.MessageP2P, time.Duration, bool) {
+			if destination == peers[1].ID() {
+				return nil, 0, false
+			}
+			if destination == peers[2].ID() {
+				return &mock.P2PMessageMock{
+					TopicField: message.Topic(),
+					DataField:  []byte("altered"),
+					PeerField:  message.Peer(),
+				}, delay, true
+			}
+
+			return message, 0, true
+		},
+	})
+	assert.Nil(t, err)
+
+	start := time.Now()
+	_ = peers[0].BroadcastOnChannelBlocking("rocket", "rocket", []byte("launch the rocket"))
+
+	select {
+	case data := <-receivedData:
+		assert.Equal(t, []byte("altered"), data)
+		assert.True(t, time.Since(start) >= delay)
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout while waiting for the delayed message")
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 0, 2: 1})
+}
+
+func TestNetwork_SetDelaySchedulerNilShouldErr(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	err := network.SetDelayScheduler(nil)
+	assert.Equal(t, memp2p.ErrNilDelayScheduler, err)
+}
+
+func TestDelaySchedulerRunsTheDelayedDeliveries(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	numPeers := 2
+	peers := make([]*memp2p.Messenger, numPeers)
+	for i := 0; i < numPeers; i++ {
+		peer, _ := memp2p.NewMessenger(network)
+		_ = peer.CreateTopic("rocket", false)
+		peers[i] = peer
+	}
+
+	delay := time.Hour
+	_ = network.SetDeliveryHandler(&mock.DeliveryHandlerStub{
+		HandleDeliveryCalled: func(message p2p.MessageP2P, destination core.PeerID) (p2p.MessageP2P, time.Duration, bool) {
+			if destination == peers[1].ID() {
+				return message, delay, true
+			}
+
+			return message, 0, true
+		},
+	})
+
+	var scheduledDelays []time.Duration
+	var scheduledHandlers []func()
+	err := network.SetDelayScheduler(&mock.DelaySchedulerStub{
+		AfterFuncCalled: func(delay time.Duration, handler func()) {
+			scheduledDelays = append(scheduledDelays, delay)
+			scheduledHandlers = append(scheduledHandlers, handler)
+		},
+	})
+	assert.Nil(t, err)
+
+	_ = peers[0].BroadcastOnChannelBlocking("rocket", "rocket", []byte("launch the rocket"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 0})
+
+	assert.Equal(t, []time.Duration{delay}, scheduledDelays)
+	scheduledHandlers[0]()
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1})
+}
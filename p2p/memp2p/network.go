@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
 // Network provides in-memory connectivity for the Messenger
@@ -12,16 +14,19 @@ import (
 // peers. The peers are connected to the network if they are in the internal
 // `peers` map; otherwise, they are disconnected.
 type Network struct {
-	mutex sync.RWMutex
-	peers map[core.PeerID]*Messenger
+	mutex           sync.RWMutex
+	peers           map[core.PeerID]*Messenger
+	deliveryHandler DeliveryHandler
+	delayScheduler  DelayScheduler
 }
 
 // NewNetwork constructs a new Network instance with an empty
 // internal map of peers.
 func NewNetwork() *Network {
 	network := Network{
-		mutex: sync.RWMutex{},
-		peers: make(map[core.PeerID]*Messenger),
+		mutex:          sync.RWMutex{},
+		peers:          make(map[core.PeerID]*Messenger),
+		delayScheduler: &realTimeScheduler{},
 	}
 
 	return &network
@@ -129,3 +134,56 @@ func (network *Network) IsPeerConnected(peerID core.PeerID) bool {
 	network.mutex.RUnlock()
 	return found
 }
+
+// SetDeliveryHandler sets the handler that will be consulted for each message delivery. Without a delivery
+// handler, all messages are delivered immediately and unaltered
+func (network *Network) SetDeliveryHandler(handler DeliveryHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilDeliveryHandler
+	}
+
+	network.mutex.Lock()
+	network.deliveryHandler = handler
+	network.mutex.Unlock()
+
+	return nil
+}
+
+// SetDelayScheduler sets the scheduler used for the deliveries delayed by the delivery handler. By default, the
+// delayed messages are delivered on the wall clock
+func (network *Network) SetDelayScheduler(scheduler DelayScheduler) error {
+	if check.IfNil(scheduler) {
+		return ErrNilDelayScheduler
+	}
+
+	network.mutex.Lock()
+	network.delayScheduler = scheduler
+	network.mutex.Unlock()
+
+	return nil
+}
+
+func (network *Network) deliver(message p2p.MessageP2P, destination *Messenger) {
+	network.mutex.RLock()
+	handler := network.deliveryHandler
+	scheduler := network.delayScheduler
+	network.mutex.RUnlock()
+
+	if check.IfNil(handler) {
+		destination.receiveMessage(message)
+		return
+	}
+
+	deliveredMessage, delay, shouldDeliver := handler.HandleDelivery(message, destination.ID())
+	if !shouldDeliver || check.IfNil(deliveredMessage) {
+		return
+	}
+	if delay <= 0 {
+		destination.receiveMessage(deliveredMessage)
+		return
+	}
+
+	scheduler.AfterFunc(delay, func() {
+		destination.receiveMessage(deliveredMessage)
+	})
+}
@@ -0,0 +1,17 @@
+package memp2p
+
+import "time"
+
+// realTimeScheduler is the default DelayScheduler of the network, running the delayed handlers on the wall clock
+type realTimeScheduler struct {
+}
+
+// AfterFunc calls the handler on its own go routine after the delay has elapsed
+func (rts *realTimeScheduler) AfterFunc(delay time.Duration, handler func()) {
+	time.AfterFunc(delay, handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rts *realTimeScheduler) IsInterfaceNil() bool {
+	return rts == nil
+}
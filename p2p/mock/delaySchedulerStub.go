@@ -0,0 +1,20 @@
+package mock
+
+import "time"
+
+// DelaySchedulerStub -
+type DelaySchedulerStub struct {
+	AfterFuncCalled func(delay time.Duration, handler func())
+}
+
+// AfterFunc -
+func (dss *DelaySchedulerStub) AfterFunc(delay time.Duration, handler func()) {
+	if dss.AfterFuncCalled != nil {
+		dss.AfterFuncCalled(delay, handler)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dss *DelaySchedulerStub) IsInterfaceNil() bool {
+	return dss == nil
+}
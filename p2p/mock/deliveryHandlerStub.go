@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// DeliveryHandlerStub -
+type DeliveryHandlerStub struct {
+	HandleDeliveryCalled func(message p2p.MessageP2P, destination core.PeerID) (p2p.MessageP2P, time.Duration, bool)
+}
+
+// HandleDelivery -
+func (dhs *DeliveryHandlerStub) HandleDelivery(message p2p.MessageP2P, destination core.PeerID) (p2p.MessageP2P, time.Duration, bool) {
+	if dhs.HandleDeliveryCalled != nil {
+		return dhs.HandleDeliveryCalled(message, destination)
+	}
+
+	return message, 0, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dhs *DeliveryHandlerStub) IsInterfaceNil() bool {
+	return dhs == nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "context"
+
+// TXTResolverStub -
+type TXTResolverStub struct {
+	LookupTXTCalled func(ctx context.Context, name string) ([]string, error)
+}
+
+// LookupTXT -
+func (trs *TXTResolverStub) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if trs.LookupTXTCalled != nil {
+		return trs.LookupTXTCalled(ctx, name)
+	}
+
+	return make([]string, 0), nil
+}
@@ -72,6 +72,7 @@ type ArgBaseProcessor struct {
 	VMContainersFactory process.VirtualMachinesContainerFactory
 	VmContainer         process.VirtualMachinesContainer
 	GasHandler          gasConsumedProvider
+	LoadMonitor         process.LoadMonitor
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
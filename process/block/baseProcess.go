@@ -84,6 +84,7 @@ type baseProcessor struct {
 	vmContainer         process.VirtualMachinesContainer
 	gasConsumedProvider gasConsumedProvider
 	economicsData       process.EconomicsDataHandler
+	loadMonitor         process.LoadMonitor
 
 	processDataTriesOnCommitEpoch bool
 }
@@ -441,6 +442,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.CoreComponents.EconomicsData()) {
 		return process.ErrNilEconomicsData
 	}
+	if check.IfNil(arguments.LoadMonitor) {
+		return process.ErrNilLoadMonitor
+	}
 
 	return nil
 }
@@ -363,6 +363,7 @@ func CreateMockArguments(
 			HistoryRepository:  &dblookupext.HistoryRepositoryStub{},
 			EpochNotifier:      &mock.EpochNotifierStub{},
 			GasHandler:         &mock.GasHandlerMock{},
+			LoadMonitor:        &testscommon.LoadMonitorStub{},
 		},
 	}
 
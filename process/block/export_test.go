@@ -130,6 +130,7 @@ func NewShardProcessorEmptyWith3shards(
 			HistoryRepository:  &dblookupext.HistoryRepositoryStub{},
 			EpochNotifier:      &mock.EpochNotifierStub{},
 			GasHandler:         &mock.GasHandlerMock{},
+			LoadMonitor:        &testscommon.LoadMonitorStub{},
 		},
 	}
 	shardProc, err := NewShardProcessor(arguments)
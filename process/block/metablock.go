@@ -116,6 +116,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		processDataTriesOnCommitEpoch: arguments.Config.Debug.EpochStart.ProcessDataTrieOnCommitEpoch,
 		gasConsumedProvider:           arguments.GasHandler,
 		economicsData:                 arguments.CoreComponents.EconomicsData(),
+		loadMonitor:                   arguments.LoadMonitor,
 	}
 
 	mp := metaProcessor{
@@ -191,6 +192,11 @@ func (mp *metaProcessor) ProcessBlock(
 		"round", headerHandler.GetRound(),
 		"nonce", headerHandler.GetNonce())
 
+	processingStartTime := time.Now()
+	defer func() {
+		mp.loadMonitor.SetBlockProcessingTime(time.Since(processingStartTime))
+	}()
+
 	header, ok := headerHandler.(*block.MetaBlock)
 	if !ok {
 		return process.ErrWrongTypeAssertion
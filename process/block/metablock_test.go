@@ -105,6 +105,7 @@ func createMockMetaArguments(
 			EpochStartTrigger:   &mock.EpochStartTriggerStub{},
 			HeaderValidator:     headerValidator,
 			GasHandler:          &mock.GasHandlerMock{},
+			LoadMonitor:         &testscommon.LoadMonitorStub{},
 			BootStorer: &mock.BoostrapStorerMock{
 				PutCalled: func(round int64, bootData bootstrapStorage.BootstrapData) error {
 					return nil
@@ -367,6 +368,17 @@ func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilLoadMonitorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments(createMockComponentHolders())
+	arguments.LoadMonitor = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilLoadMonitor, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -615,6 +627,12 @@ func TestMetaProcessor_ProcessBlockWithErrOnVerifyStateRootCallShouldRevertState
 		RevertToSnapshotCalled: revertToSnapshot,
 		RootHashCalled:         rootHashCalled,
 	}
+	numBlockProcessingTimeReports := 0
+	arguments.LoadMonitor = &testscommon.LoadMonitorStub{
+		SetBlockProcessingTimeCalled: func(duration time.Duration) {
+			numBlockProcessingTimeReports++
+		},
+	}
 	mp, _ := blproc.NewMetaProcessor(arguments)
 
 	go func() {
@@ -628,6 +646,7 @@ func TestMetaProcessor_ProcessBlockWithErrOnVerifyStateRootCallShouldRevertState
 
 	assert.Equal(t, process.ErrRootStateDoesNotMatch, err)
 	assert.True(t, wasCalled)
+	assert.Equal(t, 1, numBlockProcessingTimeReports)
 }
 
 // ------- requestFinalMissingHeader
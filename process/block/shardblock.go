@@ -86,6 +86,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		processDataTriesOnCommitEpoch: arguments.Config.Debug.EpochStart.ProcessDataTrieOnCommitEpoch,
 		gasConsumedProvider:           arguments.GasHandler,
 		economicsData:                 arguments.CoreComponents.EconomicsData(),
+		loadMonitor:                   arguments.LoadMonitor,
 	}
 
 	sp := shardProcessor{
@@ -144,6 +145,11 @@ func (sp *shardProcessor) ProcessBlock(
 		"nonce", headerHandler.GetNonce(),
 	)
 
+	processingStartTime := time.Now()
+	defer func() {
+		sp.loadMonitor.SetBlockProcessingTime(time.Since(processingStartTime))
+	}()
+
 	header, ok := headerHandler.(*block.Header)
 	if !ok {
 		return process.ErrWrongTypeAssertion
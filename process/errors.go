@@ -611,6 +611,15 @@ var ErrInvalidValue = errors.New("invalid value provided")
 // ErrNilQuotaStatusHandler signals that a nil quota status handler has been provided
 var ErrNilQuotaStatusHandler = errors.New("nil quota status handler")
 
+// ErrNilQuotaAdjuster signals that a nil quota adjuster has been provided
+var ErrNilQuotaAdjuster = errors.New("nil quota adjuster")
+
+// ErrNilLoadMonitor signals that a nil load monitor has been provided
+var ErrNilLoadMonitor = errors.New("nil load monitor")
+
+// ErrNilEffectiveQuotaStatusHandler signals that a nil effective quota status handler has been provided
+var ErrNilEffectiveQuotaStatusHandler = errors.New("nil effective quota status handler")
+
 // ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
 var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -14,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/adaptive"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 )
@@ -41,6 +43,17 @@ type baseInterceptorsContainerFactory struct {
 	requestHandler         process.RequestHandler
 }
 
+// createGlobalThrottler creates the throttler shared by all interceptors, reporting the number of messages in process
+// to the load monitor used by the adaptive antiflood
+func createGlobalThrottler(loadMonitor process.LoadMonitor) (process.InterceptorThrottler, error) {
+	numGoRoutinesThrottler, err := throttler.NewNumGoRoutinesThrottler(numGoRoutines)
+	if err != nil {
+		return nil, err
+	}
+
+	return adaptive.NewQueueDepthThrottler(numGoRoutinesThrottler, loadMonitor)
+}
+
 func checkBaseParams(
 	coreComponents process.CoreComponentsHolder,
 	cryptoComponents process.CryptoComponentsHolder,
@@ -3,7 +3,6 @@ package interceptorscontainer
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
-	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -69,6 +68,9 @@ func NewMetaInterceptorsContainerFactory(
 	if check.IfNil(args.ValidityAttester) {
 		return nil, process.ErrNilValidityAttester
 	}
+	if check.IfNil(args.LoadMonitor) {
+		return nil, process.ErrNilLoadMonitor
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		CoreComponents:            args.CoreComponents,
@@ -109,7 +111,7 @@ func NewMetaInterceptorsContainerFactory(
 		baseInterceptorsContainerFactory: base,
 	}
 
-	icf.globalThrottler, err = throttler.NewNumGoRoutinesThrottler(numGoRoutines)
+	icf.globalThrottler, err = createGlobalThrottler(args.LoadMonitor)
 	if err != nil {
 		return nil, err
 	}
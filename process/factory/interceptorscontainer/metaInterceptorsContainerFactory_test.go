@@ -356,6 +356,18 @@ func TestNewMetaInterceptorsContainerFactory_NilValidityAttesterShouldErr(t *tes
 	assert.Equal(t, process.ErrNilValidityAttester, err)
 }
 
+func TestNewMetaInterceptorsContainerFactory_NilLoadMonitorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsMeta(coreComp, cryptoComp)
+	args.LoadMonitor = nil
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilLoadMonitor, err)
+}
+
 func TestNewMetaInterceptorsContainerFactory_EpochStartTriggerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -576,5 +588,6 @@ func getArgumentsMeta(
 		ArgumentsParser:         &mock.ArgumentParserMock{},
 		PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 		RequestHandler:          &testscommon.RequestHandlerStub{},
+		LoadMonitor:             &testscommon.LoadMonitorStub{},
 	}
 }
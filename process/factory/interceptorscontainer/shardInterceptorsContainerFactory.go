@@ -3,7 +3,6 @@ package interceptorscontainer
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
-	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -68,6 +67,9 @@ func NewShardInterceptorsContainerFactory(
 	if check.IfNil(args.PreferredPeersHolder) {
 		return nil, process.ErrNilPreferredPeersHolder
 	}
+	if check.IfNil(args.LoadMonitor) {
+		return nil, process.ErrNilLoadMonitor
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		CoreComponents:            args.CoreComponents,
@@ -108,7 +110,7 @@ func NewShardInterceptorsContainerFactory(
 		baseInterceptorsContainerFactory: base,
 	}
 
-	icf.globalThrottler, err = throttler.NewNumGoRoutinesThrottler(numGoRoutines)
+	icf.globalThrottler, err = createGlobalThrottler(args.LoadMonitor)
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -331,6 +332,18 @@ func TestNewShardInterceptorsContainerFactory_NilValidityAttesterShouldErr(t *te
 	assert.Equal(t, process.ErrNilValidityAttester, err)
 }
 
+func TestNewShardInterceptorsContainerFactory_NilLoadMonitorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsShard(coreComp, cryptoComp)
+	args.LoadMonitor = nil
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilLoadMonitor, err)
+}
+
 func TestNewShardInterceptorsContainerFactory_InvalidChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -652,5 +665,32 @@ func getArgumentsShard(
 		ArgumentsParser:         &mock.ArgumentParserMock{},
 		PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 		RequestHandler:          &testscommon.RequestHandlerStub{},
+		LoadMonitor:             &testscommon.LoadMonitorStub{},
+	}
+}
+
+func TestShardInterceptorsContainerFactory_InterceptorsShouldReportQueueDepthToLoadMonitor(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsShard(coreComp, cryptoComp)
+	args.Messenger = createShardStubTopicHandler("", "")
+	queueDepths := make([]uint32, 0)
+	args.LoadMonitor = &testscommon.LoadMonitorStub{
+		SetQueueDepthCalled: func(queueDepth uint32) {
+			queueDepths = append(queueDepths, queueDepth)
+		},
 	}
+
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+	container, err := icf.Create()
+	assert.Nil(t, err)
+
+	topic := factory.ShardBlocksTopic + args.ShardCoordinator.CommunicationIdentifier(core.MetachainShardId)
+	interceptor, err := container.Get(topic)
+	assert.Nil(t, err)
+
+	err = interceptor.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: []byte("invalid header")}, "pid")
+	assert.NotNil(t, err)
+	assert.Equal(t, []uint32{1, 0}, queueDepths)
 }
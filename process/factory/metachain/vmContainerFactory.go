@@ -177,6 +177,11 @@ func (vmf *vmContainerFactory) createSystemVMFactoryAndEEI() (vm.SystemSCContain
 		return nil, nil, err
 	}
 
+	registry, err := systemVMFactory.CreateDefaultSystemSCRegistry()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	argsNewSystemScFactory := systemVMFactory.ArgsNewSystemSCFactory{
 		SystemEI:               systemEI,
 		SigVerifier:            vmf.messageSigVerifier,
@@ -190,6 +195,7 @@ func (vmf *vmContainerFactory) createSystemVMFactoryAndEEI() (vm.SystemSCContain
 		AddressPubKeyConverter: vmf.addressPubKeyConverter,
 		EpochConfig:            vmf.epochConfig,
 		ShardCoordinator:       vmf.shardCoordinator,
+		Registry:               registry,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
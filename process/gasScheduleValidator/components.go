@@ -0,0 +1,27 @@
+package gasScheduleValidator
+
+import "github.com/ElrondNetwork/elrond-go-core/core"
+
+// staticGasScheduleNotifier always provides the same gas schedule, as the fee replay does not follow epoch changes
+type staticGasScheduleNotifier struct {
+	gasSchedule map[string]map[string]uint64
+}
+
+// RegisterNotifyHandler calls the handler with the held gas schedule
+func (sgs *staticGasScheduleNotifier) RegisterNotifyHandler(handler core.GasScheduleSubscribeHandler) {
+	handler.GasScheduleChange(sgs.gasSchedule)
+}
+
+// LatestGasSchedule returns the held gas schedule
+func (sgs *staticGasScheduleNotifier) LatestGasSchedule() map[string]map[string]uint64 {
+	return sgs.gasSchedule
+}
+
+// UnRegisterAll does nothing
+func (sgs *staticGasScheduleNotifier) UnRegisterAll() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sgs *staticGasScheduleNotifier) IsInterfaceNil() bool {
+	return sgs == nil
+}
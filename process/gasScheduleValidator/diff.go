@@ -0,0 +1,76 @@
+package gasScheduleValidator
+
+import "sort"
+
+// DiffGasSchedules returns the keys added, removed and changed between the two provided gas schedules, sorted by
+// section and key
+func DiffGasSchedules(oldGasSchedule map[string]map[string]uint64, newGasSchedule map[string]map[string]uint64) *ScheduleDiff {
+	diff := &ScheduleDiff{
+		Added:   make([]*KeyDiff, 0),
+		Removed: make([]*KeyDiff, 0),
+		Changed: make([]*KeyDiff, 0),
+	}
+
+	for _, section := range sortedSections(oldGasSchedule) {
+		for _, key := range sortedKeys(oldGasSchedule[section]) {
+			oldValue := oldGasSchedule[section][key]
+			newValue, found := newGasSchedule[section][key]
+			if !found {
+				diff.Removed = append(diff.Removed, &KeyDiff{Section: section, Key: key, OldValue: oldValue})
+				continue
+			}
+			if oldValue == newValue {
+				continue
+			}
+
+			diff.Changed = append(diff.Changed, &KeyDiff{
+				Section:        section,
+				Key:            key,
+				OldValue:       oldValue,
+				NewValue:       newValue,
+				RelativeChange: computeRelativeChange(oldValue, newValue),
+			})
+		}
+	}
+
+	for _, section := range sortedSections(newGasSchedule) {
+		for _, key := range sortedKeys(newGasSchedule[section]) {
+			_, found := oldGasSchedule[section][key]
+			if found {
+				continue
+			}
+
+			diff.Added = append(diff.Added, &KeyDiff{Section: section, Key: key, NewValue: newGasSchedule[section][key]})
+		}
+	}
+
+	return diff
+}
+
+func computeRelativeChange(oldValue uint64, newValue uint64) float64 {
+	if oldValue == 0 {
+		return 0
+	}
+
+	return (float64(newValue) - float64(oldValue)) / float64(oldValue)
+}
+
+func sortedSections(gasSchedule map[string]map[string]uint64) []string {
+	sections := make([]string, 0, len(gasSchedule))
+	for section := range gasSchedule {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+
+	return sections
+}
+
+func sortedKeys(costs map[string]uint64) []string {
+	keys := make([]string, 0, len(costs))
+	for key := range costs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,63 @@
+package gasScheduleValidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGasSchedules(t *testing.T) {
+	t.Parallel()
+
+	oldGasSchedule := map[string]map[string]uint64{
+		"BuiltInCost": {
+			"ChangeOwnerAddress": 100,
+			"SaveUserName":       200,
+			"ESDTTransfer":       300,
+		},
+		"Removed": {
+			"Key": 1,
+		},
+	}
+	newGasSchedule := map[string]map[string]uint64{
+		"BuiltInCost": {
+			"ChangeOwnerAddress": 150,
+			"SaveUserName":       200,
+			"ESDTNFTCreate":      400,
+		},
+	}
+
+	diff := DiffGasSchedules(oldGasSchedule, newGasSchedule)
+
+	require.Equal(t, 1, len(diff.Changed))
+	assert.Equal(t, &KeyDiff{
+		Section:        "BuiltInCost",
+		Key:            "ChangeOwnerAddress",
+		OldValue:       100,
+		NewValue:       150,
+		RelativeChange: 0.5,
+	}, diff.Changed[0])
+
+	require.Equal(t, 2, len(diff.Removed))
+	assert.Equal(t, "ESDTTransfer", diff.Removed[0].Key)
+	assert.Equal(t, "Removed", diff.Removed[1].Section)
+
+	require.Equal(t, 1, len(diff.Added))
+	assert.Equal(t, &KeyDiff{Section: "BuiltInCost", Key: "ESDTNFTCreate", NewValue: 400}, diff.Added[0])
+}
+
+func TestDiffGasSchedules_SameScheduleShouldBeEmpty(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := map[string]map[string]uint64{
+		"BuiltInCost": {
+			"ChangeOwnerAddress": 100,
+		},
+	}
+
+	diff := DiffGasSchedules(gasSchedule, gasSchedule)
+	assert.Equal(t, 0, len(diff.Added))
+	assert.Equal(t, 0, len(diff.Removed))
+	assert.Equal(t, 0, len(diff.Changed))
+}
@@ -0,0 +1,33 @@
+package gasScheduleValidator
+
+import "errors"
+
+// ErrEmptyGasScheduleConfig signals that no gas schedule version has been provided
+var ErrEmptyGasScheduleConfig = errors.New("empty gas schedule config")
+
+// ErrEmptyArwenVersions signals that no arwen version has been provided
+var ErrEmptyArwenVersions = errors.New("empty arwen versions config")
+
+// ErrInvalidGasScheduleValue signals that a gas schedule file holds a value that is not a positive integer
+var ErrInvalidGasScheduleValue = errors.New("invalid gas schedule value")
+
+// ErrInvalidGasScheduleSection signals that a gas schedule file holds a section that is not a table
+var ErrInvalidGasScheduleSection = errors.New("invalid gas schedule section")
+
+// ErrGasScheduleVersionNotFound signals that the requested gas schedule version is not configured
+var ErrGasScheduleVersionNotFound = errors.New("gas schedule version not found")
+
+// ErrNoGasScheduleUpgrade signals that the gas schedule does not change at the provided epoch
+var ErrNoGasScheduleUpgrade = errors.New("no gas schedule upgrade at the provided epoch")
+
+// ErrNilEconomicsConfig signals that a nil economics config has been provided
+var ErrNilEconomicsConfig = errors.New("nil economics config")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
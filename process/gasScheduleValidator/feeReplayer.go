@@ -0,0 +1,235 @@
+package gasScheduleValidator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+)
+
+const (
+	categoryMoveBalance     = "moveBalance"
+	categoryBuiltInFunction = "builtInFunction"
+	categorySmartContract   = "smartContract"
+	categoryRelayed         = "relayed"
+	// maxNotReplayedRatio is the maximum ratio of not replayed transactions, out of the ones whose fees depend on
+	// the gas schedule, for which the report is still considered representative
+	maxNotReplayedRatio = 0.5
+)
+
+// GasScheduleVersion is a loaded gas schedule together with its file name
+type GasScheduleVersion struct {
+	FileName    string
+	GasSchedule map[string]map[string]uint64
+}
+
+// ArgsFeeReplayer holds the arguments needed to create a fee replayer
+type ArgsFeeReplayer struct {
+	Economics              *config.EconomicsConfig
+	EnableEpochs           config.EnableEpochs
+	AddressPubkeyConverter core.PubkeyConverter
+	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
+}
+
+type feeReplayer struct {
+	economicsConfig        *config.EconomicsConfig
+	enableEpochs           config.EnableEpochs
+	addressPubkeyConverter core.PubkeyConverter
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	argsParser             process.ArgumentsParser
+}
+
+type feeCalculator struct {
+	economicsData process.EconomicsDataHandler
+	builtInCost   economics.BuiltInFunctionsCostHandler
+}
+
+// NewFeeReplayer creates a component able to recompute the fees of stored transactions with two gas schedule versions
+func NewFeeReplayer(args ArgsFeeReplayer) (*feeReplayer, error) {
+	if args.Economics == nil {
+		return nil, ErrNilEconomicsConfig
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &feeReplayer{
+		economicsConfig:        args.Economics,
+		enableEpochs:           args.EnableEpochs,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+		argsParser:             smartContract.NewArgumentParser(),
+	}, nil
+}
+
+// Replay recomputes the gas used and the fee of the provided transactions with both gas schedule versions, as they
+// would be charged in the provided epoch. Only the costs that do not depend on the accounts state (move balance,
+// data and built-in functions) are replayed: smart contract executions and relayed transactions are marked as not
+// replayed, carry no gas and fee values and are left out of the totals.
+// The move balance fees do not depend on the gas schedule, so the report is marked as partial when the not replayed
+// transactions dominate the ones whose fees depend on the gas schedule, as the totals then miss most of the changes
+func (fr *feeReplayer) Replay(
+	txs []*transaction.Transaction,
+	epoch uint32,
+	oldVersion *GasScheduleVersion,
+	newVersion *GasScheduleVersion,
+) (*FeeReplayReport, error) {
+	oldCalculator, err := fr.createFeeCalculator(oldVersion.GasSchedule, epoch)
+	if err != nil {
+		return nil, err
+	}
+	newCalculator, err := fr.createFeeCalculator(newVersion.GasSchedule, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &FeeReplayReport{
+		OldVersion:      oldVersion.FileName,
+		NewVersion:      newVersion.FileName,
+		Epoch:           epoch,
+		NumTransactions: len(txs),
+		TotalOldFee:     big.NewInt(0),
+		TotalNewFee:     big.NewInt(0),
+		Transactions:    make([]*TxFeeReplay, 0, len(txs)),
+	}
+
+	numBuiltInFunctions := 0
+	for _, tx := range txs {
+		txReplay, errReplay := fr.replayTransaction(tx, oldCalculator, newCalculator)
+		if errReplay != nil {
+			return nil, errReplay
+		}
+
+		report.Transactions = append(report.Transactions, txReplay)
+		if !txReplay.Replayed {
+			report.NumNotReplayed++
+			continue
+		}
+
+		if txReplay.Category == categoryBuiltInFunction {
+			numBuiltInFunctions++
+		}
+		report.NumReplayed++
+		if txReplay.FeeDelta.Sign() != 0 {
+			report.NumChanged++
+		}
+		report.TotalOldFee.Add(report.TotalOldFee, txReplay.OldFee)
+		report.TotalNewFee.Add(report.TotalNewFee, txReplay.NewFee)
+	}
+	report.TotalFeeDelta = big.NewInt(0).Sub(report.TotalNewFee, report.TotalOldFee)
+
+	numScheduleDependent := numBuiltInFunctions + report.NumNotReplayed
+	if numScheduleDependent > 0 {
+		report.NotReplayedRatio = float64(report.NumNotReplayed) / float64(numScheduleDependent)
+	}
+	report.Partial = report.NotReplayedRatio > maxNotReplayedRatio
+
+	return report, nil
+}
+
+func (fr *feeReplayer) createFeeCalculator(gasSchedule map[string]map[string]uint64, epoch uint32) (*feeCalculator, error) {
+	builtInCost, err := economics.NewBuiltInFunctionsCost(&economics.ArgsBuiltInFunctionCost{
+		ArgsParser:  fr.argsParser,
+		GasSchedule: &staticGasScheduleNotifier{gasSchedule: gasSchedule},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	epochNotifier := forking.NewGenericEpochNotifier()
+	economicsData, err := economics.NewEconomicsData(economics.ArgsNewEconomicsData{
+		Economics:                      fr.economicsConfig,
+		PenalizedTooMuchGasEnableEpoch: fr.enableEpochs.PenalizedTooMuchGasEnableEpoch,
+		GasPriceModifierEnableEpoch:    fr.enableEpochs.GasPriceModifierEnableEpoch,
+		EpochNotifier:                  epochNotifier,
+		BuiltInFunctionsCostHandler:    builtInCost,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	epochNotifier.CheckEpoch(&block.Header{Epoch: epoch})
+
+	return &feeCalculator{
+		economicsData: economicsData,
+		builtInCost:   builtInCost,
+	}, nil
+}
+
+func (fr *feeReplayer) replayTransaction(tx *transaction.Transaction, oldCalculator *feeCalculator, newCalculator *feeCalculator) (*TxFeeReplay, error) {
+	txHash, err := core.CalculateHash(fr.marshalizer, fr.hasher, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	txReplay := &TxFeeReplay{
+		Hash:     hex.EncodeToString(txHash),
+		Sender:   fr.addressPubkeyConverter.Encode(tx.SndAddr),
+		Receiver: fr.addressPubkeyConverter.Encode(tx.RcvAddr),
+		Category: fr.getCategory(tx, newCalculator),
+	}
+	if len(tx.Data) > 0 {
+		txReplay.Function = strings.Split(string(tx.Data), "@")[0]
+	}
+
+	txReplay.Replayed = txReplay.Category == categoryMoveBalance || txReplay.Category == categoryBuiltInFunction
+	if !txReplay.Replayed {
+		return txReplay, nil
+	}
+
+	txReplay.OldGasUsed, txReplay.OldFee = computeGasAndFee(tx, oldCalculator)
+	txReplay.NewGasUsed, txReplay.NewFee = computeGasAndFee(tx, newCalculator)
+	txReplay.FeeDelta = big.NewInt(0).Sub(txReplay.NewFee, txReplay.OldFee)
+
+	return txReplay, nil
+}
+
+func (fr *feeReplayer) getCategory(tx *transaction.Transaction, calculator *feeCalculator) string {
+	function, _, err := fr.argsParser.ParseCallData(string(tx.Data))
+	isRelayed := err == nil && (function == core.RelayedTransaction || function == core.RelayedTransactionV2)
+	switch {
+	case isRelayed:
+		return categoryRelayed
+	case calculator.builtInCost.IsBuiltInFuncCall(tx):
+		return categoryBuiltInFunction
+	case core.IsSmartContractAddress(tx.RcvAddr):
+		return categorySmartContract
+	default:
+		return categoryMoveBalance
+	}
+}
+
+// computeGasAndFee uses the same formulas as the transactions and the smart contract processors when no refund
+// is issued
+func computeGasAndFee(tx *transaction.Transaction, calculator *feeCalculator) (uint64, *big.Int) {
+	if calculator.builtInCost.IsBuiltInFuncCall(tx) {
+		return calculator.economicsData.ComputeGasUsedAndFeeBasedOnRefundValue(tx, big.NewInt(0))
+	}
+
+	return calculator.economicsData.ComputeGasLimit(tx), calculator.economicsData.ComputeTxFee(tx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fr *feeReplayer) IsInterfaceNil() bool {
+	return fr == nil
+}
@@ -0,0 +1,252 @@
+package gasScheduleValidator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createEconomicsConfig() *config.EconomicsConfig {
+	return &config.EconomicsConfig{
+		GlobalSettings: config.GlobalSettings{
+			GenesisTotalSupply: "20000000000000000000000000",
+			MinimumInflation:   0,
+			YearSettings: []*config.YearSetting{
+				{
+					Year:             1,
+					MaximumInflation: 0.1,
+				},
+			},
+			Denomination: 18,
+		},
+		RewardsSettings: config.RewardsSettings{
+			RewardsConfigByEpoch: []config.EpochRewardSettings{
+				{
+					LeaderPercentage:                 0.1,
+					DeveloperPercentage:              0.3,
+					ProtocolSustainabilityPercentage: 0.1,
+					ProtocolSustainabilityAddress:    "erd1932eft30w753xyvme8d49qejgkjc09n5e49w4mwdjtm0neld797su0dlxp",
+					TopUpGradientPoint:               "3000000000000000000000000",
+					TopUpFactor:                      0.25,
+					EpochEnable:                      0,
+				},
+			},
+		},
+		FeeSettings: config.FeeSettings{
+			GasLimitSettings: []config.GasLimitSetting{
+				{
+					MaxGasLimitPerBlock:         "1500000000",
+					MaxGasLimitPerMiniBlock:     "1500000000",
+					MaxGasLimitPerMetaBlock:     "15000000000",
+					MaxGasLimitPerMetaMiniBlock: "15000000000",
+					MinGasLimit:                 "50000",
+				},
+			},
+			MinGasPrice:      "1000000000",
+			GasPerDataByte:   "1500",
+			GasPriceModifier: 0.01,
+		},
+	}
+}
+
+func createMockArgsFeeReplayer() ArgsFeeReplayer {
+	return ArgsFeeReplayer{
+		Economics:              createEconomicsConfig(),
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+		Marshalizer:            &marshal.GogoProtoMarshalizer{},
+		Hasher:                 &testscommon.HasherMock{},
+	}
+}
+
+func loadGasScheduleVersion(t *testing.T, fileName string) *GasScheduleVersion {
+	gasSchedule, err := LoadGasSchedule(filepath.Join(gasSchedulesDir, fileName))
+	require.Nil(t, err)
+
+	return &GasScheduleVersion{
+		FileName:    fileName,
+		GasSchedule: gasSchedule,
+	}
+}
+
+func createTransaction(nonce uint64, rcvAddr []byte, data string, gasLimit uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  rcvAddr,
+		SndAddr:  bytes.Repeat([]byte{1}, 32),
+		GasPrice: 1000000000,
+		GasLimit: gasLimit,
+		Data:     []byte(data),
+	}
+}
+
+func TestNewFeeReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil economics config", func(t *testing.T) {
+		args := createMockArgsFeeReplayer()
+		args.Economics = nil
+		replayer, err := NewFeeReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, ErrNilEconomicsConfig, err)
+	})
+	t.Run("nil pubkey converter", func(t *testing.T) {
+		args := createMockArgsFeeReplayer()
+		args.AddressPubkeyConverter = nil
+		replayer, err := NewFeeReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("nil marshalizer", func(t *testing.T) {
+		args := createMockArgsFeeReplayer()
+		args.Marshalizer = nil
+		replayer, err := NewFeeReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher", func(t *testing.T) {
+		args := createMockArgsFeeReplayer()
+		args.Hasher = nil
+		replayer, err := NewFeeReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		replayer, err := NewFeeReplayer(createMockArgsFeeReplayer())
+		assert.Nil(t, err)
+		assert.False(t, replayer.IsInterfaceNil())
+	})
+}
+
+func TestFeeReplayer_Replay(t *testing.T) {
+	t.Parallel()
+
+	replayer, _ := NewFeeReplayer(createMockArgsFeeReplayer())
+	userAddress := bytes.Repeat([]byte{2}, 32)
+	scAddress := make([]byte, 32)
+	scAddress[core.NumInitCharactersForScAddress-1] = 5
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TKN-abcdef")) + "@01"
+
+	txs := []*transaction.Transaction{
+		createTransaction(0, userAddress, "", 50000),
+		createTransaction(1, userAddress, esdtTransferData, 1000000),
+		createTransaction(2, scAddress, "claim", 5000000),
+		createTransaction(3, userAddress, core.RelayedTransaction+"@00", 5000000),
+	}
+
+	report, err := replayer.Replay(
+		txs,
+		4,
+		loadGasScheduleVersion(t, "gasScheduleV3.toml"),
+		loadGasScheduleVersion(t, "gasScheduleV4.toml"),
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, "gasScheduleV3.toml", report.OldVersion)
+	assert.Equal(t, "gasScheduleV4.toml", report.NewVersion)
+	assert.Equal(t, 4, report.NumTransactions)
+	assert.Equal(t, 2, report.NumReplayed)
+	assert.Equal(t, 2, report.NumNotReplayed)
+	assert.Equal(t, 1, report.NumChanged)
+	require.Equal(t, 4, len(report.Transactions))
+
+	moveBalance := report.Transactions[0]
+	assert.Equal(t, categoryMoveBalance, moveBalance.Category)
+	assert.True(t, moveBalance.Replayed)
+	assert.Equal(t, uint64(50000), moveBalance.OldGasUsed)
+	assert.Equal(t, 0, moveBalance.FeeDelta.Sign())
+
+	esdtTransfer := report.Transactions[1]
+	assert.Equal(t, categoryBuiltInFunction, esdtTransfer.Category)
+	assert.Equal(t, core.BuiltInFunctionESDTTransfer, esdtTransfer.Function)
+	assert.True(t, esdtTransfer.Replayed)
+	assert.Equal(t, uint64(50000)+uint64(1500*len(esdtTransferData)), esdtTransfer.OldGasUsed-250000)
+	assert.Equal(t, esdtTransfer.OldGasUsed-50000, esdtTransfer.NewGasUsed)
+	assert.True(t, esdtTransfer.FeeDelta.Sign() < 0)
+
+	scCall := report.Transactions[2]
+	assert.Equal(t, categorySmartContract, scCall.Category)
+	assert.False(t, scCall.Replayed)
+	assert.Equal(t, uint64(0), scCall.OldGasUsed)
+	assert.Nil(t, scCall.OldFee)
+	assert.Nil(t, scCall.NewFee)
+	assert.Nil(t, scCall.FeeDelta)
+
+	assert.Equal(t, categoryRelayed, report.Transactions[3].Category)
+	assert.False(t, report.Transactions[3].Replayed)
+	assert.Nil(t, report.Transactions[3].FeeDelta)
+
+	expectedTotalOldFee := big.NewInt(0).Add(moveBalance.OldFee, esdtTransfer.OldFee)
+	expectedTotalNewFee := big.NewInt(0).Add(moveBalance.NewFee, esdtTransfer.NewFee)
+	assert.Equal(t, expectedTotalOldFee, report.TotalOldFee)
+	assert.Equal(t, expectedTotalNewFee, report.TotalNewFee)
+	assert.Equal(t, esdtTransfer.FeeDelta, report.TotalFeeDelta)
+
+	assert.InDelta(t, 2.0/3.0, report.NotReplayedRatio, 0.0001)
+	assert.True(t, report.Partial)
+}
+
+func TestFeeReplayer_ReplayPartial(t *testing.T) {
+	t.Parallel()
+
+	replayer, _ := NewFeeReplayer(createMockArgsFeeReplayer())
+	userAddress := bytes.Repeat([]byte{2}, 32)
+	scAddress := make([]byte, 32)
+	scAddress[core.NumInitCharactersForScAddress-1] = 5
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TKN-abcdef")) + "@01"
+	oldVersion := loadGasScheduleVersion(t, "gasScheduleV3.toml")
+	newVersion := loadGasScheduleVersion(t, "gasScheduleV4.toml")
+
+	t.Run("move balance transactions should not hide the not replayed smart contract calls", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*transaction.Transaction{
+			createTransaction(0, userAddress, "", 50000),
+			createTransaction(1, userAddress, "", 50000),
+			createTransaction(2, userAddress, "", 50000),
+			createTransaction(3, scAddress, "claim", 5000000),
+		}
+
+		report, err := replayer.Replay(txs, 4, oldVersion, newVersion)
+		require.Nil(t, err)
+		assert.Equal(t, 3, report.NumReplayed)
+		assert.Equal(t, 1.0, report.NotReplayedRatio)
+		assert.True(t, report.Partial)
+	})
+	t.Run("mostly built-in function calls should not be partial", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*transaction.Transaction{
+			createTransaction(0, userAddress, esdtTransferData, 1000000),
+			createTransaction(1, userAddress, esdtTransferData, 1000000),
+			createTransaction(2, scAddress, "claim", 5000000),
+		}
+
+		report, err := replayer.Replay(txs, 4, oldVersion, newVersion)
+		require.Nil(t, err)
+		assert.InDelta(t, 1.0/3.0, report.NotReplayedRatio, 0.0001)
+		assert.False(t, report.Partial)
+	})
+	t.Run("only move balance transactions should not be partial", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*transaction.Transaction{
+			createTransaction(0, userAddress, "", 50000),
+		}
+
+		report, err := replayer.Replay(txs, 4, oldVersion, newVersion)
+		require.Nil(t, err)
+		assert.Equal(t, 0.0, report.NotReplayedRatio)
+		assert.False(t, report.Partial)
+	})
+}
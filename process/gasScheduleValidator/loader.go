@@ -0,0 +1,36 @@
+package gasScheduleValidator
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// LoadGasSchedule reads and flattens a gas schedule file. As opposed to common.LoadGasScheduleConfig, a malformed
+// value is reported as an error instead of causing a panic, so that a wrong edit can be pointed out
+func LoadGasSchedule(filePath string) (map[string]map[string]uint64, error) {
+	tomlMap, err := core.LoadTomlFileToMap(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	gasSchedule := make(map[string]map[string]uint64)
+	for section, sectionValue := range tomlMap {
+		costs, ok := sectionValue.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s in %s", ErrInvalidGasScheduleSection, section, filePath)
+		}
+
+		gasSchedule[section] = make(map[string]uint64)
+		for operation, costValue := range costs {
+			cost, isInt := costValue.(int64)
+			if !isInt || cost < 0 {
+				return nil, fmt.Errorf("%w: %s.%s = %v in %s", ErrInvalidGasScheduleValue, section, operation, costValue, filePath)
+			}
+
+			gasSchedule[section][operation] = uint64(cost)
+		}
+	}
+
+	return gasSchedule, nil
+}
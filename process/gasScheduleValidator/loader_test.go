@@ -0,0 +1,49 @@
+package gasScheduleValidator
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeGasScheduleFile(t *testing.T, content string) string {
+	filePath := filepath.Join(t.TempDir(), "gasSchedule.toml")
+	err := ioutil.WriteFile(filePath, []byte(content), 0644)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func TestLoadGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		gasSchedule, err := LoadGasSchedule("missing.toml")
+		assert.Nil(t, gasSchedule)
+		assert.NotNil(t, err)
+	})
+	t.Run("non table section should error", func(t *testing.T) {
+		gasSchedule, err := LoadGasSchedule(writeGasScheduleFile(t, "Cost = 5\n"))
+		assert.Nil(t, gasSchedule)
+		assert.True(t, errors.Is(err, ErrInvalidGasScheduleSection))
+	})
+	t.Run("non integer value should error", func(t *testing.T) {
+		gasSchedule, err := LoadGasSchedule(writeGasScheduleFile(t, "[BuiltInCost]\n    SaveUserName = \"100\"\n"))
+		assert.Nil(t, gasSchedule)
+		assert.True(t, errors.Is(err, ErrInvalidGasScheduleValue))
+	})
+	t.Run("negative value should error", func(t *testing.T) {
+		gasSchedule, err := LoadGasSchedule(writeGasScheduleFile(t, "[BuiltInCost]\n    SaveUserName = -100\n"))
+		assert.Nil(t, gasSchedule)
+		assert.True(t, errors.Is(err, ErrInvalidGasScheduleValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		gasSchedule, err := LoadGasSchedule(writeGasScheduleFile(t, "[BuiltInCost]\n    SaveUserName = 100\n"))
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]map[string]uint64{"BuiltInCost": {"SaveUserName": 100}}, gasSchedule)
+	})
+}
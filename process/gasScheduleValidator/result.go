@@ -0,0 +1,93 @@
+package gasScheduleValidator
+
+import "math/big"
+
+// Issue describes a problem found in a gas schedule version
+type Issue struct {
+	Component string `json:"component,omitempty"`
+	Section   string `json:"section"`
+	Key       string `json:"key,omitempty"`
+	Message   string `json:"message"`
+}
+
+// VersionReport holds the validation result of a gas schedule version. The end epoch is math.MaxUint32 for the
+// last configured version
+type VersionReport struct {
+	FileName      string   `json:"fileName"`
+	StartEpoch    uint32   `json:"startEpoch"`
+	EndEpoch      uint32   `json:"endEpoch"`
+	ArwenVersions []string `json:"arwenVersions"`
+	Errors        []*Issue `json:"errors"`
+	Warnings      []*Issue `json:"warnings"`
+}
+
+// ValidationReport holds the validation results of all configured gas schedule versions
+type ValidationReport struct {
+	Versions []*VersionReport `json:"versions"`
+}
+
+// IsValid returns true if none of the gas schedule versions has errors
+func (vr *ValidationReport) IsValid() bool {
+	for _, version := range vr.Versions {
+		if len(version.Errors) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// KeyDiff holds the values of a gas schedule key in two schedule versions. The relative change is only computed
+// for keys present in both versions
+type KeyDiff struct {
+	Section        string  `json:"section"`
+	Key            string  `json:"key"`
+	OldValue       uint64  `json:"oldValue"`
+	NewValue       uint64  `json:"newValue"`
+	RelativeChange float64 `json:"relativeChange"`
+}
+
+// ScheduleDiff holds the differences between two gas schedule versions
+type ScheduleDiff struct {
+	OldVersion string     `json:"oldVersion"`
+	NewVersion string     `json:"newVersion"`
+	Added      []*KeyDiff `json:"added"`
+	Removed    []*KeyDiff `json:"removed"`
+	Changed    []*KeyDiff `json:"changed"`
+}
+
+// TxFeeReplay holds the gas and the fee of a stored transaction computed with two gas schedule versions. The gas
+// and fee values are only set for the replayed transactions
+type TxFeeReplay struct {
+	Hash       string   `json:"hash"`
+	Sender     string   `json:"sender"`
+	Receiver   string   `json:"receiver"`
+	Function   string   `json:"function,omitempty"`
+	Category   string   `json:"category"`
+	Replayed   bool     `json:"replayed"`
+	OldGasUsed uint64   `json:"oldGasUsed,omitempty"`
+	NewGasUsed uint64   `json:"newGasUsed,omitempty"`
+	OldFee     *big.Int `json:"oldFee,omitempty"`
+	NewFee     *big.Int `json:"newFee,omitempty"`
+	FeeDelta   *big.Int `json:"feeDelta,omitempty"`
+}
+
+// FeeReplayReport holds the result of replaying a transactions corpus against two gas schedule versions. The totals
+// only cover the replayed transactions. The not replayed ratio is computed out of the transactions whose fees depend
+// on the gas schedule, the move balance ones being left out, and a partial report is one dominated by not replayed
+// transactions
+type FeeReplayReport struct {
+	OldVersion       string         `json:"oldVersion"`
+	NewVersion       string         `json:"newVersion"`
+	Epoch            uint32         `json:"epoch"`
+	NumTransactions  int            `json:"numTransactions"`
+	NumReplayed      int            `json:"numReplayed"`
+	NumNotReplayed   int            `json:"numNotReplayed"`
+	NumChanged       int            `json:"numChanged"`
+	NotReplayedRatio float64        `json:"notReplayedRatio"`
+	Partial          bool           `json:"partial"`
+	TotalOldFee      *big.Int       `json:"totalOldFee"`
+	TotalNewFee      *big.Int       `json:"totalNewFee"`
+	TotalFeeDelta    *big.Int       `json:"totalFeeDelta"`
+	Transactions     []*TxFeeReplay `json:"transactions"`
+}
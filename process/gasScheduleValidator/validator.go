@@ -0,0 +1,337 @@
+package gasScheduleValidator
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	arwen12config "github.com/ElrondNetwork/arwen-wasm-vm/v1_2/config"
+	arwen13config "github.com/ElrondNetwork/arwen-wasm-vm/v1_3/config"
+	arwen14config "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var log = logger.GetOrCreate("process/gasScheduleValidator")
+
+const defaultArwenVersion = "v1.4"
+
+// gasCostComponent is a consumer of the gas schedule. Each struct field of the gas cost is a section of the gas
+// schedule and each field of a section is a key the consumer requires
+type gasCostComponent struct {
+	name    string
+	gasCost interface{}
+}
+
+var nodeGasCostComponents = []gasCostComponent{
+	{name: "built-in functions", gasCost: vmcommon.GasCost{}},
+	{name: "built-in functions fees", gasCost: process.GasCost{}},
+	{name: "system smart contracts", gasCost: vm.GasCost{}},
+}
+
+var arwenGasCostComponents = map[string]gasCostComponent{
+	"v1.2": {name: "arwen v1.2", gasCost: arwen12config.GasCost{}},
+	"v1.3": {name: "arwen v1.3", gasCost: arwen13config.GasCost{}},
+	"v1.4": {name: "arwen v1.4", gasCost: arwen14config.GasCost{}},
+}
+
+// ArgsGasScheduleValidator holds the arguments needed to create a gas schedule validator
+type ArgsGasScheduleValidator struct {
+	GasScheduleConfig config.GasScheduleConfig
+	ArwenVersions     []config.ArwenVersionByEpoch
+	ConfigDir         string
+}
+
+type gasScheduleValidator struct {
+	versions      []config.GasScheduleByEpochs
+	arwenVersions []config.ArwenVersionByEpoch
+	gasSchedules  map[string]map[string]map[string]uint64
+}
+
+// NewGasScheduleValidator loads all the configured gas schedule versions and creates a component able to check
+// them against the requirements of the node's gas consumers
+func NewGasScheduleValidator(args ArgsGasScheduleValidator) (*gasScheduleValidator, error) {
+	if len(args.GasScheduleConfig.GasScheduleByEpochs) == 0 {
+		return nil, ErrEmptyGasScheduleConfig
+	}
+	if len(args.ArwenVersions) == 0 {
+		return nil, ErrEmptyArwenVersions
+	}
+
+	gsv := &gasScheduleValidator{
+		versions:      make([]config.GasScheduleByEpochs, len(args.GasScheduleConfig.GasScheduleByEpochs)),
+		arwenVersions: make([]config.ArwenVersionByEpoch, len(args.ArwenVersions)),
+		gasSchedules:  make(map[string]map[string]map[string]uint64),
+	}
+	copy(gsv.versions, args.GasScheduleConfig.GasScheduleByEpochs)
+	copy(gsv.arwenVersions, args.ArwenVersions)
+	sort.Slice(gsv.versions, func(i, j int) bool {
+		return gsv.versions[i].StartEpoch < gsv.versions[j].StartEpoch
+	})
+	sort.Slice(gsv.arwenVersions, func(i, j int) bool {
+		return gsv.arwenVersions[i].StartEpoch < gsv.arwenVersions[j].StartEpoch
+	})
+
+	for _, version := range gsv.versions {
+		gasSchedule, err := LoadGasSchedule(filepath.Join(args.ConfigDir, version.FileName))
+		if err != nil {
+			return nil, err
+		}
+
+		gsv.gasSchedules[version.FileName] = gasSchedule
+	}
+
+	return gsv, nil
+}
+
+// Validate checks every gas schedule version against the requirements of the built-in functions, of the system
+// smart contracts and of each arwen version active while the gas schedule version is in use
+func (gsv *gasScheduleValidator) Validate() *ValidationReport {
+	report := &ValidationReport{
+		Versions: make([]*VersionReport, 0, len(gsv.versions)),
+	}
+
+	for idx, version := range gsv.versions {
+		endEpoch := uint32(math.MaxUint32)
+		if idx+1 < len(gsv.versions) {
+			endEpoch = computeEndEpoch(version.StartEpoch, gsv.versions[idx+1].StartEpoch)
+		}
+
+		versionReport := gsv.validateVersion(version, endEpoch)
+		log.Debug("gas schedule validated",
+			"file", version.FileName,
+			"num errors", len(versionReport.Errors),
+			"num warnings", len(versionReport.Warnings),
+		)
+		report.Versions = append(report.Versions, versionReport)
+	}
+
+	return report
+}
+
+func (gsv *gasScheduleValidator) validateVersion(version config.GasScheduleByEpochs, endEpoch uint32) *VersionReport {
+	gasSchedule := gsv.gasSchedules[version.FileName]
+	versionReport := &VersionReport{
+		FileName:      version.FileName,
+		StartEpoch:    version.StartEpoch,
+		EndEpoch:      endEpoch,
+		ArwenVersions: gsv.getArwenVersions(version.StartEpoch, endEpoch),
+		Errors:        make([]*Issue, 0),
+		Warnings:      make([]*Issue, 0),
+	}
+
+	components := make([]gasCostComponent, 0, len(nodeGasCostComponents)+len(versionReport.ArwenVersions))
+	components = append(components, nodeGasCostComponents...)
+	for _, arwenVersion := range versionReport.ArwenVersions {
+		component, found := arwenGasCostComponents[arwenVersion]
+		if !found {
+			versionReport.Warnings = append(versionReport.Warnings, &Issue{
+				Component: "arwen " + arwenVersion,
+				Message:   fmt.Sprintf("unknown arwen version, validated as %s", defaultArwenVersion),
+			})
+			component = arwenGasCostComponents[defaultArwenVersion]
+		}
+
+		components = append(components, component)
+	}
+
+	usedKeys := make(map[string]struct{})
+	for _, component := range components {
+		versionReport.Errors = append(versionReport.Errors, checkComponent(component, gasSchedule, usedKeys)...)
+	}
+	versionReport.Warnings = append(versionReport.Warnings, findUnusedKeys(gasSchedule, usedKeys)...)
+
+	return versionReport
+}
+
+// getArwenVersions returns the arwen versions active in the provided epochs interval
+func (gsv *gasScheduleValidator) getArwenVersions(startEpoch uint32, endEpoch uint32) []string {
+	versions := make([]string, 0)
+	for idx, arwenVersion := range gsv.arwenVersions {
+		arwenEndEpoch := uint32(math.MaxUint32)
+		if idx+1 < len(gsv.arwenVersions) {
+			arwenEndEpoch = computeEndEpoch(arwenVersion.StartEpoch, gsv.arwenVersions[idx+1].StartEpoch)
+		}
+
+		isFirstVersion := idx == 0
+		overlaps := (isFirstVersion || arwenVersion.StartEpoch <= endEpoch) && startEpoch <= arwenEndEpoch
+		if overlaps {
+			versions = append(versions, arwenVersion.Version)
+		}
+	}
+
+	return versions
+}
+
+func computeEndEpoch(startEpoch uint32, nextStartEpoch uint32) uint32 {
+	if nextStartEpoch <= startEpoch {
+		return startEpoch
+	}
+
+	return nextStartEpoch - 1
+}
+
+func checkComponent(
+	component gasCostComponent,
+	gasSchedule map[string]map[string]uint64,
+	usedKeys map[string]struct{},
+) []*Issue {
+	issues := make([]*Issue, 0)
+	gasCostType := reflect.TypeOf(component.gasCost)
+	for i := 0; i < gasCostType.NumField(); i++ {
+		sectionType := gasCostType.Field(i)
+		if sectionType.Type.Kind() != reflect.Struct {
+			continue
+		}
+
+		section := sectionType.Name
+		costs, found := gasSchedule[section]
+		if !found {
+			issues = append(issues, &Issue{
+				Component: component.name,
+				Section:   section,
+				Message:   "missing section",
+			})
+			continue
+		}
+
+		for j := 0; j < sectionType.Type.NumField(); j++ {
+			issue := checkKey(component.name, section, sectionType.Type.Field(j), costs, usedKeys)
+			if issue != nil {
+				issues = append(issues, issue)
+			}
+		}
+	}
+
+	return issues
+}
+
+func checkKey(
+	componentName string,
+	section string,
+	keyType reflect.StructField,
+	costs map[string]uint64,
+	usedKeys map[string]struct{},
+) *Issue {
+	key, value, found := findKey(costs, keyType.Name)
+	if !found {
+		return &Issue{
+			Component: componentName,
+			Section:   section,
+			Key:       keyType.Name,
+			Message:   "missing key",
+		}
+	}
+
+	usedKeys[section+"."+key] = struct{}{}
+	if value == 0 {
+		return &Issue{
+			Component: componentName,
+			Section:   section,
+			Key:       key,
+			Message:   "cost is set to 0",
+		}
+	}
+	if keyType.Type.Kind() == reflect.Uint32 && value > math.MaxUint32 {
+		return &Issue{
+			Component: componentName,
+			Section:   section,
+			Key:       key,
+			Message:   fmt.Sprintf("cost %d does not fit in 32 bits", value),
+		}
+	}
+
+	return nil
+}
+
+// findKey mirrors the mapstructure decoding used by the gas consumers: an exact match first, then a case
+// insensitive one
+func findKey(costs map[string]uint64, name string) (string, uint64, bool) {
+	value, found := costs[name]
+	if found {
+		return name, value, true
+	}
+
+	for key, value := range costs {
+		if strings.EqualFold(key, name) {
+			return key, value, true
+		}
+	}
+
+	return "", 0, false
+}
+
+func findUnusedKeys(gasSchedule map[string]map[string]uint64, usedKeys map[string]struct{}) []*Issue {
+	issues := make([]*Issue, 0)
+	for _, section := range sortedSections(gasSchedule) {
+		for _, key := range sortedKeys(gasSchedule[section]) {
+			_, isUsed := usedKeys[section+"."+key]
+			if isUsed {
+				continue
+			}
+
+			issues = append(issues, &Issue{
+				Section: section,
+				Key:     key,
+				Message: "key is not used by any gas consumer",
+			})
+		}
+	}
+
+	return issues
+}
+
+// GetGasSchedule returns the loaded gas schedule of the provided version
+func (gsv *gasScheduleValidator) GetGasSchedule(fileName string) (map[string]map[string]uint64, error) {
+	gasSchedule, found := gsv.gasSchedules[fileName]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrGasScheduleVersionNotFound, fileName)
+	}
+
+	return gasSchedule, nil
+}
+
+// GetUpgradeVersions returns the gas schedule version used before the provided epoch and the one starting at it
+func (gsv *gasScheduleValidator) GetUpgradeVersions(epoch uint32) (config.GasScheduleByEpochs, config.GasScheduleByEpochs, error) {
+	for idx := 1; idx < len(gsv.versions); idx++ {
+		if gsv.versions[idx].StartEpoch == epoch {
+			return gsv.versions[idx-1], gsv.versions[idx], nil
+		}
+	}
+
+	return config.GasScheduleByEpochs{}, config.GasScheduleByEpochs{}, fmt.Errorf("%w: %d", ErrNoGasScheduleUpgrade, epoch)
+}
+
+// LatestUpgradeEpoch returns the start epoch of the last configured gas schedule version
+func (gsv *gasScheduleValidator) LatestUpgradeEpoch() uint32 {
+	return gsv.versions[len(gsv.versions)-1].StartEpoch
+}
+
+// Diff returns the differences between the two provided gas schedule versions
+func (gsv *gasScheduleValidator) Diff(oldFileName string, newFileName string) (*ScheduleDiff, error) {
+	oldGasSchedule, err := gsv.GetGasSchedule(oldFileName)
+	if err != nil {
+		return nil, err
+	}
+	newGasSchedule, err := gsv.GetGasSchedule(newFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := DiffGasSchedules(oldGasSchedule, newGasSchedule)
+	diff.OldVersion = oldFileName
+	diff.NewVersion = newFileName
+
+	return diff, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gsv *gasScheduleValidator) IsInterfaceNil() bool {
+	return gsv == nil
+}
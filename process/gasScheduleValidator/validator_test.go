@@ -0,0 +1,155 @@
+package gasScheduleValidator
+
+import (
+	"errors"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const gasSchedulesDir = "../../cmd/node/config/gasSchedules"
+
+func createMockArgsGasScheduleValidator() ArgsGasScheduleValidator {
+	return ArgsGasScheduleValidator{
+		GasScheduleConfig: config.GasScheduleConfig{
+			GasScheduleByEpochs: []config.GasScheduleByEpochs{
+				{StartEpoch: 4, FileName: "gasScheduleV4.toml"},
+				{StartEpoch: 0, FileName: "gasScheduleV3.toml"},
+			},
+		},
+		ArwenVersions: []config.ArwenVersionByEpoch{
+			{StartEpoch: 0, Version: "v1.3"},
+			{StartEpoch: 4, Version: "v1.4"},
+		},
+		ConfigDir: gasSchedulesDir,
+	}
+}
+
+func TestNewGasScheduleValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty gas schedule config", func(t *testing.T) {
+		args := createMockArgsGasScheduleValidator()
+		args.GasScheduleConfig.GasScheduleByEpochs = nil
+		gsv, err := NewGasScheduleValidator(args)
+		assert.Nil(t, gsv)
+		assert.Equal(t, ErrEmptyGasScheduleConfig, err)
+	})
+	t.Run("empty arwen versions", func(t *testing.T) {
+		args := createMockArgsGasScheduleValidator()
+		args.ArwenVersions = nil
+		gsv, err := NewGasScheduleValidator(args)
+		assert.Nil(t, gsv)
+		assert.Equal(t, ErrEmptyArwenVersions, err)
+	})
+	t.Run("missing file", func(t *testing.T) {
+		args := createMockArgsGasScheduleValidator()
+		args.GasScheduleConfig.GasScheduleByEpochs[0].FileName = "missing.toml"
+		gsv, err := NewGasScheduleValidator(args)
+		assert.Nil(t, gsv)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		gsv, err := NewGasScheduleValidator(createMockArgsGasScheduleValidator())
+		assert.Nil(t, err)
+		assert.False(t, gsv.IsInterfaceNil())
+		assert.Equal(t, uint32(4), gsv.LatestUpgradeEpoch())
+	})
+}
+
+func TestGasScheduleValidator_ValidateShippedSchedulesShouldBeValid(t *testing.T) {
+	t.Parallel()
+
+	gsv, err := NewGasScheduleValidator(createMockArgsGasScheduleValidator())
+	require.Nil(t, err)
+
+	report := gsv.Validate()
+	require.Equal(t, 2, len(report.Versions))
+	assert.True(t, report.IsValid())
+
+	assert.Equal(t, "gasScheduleV3.toml", report.Versions[0].FileName)
+	assert.Equal(t, uint32(3), report.Versions[0].EndEpoch)
+	assert.Equal(t, []string{"v1.3"}, report.Versions[0].ArwenVersions)
+	assert.Equal(t, "gasScheduleV4.toml", report.Versions[1].FileName)
+	assert.Equal(t, uint32(math.MaxUint32), report.Versions[1].EndEpoch)
+	assert.Equal(t, []string{"v1.4"}, report.Versions[1].ArwenVersions)
+}
+
+func TestGasScheduleValidator_ValidateShouldReportMissingAndZeroKeys(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	content := `[BuiltInCost]
+    ChangeOwnerAddress = 0
+[MetaChainSystemSCsCost]
+    Stake = 5000000
+    UnusedCost = 10
+`
+	err := ioutil.WriteFile(filepath.Join(dir, "broken.toml"), []byte(content), 0644)
+	require.Nil(t, err)
+
+	args := createMockArgsGasScheduleValidator()
+	args.ConfigDir = dir
+	args.GasScheduleConfig.GasScheduleByEpochs = []config.GasScheduleByEpochs{{StartEpoch: 0, FileName: "broken.toml"}}
+	args.ArwenVersions = []config.ArwenVersionByEpoch{{StartEpoch: 0, Version: "v2.0"}}
+	gsv, err := NewGasScheduleValidator(args)
+	require.Nil(t, err)
+
+	report := gsv.Validate()
+	require.Equal(t, 1, len(report.Versions))
+	assert.False(t, report.IsValid())
+
+	versionReport := report.Versions[0]
+	assert.True(t, containsIssue(versionReport.Errors, "BaseOperationCost", "", "missing section"))
+	assert.True(t, containsIssue(versionReport.Errors, "BuiltInCost", "ChangeOwnerAddress", "cost is set to 0"))
+	assert.True(t, containsIssue(versionReport.Errors, "BuiltInCost", "ClaimDeveloperRewards", "missing key"))
+	assert.False(t, containsIssue(versionReport.Errors, "MetaChainSystemSCsCost", "Stake", "missing key"))
+	assert.True(t, containsIssue(versionReport.Warnings, "MetaChainSystemSCsCost", "UnusedCost", "key is not used by any gas consumer"))
+	assert.True(t, containsIssue(versionReport.Warnings, "", "", "unknown arwen version, validated as v1.4"))
+}
+
+func TestGasScheduleValidator_GetUpgradeVersions(t *testing.T) {
+	t.Parallel()
+
+	gsv, _ := NewGasScheduleValidator(createMockArgsGasScheduleValidator())
+
+	oldVersion, newVersion, err := gsv.GetUpgradeVersions(4)
+	assert.Nil(t, err)
+	assert.Equal(t, "gasScheduleV3.toml", oldVersion.FileName)
+	assert.Equal(t, "gasScheduleV4.toml", newVersion.FileName)
+
+	_, _, err = gsv.GetUpgradeVersions(3)
+	assert.True(t, errors.Is(err, ErrNoGasScheduleUpgrade))
+}
+
+func TestGasScheduleValidator_Diff(t *testing.T) {
+	t.Parallel()
+
+	gsv, _ := NewGasScheduleValidator(createMockArgsGasScheduleValidator())
+
+	diff, err := gsv.Diff("gasScheduleV3.toml", "missing.toml")
+	assert.Nil(t, diff)
+	assert.True(t, errors.Is(err, ErrGasScheduleVersionNotFound))
+
+	diff, err = gsv.Diff("gasScheduleV3.toml", "gasScheduleV4.toml")
+	require.Nil(t, err)
+	assert.Equal(t, "gasScheduleV3.toml", diff.OldVersion)
+	assert.Equal(t, "gasScheduleV4.toml", diff.NewVersion)
+	assert.True(t, len(diff.Changed) > 0)
+	assert.Equal(t, 0, len(diff.Removed))
+}
+
+func containsIssue(issues []*Issue, section string, key string, message string) bool {
+	for _, issue := range issues {
+		if issue.Section == section && issue.Key == key && issue.Message == message {
+			return true
+		}
+	}
+
+	return false
+}
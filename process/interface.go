@@ -1089,3 +1089,12 @@ type CurrentNetworkEpochProviderHandler interface {
 	EpochIsActiveInNetwork(epoch uint32) bool
 	IsInterfaceNil() bool
 }
+
+// LoadMonitor defines the behavior of a component that gathers the node load signals used by the adaptive antiflood
+type LoadMonitor interface {
+	SetQueueDepth(queueDepth uint32)
+	SetCpuLoadPercent(cpuLoadPercent uint64)
+	SetBlockProcessingTime(duration time.Duration)
+	Load() float64
+	IsInterfaceNil() bool
+}
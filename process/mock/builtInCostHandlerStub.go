@@ -6,15 +6,25 @@ import (
 
 // BuiltInCostHandlerStub -
 type BuiltInCostHandlerStub struct {
+	ComputeBuiltInCostCalled func(tx data.TransactionWithFeeHandler) uint64
+	IsBuiltInFuncCallCalled  func(tx data.TransactionWithFeeHandler) bool
 }
 
 // ComputeBuiltInCost -
-func (b *BuiltInCostHandlerStub) ComputeBuiltInCost(_ data.TransactionWithFeeHandler) uint64 {
+func (b *BuiltInCostHandlerStub) ComputeBuiltInCost(tx data.TransactionWithFeeHandler) uint64 {
+	if b.ComputeBuiltInCostCalled != nil {
+		return b.ComputeBuiltInCostCalled(tx)
+	}
+
 	return 1
 }
 
 // IsBuiltInFuncCall -
-func (b *BuiltInCostHandlerStub) IsBuiltInFuncCall(_ data.TransactionWithFeeHandler) bool {
+func (b *BuiltInCostHandlerStub) IsBuiltInFuncCall(tx data.TransactionWithFeeHandler) bool {
+	if b.IsBuiltInFuncCallCalled != nil {
+		return b.IsBuiltInFuncCallCalled(tx)
+	}
+
 	return false
 }
 
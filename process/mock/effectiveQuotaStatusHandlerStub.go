@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go-core/core"
+
+// EffectiveQuotaStatusHandlerStub -
+type EffectiveQuotaStatusHandlerStub struct {
+	SetEffectiveQuotaCalled func(peerType core.P2PPeerType, maxNumMessages uint32, maxTotalSize uint64)
+}
+
+// SetEffectiveQuota -
+func (eqshs *EffectiveQuotaStatusHandlerStub) SetEffectiveQuota(peerType core.P2PPeerType, maxNumMessages uint32, maxTotalSize uint64) {
+	if eqshs.SetEffectiveQuotaCalled != nil {
+		eqshs.SetEffectiveQuotaCalled(peerType, maxNumMessages, maxTotalSize)
+	}
+}
+
+// IsInterfaceNil -
+func (eqshs *EffectiveQuotaStatusHandlerStub) IsInterfaceNil() bool {
+	return eqshs == nil
+}
@@ -11,6 +11,8 @@ type FeeHandlerStub struct {
 	SetMaxGasLimitPerBlockCalled                   func(maxGasLimitPerBlock uint64)
 	SetMinGasPriceCalled                           func(minGasPrice uint64)
 	SetMinGasLimitCalled                           func(minGasLimit uint64)
+	MinGasLimitCalled                              func() uint64
+	GasPerDataByteCalled                           func() uint64
 	MaxGasLimitPerBlockCalled                      func() uint64
 	MaxGasLimitPerMiniBlockCalled                  func() uint64
 	MaxGasLimitPerBlockForSafeCrossShardCalled     func() uint64
@@ -67,6 +69,10 @@ func (fhs *FeeHandlerStub) MinGasPrice() uint64 {
 
 // MinGasLimit will return min gas limit
 func (fhs *FeeHandlerStub) MinGasLimit() uint64 {
+	if fhs.MinGasLimitCalled != nil {
+		return fhs.MinGasLimitCalled()
+	}
+
 	return 0
 }
 
@@ -81,6 +87,10 @@ func (fhs *FeeHandlerStub) DeveloperPercentage() float64 {
 
 // GasPerDataByte -
 func (fhs *FeeHandlerStub) GasPerDataByte() uint64 {
+	if fhs.GasPerDataByteCalled != nil {
+		return fhs.GasPerDataByteCalled()
+	}
+
 	return 0
 }
 
@@ -4,6 +4,7 @@ import "github.com/ElrondNetwork/elrond-go-core/core"
 
 // GasScheduleNotifierMock -
 type GasScheduleNotifierMock struct {
+	GasScheduleVersion          string
 	GasSchedule                 map[string]map[string]uint64
 	RegisterNotifyHandlerCalled func(handler core.GasScheduleSubscribeHandler)
 }
@@ -31,6 +32,11 @@ func (g *GasScheduleNotifierMock) LatestGasSchedule() map[string]map[string]uint
 	return g.GasSchedule
 }
 
+// LatestGasScheduleVersion -
+func (g *GasScheduleNotifierMock) LatestGasScheduleVersion() string {
+	return g.GasScheduleVersion
+}
+
 // UnRegisterAll -
 func (g *GasScheduleNotifierMock) UnRegisterAll() {
 }
@@ -0,0 +1,20 @@
+package mock
+
+// LoadProviderStub -
+type LoadProviderStub struct {
+	LoadCalled func() float64
+}
+
+// Load -
+func (lps *LoadProviderStub) Load() float64 {
+	if lps.LoadCalled != nil {
+		return lps.LoadCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (lps *LoadProviderStub) IsInterfaceNil() bool {
+	return lps == nil
+}
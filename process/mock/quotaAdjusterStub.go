@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go-core/core"
+
+// QuotaAdjusterStub -
+type QuotaAdjusterStub struct {
+	QuotaMultiplierCalled func(peerType core.P2PPeerType) float32
+}
+
+// QuotaMultiplier -
+func (qas *QuotaAdjusterStub) QuotaMultiplier(peerType core.P2PPeerType) float32 {
+	if qas.QuotaMultiplierCalled != nil {
+		return qas.QuotaMultiplierCalled(peerType)
+	}
+
+	return 1
+}
+
+// IsInterfaceNil -
+func (qas *QuotaAdjusterStub) IsInterfaceNil() bool {
+	return qas == nil
+}
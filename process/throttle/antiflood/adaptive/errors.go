@@ -0,0 +1,6 @@
+package adaptive
+
+import "errors"
+
+// ErrNilLoadProvider signals that a nil load provider has been provided
+var ErrNilLoadProvider = errors.New("nil load provider")
@@ -0,0 +1,8 @@
+package adaptive
+
+// LoadProvider defines the behavior of a component able to provide the current node load as a value in the [0, 1]
+// interval
+type LoadProvider interface {
+	Load() float64
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,91 @@
+package adaptive
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgLoadMonitor defines the arguments for a load monitor
+type ArgLoadMonitor struct {
+	MaxQueueDepth          uint32
+	MaxCpuLoadPercent      uint64
+	MaxBlockProcessingTime time.Duration
+}
+
+// loadMonitor gathers the load signals of the node (the depth of the interceptors processing queue, the CPU load
+// and the block processing time) and normalizes them in a single load value
+type loadMonitor struct {
+	mutLoad                sync.RWMutex
+	maxQueueDepth          uint32
+	maxCpuLoadPercent      uint64
+	maxBlockProcessingTime time.Duration
+	queueDepth             uint32
+	cpuLoadPercent         uint64
+	blockProcessingTime    time.Duration
+}
+
+// NewLoadMonitor creates a new load monitor instance
+func NewLoadMonitor(arg ArgLoadMonitor) (*loadMonitor, error) {
+	if arg.MaxQueueDepth == 0 {
+		return nil, fmt.Errorf("%w, maxQueueDepth == 0", process.ErrInvalidValue)
+	}
+	if arg.MaxCpuLoadPercent == 0 || arg.MaxCpuLoadPercent > 100 {
+		return nil, fmt.Errorf("%w, maxCpuLoadPercent: provided %d, should be in (0, 100]",
+			process.ErrInvalidValue,
+			arg.MaxCpuLoadPercent,
+		)
+	}
+	if arg.MaxBlockProcessingTime <= 0 {
+		return nil, fmt.Errorf("%w, maxBlockProcessingTime: provided %v", process.ErrInvalidValue, arg.MaxBlockProcessingTime)
+	}
+
+	return &loadMonitor{
+		maxQueueDepth:          arg.MaxQueueDepth,
+		maxCpuLoadPercent:      arg.MaxCpuLoadPercent,
+		maxBlockProcessingTime: arg.MaxBlockProcessingTime,
+	}, nil
+}
+
+// SetQueueDepth sets the current number of messages in the interceptors processing queue
+func (lm *loadMonitor) SetQueueDepth(queueDepth uint32) {
+	lm.mutLoad.Lock()
+	lm.queueDepth = queueDepth
+	lm.mutLoad.Unlock()
+}
+
+// SetCpuLoadPercent sets the current CPU load
+func (lm *loadMonitor) SetCpuLoadPercent(cpuLoadPercent uint64) {
+	lm.mutLoad.Lock()
+	lm.cpuLoadPercent = cpuLoadPercent
+	lm.mutLoad.Unlock()
+}
+
+// SetBlockProcessingTime sets the duration of the last block processing
+func (lm *loadMonitor) SetBlockProcessingTime(duration time.Duration) {
+	lm.mutLoad.Lock()
+	lm.blockProcessingTime = duration
+	lm.mutLoad.Unlock()
+}
+
+// Load returns the maximum between the normalized queue depth, CPU load and block processing time, in the [0, 1] interval
+func (lm *loadMonitor) Load() float64 {
+	lm.mutLoad.RLock()
+	defer lm.mutLoad.RUnlock()
+
+	queueLoad := float64(lm.queueDepth) / float64(lm.maxQueueDepth)
+	cpuLoad := float64(lm.cpuLoadPercent) / float64(lm.maxCpuLoadPercent)
+	blockProcessingLoad := float64(lm.blockProcessingTime) / float64(lm.maxBlockProcessingTime)
+
+	load := math.Max(queueLoad, math.Max(cpuLoad, blockProcessingLoad))
+
+	return math.Min(math.Max(load, 0), 1)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lm *loadMonitor) IsInterfaceNil() bool {
+	return lm == nil
+}
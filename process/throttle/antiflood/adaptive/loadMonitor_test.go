@@ -0,0 +1,68 @@
+package adaptive
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgLoadMonitor() ArgLoadMonitor {
+	return ArgLoadMonitor{
+		MaxQueueDepth:          1000,
+		MaxCpuLoadPercent:      80,
+		MaxBlockProcessingTime: time.Second,
+	}
+}
+
+func TestNewLoadMonitor_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgLoadMonitor()
+	arg.MaxQueueDepth = 0
+	lm, err := NewLoadMonitor(arg)
+	assert.True(t, check.IfNil(lm))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	arg = createMockArgLoadMonitor()
+	arg.MaxCpuLoadPercent = 101
+	lm, err = NewLoadMonitor(arg)
+	assert.True(t, check.IfNil(lm))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	arg = createMockArgLoadMonitor()
+	arg.MaxBlockProcessingTime = 0
+	lm, err = NewLoadMonitor(arg)
+	assert.True(t, check.IfNil(lm))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewLoadMonitor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	lm, err := NewLoadMonitor(createMockArgLoadMonitor())
+	assert.False(t, check.IfNil(lm))
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, lm.Load())
+}
+
+func TestLoadMonitor_LoadShouldReturnTheMaximumNormalizedValue(t *testing.T) {
+	t.Parallel()
+
+	lm, _ := NewLoadMonitor(createMockArgLoadMonitor())
+
+	lm.SetQueueDepth(250)
+	assert.Equal(t, 0.25, lm.Load())
+
+	lm.SetCpuLoadPercent(40)
+	assert.Equal(t, 0.5, lm.Load())
+
+	lm.SetBlockProcessingTime(time.Millisecond * 750)
+	assert.Equal(t, 0.75, lm.Load())
+
+	lm.SetCpuLoadPercent(100)
+	assert.Equal(t, 1.0, lm.Load())
+}
@@ -0,0 +1,55 @@
+package adaptive
+
+import (
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// queueDepthThrottler wraps the throttler used by the interceptors and reports to the load monitor the number of
+// messages that are being processed at any given time
+type queueDepthThrottler struct {
+	process.InterceptorThrottler
+	loadMonitor  process.LoadMonitor
+	numInProcess int32
+}
+
+// NewQueueDepthThrottler creates a new interceptor throttler that reports the processing queue depth
+func NewQueueDepthThrottler(throttler process.InterceptorThrottler, loadMonitor process.LoadMonitor) (*queueDepthThrottler, error) {
+	if check.IfNil(throttler) {
+		return nil, process.ErrNilInterceptorThrottler
+	}
+	if check.IfNil(loadMonitor) {
+		return nil, process.ErrNilLoadMonitor
+	}
+
+	return &queueDepthThrottler{
+		InterceptorThrottler: throttler,
+		loadMonitor:          loadMonitor,
+	}, nil
+}
+
+// StartProcessing notifies the wrapped throttler and reports the increased queue depth
+func (qdt *queueDepthThrottler) StartProcessing() {
+	qdt.InterceptorThrottler.StartProcessing()
+
+	numInProcess := atomic.AddInt32(&qdt.numInProcess, 1)
+	qdt.loadMonitor.SetQueueDepth(uint32(numInProcess))
+}
+
+// EndProcessing notifies the wrapped throttler and reports the decreased queue depth
+func (qdt *queueDepthThrottler) EndProcessing() {
+	qdt.InterceptorThrottler.EndProcessing()
+
+	numInProcess := atomic.AddInt32(&qdt.numInProcess, -1)
+	if numInProcess < 0 {
+		numInProcess = 0
+	}
+	qdt.loadMonitor.SetQueueDepth(uint32(numInProcess))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qdt *queueDepthThrottler) IsInterfaceNil() bool {
+	return qdt == nil
+}
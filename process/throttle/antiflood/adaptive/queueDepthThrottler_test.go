@@ -0,0 +1,61 @@
+package adaptive
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQueueDepthThrottler_NilThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	qdt, err := NewQueueDepthThrottler(nil, &testscommon.LoadMonitorStub{})
+	assert.True(t, check.IfNil(qdt))
+	assert.Equal(t, process.ErrNilInterceptorThrottler, err)
+}
+
+func TestNewQueueDepthThrottler_NilLoadMonitorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	qdt, err := NewQueueDepthThrottler(&mock.InterceptorThrottlerStub{}, nil)
+	assert.True(t, check.IfNil(qdt))
+	assert.Equal(t, process.ErrNilLoadMonitor, err)
+}
+
+func TestQueueDepthThrottler_ShouldReportTheNumberOfMessagesInProcess(t *testing.T) {
+	t.Parallel()
+
+	mutQueueDepths := sync.Mutex{}
+	queueDepths := make([]uint32, 0)
+	throttler := &mock.InterceptorThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	qdt, err := NewQueueDepthThrottler(throttler, &testscommon.LoadMonitorStub{
+		SetQueueDepthCalled: func(queueDepth uint32) {
+			mutQueueDepths.Lock()
+			queueDepths = append(queueDepths, queueDepth)
+			mutQueueDepths.Unlock()
+		},
+	})
+	assert.False(t, check.IfNil(qdt))
+	assert.Nil(t, err)
+
+	assert.True(t, qdt.CanProcess())
+	qdt.StartProcessing()
+	qdt.StartProcessing()
+	qdt.EndProcessing()
+	qdt.StartProcessing()
+	qdt.EndProcessing()
+	qdt.EndProcessing()
+
+	assert.Equal(t, []uint32{1, 2, 1, 2, 1, 0}, queueDepths)
+	assert.Equal(t, int32(3), throttler.StartProcessingCount())
+	assert.Equal(t, int32(3), throttler.EndProcessingCount())
+}
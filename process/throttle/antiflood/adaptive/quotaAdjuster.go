@@ -0,0 +1,141 @@
+package adaptive
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("process/throttle/antiflood/adaptive")
+
+// ArgQuotaAdjuster defines the arguments for an adaptive quota adjuster
+type ArgQuotaAdjuster struct {
+	LoadProvider   LoadProvider
+	ValidatorCurve config.AdaptiveQuotaCurveConfig
+	ObserverCurve  config.AdaptiveQuotaCurveConfig
+	UnknownCurve   config.AdaptiveQuotaCurveConfig
+}
+
+// quotaAdjuster computes, from the current node load, the multipliers applied on the configured per-peer quotas.
+// Each peer class has its own curve so validators can be favored over observers and unknown peers when the node is busy
+type quotaAdjuster struct {
+	loadProvider   LoadProvider
+	curves         map[core.P2PPeerType]config.AdaptiveQuotaCurveConfig
+	mutMultipliers sync.RWMutex
+	multipliers    map[core.P2PPeerType]float32
+	lastLoad       float64
+}
+
+// NewQuotaAdjuster creates a new adaptive quota adjuster
+func NewQuotaAdjuster(arg ArgQuotaAdjuster) (*quotaAdjuster, error) {
+	if check.IfNil(arg.LoadProvider) {
+		return nil, ErrNilLoadProvider
+	}
+
+	curves := map[core.P2PPeerType]config.AdaptiveQuotaCurveConfig{
+		core.ValidatorPeer: arg.ValidatorCurve,
+		core.ObserverPeer:  arg.ObserverCurve,
+		core.UnknownPeer:   arg.UnknownCurve,
+	}
+	for peerType, curve := range curves {
+		err := checkCurve(curve)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s curve", err, peerType.String())
+		}
+	}
+
+	qa := &quotaAdjuster{
+		loadProvider: arg.LoadProvider,
+		curves:       curves,
+		multipliers:  make(map[core.P2PPeerType]float32),
+	}
+	qa.Update()
+
+	return qa, nil
+}
+
+func checkCurve(curve config.AdaptiveQuotaCurveConfig) error {
+	if curve.MinMultiplier <= 0 {
+		return fmt.Errorf("%w, minMultiplier: provided %0.3f, should be positive",
+			process.ErrInvalidValue,
+			curve.MinMultiplier,
+		)
+	}
+	if curve.MaxMultiplier < curve.MinMultiplier {
+		return fmt.Errorf("%w, maxMultiplier: provided %0.3f, lower than minMultiplier %0.3f",
+			process.ErrInvalidValue,
+			curve.MaxMultiplier,
+			curve.MinMultiplier,
+		)
+	}
+	if curve.Exponent <= 0 {
+		return fmt.Errorf("%w, exponent: provided %0.3f, should be positive",
+			process.ErrInvalidValue,
+			curve.Exponent,
+		)
+	}
+
+	return nil
+}
+
+// Update reads the current load and recomputes the multipliers for all peer classes
+func (qa *quotaAdjuster) Update() {
+	load := qa.loadProvider.Load()
+	multipliers := make(map[core.P2PPeerType]float32, len(qa.curves))
+	for peerType, curve := range qa.curves {
+		multipliers[peerType] = computeMultiplier(curve, load)
+	}
+
+	qa.mutMultipliers.Lock()
+	qa.multipliers = multipliers
+	qa.lastLoad = load
+	qa.mutMultipliers.Unlock()
+
+	log.Trace("quotaAdjuster.Update",
+		"load", load,
+		"validators", multipliers[core.ValidatorPeer],
+		"observers", multipliers[core.ObserverPeer],
+		"unknown", multipliers[core.UnknownPeer],
+	)
+}
+
+// computeMultiplier returns max - (max - min) * load^exponent so an idle node applies the maximum multiplier and a
+// fully loaded one applies the minimum multiplier
+func computeMultiplier(curve config.AdaptiveQuotaCurveConfig, load float64) float32 {
+	scaledLoad := math.Pow(load, float64(curve.Exponent))
+	multiplier := float64(curve.MaxMultiplier) - float64(curve.MaxMultiplier-curve.MinMultiplier)*scaledLoad
+
+	return float32(multiplier)
+}
+
+// QuotaMultiplier returns the current multiplier for the provided peer type
+func (qa *quotaAdjuster) QuotaMultiplier(peerType core.P2PPeerType) float32 {
+	qa.mutMultipliers.RLock()
+	defer qa.mutMultipliers.RUnlock()
+
+	multiplier, ok := qa.multipliers[peerType]
+	if !ok {
+		return qa.multipliers[core.UnknownPeer]
+	}
+
+	return multiplier
+}
+
+// Load returns the load value used in the last update
+func (qa *quotaAdjuster) Load() float64 {
+	qa.mutMultipliers.RLock()
+	defer qa.mutMultipliers.RUnlock()
+
+	return qa.lastLoad
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qa *quotaAdjuster) IsInterfaceNil() bool {
+	return qa == nil
+}
@@ -0,0 +1,118 @@
+package adaptive
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgQuotaAdjuster() ArgQuotaAdjuster {
+	return ArgQuotaAdjuster{
+		LoadProvider: &mock.LoadProviderStub{},
+		ValidatorCurve: config.AdaptiveQuotaCurveConfig{
+			MinMultiplier: 1,
+			MaxMultiplier: 2,
+			Exponent:      1,
+		},
+		ObserverCurve: config.AdaptiveQuotaCurveConfig{
+			MinMultiplier: 0.5,
+			MaxMultiplier: 1,
+			Exponent:      1,
+		},
+		UnknownCurve: config.AdaptiveQuotaCurveConfig{
+			MinMultiplier: 0.2,
+			MaxMultiplier: 1,
+			Exponent:      2,
+		},
+	}
+}
+
+func TestNewQuotaAdjuster_NilLoadProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgQuotaAdjuster()
+	arg.LoadProvider = nil
+	qa, err := NewQuotaAdjuster(arg)
+
+	assert.True(t, check.IfNil(qa))
+	assert.Equal(t, ErrNilLoadProvider, err)
+}
+
+func TestNewQuotaAdjuster_InvalidCurvesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgQuotaAdjuster()
+	arg.ValidatorCurve.MinMultiplier = 0
+	qa, err := NewQuotaAdjuster(arg)
+	assert.True(t, check.IfNil(qa))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	arg = createMockArgQuotaAdjuster()
+	arg.ObserverCurve.MaxMultiplier = 0.1
+	qa, err = NewQuotaAdjuster(arg)
+	assert.True(t, check.IfNil(qa))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	arg = createMockArgQuotaAdjuster()
+	arg.UnknownCurve.Exponent = 0
+	qa, err = NewQuotaAdjuster(arg)
+	assert.True(t, check.IfNil(qa))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewQuotaAdjuster_ShouldComputeInitialMultipliers(t *testing.T) {
+	t.Parallel()
+
+	qa, err := NewQuotaAdjuster(createMockArgQuotaAdjuster())
+	assert.False(t, check.IfNil(qa))
+	assert.Nil(t, err)
+
+	assert.Equal(t, float32(2), qa.QuotaMultiplier(core.ValidatorPeer))
+	assert.Equal(t, float32(1), qa.QuotaMultiplier(core.ObserverPeer))
+	assert.Equal(t, float32(1), qa.QuotaMultiplier(core.UnknownPeer))
+}
+
+func TestQuotaAdjuster_UpdateShouldApplyCurvesPerPeerType(t *testing.T) {
+	t.Parallel()
+
+	load := 0.5
+	arg := createMockArgQuotaAdjuster()
+	arg.LoadProvider = &mock.LoadProviderStub{
+		LoadCalled: func() float64 {
+			return load
+		},
+	}
+	qa, _ := NewQuotaAdjuster(arg)
+
+	assert.Equal(t, 0.5, qa.Load())
+	assert.Equal(t, float32(1.5), qa.QuotaMultiplier(core.ValidatorPeer))
+	assert.Equal(t, float32(0.75), qa.QuotaMultiplier(core.ObserverPeer))
+	assert.InDelta(t, 0.8, qa.QuotaMultiplier(core.UnknownPeer), 0.0001)
+
+	load = 1
+	qa.Update()
+
+	assert.Equal(t, float32(1), qa.QuotaMultiplier(core.ValidatorPeer))
+	assert.Equal(t, float32(0.5), qa.QuotaMultiplier(core.ObserverPeer))
+	assert.InDelta(t, 0.2, qa.QuotaMultiplier(core.UnknownPeer), 0.0001)
+}
+
+func TestQuotaAdjuster_QuotaMultiplierForNotConfiguredTypeShouldReturnUnknown(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgQuotaAdjuster()
+	arg.LoadProvider = &mock.LoadProviderStub{
+		LoadCalled: func() float64 {
+			return 1
+		},
+	}
+	qa, _ := NewQuotaAdjuster(arg)
+
+	assert.InDelta(t, 0.2, qa.QuotaMultiplier(core.P2PPeerType(100)), 0.0001)
+}
@@ -0,0 +1,29 @@
+package disabled
+
+import "time"
+
+// LoadMonitor is a disabled load monitor
+type LoadMonitor struct {
+}
+
+// SetQueueDepth does nothing
+func (lm *LoadMonitor) SetQueueDepth(_ uint32) {
+}
+
+// SetCpuLoadPercent does nothing
+func (lm *LoadMonitor) SetCpuLoadPercent(_ uint64) {
+}
+
+// SetBlockProcessingTime does nothing
+func (lm *LoadMonitor) SetBlockProcessingTime(_ time.Duration) {
+}
+
+// Load returns 0
+func (lm *LoadMonitor) Load() float64 {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lm *LoadMonitor) IsInterfaceNil() bool {
+	return lm == nil
+}
@@ -0,0 +1,19 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-go-core/core"
+
+const neutralMultiplier = 1.0
+
+// QuotaAdjuster is a disabled quota adjuster that does not alter the configured quotas
+type QuotaAdjuster struct {
+}
+
+// QuotaMultiplier returns the neutral multiplier for all peer types
+func (qa *QuotaAdjuster) QuotaMultiplier(_ core.P2PPeerType) float32 {
+	return neutralMultiplier
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qa *QuotaAdjuster) IsInterfaceNil() bool {
+	return qa == nil
+}
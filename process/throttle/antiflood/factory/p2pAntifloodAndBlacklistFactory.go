@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/adaptive"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
@@ -31,6 +32,10 @@ const outputIdentifier = "output"
 
 var durationSweepP2PBlacklist = time.Second * 5
 
+type quotaUpdater interface {
+	Update()
+}
+
 // AntiFloodComponents holds the handlers for the anti-flood and blacklist mechanisms
 type AntiFloodComponents struct {
 	AntiFloodHandler process.P2PAntifloodHandler
@@ -38,6 +43,7 @@ type AntiFloodComponents struct {
 	FloodPreventers  []process.FloodPreventer
 	TopicPreventer   process.TopicFloodPreventer
 	PubKeysCacher    process.TimeCacher
+	LoadMonitor      process.LoadMonitor
 }
 
 // NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config
@@ -55,6 +61,7 @@ func NewP2PAntiFloodComponents(ctx context.Context, config config.Config, status
 		FloodPreventers:  make([]process.FloodPreventer, 0),
 		TopicPreventer:   disabled.NewNilTopicFloodPreventer(),
 		PubKeysCacher:    &disabled.TimeCache{},
+		LoadMonitor:      &disabled.LoadMonitor{},
 	}, nil
 }
 
@@ -72,6 +79,11 @@ func initP2PAntiFloodComponents(
 
 	publicKeysCache := timecache.NewTimeCache(defaultSpan)
 
+	adaptiveComponents, err := createAdaptiveComponents(ctx, mainConfig.Antiflood.Adaptive)
+	if err != nil {
+		return nil, fmt.Errorf("%w when creating adaptive antiflood components", err)
+	}
+
 	fastReactingFloodPreventer, err := createFloodPreventer(
 		ctx,
 		mainConfig.Antiflood.FastReacting,
@@ -80,6 +92,7 @@ func initP2PAntiFloodComponents(
 		fastReactingIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		adaptiveComponents.quotaAdjuster,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
@@ -93,6 +106,7 @@ func initP2PAntiFloodComponents(
 		slowReactingIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		adaptiveComponents.quotaAdjuster,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
@@ -106,6 +120,7 @@ func initP2PAntiFloodComponents(
 		outOfSpecsIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		adaptiveComponents.quotaAdjuster,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w when creating out of specs flood preventer", err)
@@ -143,9 +158,78 @@ func initP2PAntiFloodComponents(
 			outOfSpecsFloodPreventer,
 		},
 		TopicPreventer: topicFloodPreventer,
+		LoadMonitor:    adaptiveComponents.loadMonitor,
+	}, nil
+}
+
+type adaptiveAntifloodComponents struct {
+	loadMonitor   process.LoadMonitor
+	quotaAdjuster floodPreventers.QuotaAdjuster
+}
+
+func createAdaptiveComponents(ctx context.Context, adaptiveConfig config.AdaptiveAntifloodConfig) (*adaptiveAntifloodComponents, error) {
+	if !adaptiveConfig.Enabled {
+		return &adaptiveAntifloodComponents{
+			loadMonitor:   &disabled.LoadMonitor{},
+			quotaAdjuster: &disabled.QuotaAdjuster{},
+		}, nil
+	}
+
+	if adaptiveConfig.EvaluationIntervalInMilliseconds == 0 {
+		return nil, fmt.Errorf("%w, EvaluationIntervalInMilliseconds == 0", process.ErrInvalidValue)
+	}
+
+	argLoadMonitor := adaptive.ArgLoadMonitor{
+		MaxQueueDepth:          adaptiveConfig.MaxQueueDepth,
+		MaxCpuLoadPercent:      adaptiveConfig.MaxCpuLoadPercent,
+		MaxBlockProcessingTime: time.Duration(adaptiveConfig.MaxBlockProcessingTimeInMs) * time.Millisecond,
+	}
+	loadMonitor, err := adaptive.NewLoadMonitor(argLoadMonitor)
+	if err != nil {
+		return nil, err
+	}
+
+	argQuotaAdjuster := adaptive.ArgQuotaAdjuster{
+		LoadProvider:   loadMonitor,
+		ValidatorCurve: adaptiveConfig.Validators,
+		ObserverCurve:  adaptiveConfig.Observers,
+		UnknownCurve:   adaptiveConfig.Unknown,
+	}
+	quotaAdjuster, err := adaptive.NewQuotaAdjuster(argQuotaAdjuster)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("started adaptive antiflood components",
+		"evaluation interval in ms", adaptiveConfig.EvaluationIntervalInMilliseconds,
+		"max queue depth", adaptiveConfig.MaxQueueDepth,
+		"max CPU load percent", adaptiveConfig.MaxCpuLoadPercent,
+		"max block processing time in ms", adaptiveConfig.MaxBlockProcessingTimeInMs,
+	)
+
+	startUpdatingQuotaAdjuster(ctx, quotaAdjuster, time.Duration(adaptiveConfig.EvaluationIntervalInMilliseconds)*time.Millisecond)
+
+	return &adaptiveAntifloodComponents{
+		loadMonitor:   loadMonitor,
+		quotaAdjuster: quotaAdjuster,
 	}, nil
 }
 
+func startUpdatingQuotaAdjuster(ctx context.Context, quotaAdjuster quotaUpdater, interval time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("startUpdatingQuotaAdjuster's go routine is stopping...")
+				return
+			case <-time.After(interval):
+			}
+
+			quotaAdjuster.Update()
+		}
+	}()
+}
+
 func setMaxMessages(topicFloodPreventer process.TopicFloodPreventer, topicMaxMessages []config.TopicMaxMessagesConfig) {
 	for _, topicMaxMsg := range topicMaxMessages {
 		topicFloodPreventer.SetMaxMessagesForTopic(topicMaxMsg.Topic, topicMaxMsg.NumMessagesPerSec)
@@ -205,6 +289,7 @@ func createFloodPreventer(
 	quotaIdentifier string,
 	blackListHandler process.PeerBlackListCacher,
 	selfPid core.PeerID,
+	quotaAdjuster floodPreventers.QuotaAdjuster,
 ) (process.FloodPreventer, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
 	blackListCache, err := storageUnit.NewCache(cacheConfig)
@@ -244,6 +329,8 @@ func createFloodPreventer(
 		Name:                      quotaIdentifier,
 		Cacher:                    antifloodCache,
 		StatusHandlers:            []floodPreventers.QuotaStatusHandler{quotaProcessor, blackListProcessor},
+		EffectiveQuotaHandlers:    []floodPreventers.EffectiveQuotaStatusHandler{quotaProcessor},
+		QuotaAdjuster:             quotaAdjuster,
 		BaseMaxNumMessagesPerPeer: basePeerMaxMessagesPerInterval,
 		MaxTotalSizePerPeer:       peerMaxTotalSizePerInterval,
 		PercentReserved:           reservedPercent,
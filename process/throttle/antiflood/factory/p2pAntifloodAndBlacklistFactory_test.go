@@ -2,12 +2,14 @@ package factory
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -42,9 +44,11 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 	_, ok1 := components.AntiFloodHandler.(*disabled.AntiFlood)
 	_, ok2 := components.BlacklistHandler.(*disabled.PeerBlacklistCacher)
 	_, ok3 := components.PubKeysCacher.(*disabled.TimeCache)
+	_, ok4 := components.LoadMonitor.(*disabled.LoadMonitor)
 	assert.True(t, ok1)
 	assert.True(t, ok2)
 	assert.True(t, ok3)
+	assert.True(t, ok4)
 }
 
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *testing.T) {
@@ -80,6 +84,75 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 	time.Sleep(time.Second * 2)
 }
 
+func TestNewP2PAntiFloodAndBlackList_InvalidAdaptiveConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createEnabledAntifloodConfig()
+	cfg.Antiflood.Adaptive = createAdaptiveConfig()
+	cfg.Antiflood.Adaptive.EvaluationIntervalInMilliseconds = 0
+
+	ash := statusHandler.NewAppStatusHandlerMock()
+	components, err := NewP2PAntiFloodComponents(context.Background(), cfg, ash, currentPid)
+	assert.Nil(t, components)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewP2PAntiFloodAndBlackList_AdaptiveEnabledShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cfg := createEnabledAntifloodConfig()
+	cfg.Antiflood.Adaptive = createAdaptiveConfig()
+
+	ash := statusHandler.NewAppStatusHandlerMock()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	assert.Nil(t, err)
+	assert.NotNil(t, components.LoadMonitor)
+
+	components.LoadMonitor.SetBlockProcessingTime(time.Second)
+	assert.Equal(t, 1.0, components.LoadMonitor.Load())
+}
+
+func createEnabledAntifloodConfig() config.Config {
+	return config.Config{
+		Antiflood: config.AntifloodConfig{
+			Enabled: true,
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 10,
+				Shards:   2,
+			},
+			FastReacting: createFloodPreventerConfig(),
+			SlowReacting: createFloodPreventerConfig(),
+			OutOfSpecs:   createFloodPreventerConfig(),
+			Topic: config.TopicAntifloodConfig{
+				DefaultMaxMessagesPerSec: 10,
+			},
+		},
+	}
+}
+
+func createAdaptiveConfig() config.AdaptiveAntifloodConfig {
+	curve := config.AdaptiveQuotaCurveConfig{
+		MinMultiplier: 0.5,
+		MaxMultiplier: 1,
+		Exponent:      1,
+	}
+
+	return config.AdaptiveAntifloodConfig{
+		Enabled:                          true,
+		EvaluationIntervalInMilliseconds: 100,
+		MaxQueueDepth:                    100,
+		MaxCpuLoadPercent:                90,
+		MaxBlockProcessingTimeInMs:       1000,
+		Validators:                       curve,
+		Observers:                        curve,
+		Unknown:                          curve,
+	}
+}
+
 func createFloodPreventerConfig() config.FloodPreventerConfig {
 	return config.FloodPreventerConfig{
 		IntervalInSeconds: 1,
@@ -36,6 +36,7 @@ func initP2POutputAntiFlood(ctx context.Context, mainConfig config.Config) (proc
 		Name:                      outputIdentifier,
 		Cacher:                    antifloodCache,
 		StatusHandlers:            make([]floodPreventers.QuotaStatusHandler, 0),
+		QuotaAdjuster:             &disabled.QuotaAdjuster{},
 		BaseMaxNumMessagesPerPeer: basePeerMaxMessagesPerInterval,
 		MaxTotalSizePerPeer:       peerMaxTotalSizePerInterval,
 		PercentReserved:           outputReservedPercent,
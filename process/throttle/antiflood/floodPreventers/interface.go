@@ -9,3 +9,16 @@ type QuotaStatusHandler interface {
 	AddQuota(pid core.PeerID, numReceived uint32, sizeReceived uint64, numProcessed uint32, sizeProcessed uint64)
 	IsInterfaceNil() bool
 }
+
+// QuotaAdjuster defines the behavior of a component able to scale the per-peer quotas for a given peer class
+type QuotaAdjuster interface {
+	QuotaMultiplier(peerType core.P2PPeerType) float32
+	IsInterfaceNil() bool
+}
+
+// EffectiveQuotaStatusHandler defines the behavior of a component able to record the effective per-peer quotas
+// applied for each peer class
+type EffectiveQuotaStatusHandler interface {
+	SetEffectiveQuota(peerType core.P2PPeerType, maxNumMessages uint32, maxTotalSize uint64)
+	IsInterfaceNil() bool
+}
@@ -2,11 +2,13 @@ package floodPreventers
 
 import (
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
@@ -15,6 +17,8 @@ type ArgQuotaFloodPreventer struct {
 	Name                      string
 	Cacher                    storage.Cacher
 	StatusHandlers            []QuotaStatusHandler
+	EffectiveQuotaHandlers    []EffectiveQuotaStatusHandler
+	QuotaAdjuster             QuotaAdjuster
 	MaxTotalSizePerPeer       uint64
 	PercentReserved           float32
 	IncreaseFactor            float32
@@ -31,6 +35,8 @@ const maxPercentReserved = 90.0
 const minPercentReserved = 0.0
 const quotaStructSize = 24
 
+var reportedPeerTypes = []core.P2PPeerType{core.ValidatorPeer, core.ObserverPeer, core.UnknownPeer}
+
 type quota struct {
 	numReceivedMessages   uint32
 	numProcessedMessages  uint32
@@ -49,6 +55,9 @@ type quotaFloodPreventer struct {
 	mutOperation                  sync.RWMutex
 	cacher                        storage.Cacher
 	statusHandlers                []QuotaStatusHandler
+	effectiveQuotaHandlers        []EffectiveQuotaStatusHandler
+	quotaAdjuster                 QuotaAdjuster
+	peerValidatorMapper           process.PeerValidatorMapper
 	computedMaxNumMessagesPerPeer uint32
 	baseMaxNumMessagesPerPeer     uint32
 	maxTotalSizePerPeer           uint64
@@ -68,6 +77,14 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 			return nil, process.ErrNilQuotaStatusHandler
 		}
 	}
+	for _, effectiveQuotaHandler := range arg.EffectiveQuotaHandlers {
+		if check.IfNil(effectiveQuotaHandler) {
+			return nil, process.ErrNilEffectiveQuotaStatusHandler
+		}
+	}
+	if check.IfNil(arg.QuotaAdjuster) {
+		return nil, process.ErrNilQuotaAdjuster
+	}
 	if arg.BaseMaxNumMessagesPerPeer < minMessages {
 		return nil, fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
 			process.ErrInvalidValue,
@@ -107,6 +124,9 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 		name:                          arg.Name,
 		cacher:                        arg.Cacher,
 		statusHandlers:                arg.StatusHandlers,
+		effectiveQuotaHandlers:        arg.EffectiveQuotaHandlers,
+		quotaAdjuster:                 arg.QuotaAdjuster,
+		peerValidatorMapper:           &disabled.PeerValidatorMapper{},
 		computedMaxNumMessagesPerPeer: arg.BaseMaxNumMessagesPerPeer,
 		baseMaxNumMessagesPerPeer:     arg.BaseMaxNumMessagesPerPeer,
 		maxTotalSizePerPeer:           arg.MaxTotalSizePerPeer,
@@ -146,8 +166,10 @@ func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error
 	q.numReceivedMessages++
 	q.sizeReceivedMessages += size
 
-	maxNumMessagesReached := qfp.isMaximumReached(uint64(qfp.computedMaxNumMessagesPerPeer), uint64(q.numReceivedMessages))
-	maxSizeMessagesReached := qfp.isMaximumReached(qfp.maxTotalSizePerPeer, q.sizeReceivedMessages)
+	peerType := qfp.peerValidatorMapper.GetPeerInfo(pid).PeerType
+	maxNumMessages, maxTotalSize := qfp.effectiveQuota(peerType)
+	maxNumMessagesReached := qfp.isMaximumReached(uint64(maxNumMessages), uint64(q.numReceivedMessages))
+	maxSizeMessagesReached := qfp.isMaximumReached(maxTotalSize, q.sizeReceivedMessages)
 	isPeerQuotaReached := maxNumMessagesReached || maxSizeMessagesReached
 	if isPeerQuotaReached {
 		return fmt.Errorf("%w for pid %s", process.ErrSystemBusy, pid.Pretty())
@@ -159,6 +181,31 @@ func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error
 	return nil
 }
 
+func (qfp *quotaFloodPreventer) effectiveQuota(peerType core.P2PPeerType) (uint32, uint64) {
+	multiplier := qfp.quotaAdjuster.QuotaMultiplier(peerType)
+	if multiplier <= 0 {
+		return minMessages, minTotalSize
+	}
+
+	maxNumMessages := scaleValue(uint64(qfp.computedMaxNumMessagesPerPeer), multiplier, math.MaxUint32)
+	maxTotalSize := scaleValue(qfp.maxTotalSizePerPeer, multiplier, math.MaxUint64)
+
+	return core.MaxUint32(uint32(maxNumMessages), minMessages), core.MaxUint64(maxTotalSize, minTotalSize)
+}
+
+func scaleValue(value uint64, multiplier float32, maxValue uint64) uint64 {
+	if multiplier == 1 {
+		return value
+	}
+
+	scaled := float64(value) * float64(multiplier)
+	if scaled >= float64(maxValue) {
+		return maxValue
+	}
+
+	return uint64(scaled)
+}
+
 func (qfp *quotaFloodPreventer) isMaximumReached(absoluteMax uint64, counted uint64) bool {
 	max := uint64(100-qfp.percentReserved) * absoluteMax / 100
 
@@ -182,6 +229,7 @@ func (qfp *quotaFloodPreventer) Reset() {
 
 	qfp.resetStatusHandlers()
 	qfp.createStatistics()
+	qfp.reportEffectiveQuotas()
 
 	//TODO change this if cacher.Clear() is time consuming
 	qfp.cacher.Clear()
@@ -193,6 +241,15 @@ func (qfp *quotaFloodPreventer) resetStatusHandlers() {
 	}
 }
 
+func (qfp *quotaFloodPreventer) reportEffectiveQuotas() {
+	for _, peerType := range reportedPeerTypes {
+		maxNumMessages, maxTotalSize := qfp.effectiveQuota(peerType)
+		for _, handler := range qfp.effectiveQuotaHandlers {
+			handler.SetEffectiveQuota(peerType, maxNumMessages, maxTotalSize)
+		}
+	}
+}
+
 // createStatistics is useful to benchmark the system when running
 func (qfp *quotaFloodPreventer) createStatistics() {
 	keys := qfp.cacher.Keys()
@@ -266,6 +323,19 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 	)
 }
 
+// SetPeerValidatorMapper sets the peer validator mapper used to determine the class of a peer
+func (qfp *quotaFloodPreventer) SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error {
+	if check.IfNil(validatorMapper) {
+		return process.ErrNilPeerValidatorMapper
+	}
+
+	qfp.mutOperation.Lock()
+	qfp.peerValidatorMapper = validatorMapper
+	qfp.mutOperation.Unlock()
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (qfp *quotaFloodPreventer) IsInterfaceNil() bool {
 	return qfp == nil
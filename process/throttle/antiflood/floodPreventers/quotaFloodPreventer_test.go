@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,6 +21,8 @@ func createDefaultArgument() ArgQuotaFloodPreventer {
 		Name:                      "test",
 		Cacher:                    testscommon.NewCacherStub(),
 		StatusHandlers:            []QuotaStatusHandler{&mock.QuotaStatusHandlerStub{}},
+		EffectiveQuotaHandlers:    []EffectiveQuotaStatusHandler{&mock.EffectiveQuotaStatusHandlerStub{}},
+		QuotaAdjuster:             &disabled.QuotaAdjuster{},
 		BaseMaxNumMessagesPerPeer: minMessages,
 		MaxTotalSizePerPeer:       minTotalSize,
 		PercentReserved:           10,
@@ -52,6 +55,28 @@ func TestNewQuotaFloodPreventer_NilStatusHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilQuotaStatusHandler, err)
 }
 
+func TestNewQuotaFloodPreventer_NilEffectiveQuotaHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.EffectiveQuotaHandlers = []EffectiveQuotaStatusHandler{nil}
+	qfp, err := NewQuotaFloodPreventer(arg)
+
+	assert.True(t, check.IfNil(qfp))
+	assert.Equal(t, process.ErrNilEffectiveQuotaStatusHandler, err)
+}
+
+func TestNewQuotaFloodPreventer_NilQuotaAdjusterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.QuotaAdjuster = nil
+	qfp, err := NewQuotaFloodPreventer(arg)
+
+	assert.True(t, check.IfNil(qfp))
+	assert.Equal(t, process.ErrNilQuotaAdjuster, err)
+}
+
 func TestNewQuotaFloodPreventer_LowerMinMessagesPerPeerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -488,3 +513,107 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+//------- adaptive quotas
+
+func TestQuotaFloodPreventer_SetPeerValidatorMapperNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	qfp, _ := NewQuotaFloodPreventer(createDefaultArgument())
+
+	err := qfp.SetPeerValidatorMapper(nil)
+	assert.Equal(t, process.ErrNilPeerValidatorMapper, err)
+}
+
+func TestQuotaFloodPreventer_IncreaseLoadShouldApplyMultiplierPerPeerType(t *testing.T) {
+	t.Parallel()
+
+	validatorPid := core.PeerID("validator")
+	unknownPid := core.PeerID("unknown")
+	arg := createDefaultArgument()
+	arg.Cacher = testscommon.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 100
+	arg.MaxTotalSizePerPeer = math.MaxUint32
+	arg.PercentReserved = 0
+	arg.QuotaAdjuster = &mock.QuotaAdjusterStub{
+		QuotaMultiplierCalled: func(peerType core.P2PPeerType) float32 {
+			if peerType == core.ValidatorPeer {
+				return 1.5
+			}
+			return 0.2
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	_ = qfp.SetPeerValidatorMapper(&mock.PeerShardResolverStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			if pid == validatorPid {
+				return core.P2PPeerInfo{PeerType: core.ValidatorPeer}
+			}
+			return core.P2PPeerInfo{PeerType: core.UnknownPeer}
+		},
+	})
+
+	for i := 0; i < 150; i++ {
+		err := qfp.IncreaseLoad(validatorPid, 1)
+		assert.Nil(t, err, fmt.Sprintf("on iteration %d", i))
+	}
+	err := qfp.IncreaseLoad(validatorPid, 1)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+
+	for i := 0; i < 20; i++ {
+		err = qfp.IncreaseLoad(unknownPid, 1)
+		assert.Nil(t, err, fmt.Sprintf("on iteration %d", i))
+	}
+	err = qfp.IncreaseLoad(unknownPid, 1)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+}
+
+func TestQuotaFloodPreventer_ZeroMultiplierShouldKeepMinimumQuota(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 100
+	arg.MaxTotalSizePerPeer = 1000
+	arg.QuotaAdjuster = &mock.QuotaAdjusterStub{
+		QuotaMultiplierCalled: func(peerType core.P2PPeerType) float32 {
+			return 0
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	maxNumMessages, maxTotalSize := qfp.effectiveQuota(core.UnknownPeer)
+	assert.Equal(t, uint32(minMessages), maxNumMessages)
+	assert.Equal(t, uint64(minTotalSize), maxTotalSize)
+}
+
+func TestQuotaFloodPreventer_ResetShouldReportEffectiveQuotas(t *testing.T) {
+	t.Parallel()
+
+	reported := make(map[core.P2PPeerType][2]uint64)
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 100
+	arg.MaxTotalSizePerPeer = 1000
+	arg.QuotaAdjuster = &mock.QuotaAdjusterStub{
+		QuotaMultiplierCalled: func(peerType core.P2PPeerType) float32 {
+			if peerType == core.ObserverPeer {
+				return 0.5
+			}
+			return 1
+		},
+	}
+	arg.EffectiveQuotaHandlers = []EffectiveQuotaStatusHandler{
+		&mock.EffectiveQuotaStatusHandlerStub{
+			SetEffectiveQuotaCalled: func(peerType core.P2PPeerType, maxNumMessages uint32, maxTotalSize uint64) {
+				reported[peerType] = [2]uint64{uint64(maxNumMessages), maxTotalSize}
+			},
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	qfp.Reset()
+
+	assert.Equal(t, 3, len(reported))
+	assert.Equal(t, [2]uint64{100, 1000}, reported[core.ValidatorPeer])
+	assert.Equal(t, [2]uint64{50, 500}, reported[core.ObserverPeer])
+	assert.Equal(t, [2]uint64{100, 1000}, reported[core.UnknownPeer])
+}
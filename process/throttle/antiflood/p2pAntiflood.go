@@ -18,6 +18,10 @@ const unidentifiedTopic = "unidentifier topic"
 var log = logger.GetOrCreate("process/throttle/antiflood")
 var _ process.P2PAntifloodHandler = (*p2pAntiflood)(nil)
 
+type peerValidatorMapperSetter interface {
+	SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error
+}
+
 type p2pAntiflood struct {
 	blacklistHandler    process.PeerBlackListCacher
 	floodPreventers     []process.FloodPreventer
@@ -127,6 +131,18 @@ func (af *p2pAntiflood) SetPeerValidatorMapper(validatorMapper process.PeerValid
 		return process.ErrNilPeerValidatorMapper
 	}
 
+	for _, fp := range af.floodPreventers {
+		setter, ok := fp.(peerValidatorMapperSetter)
+		if !ok {
+			continue
+		}
+
+		err := setter.SetPeerValidatorMapper(validatorMapper)
+		if err != nil {
+			return err
+		}
+	}
+
 	af.mutTopicCheck.Lock()
 	defer af.mutTopicCheck.Unlock()
 
@@ -13,6 +13,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -463,3 +465,43 @@ func TestP2pAntiflood_IsOriginatorEligibleForTopic(t *testing.T) {
 	err = afm.IsOriginatorEligibleForTopic(core.PeerID(validatorPID), "topic")
 	assert.Nil(t, err)
 }
+
+func TestP2pAntiflood_SetPeerValidatorMapperShouldPropagateToFloodPreventers(t *testing.T) {
+	t.Parallel()
+
+	arg := floodPreventers.ArgQuotaFloodPreventer{
+		Name:   "test",
+		Cacher: testscommon.NewCacherMock(),
+		QuotaAdjuster: &mock.QuotaAdjusterStub{
+			QuotaMultiplierCalled: func(peerType core.P2PPeerType) float32 {
+				if peerType == core.ValidatorPeer {
+					return 1
+				}
+				return 0.5
+			},
+		},
+		BaseMaxNumMessagesPerPeer: 10,
+		MaxTotalSizePerPeer:       1000,
+	}
+	qfp, _ := floodPreventers.NewQuotaFloodPreventer(arg)
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		qfp,
+		&mock.FloodPreventerStub{},
+	)
+
+	err := afm.SetPeerValidatorMapper(&mock.PeerShardResolverStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			return core.P2PPeerInfo{PeerType: core.ObserverPeer}
+		},
+	})
+	assert.Nil(t, err)
+
+	for i := 0; i < 5; i++ {
+		err = qfp.IncreaseLoad("observer", 1)
+		assert.Nil(t, err)
+	}
+	err = qfp.IncreaseLoad("observer", 1)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+}
@@ -12,5 +12,40 @@ type SimulationResults struct {
 	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
 	Hash       string                                         `json:"hash,omitempty"`
+	GasProfile *GasProfile                                    `json:"gasProfile,omitempty"`
 	VMOutput   *vmcommon.VMOutput                             `json:"-"`
 }
+
+// GasProfile holds the breakdown of the gas consumed by a simulated transaction
+type GasProfile struct {
+	GasScheduleVersion string                `json:"gasScheduleVersion"`
+	GasLimit           uint64                `json:"gasLimit"`
+	GasUsed            uint64                `json:"gasUsed"`
+	MoveBalanceGas     uint64                `json:"moveBalanceGas"`
+	DataBytesGas       uint64                `json:"dataBytesGas"`
+	ProcessingGasLimit uint64                `json:"processingGasLimit"`
+	ProcessingGasUsed  uint64                `json:"processingGasUsed"`
+	BuiltInFunctionGas uint64                `json:"builtInFunctionGas"`
+	GasRemaining       uint64                `json:"gasRemaining"`
+	GasRefund          string                `json:"gasRefund"`
+	GasUsedByAccount   map[string]uint64     `json:"gasUsedByAccount,omitempty"`
+	ContractCalls      []*ContractCallGas    `json:"contractCalls,omitempty"`
+	StorageWrites      *StorageWritesProfile `json:"storageWrites"`
+}
+
+// ContractCallGas holds the gas forwarded by a cross-contract call or transfer issued during the execution
+type ContractCallGas struct {
+	Sender    string `json:"sender,omitempty"`
+	Receiver  string `json:"receiver"`
+	Function  string `json:"function,omitempty"`
+	CallType  string `json:"callType"`
+	GasLimit  uint64 `json:"gasLimit"`
+	GasLocked uint64 `json:"gasLocked"`
+}
+
+// StorageWritesProfile holds the storage writes done by a simulated transaction
+type StorageWritesProfile struct {
+	NumWrites    uint64 `json:"numWrites"`
+	NumDeletions uint64 `json:"numDeletions"`
+	NumBytes     uint64 `json:"numBytes"`
+}
@@ -25,3 +25,12 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrNilEconomicsFeeHandler signals that a nil economics fee handler has been provided
+var ErrNilEconomicsFeeHandler = errors.New("nil economics fee handler")
+
+// ErrNilBuiltInFunctionsCostHandler signals that a nil built in functions cost handler has been provided
+var ErrNilBuiltInFunctionsCostHandler = errors.New("nil built in functions cost handler")
+
+// ErrNilGasScheduleVersionHandler signals that a nil gas schedule version handler has been provided
+var ErrNilGasScheduleVersionHandler = errors.New("nil gas schedule version handler")
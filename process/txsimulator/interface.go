@@ -1,6 +1,7 @@
 package txsimulator
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -10,3 +11,16 @@ type TransactionProcessor interface {
 	ProcessTransaction(transaction *transaction.Transaction) (vmcommon.ReturnCode, error)
 	IsInterfaceNil() bool
 }
+
+// BuiltInFunctionsCostHandler defines the component able to compute the cost of a built in function call
+type BuiltInFunctionsCostHandler interface {
+	ComputeBuiltInCost(tx data.TransactionWithFeeHandler) uint64
+	IsBuiltInFuncCall(tx data.TransactionWithFeeHandler) bool
+	IsInterfaceNil() bool
+}
+
+// GasScheduleVersionHandler defines the component able to provide the gas schedule version currently in use
+type GasScheduleVersionHandler interface {
+	LatestGasScheduleVersion() string
+	IsInterfaceNil() bool
+}
@@ -2,6 +2,8 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"sort"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -9,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -27,6 +30,9 @@ type ArgsTxSimulator struct {
 	VMOutputCacher            storage.Cacher
 	Hasher                    hashing.Hasher
 	Marshalizer               marshal.Marshalizer
+	EconomicsFee              process.FeeHandler
+	BuiltInFunctionsCost      BuiltInFunctionsCostHandler
+	GasScheduleVersion        GasScheduleVersionHandler
 }
 
 type transactionSimulator struct {
@@ -37,6 +43,9 @@ type transactionSimulator struct {
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	economicsFee           process.FeeHandler
+	builtInFunctionsCost   BuiltInFunctionsCostHandler
+	gasScheduleVersion     GasScheduleVersionHandler
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -62,6 +71,15 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.EconomicsFee) {
+		return nil, ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.BuiltInFunctionsCost) {
+		return nil, ErrNilBuiltInFunctionsCostHandler
+	}
+	if check.IfNil(args.GasScheduleVersion) {
+		return nil, ErrNilGasScheduleVersionHandler
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -71,6 +89,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		economicsFee:           args.EconomicsFee,
+		builtInFunctionsCost:   args.BuiltInFunctionsCost,
+		gasScheduleVersion:     args.GasScheduleVersion,
 	}, nil
 }
 
@@ -103,10 +124,120 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 	if ok {
 		results.VMOutput = vmOutput
 	}
+	results.GasProfile = ts.computeGasProfile(tx, results.VMOutput)
 
 	return results, nil
 }
 
+// computeGasProfile splits the gas consumed by the simulated transaction in categories. When no VM output is
+// available (move balance or a transaction that failed before reaching the VM), only the static costs are accounted
+func (ts *transactionSimulator) computeGasProfile(tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) *txSimData.GasProfile {
+	moveBalanceGas := ts.economicsFee.MinGasLimit()
+	dataBytesGas := uint64(len(tx.Data)) * ts.economicsFee.GasPerDataByte()
+	gasLimitMove, gasLimitProcess := ts.economicsFee.SplitTxGasInCategories(tx)
+
+	profile := &txSimData.GasProfile{
+		GasScheduleVersion: ts.gasScheduleVersion.LatestGasScheduleVersion(),
+		GasLimit:           tx.GasLimit,
+		MoveBalanceGas:     moveBalanceGas,
+		DataBytesGas:       dataBytesGas,
+		ProcessingGasLimit: gasLimitProcess,
+		GasRefund:          "0",
+		StorageWrites:      &txSimData.StorageWritesProfile{},
+	}
+	if ts.builtInFunctionsCost.IsBuiltInFuncCall(tx) {
+		profile.BuiltInFunctionGas = ts.builtInFunctionsCost.ComputeBuiltInCost(tx)
+	}
+
+	if vmOutput == nil {
+		profile.ProcessingGasUsed = core.MinUint64(profile.BuiltInFunctionGas, gasLimitProcess)
+		profile.GasUsed = core.MinUint64(gasLimitMove+profile.ProcessingGasUsed, tx.GasLimit)
+		profile.GasRemaining = tx.GasLimit - profile.GasUsed
+
+		return profile
+	}
+
+	profile.GasRemaining = core.MinUint64(vmOutput.GasRemaining, tx.GasLimit)
+	profile.GasUsed = tx.GasLimit - profile.GasRemaining
+	if profile.GasUsed > gasLimitMove {
+		profile.ProcessingGasUsed = profile.GasUsed - gasLimitMove
+	}
+	if vmOutput.GasRefund != nil {
+		profile.GasRefund = vmOutput.GasRefund.String()
+	}
+	ts.addOutputAccountsToGasProfile(profile, vmOutput)
+
+	return profile
+}
+
+func (ts *transactionSimulator) addOutputAccountsToGasProfile(profile *txSimData.GasProfile, vmOutput *vmcommon.VMOutput) {
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	profile.GasUsedByAccount = make(map[string]uint64)
+	profile.ContractCalls = make([]*txSimData.ContractCallGas, 0)
+	for _, address := range addresses {
+		outputAccount := vmOutput.OutputAccounts[address]
+		if outputAccount == nil {
+			continue
+		}
+
+		encodedAddress := ts.addressPubKeyConverter.Encode(outputAccount.Address)
+		if outputAccount.GasUsed > 0 {
+			profile.GasUsedByAccount[encodedAddress] = outputAccount.GasUsed
+		}
+
+		for _, storageUpdate := range outputAccount.StorageUpdates {
+			if storageUpdate == nil {
+				continue
+			}
+
+			profile.StorageWrites.NumWrites++
+			profile.StorageWrites.NumBytes += uint64(len(storageUpdate.Offset) + len(storageUpdate.Data))
+			if len(storageUpdate.Data) == 0 {
+				profile.StorageWrites.NumDeletions++
+			}
+		}
+
+		for _, outputTransfer := range outputAccount.OutputTransfers {
+			profile.ContractCalls = append(profile.ContractCalls, ts.createContractCallGas(encodedAddress, outputTransfer))
+		}
+	}
+}
+
+func (ts *transactionSimulator) createContractCallGas(receiver string, outputTransfer vmcommon.OutputTransfer) *txSimData.ContractCallGas {
+	contractCall := &txSimData.ContractCallGas{
+		Receiver:  receiver,
+		Function:  strings.Split(string(outputTransfer.Data), "@")[0],
+		CallType:  callTypeToString(outputTransfer.CallType),
+		GasLimit:  outputTransfer.GasLimit,
+		GasLocked: outputTransfer.GasLocked,
+	}
+	if len(outputTransfer.SenderAddress) > 0 {
+		contractCall.Sender = ts.addressPubKeyConverter.Encode(outputTransfer.SenderAddress)
+	}
+
+	return contractCall
+}
+
+func callTypeToString(callType vm.CallType) string {
+	switch callType {
+	case vm.DirectCall:
+		return "directCall"
+	case vm.AsynchronousCall:
+		return "asynchronousCall"
+	case vm.AsynchronousCallBack:
+		return "asynchronousCallBack"
+	case vm.ESDTTransferAndExecute:
+		return "esdtTransferAndExecute"
+	default:
+		return "unknown"
+	}
+}
+
 func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*vmcommon.VMOutput, bool) {
 	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
 	if err != nil {
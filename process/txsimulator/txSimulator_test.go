@@ -3,6 +3,7 @@ package txsimulator
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -11,8 +12,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -89,6 +92,33 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilEconomicsFeeHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.EconomicsFee = nil
+				return args
+			},
+			exError: ErrNilEconomicsFeeHandler,
+		},
+		{
+			name: "NilBuiltInFunctionsCostHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.BuiltInFunctionsCost = nil
+				return args
+			},
+			exError: ErrNilBuiltInFunctionsCostHandler,
+		},
+		{
+			name: "NilGasScheduleVersionHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.GasScheduleVersion = nil
+				return args
+			},
+			exError: ErrNilGasScheduleVersionHandler,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -216,6 +246,136 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func createGasProfileTxSimulatorArgs() ArgsTxSimulator {
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher, _ = storageUnit.NewCache(storageUnit.CacheConfig{
+		Type:     storageUnit.LRUCache,
+		Capacity: 100,
+	})
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	args.EconomicsFee = &mock.FeeHandlerStub{
+		MinGasLimitCalled: func() uint64 {
+			return 50000
+		},
+		GasPerDataByteCalled: func() uint64 {
+			return 1500
+		},
+		SplitTxGasInCategoriesCalled: func(tx data.TransactionWithFeeHandler) (uint64, uint64) {
+			gasLimitMove := 50000 + uint64(len(tx.GetData()))*1500
+			return gasLimitMove, tx.GetGasLimit() - gasLimitMove
+		},
+	}
+
+	return args
+}
+
+func TestTransactionSimulator_ProcessTxMoveBalanceShouldProfileStaticCosts(t *testing.T) {
+	t.Parallel()
+
+	args := createGasProfileTxSimulatorArgs()
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{GasLimit: 100000, Data: []byte("memo")})
+	require.NoError(t, err)
+
+	profile := results.GasProfile
+	require.NotNil(t, profile)
+	require.Equal(t, "gasScheduleV3.toml", profile.GasScheduleVersion)
+	require.Equal(t, uint64(100000), profile.GasLimit)
+	require.Equal(t, uint64(50000), profile.MoveBalanceGas)
+	require.Equal(t, uint64(6000), profile.DataBytesGas)
+	require.Equal(t, uint64(44000), profile.ProcessingGasLimit)
+	require.Equal(t, uint64(0), profile.ProcessingGasUsed)
+	require.Equal(t, uint64(56000), profile.GasUsed)
+	require.Equal(t, uint64(44000), profile.GasRemaining)
+	require.Equal(t, "0", profile.GasRefund)
+	require.Empty(t, profile.ContractCalls)
+}
+
+func TestTransactionSimulator_ProcessTxBuiltInFunctionShouldProfileBuiltInCost(t *testing.T) {
+	t.Parallel()
+
+	args := createGasProfileTxSimulatorArgs()
+	args.BuiltInFunctionsCost = &mock.BuiltInCostHandlerStub{
+		IsBuiltInFuncCallCalled: func(tx data.TransactionWithFeeHandler) bool {
+			return true
+		},
+		ComputeBuiltInCostCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+			return 200000
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{GasLimit: 500000, Data: []byte("ESDTTransfer@aa@01")})
+	require.NoError(t, err)
+
+	profile := results.GasProfile
+	require.Equal(t, uint64(200000), profile.BuiltInFunctionGas)
+	require.Equal(t, uint64(200000), profile.ProcessingGasUsed)
+	require.Equal(t, uint64(50000+18*1500+200000), profile.GasUsed)
+}
+
+func TestTransactionSimulator_ProcessTxWithVMOutputShouldProfileExecution(t *testing.T) {
+	t.Parallel()
+
+	args := createGasProfileTxSimulatorArgs()
+	ts, _ := NewTransactionSimulator(args)
+
+	tx := &transaction.Transaction{GasLimit: 1000000, Data: []byte("add@01")}
+	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+	args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{
+		GasRemaining: 400000,
+		GasRefund:    big.NewInt(15000),
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			"contractA": {
+				Address: []byte("contractA"),
+				GasUsed: 300000,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"key1": {Offset: []byte("key1"), Data: []byte("value1")},
+					"key2": {Offset: []byte("key2"), Data: nil},
+				},
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						GasLimit:      200000,
+						GasLocked:     50000,
+						Data:          []byte("callBack@01"),
+						CallType:      vm.AsynchronousCall,
+						SenderAddress: []byte("sender"),
+					},
+				},
+			},
+			"contractB": {
+				Address: []byte("contractB"),
+			},
+		},
+	}, 0)
+
+	results, err := ts.ProcessTx(tx)
+	require.NoError(t, err)
+
+	profile := results.GasProfile
+	require.Equal(t, uint64(600000), profile.GasUsed)
+	require.Equal(t, uint64(400000), profile.GasRemaining)
+	require.Equal(t, uint64(600000-50000-6*1500), profile.ProcessingGasUsed)
+	require.Equal(t, "15000", profile.GasRefund)
+	require.Equal(t, map[string]uint64{hex.EncodeToString([]byte("contractA")): 300000}, profile.GasUsedByAccount)
+	require.Equal(t, &txSimData.StorageWritesProfile{NumWrites: 2, NumDeletions: 1, NumBytes: 14}, profile.StorageWrites)
+	require.Equal(t, []*txSimData.ContractCallGas{
+		{
+			Sender:    hex.EncodeToString([]byte("sender")),
+			Receiver:  hex.EncodeToString([]byte("contractA")),
+			Function:  "callBack",
+			CallType:  "asynchronousCall",
+			GasLimit:  200000,
+			GasLocked: 50000,
+		},
+	}, profile.ContractCalls)
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
 	return ArgsTxSimulator{
 		TransactionProcessor:      &testscommon.TxProcessorStub{},
@@ -225,5 +385,8 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		VMOutputCacher:            txcache.NewDisabledCache(),
 		Marshalizer:               &mock.MarshalizerMock{},
 		Hasher:                    &mock.HasherMock{},
+		EconomicsFee:              &mock.FeeHandlerStub{},
+		BuiltInFunctionsCost:      &mock.BuiltInCostHandlerStub{},
+		GasScheduleVersion:        &mock.GasScheduleNotifierMock{GasScheduleVersion: "gasScheduleV3.toml"},
 	}
 }
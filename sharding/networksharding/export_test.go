@@ -6,14 +6,24 @@ import (
 )
 
 const MaxNumPidsPerPk = maxNumPidsPerPk
+const MaxNumPksPerPid = maxNumPksPerPid
 
 func (psm *PeerShardMapper) GetPkFromPidPk(pid core.PeerID) []byte {
-	pk, ok := psm.peerIdPkCache.Get([]byte(pid))
+	pks := psm.GetPksFromPidPk(pid)
+	if len(pks) == 0 {
+		return nil
+	}
+
+	return pks[len(pks)-1]
+}
+
+func (psm *PeerShardMapper) GetPksFromPidPk(pid core.PeerID) [][]byte {
+	pks, ok := psm.peerIdPkCache.Get([]byte(pid))
 	if !ok {
 		return nil
 	}
 
-	return pk.([]byte)
+	return pks.([][]byte)
 }
 
 func (psm *PeerShardMapper) GetShardIdFromPkShardId(pk []byte) uint32 {
@@ -1,6 +1,7 @@
 package networksharding
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"sync"
@@ -17,6 +18,7 @@ import (
 )
 
 const maxNumPidsPerPk = 3
+const maxNumPksPerPid = 100
 const uint32Size = 4
 const defaultShardId = uint32(0)
 
@@ -31,6 +33,8 @@ var _ p2p.PeerShardResolver = (*PeerShardMapper)(nil)
 // by this map is then fed to the nodes coordinator that will output the shard id in which that public key resides.
 // This component also have a reversed lookup map that will ensure that there won't be unlimited peer ids with the
 // same public key. This will prevent eclipse attacks.
+// A peer id can be associated with more than one public key as a node can manage several keys that all broadcast
+// messages using the same peer id. The number of public keys kept for a peer id is bounded by maxNumPksPerPid.
 // The mapping between shard id and public key is done by the nodes coordinator implementation but the fallbackPkShard
 // fallback map is only used whenever nodes coordinator has a wrong view about the peers in a shard.
 type PeerShardMapper struct {
@@ -137,38 +141,49 @@ func (psm *PeerShardMapper) GetPeerInfo(pid core.PeerID) core.P2PPeerInfo {
 }
 
 func (psm *PeerShardMapper) getPeerInfoWithNodesCoordinator(pid core.PeerID) (*core.P2PPeerInfo, bool) {
-	pkObj, ok := psm.peerIdPkCache.Get([]byte(pid))
-	if !ok {
+	pks := psm.getPublicKeys(pid)
+	if len(pks) == 0 {
 		return &core.P2PPeerInfo{
 			PeerType: core.UnknownPeer,
 			ShardID:  0,
 		}, false
 	}
 
-	pkBuff, ok := pkObj.([]byte)
-	if !ok {
-		log.Warn("PeerShardMapper.getShardIDWithNodesCoordinator: the contained element should have been of type []byte")
+	for i := len(pks) - 1; i >= 0; i-- {
+		_, shardId, err := psm.nodesCoordinator.GetValidatorWithPublicKey(pks[i])
+		if err != nil {
+			continue
+		}
 
 		return &core.P2PPeerInfo{
-			PeerType: core.UnknownPeer,
-			ShardID:  0,
-		}, false
+			PeerType: core.ValidatorPeer,
+			ShardID:  shardId,
+			PkBytes:  pks[i],
+		}, true
 	}
 
-	_, shardId, err := psm.nodesCoordinator.GetValidatorWithPublicKey(pkBuff)
-	if err != nil {
-		return &core.P2PPeerInfo{
-			PeerType: core.UnknownPeer,
-			ShardID:  0,
-			PkBytes:  pkBuff,
-		}, false
+	return &core.P2PPeerInfo{
+		PeerType: core.UnknownPeer,
+		ShardID:  0,
+		PkBytes:  pks[len(pks)-1],
+	}, false
+}
+
+// getPublicKeys returns the public keys associated with the provided peer id, the most recent one being the last
+func (psm *PeerShardMapper) getPublicKeys(pid core.PeerID) [][]byte {
+	pksObj, ok := psm.peerIdPkCache.Get([]byte(pid))
+	if !ok {
+		return nil
 	}
 
-	return &core.P2PPeerInfo{
-		PeerType: core.ValidatorPeer,
-		ShardID:  shardId,
-		PkBytes:  pkBuff,
-	}, true
+	pks, ok := pksObj.([][]byte)
+	if !ok {
+		log.Warn("PeerShardMapper.getPublicKeys: the contained element should have been of type [][]byte")
+
+		return nil
+	}
+
+	return pks
 }
 
 func (psm *PeerShardMapper) getShardIDSearchingPkInFallbackCache(pkBuff []byte) (shardId uint32, ok bool) {
@@ -259,11 +274,9 @@ func (psm *PeerShardMapper) updatePeerIDPublicKey(pid core.PeerID, pk []byte) {
 	psm.mutUpdatePeerIdPublicKey.Lock()
 	defer psm.mutUpdatePeerIdPublicKey.Unlock()
 
-	psm.removePidAssociation(pid)
-
 	objPidsQueue, found := psm.pkPeerIdCache.Get(pk)
 	if !found {
-		psm.peerIdPkCache.Put([]byte(pid), pk, len(pk))
+		psm.addPublicKeyToPid(pid, pk)
 		pq := newPidQueue()
 		pq.push(pid)
 		psm.pkPeerIdCache.Put(pk, pq, len(pk))
@@ -280,7 +293,7 @@ func (psm *PeerShardMapper) updatePeerIDPublicKey(pid core.PeerID, pk []byte) {
 	idxPid := pq.indexOf(pid)
 	if idxPid != indexNotFound {
 		pq.promote(idxPid)
-		psm.peerIdPkCache.Put([]byte(pid), pk, len(pk))
+		psm.addPublicKeyToPid(pid, pk)
 		return
 	}
 
@@ -288,43 +301,78 @@ func (psm *PeerShardMapper) updatePeerIDPublicKey(pid core.PeerID, pk []byte) {
 	for len(pq.data) > maxNumPidsPerPk {
 		evictedPid := pq.pop()
 
-		psm.peerIdPkCache.Remove([]byte(evictedPid))
-		psm.fallbackPidShardCache.Remove([]byte(evictedPid))
+		psm.removePublicKeyFromPid(evictedPid, pk)
 	}
 	psm.pkPeerIdCache.Put(pk, pq, pq.size())
-	psm.peerIdPkCache.Put([]byte(pid), pk, len(pk))
+	psm.addPublicKeyToPid(pid, pk)
 }
 
-func (psm *PeerShardMapper) removePidAssociation(pid core.PeerID) {
-	oldPk, found := psm.peerIdPkCache.Get([]byte(pid))
-	if !found {
-		return
+// addPublicKeyToPid appends (or moves at the end) the public key in the list of the peer id. The list is rebuilt on
+// each call so the readers of the previous list are not affected
+func (psm *PeerShardMapper) addPublicKeyToPid(pid core.PeerID, pk []byte) {
+	oldPks := psm.getPublicKeys(pid)
+	pks := make([][]byte, 0, len(oldPks)+1)
+	for _, oldPk := range oldPks {
+		if !bytes.Equal(oldPk, pk) {
+			pks = append(pks, oldPk)
+		}
 	}
+	pks = append(pks, pk)
 
-	oldPkBuff, ok := oldPk.([]byte)
-	if !ok {
+	for len(pks) > maxNumPksPerPid {
+		psm.removePidFromPublicKey(pks[0], pid)
+		pks = pks[1:]
+	}
+
+	psm.putPublicKeys(pid, pks)
+}
+
+func (psm *PeerShardMapper) removePublicKeyFromPid(pid core.PeerID, pk []byte) {
+	oldPks := psm.getPublicKeys(pid)
+	pks := make([][]byte, 0, len(oldPks))
+	for _, oldPk := range oldPks {
+		if !bytes.Equal(oldPk, pk) {
+			pks = append(pks, oldPk)
+		}
+	}
+
+	if len(pks) == 0 {
 		psm.peerIdPkCache.Remove([]byte(pid))
+		psm.fallbackPidShardCache.Remove([]byte(pid))
 		return
 	}
 
-	objPidsQueue, found := psm.pkPeerIdCache.Get(oldPkBuff)
+	psm.putPublicKeys(pid, pks)
+}
+
+func (psm *PeerShardMapper) putPublicKeys(pid core.PeerID, pks [][]byte) {
+	size := 0
+	for _, pk := range pks {
+		size += len(pk)
+	}
+
+	psm.peerIdPkCache.Put([]byte(pid), pks, size)
+}
+
+func (psm *PeerShardMapper) removePidFromPublicKey(pk []byte, pid core.PeerID) {
+	objPidsQueue, found := psm.pkPeerIdCache.Get(pk)
 	if !found {
 		return
 	}
 
 	pq, ok := objPidsQueue.(*pidQueue)
 	if !ok {
-		psm.pkPeerIdCache.Remove(oldPkBuff)
+		psm.pkPeerIdCache.Remove(pk)
 		return
 	}
 
 	pq.remove(pid)
 	if len(pq.data) == 0 {
-		psm.pkPeerIdCache.Remove(oldPkBuff)
+		psm.pkPeerIdCache.Remove(pk)
 		return
 	}
 
-	psm.pkPeerIdCache.Put(oldPkBuff, pq, pq.size())
+	psm.pkPeerIdCache.Put(pk, pq, pq.size())
 }
 
 // UpdatePeerIdSubType updates the peerIdSubType search map containing peer IDs and peer subtypes
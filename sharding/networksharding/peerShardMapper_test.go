@@ -208,7 +208,46 @@ func TestPeerShardMapper_UpdatePeerIDInfoShouldUpdatePkForExistentPid(t *testing
 		assert.Equal(t, pk2, pkRecovered)
 	}
 
-	assert.Equal(t, []core.PeerID{newPid}, psm.GetFromPkPeerId(pk1))
+	//pids[0] still announced pk1 so it is kept next to pk2
+	assert.Equal(t, []core.PeerID{pids[0], newPid}, psm.GetFromPkPeerId(pk1))
+	assert.Equal(t, [][]byte{pk1, pk2}, psm.GetPksFromPidPk(pids[0]))
+}
+
+func TestPeerShardMapper_UpdatePeerIDInfoMorePksThanAllowedShouldTrim(t *testing.T) {
+	t.Parallel()
+
+	psm := createPeerShardMapper()
+	pid := core.PeerID("dummy peer ID")
+	pks := make([][]byte, networksharding.MaxNumPksPerPid+1)
+	for i := 0; i < networksharding.MaxNumPksPerPid+1; i++ {
+		pks[i] = []byte(fmt.Sprintf("pk %d", i))
+		psm.UpdatePeerIDInfo(pid, pks[i], core.AllShardId)
+	}
+
+	//the pk is evicted based on the first-in-first-out rule
+	assert.Equal(t, pks[1:], psm.GetPksFromPidPk(pid))
+	assert.Nil(t, psm.GetFromPkPeerId(pks[0]))
+	for i := 1; i < networksharding.MaxNumPksPerPid+1; i++ {
+		assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(pks[i]))
+	}
+}
+
+func TestPeerShardMapper_UpdatePeerIDInfoEvictedPidShouldKeepTheOtherPks(t *testing.T) {
+	t.Parallel()
+
+	psm := createPeerShardMapper()
+	pk1 := []byte("dummy pk1")
+	pk2 := []byte("dummy pk2")
+	pid := core.PeerID("dummy peer ID")
+	psm.UpdatePeerIDInfo(pid, pk1, 0)
+	psm.UpdatePeerIDInfo(pid, pk2, 0)
+
+	for i := 0; i < networksharding.MaxNumPidsPerPk; i++ {
+		psm.UpdatePeerIDInfo(core.PeerID(fmt.Sprintf("pid %d", i)), pk1, 0)
+	}
+
+	assert.Equal(t, [][]byte{pk2}, psm.GetPksFromPidPk(pid))
+	assert.Equal(t, uint32(0), psm.GetShardIdFromPidShardId(pid))
 }
 
 func TestPeerShardMapper_UpdatePeerIDInfoWrongTypePkInPeerIdPkShouldRemove(t *testing.T) {
@@ -297,6 +336,41 @@ func TestPeerShardMapper_GetPeerInfoNodesCoordinatorHasTheShardId(t *testing.T)
 	assert.Equal(t, expectedPeerInfo, peerInfo)
 }
 
+func TestPeerShardMapper_GetPeerInfoManagedKeysOnTheSamePidShouldAllBeMapped(t *testing.T) {
+	t.Parallel()
+
+	shardId := uint32(445)
+	validatorPk := []byte("validator pk")
+	observerPk := []byte("observer pk")
+	arg := createMockArgumentForPeerShardMapper()
+	arg.NodesCoordinator = &nodesCoordinatorStub{
+		GetValidatorWithPublicKeyCalled: func(publicKey []byte) (validator sharding.Validator, u uint32, e error) {
+			if bytes.Equal(publicKey, validatorPk) {
+				return nil, shardId, nil
+			}
+
+			return nil, 0, errors.New("not found")
+		},
+	}
+	psm, _ := networksharding.NewPeerShardMapper(arg)
+	pid := core.PeerID("dummy peer ID")
+	psm.UpdatePeerIDInfo(pid, validatorPk, shardId)
+	psm.UpdatePeerIDInfo(pid, observerPk, shardId)
+
+	assert.Equal(t, [][]byte{validatorPk, observerPk}, psm.GetPksFromPidPk(pid))
+	assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(validatorPk))
+	assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(observerPk))
+
+	peerInfo := psm.GetPeerInfo(pid)
+	expectedPeerInfo := core.P2PPeerInfo{
+		PeerType:    core.ValidatorPeer,
+		PeerSubType: core.RegularPeer,
+		ShardID:     shardId,
+		PkBytes:     validatorPk,
+	}
+	assert.Equal(t, expectedPeerInfo, peerInfo)
+}
+
 func TestPeerShardMapper_GetPeerInfoNodesCoordinatorWrongTypeInCacheShouldReturnUnknown(t *testing.T) {
 	t.Parallel()
 
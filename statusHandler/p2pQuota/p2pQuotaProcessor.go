@@ -121,6 +121,16 @@ func (pqp *p2pQuotaProcessor) AddQuota(
 	pqp.mutStatistics.Unlock()
 }
 
+// SetEffectiveQuota records the effective per-peer quota applied for the provided peer class
+func (pqp *p2pQuotaProcessor) SetEffectiveQuota(peerType core.P2PPeerType, maxNumMessages uint32, maxTotalSize uint64) {
+	pqp.handler.SetUInt64Value(pqp.getPeerTypeMetric(common.MetricP2PEffectiveMaxNumMessagesPerPeer, peerType), uint64(maxNumMessages))
+	pqp.handler.SetUInt64Value(pqp.getPeerTypeMetric(common.MetricP2PEffectiveMaxSizePerPeer, peerType), maxTotalSize)
+}
+
+func (pqp *p2pQuotaProcessor) getPeerTypeMetric(metric string, peerType core.P2PPeerType) string {
+	return pqp.getMetric(metric + "_" + peerType.String())
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (pqp *p2pQuotaProcessor) IsInterfaceNil() bool {
 	return pqp == nil
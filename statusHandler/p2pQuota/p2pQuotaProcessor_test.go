@@ -53,6 +53,24 @@ func TestP2PQuotaProcessor_AddQuotaShouldWork(t *testing.T) {
 	assert.Equal(t, sizeProcessed, quota.SizeProcessed())
 }
 
+//------- SetEffectiveQuota
+
+func TestP2PQuotaProcessor_SetEffectiveQuotaShouldSetMetricsPerPeerType(t *testing.T) {
+	t.Parallel()
+
+	status := statusHandlerMock.NewAppStatusHandlerMock()
+	quotaIdentifier := "identifier"
+	pqp, _ := p2pQuota.NewP2PQuotaProcessor(status, quotaIdentifier)
+
+	pqp.SetEffectiveQuota(core.ValidatorPeer, 150, 3000)
+	pqp.SetEffectiveQuota(core.UnknownPeer, 20, 400)
+
+	assert.Equal(t, uint64(150), status.GetUint64(common.MetricP2PEffectiveMaxNumMessagesPerPeer+"_validator_"+quotaIdentifier))
+	assert.Equal(t, uint64(3000), status.GetUint64(common.MetricP2PEffectiveMaxSizePerPeer+"_validator_"+quotaIdentifier))
+	assert.Equal(t, uint64(20), status.GetUint64(common.MetricP2PEffectiveMaxNumMessagesPerPeer+"_unknown_"+quotaIdentifier))
+	assert.Equal(t, uint64(400), status.GetUint64(common.MetricP2PEffectiveMaxSizePerPeer+"_unknown_"+quotaIdentifier))
+}
+
 //------- ResetStatistics
 
 func TestP2PQuotaProcessor_ResetStatisticsShouldEmptyStatsAndCallSetOnAllMetrics(t *testing.T) {
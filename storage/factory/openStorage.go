@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
@@ -90,6 +91,34 @@ func (o *openStorageUnits) GetMostRecentBootstrapStorageUnit() (storage.Storer,
 	return storer, nil
 }
 
+// OpenDB will open the storage unit with the provided configuration for the given shard and epoch
+func (o *openStorageUnits) OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Storer, error) {
+	parentDir, _, err := o.latestStorageDataProvider.GetParentDirAndLastEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	persisterFactory := NewPersisterFactory(dbConfig)
+	persisterPath := filepath.Join(
+		parentDir,
+		fmt.Sprintf("%s_%d", o.defaultEpochString, epoch),
+		fmt.Sprintf("%s_%s", o.defaultShardString, core.GetShardIDString(shardID)),
+		dbConfig.FilePath,
+	)
+
+	persister, err := createDB(persisterFactory, persisterPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cacher, err := lrucache.NewCache(10)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageUnit.NewStorageUnit(cacher, persister)
+}
+
 func createDB(persisterFactory *PersisterFactory, persisterPath string) (storage.Persister, error) {
 	var persister storage.Persister
 	var err error
@@ -143,3 +143,30 @@ func TestGetMostRecentBootstrapStorageUnit(t *testing.T) {
 	assert.NotNil(t, storer)
 
 }
+
+func TestOpenDB_GetParentDirAndLastEpochErr(t *testing.T) {
+	t.Parallel()
+
+	localErr := errors.New("localErr")
+	args := createMockArgsOpenStorageUnits()
+	args.LatestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+		GetParentDirAndLastEpochCalled: func() (string, uint32, error) {
+			return "", 0, localErr
+		},
+	}
+	suoh, _ := NewStorageUnitOpenHandler(args)
+
+	storer, err := suoh.OpenDB(config.DBConfig{Type: "MemoryDB"}, 0, 1)
+	assert.Nil(t, storer)
+	assert.Equal(t, localErr, err)
+}
+
+func TestOpenDB(t *testing.T) {
+	t.Parallel()
+
+	suoh, _ := NewStorageUnitOpenHandler(createMockArgsOpenStorageUnits())
+
+	storer, err := suoh.OpenDB(config.DBConfig{Type: "MemoryDB"}, 0, 1)
+	assert.NoError(t, err)
+	assert.NotNil(t, storer)
+}
@@ -365,6 +365,18 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, statusMetricsStorageUnit)
 
+	epochEconomicsDbConfig := GetDBFromConfig(psf.generalConfig.EpochEconomicsStorage.DB)
+	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.EpochEconomicsStorage.DB.FilePath)
+	epochEconomicsDbConfig.FilePath = dbPath
+	epochEconomicsStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.EpochEconomicsStorage.Cache),
+		epochEconomicsDbConfig,
+		GetBloomFromConfig(psf.generalConfig.EpochEconomicsStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, epochEconomicsStorageUnit)
+
 	trieEpochRootHashStorageUnit, err := psf.createTrieEpochRootHashStorerIfNeeded()
 	if err != nil {
 		return nil, err
@@ -430,6 +442,7 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, trieEpochRootHashStorageUnit)
+	store.AddStorer(dataRetriever.EpochEconomicsUnit, epochEconomicsStorageUnit)
 
 	createdStorers, err := psf.setupDbLookupExtensions(store)
 	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
@@ -3,6 +3,7 @@ package storage
 import (
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 )
 
@@ -140,6 +141,7 @@ type PersisterFactory interface {
 // UnitOpenerHandler defines which actions should be done for opening storage units
 type UnitOpenerHandler interface {
 	GetMostRecentBootstrapStorageUnit() (Storer, error)
+	OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (Storer, error)
 	IsInterfaceNil() bool
 }
 
@@ -188,7 +188,7 @@ func (u *Unit) Get(key []byte) ([]byte, error) {
 			// if found in persistence unit, add it in cache
 			u.cacher.Put(key, v, len(buff))
 		} else {
-			return nil, fmt.Errorf("key: %s not found", base64.StdEncoding.EncodeToString(key))
+			return nil, fmt.Errorf("%w: %s", storage.ErrKeyNotFound, base64.StdEncoding.EncodeToString(key))
 		}
 	}
 
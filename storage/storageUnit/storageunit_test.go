@@ -1,6 +1,7 @@
 package storageUnit_test
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -184,6 +185,7 @@ func TestGetNotPresent(t *testing.T) {
 	v, err := s.Get(key)
 
 	assert.NotNil(t, err, "expected to find no value, but found %s", v)
+	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
 }
 
 func TestGetNotPresentWithNilBloomFilter(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
 
 // HistoryRepositoryStub -
@@ -15,6 +16,8 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	IsEnabledCalled                    func() bool
+	GetESDTHoldersCalled               func(token string, offset uint32, limit uint32) (*esdtSupply.HoldersPage, error)
+	GetESDTSupplyHistoryCalled         func(token string, filter esdtSupply.SupplyHistoryFilter) (*esdtSupply.SupplyHistoryResult, error)
 }
 
 // RecordBlock -
@@ -78,6 +81,22 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(_ string) (string, error) {
 	return "", nil
 }
 
+// GetESDTHolders -
+func (hp *HistoryRepositoryStub) GetESDTHolders(token string, offset uint32, limit uint32) (*esdtSupply.HoldersPage, error) {
+	if hp.GetESDTHoldersCalled != nil {
+		return hp.GetESDTHoldersCalled(token, offset, limit)
+	}
+	return nil, nil
+}
+
+// GetESDTSupplyHistory -
+func (hp *HistoryRepositoryStub) GetESDTSupplyHistory(token string, filter esdtSupply.SupplyHistoryFilter) (*esdtSupply.SupplyHistoryResult, error) {
+	if hp.GetESDTSupplyHistoryCalled != nil {
+		return hp.GetESDTSupplyHistoryCalled(token, filter)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
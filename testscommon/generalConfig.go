@@ -41,6 +41,20 @@ func GetGeneralConfig() config.Config {
 		Consensus: config.ConsensusConfig{
 			Type: "bls",
 		},
+		SlashingDetector: config.SlashingDetectorConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 50,
+			EvidenceStorage: config.StorageConfig{
+				Cache: getLRUCacheConfig(),
+				DB: config.DBConfig{
+					FilePath:          AddTimestampSuffix("SlashingEvidenceStorage"),
+					Type:              string(storageUnit.MemoryDB),
+					BatchDelaySeconds: 30,
+					MaxBatchSize:      6,
+					MaxOpenFiles:      10,
+				},
+			},
+		},
 		ValidatorStatistics: config.ValidatorStatisticsConfig{
 			CacheRefreshIntervalInSec: uint32(100),
 		},
@@ -253,6 +267,16 @@ func GetGeneralConfig() config.Config {
 				MaxOpenFiles:      10,
 			},
 		},
+		EpochEconomicsStorage: config.StorageConfig{
+			Cache: getLRUCacheConfig(),
+			DB: config.DBConfig{
+				FilePath:          AddTimestampSuffix("EpochEconomicsStorageDB"),
+				Type:              string(storageUnit.MemoryDB),
+				BatchDelaySeconds: 30,
+				MaxBatchSize:      6,
+				MaxOpenFiles:      10,
+			},
+		},
 		SmartContractsStorage: config.StorageConfig{
 			Cache: getLRUCacheConfig(),
 			DB: config.DBConfig{
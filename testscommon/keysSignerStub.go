@@ -0,0 +1,50 @@
+package testscommon
+
+// KeysSignerStub -
+type KeysSignerStub struct {
+	SignBlockSignatureShareCalled func(pkBytes []byte, round int64, headerHash []byte) ([]byte, error)
+	SignBlockHeaderCalled         func(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error)
+	SignRandSeedCalled            func(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error)
+	SignPeerIDCalled              func(pkBytes []byte, pid []byte) ([]byte, error)
+}
+
+// SignBlockSignatureShare -
+func (stub *KeysSignerStub) SignBlockSignatureShare(pkBytes []byte, round int64, headerHash []byte) ([]byte, error) {
+	if stub.SignBlockSignatureShareCalled != nil {
+		return stub.SignBlockSignatureShareCalled(pkBytes, round, headerHash)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// SignBlockHeader -
+func (stub *KeysSignerStub) SignBlockHeader(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
+	if stub.SignBlockHeaderCalled != nil {
+		return stub.SignBlockHeaderCalled(pkBytes, round, marshalizedHeader)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// SignRandSeed -
+func (stub *KeysSignerStub) SignRandSeed(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error) {
+	if stub.SignRandSeedCalled != nil {
+		return stub.SignRandSeedCalled(pkBytes, round, prevRandSeed)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// SignPeerID -
+func (stub *KeysSignerStub) SignPeerID(pkBytes []byte, pid []byte) ([]byte, error) {
+	if stub.SignPeerIDCalled != nil {
+		return stub.SignPeerIDCalled(pkBytes, pid)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *KeysSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
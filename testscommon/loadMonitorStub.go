@@ -0,0 +1,46 @@
+package testscommon
+
+import "time"
+
+// LoadMonitorStub -
+type LoadMonitorStub struct {
+	SetQueueDepthCalled          func(queueDepth uint32)
+	SetCpuLoadPercentCalled      func(cpuLoadPercent uint64)
+	SetBlockProcessingTimeCalled func(duration time.Duration)
+	LoadCalled                   func() float64
+}
+
+// SetQueueDepth -
+func (lms *LoadMonitorStub) SetQueueDepth(queueDepth uint32) {
+	if lms.SetQueueDepthCalled != nil {
+		lms.SetQueueDepthCalled(queueDepth)
+	}
+}
+
+// SetCpuLoadPercent -
+func (lms *LoadMonitorStub) SetCpuLoadPercent(cpuLoadPercent uint64) {
+	if lms.SetCpuLoadPercentCalled != nil {
+		lms.SetCpuLoadPercentCalled(cpuLoadPercent)
+	}
+}
+
+// SetBlockProcessingTime -
+func (lms *LoadMonitorStub) SetBlockProcessingTime(duration time.Duration) {
+	if lms.SetBlockProcessingTimeCalled != nil {
+		lms.SetBlockProcessingTimeCalled(duration)
+	}
+}
+
+// Load -
+func (lms *LoadMonitorStub) Load() float64 {
+	if lms.LoadCalled != nil {
+		return lms.LoadCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (lms *LoadMonitorStub) IsInterfaceNil() bool {
+	return lms == nil
+}
@@ -0,0 +1,91 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// ManagedPeersHolderStub -
+type ManagedPeersHolderStub struct {
+	AddManagedPeerCalled              func(privateKeyBytes []byte) error
+	GetPrivateKeyCalled               func(pkBytes []byte) (crypto.PrivateKey, error)
+	IsKeyRegisteredCalled             func(pkBytes []byte) bool
+	IsKeyManagedByCurrentNodeCalled   func(pkBytes []byte) bool
+	IncrementRoundsOfInactivityCalled func(pkBytes []byte, roundIndex int64)
+	ResetRoundsOfInactivityCalled     func(pkBytes []byte, pid core.PeerID)
+	GetManagedKeysByCurrentNodeCalled func() map[string]crypto.PrivateKey
+	IsMultiKeyModeCalled              func() bool
+}
+
+// AddManagedPeer -
+func (stub *ManagedPeersHolderStub) AddManagedPeer(privateKeyBytes []byte) error {
+	if stub.AddManagedPeerCalled != nil {
+		return stub.AddManagedPeerCalled(privateKeyBytes)
+	}
+
+	return nil
+}
+
+// GetPrivateKey -
+func (stub *ManagedPeersHolderStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if stub.GetPrivateKeyCalled != nil {
+		return stub.GetPrivateKeyCalled(pkBytes)
+	}
+
+	return nil, nil
+}
+
+// IsKeyRegistered -
+func (stub *ManagedPeersHolderStub) IsKeyRegistered(pkBytes []byte) bool {
+	if stub.IsKeyRegisteredCalled != nil {
+		return stub.IsKeyRegisteredCalled(pkBytes)
+	}
+
+	return false
+}
+
+// IsKeyManagedByCurrentNode -
+func (stub *ManagedPeersHolderStub) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	if stub.IsKeyManagedByCurrentNodeCalled != nil {
+		return stub.IsKeyManagedByCurrentNodeCalled(pkBytes)
+	}
+
+	return false
+}
+
+// IncrementRoundsOfInactivity -
+func (stub *ManagedPeersHolderStub) IncrementRoundsOfInactivity(pkBytes []byte, roundIndex int64) {
+	if stub.IncrementRoundsOfInactivityCalled != nil {
+		stub.IncrementRoundsOfInactivityCalled(pkBytes, roundIndex)
+	}
+}
+
+// ResetRoundsOfInactivity -
+func (stub *ManagedPeersHolderStub) ResetRoundsOfInactivity(pkBytes []byte, pid core.PeerID) {
+	if stub.ResetRoundsOfInactivityCalled != nil {
+		stub.ResetRoundsOfInactivityCalled(pkBytes, pid)
+	}
+}
+
+// GetManagedKeysByCurrentNode -
+func (stub *ManagedPeersHolderStub) GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey {
+	if stub.GetManagedKeysByCurrentNodeCalled != nil {
+		return stub.GetManagedKeysByCurrentNodeCalled()
+	}
+
+	return make(map[string]crypto.PrivateKey)
+}
+
+// IsMultiKeyMode -
+func (stub *ManagedPeersHolderStub) IsMultiKeyMode() bool {
+	if stub.IsMultiKeyModeCalled != nil {
+		return stub.IsMultiKeyModeCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *ManagedPeersHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
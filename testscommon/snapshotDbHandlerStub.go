@@ -0,0 +1,61 @@
+package testscommon
+
+// SnapshotDbHandlerStub -
+type SnapshotDbHandlerStub struct {
+	*MemDbMock
+	IsInUseCalled               func() bool
+	DecreaseNumReferencesCalled func()
+	IncreaseNumReferencesCalled func()
+	MarkForRemovalCalled        func()
+	MarkForDisconnectionCalled  func()
+	SetPathCalled               func(string)
+}
+
+// IsInUse -
+func (sdhs *SnapshotDbHandlerStub) IsInUse() bool {
+	if sdhs.IsInUseCalled != nil {
+		return sdhs.IsInUseCalled()
+	}
+
+	return false
+}
+
+// DecreaseNumReferences -
+func (sdhs *SnapshotDbHandlerStub) DecreaseNumReferences() {
+	if sdhs.DecreaseNumReferencesCalled != nil {
+		sdhs.DecreaseNumReferencesCalled()
+	}
+}
+
+// IncreaseNumReferences -
+func (sdhs *SnapshotDbHandlerStub) IncreaseNumReferences() {
+	if sdhs.IncreaseNumReferencesCalled != nil {
+		sdhs.IncreaseNumReferencesCalled()
+	}
+}
+
+// MarkForRemoval -
+func (sdhs *SnapshotDbHandlerStub) MarkForRemoval() {
+	if sdhs.MarkForRemovalCalled != nil {
+		sdhs.MarkForRemovalCalled()
+	}
+}
+
+// MarkForDisconnection -
+func (sdhs *SnapshotDbHandlerStub) MarkForDisconnection() {
+	if sdhs.MarkForDisconnectionCalled != nil {
+		sdhs.MarkForDisconnectionCalled()
+	}
+}
+
+// SetPath -
+func (sdhs *SnapshotDbHandlerStub) SetPath(path string) {
+	if sdhs.SetPathCalled != nil {
+		sdhs.SetPathCalled(path)
+	}
+}
+
+// IsInterfaceNil -
+func (sdhs *SnapshotDbHandlerStub) IsInterfaceNil() bool {
+	return sdhs == nil
+}
@@ -277,3 +277,24 @@ var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for mi
 
 // ErrInvalidNumConcurrentTrieSyncers signals that the number of concurrent trie syncers is invalid
 var ErrInvalidNumConcurrentTrieSyncers = errors.New("invalid num concurrent trie syncers")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilExportReport signals that a nil export report has been provided
+var ErrNilExportReport = errors.New("nil export report")
+
+// ErrExportReportHashMismatch signals that the hash of a signed export report does not match its content
+var ErrExportReportHashMismatch = errors.New("export report hash mismatch")
+
+// ErrNilHardforkProposalsProvider signals that a nil hardfork proposals provider has been provided
+var ErrNilHardforkProposalsProvider = errors.New("nil hardfork proposals provider")
+
+// ErrNilGovernanceHardforkTrigger signals that a nil governance hardfork trigger has been provided
+var ErrNilGovernanceHardforkTrigger = errors.New("nil governance hardfork trigger")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrEmptySoftwareVersion signals that an empty software version has been provided
+var ErrEmptySoftwareVersion = errors.New("empty software version")
@@ -212,6 +212,19 @@ type EpochChangeConfirmedNotifier interface {
 	IsInterfaceNil() bool
 }
 
+// HardforkProposalsProvider defines the component able to provide the hardfork governance proposals, used to find the
+// passed ones
+type HardforkProposalsProvider interface {
+	GetHardforkProposals() (*common.GovernanceProposalsResponse, error)
+	IsInterfaceNil() bool
+}
+
+// GovernanceHardforkTrigger defines the hardfork trigger functionality used when a governance hardfork proposal passes
+type GovernanceHardforkTrigger interface {
+	TriggerFromGovernance(epoch uint32) error
+	IsInterfaceNil() bool
+}
+
 // Closer defines the functionality of an entity that can be closed
 type Closer interface {
 	Close() error
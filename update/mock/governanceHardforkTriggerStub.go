@@ -0,0 +1,20 @@
+package mock
+
+// GovernanceHardforkTriggerStub -
+type GovernanceHardforkTriggerStub struct {
+	TriggerFromGovernanceCalled func(epoch uint32) error
+}
+
+// TriggerFromGovernance -
+func (ghts *GovernanceHardforkTriggerStub) TriggerFromGovernance(epoch uint32) error {
+	if ghts.TriggerFromGovernanceCalled != nil {
+		return ghts.TriggerFromGovernanceCalled(epoch)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ghts *GovernanceHardforkTriggerStub) IsInterfaceNil() bool {
+	return ghts == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// HardforkProposalsProviderStub -
+type HardforkProposalsProviderStub struct {
+	GetHardforkProposalsCalled func() (*common.GovernanceProposalsResponse, error)
+}
+
+// GetHardforkProposals -
+func (hpps *HardforkProposalsProviderStub) GetHardforkProposals() (*common.GovernanceProposalsResponse, error) {
+	if hpps.GetHardforkProposalsCalled != nil {
+		return hpps.GetHardforkProposalsCalled()
+	}
+
+	return &common.GovernanceProposalsResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (hpps *HardforkProposalsProviderStub) IsInterfaceNil() bool {
+	return hpps == nil
+}
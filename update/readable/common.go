@@ -0,0 +1,149 @@
+package readable
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+)
+
+var log = logger.GetOrCreate("update/readable")
+
+const atSep = "@"
+const maxTrieLevelInMemory = uint(5)
+
+// esdtTickerRandomSequenceLength is the length of the random suffix added to a ticker to form the token identifier
+const esdtTickerRandomSequenceLength = 6
+
+var esdtKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+var txTypeNames = map[genesis.Type]string{
+	genesis.Transaction:         "normal",
+	genesis.SmartContractResult: "scr",
+	genesis.RewardTransaction:   "reward",
+}
+
+func txTypeFromName(name string) (genesis.Type, error) {
+	for txType, txTypeName := range txTypeNames {
+		if txTypeName == name {
+			return txType, nil
+		}
+	}
+
+	return genesis.Unknown, update.ErrUnknownType
+}
+
+func createTrieIdentifier(trieKey string) string {
+	return genesis.TrieIdentifier + atSep + trieKey
+}
+
+func createDataTrieKey(shardID uint32, rootHash []byte) string {
+	return genesis.AddRootHashToIdentifier(genesis.CreateTrieIdentifier(shardID, genesis.DataTrie), string(rootHash))
+}
+
+func encodeAddress(converter core.PubkeyConverter, address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return converter.Encode(address)
+}
+
+func decodeAddress(converter core.PubkeyConverter, address string) ([]byte, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+
+	return converter.Decode(address)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return nil, update.ErrInvalidValue
+	}
+
+	return result, nil
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// trimDataTrieValue removes the key and the account address appended to each value saved in a data trie
+func trimDataTrieValue(value []byte, key []byte, address []byte) []byte {
+	dataLength := len(value) - len(key) - len(address)
+	if dataLength < 0 {
+		return value
+	}
+
+	return value[:dataLength]
+}
+
+func appendDataTrieTail(value []byte, key []byte, address []byte) []byte {
+	result := make([]byte, 0, len(value)+len(key)+len(address))
+	result = append(result, value...)
+	result = append(result, key...)
+
+	return append(result, address...)
+}
+
+func decodeESDTBalance(key []byte, value []byte, marshalizer marshal.Marshalizer) (*ESDTBalance, bool) {
+	if !bytes.HasPrefix(key, esdtKeyPrefix) {
+		return nil, false
+	}
+
+	token := &esdt.ESDigitalToken{}
+	err := marshalizer.Unmarshal(token, value)
+	if err != nil {
+		log.Trace("cannot decode ESDT balance", "key", hex.EncodeToString(key), "error", err)
+		return nil, false
+	}
+
+	tokenIdentifier, nonce := splitTokenIdentifierAndNonce(key[len(esdtKeyPrefix):])
+
+	return &ESDTBalance{
+		TokenIdentifier: string(tokenIdentifier),
+		Nonce:           nonce,
+		Balance:         bigIntToString(token.Value),
+	}, true
+}
+
+func splitTokenIdentifierAndNonce(tokenKey []byte) ([]byte, uint64) {
+	separatorIndex := bytes.IndexByte(tokenKey, '-')
+	tokenIdentifierLength := separatorIndex + 1 + esdtTickerRandomSequenceLength
+	if separatorIndex < 0 || len(tokenKey) < tokenIdentifierLength {
+		return tokenKey, 0
+	}
+
+	nonce := big.NewInt(0).SetBytes(tokenKey[tokenIdentifierLength:])
+
+	return tokenKey[:tokenIdentifierLength], nonce.Uint64()
+}
+
+func newInMemoryTrie(marshalizer marshal.Marshalizer, hasher hashing.Hasher) (common.Trie, error) {
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.NewTrie(trieStorage, marshalizer, hasher, maxTrieLevelInMemory)
+}
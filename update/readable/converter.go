@@ -0,0 +1,552 @@
+package readable
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+)
+
+// ArgsReadableConverter is the argument structure used to create a new readable converter
+type ArgsReadableConverter struct {
+	HardforkStorer         update.HardforkStorer
+	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	AddressPubKeyConverter core.PubkeyConverter
+	InputFolder            string
+	NodesSetupOutputFile   string
+}
+
+type readableConverter struct {
+	hardforkStorer         update.HardforkStorer
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	addressPubKeyConverter core.PubkeyConverter
+	inputFolder            string
+	nodesSetupOutputFile   string
+	accountsTries          map[uint32]common.Trie
+	dataTries              map[string]common.Trie
+}
+
+// NewReadableConverter creates a component able to convert a readable export back in the hardfork storer format,
+// so that an edited state can be imported. The nodes setup output file is optional, when provided the validators are
+// written in it
+func NewReadableConverter(args ArgsReadableConverter) (*readableConverter, error) {
+	if check.IfNil(args.HardforkStorer) {
+		return nil, update.ErrNilHardforkStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, update.ErrNilHasher
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, update.ErrNilPubKeyConverter
+	}
+	if len(args.InputFolder) == 0 {
+		return nil, update.ErrEmptyExportFolderPath
+	}
+
+	return &readableConverter{
+		hardforkStorer:         args.HardforkStorer,
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		inputFolder:            args.InputFolder,
+		nodesSetupOutputFile:   args.NodesSetupOutputFile,
+		accountsTries:          make(map[uint32]common.Trie),
+		dataTries:              make(map[string]common.Trie),
+	}, nil
+}
+
+// Convert reads all the files of the readable export and writes their content in the hardfork storer. The accounts
+// tries and the data tries are rebuilt in memory, so their root hashes reflect the edited state
+func (rc *readableConverter) Convert() error {
+	convertFunctions := []func() error{
+		rc.convertMetaBlocks,
+		rc.convertMiniBlocks,
+		rc.convertTransactions,
+		rc.convertAccounts,
+		rc.convertValidators,
+	}
+	for _, convertFunction := range convertFunctions {
+		err := convertFunction()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rc *readableConverter) convertMetaBlocks() error {
+	newRecord := func() interface{} {
+		return &MetaBlockRecord{}
+	}
+	handler := func(record interface{}) error {
+		return rc.convertMetaBlock(record.(*MetaBlockRecord))
+	}
+
+	err := readJsonLines(rc.inputFolder, MetaBlocksFileName, newRecord, handler)
+	if err != nil {
+		return err
+	}
+
+	err = rc.hardforkStorer.FinishedIdentifier(genesis.EpochStartMetaBlockIdentifier)
+	if err != nil {
+		return err
+	}
+
+	return rc.hardforkStorer.FinishedIdentifier(genesis.UnFinishedMetaBlocksIdentifier)
+}
+
+func (rc *readableConverter) convertMetaBlock(record *MetaBlockRecord) error {
+	var identifier string
+	switch record.Kind {
+	case EpochStartMetaBlockKind:
+		identifier = genesis.EpochStartMetaBlockIdentifier
+	case UnFinishedMetaBlockKind:
+		identifier = genesis.UnFinishedMetaBlocksIdentifier
+	default:
+		return fmt.Errorf("%w metaBlock kind %s", update.ErrUnknownType, record.Kind)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err := json.Unmarshal(record.MetaBlock, metaBlock)
+	if err != nil {
+		return err
+	}
+
+	if identifier == genesis.EpochStartMetaBlockIdentifier {
+		rc.createAccountsTriesForMetaBlock(metaBlock)
+	}
+
+	jsonData, err := json.Marshal(metaBlock)
+	if err != nil {
+		return err
+	}
+
+	versionKey := genesis.CreateVersionKey(metaBlock, rc.hasher.Compute(string(jsonData)))
+
+	return rc.hardforkStorer.Write(identifier, []byte(versionKey), jsonData)
+}
+
+// createAccountsTriesForMetaBlock makes sure that an accounts trie is exported for every shard of the epoch start
+// metaBlock, even if the shard has no account in the readable export
+func (rc *readableConverter) createAccountsTriesForMetaBlock(metaBlock *block.MetaBlock) {
+	_, _ = rc.getAccountsTrie(core.MetachainShardId)
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		_, _ = rc.getAccountsTrie(shardData.ShardID)
+	}
+}
+
+func (rc *readableConverter) convertMiniBlocks() error {
+	newRecord := func() interface{} {
+		return &MiniBlockRecord{}
+	}
+	handler := func(record interface{}) error {
+		return rc.convertMiniBlock(record.(*MiniBlockRecord))
+	}
+
+	err := readJsonLines(rc.inputFolder, MiniBlocksFileName, newRecord, handler)
+	if err != nil {
+		return err
+	}
+
+	return rc.hardforkStorer.FinishedIdentifier(genesis.MiniBlocksIdentifier)
+}
+
+func (rc *readableConverter) convertMiniBlock(record *MiniBlockRecord) error {
+	hash, err := hex.DecodeString(record.Hash)
+	if err != nil {
+		return err
+	}
+	reserved, err := hex.DecodeString(record.Reserved)
+	if err != nil {
+		return err
+	}
+
+	miniBlock := &block.MiniBlock{
+		TxHashes:        make([][]byte, 0, len(record.TxHashes)),
+		ReceiverShardID: record.ReceiverShardID,
+		SenderShardID:   record.SenderShardID,
+		Type:            block.Type(record.Type),
+	}
+	if len(reserved) > 0 {
+		miniBlock.Reserved = reserved
+	}
+	for _, txHash := range record.TxHashes {
+		decodedTxHash, errDecode := hex.DecodeString(txHash)
+		if errDecode != nil {
+			return errDecode
+		}
+		miniBlock.TxHashes = append(miniBlock.TxHashes, decodedTxHash)
+	}
+
+	jsonData, err := json.Marshal(miniBlock)
+	if err != nil {
+		return err
+	}
+
+	return rc.hardforkStorer.Write(genesis.MiniBlocksIdentifier, []byte(genesis.CreateMiniBlockKey(string(hash))), jsonData)
+}
+
+func (rc *readableConverter) convertTransactions() error {
+	newRecord := func() interface{} {
+		return &TransactionRecord{}
+	}
+	handler := func(record interface{}) error {
+		return rc.convertTransaction(record.(*TransactionRecord))
+	}
+
+	err := readJsonLines(rc.inputFolder, TransactionsFileName, newRecord, handler)
+	if err != nil {
+		return err
+	}
+
+	return rc.hardforkStorer.FinishedIdentifier(genesis.TransactionsIdentifier)
+}
+
+func (rc *readableConverter) convertTransaction(record *TransactionRecord) error {
+	hash, err := hex.DecodeString(record.Hash)
+	if err != nil {
+		return err
+	}
+	txType, err := txTypeFromName(record.Type)
+	if err != nil {
+		return fmt.Errorf("%w transaction type %s", err, record.Type)
+	}
+
+	object, err := genesis.NewObject(txType)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(record.Transaction, object)
+	if err != nil {
+		return err
+	}
+	tx, ok := object.(data.TransactionHandler)
+	if !ok {
+		return fmt.Errorf("%w: wanted a transaction handler", update.ErrWrongTypeAssertion)
+	}
+
+	jsonData, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	return rc.hardforkStorer.Write(genesis.TransactionsIdentifier, []byte(genesis.CreateTransactionKey(string(hash), tx)), jsonData)
+}
+
+func (rc *readableConverter) convertAccounts() error {
+	newCodeRecord := func() interface{} {
+		return &CodeRecord{}
+	}
+	codeHandler := func(record interface{}) error {
+		return rc.convertCode(record.(*CodeRecord))
+	}
+	err := readJsonLines(rc.inputFolder, CodeFileName, newCodeRecord, codeHandler)
+	if err != nil {
+		return err
+	}
+
+	newAccountRecord := func() interface{} {
+		return &AccountRecord{}
+	}
+	accountHandler := func(record interface{}) error {
+		return rc.convertAccount(record.(*AccountRecord))
+	}
+	err = readJsonLines(rc.inputFolder, AccountsFileName, newAccountRecord, accountHandler)
+	if err != nil {
+		return err
+	}
+
+	return rc.writeTries()
+}
+
+func (rc *readableConverter) convertCode(record *CodeRecord) error {
+	key, err := hex.DecodeString(record.Key)
+	if err != nil {
+		return err
+	}
+	value, err := rc.createCodeLeafValue(record)
+	if err != nil {
+		return err
+	}
+
+	accountsTrie, err := rc.getAccountsTrie(record.ShardID)
+	if err != nil {
+		return err
+	}
+
+	return accountsTrie.Update(key, value)
+}
+
+func (rc *readableConverter) createCodeLeafValue(record *CodeRecord) ([]byte, error) {
+	if len(record.Raw) > 0 {
+		return hex.DecodeString(record.Raw)
+	}
+
+	code, err := hex.DecodeString(record.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.marshalizer.Marshal(&state.CodeEntry{
+		Code:          code,
+		NumReferences: record.NumReferences,
+	})
+}
+
+func (rc *readableConverter) convertAccount(record *AccountRecord) error {
+	account, err := rc.createAccountData(record)
+	if err != nil {
+		return fmt.Errorf("%w for account %s", err, record.Address)
+	}
+
+	account.RootHash, err = rc.createDataTrie(record.ShardID, account.Address, record.DataTrie)
+	if err != nil {
+		return fmt.Errorf("%w for account %s", err, record.Address)
+	}
+
+	buff, err := rc.marshalizer.Marshal(account)
+	if err != nil {
+		return err
+	}
+
+	accountsTrie, err := rc.getAccountsTrie(record.ShardID)
+	if err != nil {
+		return err
+	}
+
+	return accountsTrie.Update(account.Address, buff)
+}
+
+func (rc *readableConverter) createAccountData(record *AccountRecord) (*state.UserAccountData, error) {
+	address, err := decodeAddress(rc.addressPubKeyConverter, record.Address)
+	if err != nil {
+		return nil, err
+	}
+	if len(address) == 0 {
+		return nil, state.ErrNilAddress
+	}
+	ownerAddress, err := decodeAddress(rc.addressPubKeyConverter, record.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := decodeBigInt(record.Balance)
+	if err != nil {
+		return nil, err
+	}
+	developerReward, err := decodeBigInt(record.DeveloperReward)
+	if err != nil {
+		return nil, err
+	}
+	codeHash, err := hex.DecodeString(record.CodeHash)
+	if err != nil {
+		return nil, err
+	}
+	codeMetadata, err := hex.DecodeString(record.CodeMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &state.UserAccountData{
+		Nonce:           record.Nonce,
+		Balance:         balance,
+		Address:         address,
+		DeveloperReward: developerReward,
+		OwnerAddress:    ownerAddress,
+	}
+	if len(record.UserName) > 0 {
+		account.UserName = []byte(record.UserName)
+	}
+	if len(codeHash) > 0 {
+		account.CodeHash = codeHash
+	}
+	if len(codeMetadata) > 0 {
+		account.CodeMetadata = codeMetadata
+	}
+
+	return account, nil
+}
+
+func (rc *readableConverter) createDataTrie(shardID uint32, address []byte, entries []*DataTrieEntry) ([]byte, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	dataTrie, err := newInMemoryTrie(rc.marshalizer, rc.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		key, errDecode := hex.DecodeString(entry.Key)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		value, errDecode := hex.DecodeString(entry.Value)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		if len(value) == 0 {
+			continue
+		}
+
+		err = dataTrie.Update(key, appendDataTrieTail(value, key, address))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rootHash, err := dataTrie.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	rc.dataTries[createDataTrieKey(shardID, rootHash)] = dataTrie
+
+	return rootHash, nil
+}
+
+func (rc *readableConverter) getAccountsTrie(shardID uint32) (common.Trie, error) {
+	accountsTrie, found := rc.accountsTries[shardID]
+	if found {
+		return accountsTrie, nil
+	}
+
+	accountsTrie, err := newInMemoryTrie(rc.marshalizer, rc.hasher)
+	if err != nil {
+		return nil, err
+	}
+	rc.accountsTries[shardID] = accountsTrie
+
+	return accountsTrie, nil
+}
+
+func (rc *readableConverter) writeTries() error {
+	shardIDs := make([]uint32, 0, len(rc.accountsTries))
+	for shardID := range rc.accountsTries {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	for _, shardID := range shardIDs {
+		trieKey := genesis.CreateTrieIdentifier(shardID, genesis.UserAccount)
+		err := rc.writeTrie(trieKey, genesis.UserAccount, shardID, rc.accountsTries[shardID])
+		if err != nil {
+			return err
+		}
+	}
+
+	for trieKey, dataTrie := range rc.dataTries {
+		_, shardID, err := genesis.GetTrieTypeAndShId(createTrieIdentifier(trieKey))
+		if err != nil {
+			return err
+		}
+
+		err = rc.writeTrie(trieKey, genesis.DataTrie, shardID, dataTrie)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rc *readableConverter) writeTrie(trieKey string, accType genesis.Type, shardID uint32, tr common.Trie) error {
+	err := tr.Commit()
+	if err != nil {
+		return err
+	}
+
+	rootHash, err := tr.RootHash()
+	if err != nil {
+		return err
+	}
+
+	identifier := createTrieIdentifier(trieKey)
+	err = rc.hardforkStorer.Write(identifier, []byte(genesis.CreateRootHashKey(trieKey)), rootHash)
+	if err != nil {
+		return err
+	}
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return err
+	}
+
+	for leaf := range leavesChannel {
+		keyToWrite := genesis.CreateAccountKey(accType, shardID, leaf.Key())
+		err = rc.hardforkStorer.Write(identifier, []byte(keyToWrite), leaf.Value())
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("converted trie", "identifier", identifier, "root hash", rootHash)
+
+	return rc.hardforkStorer.FinishedIdentifier(identifier)
+}
+
+func (rc *readableConverter) convertValidators() error {
+	if len(rc.nodesSetupOutputFile) == 0 {
+		log.Debug("no nodes setup output file provided, validators not converted")
+		return nil
+	}
+
+	nodesSetup := &sharding.NodesSetup{}
+	nodesSetupFile := filepath.Join(rc.inputFolder, common.NodesSetupJsonFileName)
+	err := core.LoadJsonFile(nodesSetup, nodesSetupFile)
+	if err != nil {
+		return err
+	}
+
+	nodesSetup.InitialNodes = make([]*sharding.InitialNode, 0)
+	newRecord := func() interface{} {
+		return &ValidatorRecord{}
+	}
+	handler := func(record interface{}) error {
+		validator := record.(*ValidatorRecord)
+		nodesSetup.InitialNodes = append(nodesSetup.InitialNodes, &sharding.InitialNode{
+			PubKey:        validator.PubKey,
+			Address:       validator.Address,
+			InitialRating: validator.InitialRating,
+		})
+		return nil
+	}
+	err = readJsonLines(rc.inputFolder, ValidatorsFileName, newRecord, handler)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(nodesSetup, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Clean(rc.nodesSetupOutputFile), buff, core.FileModeUserReadWrite)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *readableConverter) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,193 @@
+package readable
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/ElrondNetwork/elrond-go/update/verifier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsReadableConverter(hs update.HardforkStorer, inputFolder string) ArgsReadableConverter {
+	return ArgsReadableConverter{
+		HardforkStorer:         hs,
+		Marshalizer:            &marshal.GogoProtoMarshalizer{},
+		Hasher:                 &testscommon.HasherMock{},
+		AddressPubKeyConverter: createAddressPubKeyConverter(),
+		InputFolder:            inputFolder,
+	}
+}
+
+func exportReadable(t *testing.T, te *testExport) string {
+	outputFolder := t.TempDir()
+	args := createMockArgsReadableExporter(te.hardforkStorer, outputFolder)
+	args.NodesSetupFile = te.nodesSetupFile
+	re, _ := NewReadableExporter(args)
+	require.Nil(t, re.Export())
+
+	return outputFolder
+}
+
+func verifyExport(t *testing.T, hs update.HardforkStorer) *verifier.ExportReport {
+	ev, err := verifier.NewExportVerifier(verifier.ArgsExportVerifier{
+		HardforkStorer: hs,
+		Marshalizer:    &marshal.GogoProtoMarshalizer{},
+		Hasher:         &testscommon.HasherMock{},
+	})
+	require.Nil(t, err)
+
+	report, err := ev.Verify()
+	require.Nil(t, err)
+
+	return report
+}
+
+func TestNewReadableConverter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hardfork storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := NewReadableConverter(createMockArgsReadableConverter(nil, "folder"))
+		assert.True(t, check.IfNil(rc))
+		assert.Equal(t, update.ErrNilHardforkStorer, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReadableConverter(&mock.HardforkStorerStub{}, "folder")
+		args.Marshalizer = nil
+		rc, err := NewReadableConverter(args)
+		assert.True(t, check.IfNil(rc))
+		assert.Equal(t, update.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReadableConverter(&mock.HardforkStorerStub{}, "folder")
+		args.Hasher = nil
+		rc, err := NewReadableConverter(args)
+		assert.True(t, check.IfNil(rc))
+		assert.Equal(t, update.ErrNilHasher, err)
+	})
+	t.Run("nil address pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReadableConverter(&mock.HardforkStorerStub{}, "folder")
+		args.AddressPubKeyConverter = nil
+		rc, err := NewReadableConverter(args)
+		assert.True(t, check.IfNil(rc))
+		assert.Equal(t, update.ErrNilPubKeyConverter, err)
+	})
+	t.Run("empty input folder should error", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := NewReadableConverter(createMockArgsReadableConverter(&mock.HardforkStorerStub{}, ""))
+		assert.True(t, check.IfNil(rc))
+		assert.Equal(t, update.ErrEmptyExportFolderPath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := NewReadableConverter(createMockArgsReadableConverter(&mock.HardforkStorerStub{}, "folder"))
+		assert.False(t, check.IfNil(rc))
+		assert.Nil(t, err)
+	})
+}
+
+func TestReadableConverter_ConvertUnchangedExportShouldKeepRootHashes(t *testing.T) {
+	t.Parallel()
+
+	te := createTestExport(t)
+	readableFolder := exportReadable(t, te)
+
+	hs := createHardforkStorer(t)
+	nodesSetupOutputFile := filepath.Join(t.TempDir(), common.NodesSetupJsonFileName)
+	args := createMockArgsReadableConverter(hs, readableFolder)
+	args.NodesSetupOutputFile = nodesSetupOutputFile
+	rc, _ := NewReadableConverter(args)
+
+	err := rc.Convert()
+	require.Nil(t, err)
+
+	report := verifyExport(t, hs)
+	assert.True(t, report.IsValid(), strings.Join(report.Issues, "\n"))
+	assert.Equal(t, 1, report.NumDataTries)
+	assert.Equal(t, 1, report.NumPendingMiniBlocks)
+	assert.Equal(t, 1, report.NumTransactions)
+	require.Equal(t, 2, len(report.Shards))
+	for _, shard := range report.Shards {
+		assert.Equal(t, shard.EpochStartRootHash, shard.RecomputedRootHash)
+	}
+	assert.Equal(t, hex.EncodeToString(te.epochStartMetaBlock.EpochStart.LastFinalizedHeaders[0].RootHash), report.Shards[0].ExportedRootHash)
+
+	nodesSetup := &sharding.NodesSetup{}
+	require.Nil(t, core.LoadJsonFile(nodesSetup, nodesSetupOutputFile))
+	require.Equal(t, 1, len(nodesSetup.InitialNodes))
+	assert.Equal(t, "aaaa", nodesSetup.InitialNodes[0].PubKey)
+	assert.Equal(t, uint32(6000), uint32(nodesSetup.RoundDuration))
+}
+
+func TestReadableConverter_ConvertEditedExportShouldChangeRootHash(t *testing.T) {
+	t.Parallel()
+
+	te := createTestExport(t)
+	readableFolder := exportReadable(t, te)
+
+	accounts := readRecords(t, readableFolder, AccountsFileName, func() interface{} { return &AccountRecord{} })
+	lines := make([]string, 0, len(accounts))
+	for _, record := range accounts {
+		account := record.(*AccountRecord)
+		if account.Address == createAddressPubKeyConverter().Encode(testAddress0) {
+			account.Balance = "123456"
+		}
+		buff, _ := json.Marshal(account)
+		lines = append(lines, string(buff))
+	}
+	content := strings.Join(lines, "\n") + "\n"
+	require.Nil(t, ioutil.WriteFile(filepath.Join(readableFolder, AccountsFileName), []byte(content), core.FileModeUserReadWrite))
+
+	hs := createHardforkStorer(t)
+	rc, _ := NewReadableConverter(createMockArgsReadableConverter(hs, readableFolder))
+	err := rc.Convert()
+	require.Nil(t, err)
+
+	report := verifyExport(t, hs)
+	require.Equal(t, 1, len(report.Issues))
+	assert.True(t, strings.Contains(report.Issues[0], "epoch start"), report.Issues[0])
+	for _, shard := range report.Shards {
+		if shard.ShardID == 0 {
+			assert.NotEqual(t, shard.EpochStartRootHash, shard.RecomputedRootHash)
+			assert.Equal(t, shard.ExportedRootHash, shard.RecomputedRootHash)
+			continue
+		}
+		assert.Equal(t, shard.EpochStartRootHash, shard.RecomputedRootHash)
+	}
+}
+
+func TestReadableConverter_ConvertInvalidRecordShouldError(t *testing.T) {
+	t.Parallel()
+
+	te := createTestExport(t)
+	readableFolder := exportReadable(t, te)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(readableFolder, TransactionsFileName), []byte(`{"type":"unknown"}`), core.FileModeUserReadWrite))
+
+	rc, _ := NewReadableConverter(createMockArgsReadableConverter(createHardforkStorer(t), readableFolder))
+	err := rc.Convert()
+	require.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), update.ErrUnknownType.Error()))
+	assert.True(t, strings.Contains(err.Error(), TransactionsFileName))
+}
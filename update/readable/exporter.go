@@ -0,0 +1,470 @@
+package readable
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+)
+
+// ArgsReadableExporter is the argument structure used to create a new readable exporter
+type ArgsReadableExporter struct {
+	HardforkStorer         update.HardforkStorer
+	Marshalizer            marshal.Marshalizer
+	AddressPubKeyConverter core.PubkeyConverter
+	NodesSetupFile         string
+	OutputFolder           string
+}
+
+type readableExporter struct {
+	hardforkStorer         update.HardforkStorer
+	marshalizer            marshal.Marshalizer
+	addressPubKeyConverter core.PubkeyConverter
+	nodesSetupFile         string
+	outputFolder           string
+	identifiers            map[string][][]byte
+}
+
+// NewReadableExporter creates a component able to convert a hardfork export in the readable JSON-lines format.
+// The nodes setup file is optional, when provided the validators are exported from it
+func NewReadableExporter(args ArgsReadableExporter) (*readableExporter, error) {
+	if check.IfNil(args.HardforkStorer) {
+		return nil, update.ErrNilHardforkStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, update.ErrNilPubKeyConverter
+	}
+	if len(args.OutputFolder) == 0 {
+		return nil, update.ErrEmptyExportFolderPath
+	}
+
+	return &readableExporter{
+		hardforkStorer:         args.HardforkStorer,
+		marshalizer:            args.Marshalizer,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		nodesSetupFile:         args.NodesSetupFile,
+		outputFolder:           args.OutputFolder,
+		identifiers:            make(map[string][][]byte),
+	}, nil
+}
+
+// Export writes all the data from the hardfork storer in the output folder, one JSON-lines file for each kind of data
+func (re *readableExporter) Export() error {
+	re.hardforkStorer.RangeKeys(func(identifier string, keys [][]byte) bool {
+		re.identifiers[identifier] = keys
+		return true
+	})
+
+	err := os.MkdirAll(re.outputFolder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	exportFunctions := []func() error{
+		re.exportMetaBlocks,
+		re.exportMiniBlocks,
+		re.exportTransactions,
+		re.exportAccounts,
+		re.exportValidators,
+	}
+	for _, exportFunction := range exportFunctions {
+		err = exportFunction()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (re *readableExporter) exportMetaBlocks() error {
+	writer, err := newJsonLinesWriter(re.outputFolder, MetaBlocksFileName)
+	if err != nil {
+		return err
+	}
+
+	err = re.writeMetaBlocks(writer, genesis.EpochStartMetaBlockIdentifier, EpochStartMetaBlockKind)
+	if err == nil {
+		err = re.writeMetaBlocks(writer, genesis.UnFinishedMetaBlocksIdentifier, UnFinishedMetaBlockKind)
+	}
+
+	return re.closeWriter(writer, MetaBlocksFileName, err)
+}
+
+func (re *readableExporter) writeMetaBlocks(writer *jsonLinesWriter, identifier string, kind string) error {
+	for _, key := range re.identifiers[identifier] {
+		value, err := re.hardforkStorer.Get(identifier, key)
+		if err != nil {
+			return err
+		}
+
+		err = writer.write(&MetaBlockRecord{
+			Kind:      kind,
+			MetaBlock: value,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (re *readableExporter) exportMiniBlocks() error {
+	writer, err := newJsonLinesWriter(re.outputFolder, MiniBlocksFileName)
+	if err != nil {
+		return err
+	}
+
+	err = re.writeMiniBlocks(writer)
+
+	return re.closeWriter(writer, MiniBlocksFileName, err)
+}
+
+func (re *readableExporter) writeMiniBlocks(writer *jsonLinesWriter) error {
+	for _, key := range re.identifiers[genesis.MiniBlocksIdentifier] {
+		_, hash, err := genesis.GetKeyTypeAndHash(string(key))
+		if err != nil {
+			return err
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = re.readJson(genesis.MiniBlocksIdentifier, key, miniBlock)
+		if err != nil {
+			return err
+		}
+
+		txHashes := make([]string, 0, len(miniBlock.TxHashes))
+		for _, txHash := range miniBlock.TxHashes {
+			txHashes = append(txHashes, hex.EncodeToString(txHash))
+		}
+
+		err = writer.write(&MiniBlockRecord{
+			Hash:            hex.EncodeToString(hash),
+			SenderShardID:   miniBlock.SenderShardID,
+			ReceiverShardID: miniBlock.ReceiverShardID,
+			Type:            int32(miniBlock.Type),
+			TxHashes:        txHashes,
+			Reserved:        hex.EncodeToString(miniBlock.Reserved),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (re *readableExporter) exportTransactions() error {
+	writer, err := newJsonLinesWriter(re.outputFolder, TransactionsFileName)
+	if err != nil {
+		return err
+	}
+
+	err = re.writeTransactions(writer)
+
+	return re.closeWriter(writer, TransactionsFileName, err)
+}
+
+func (re *readableExporter) writeTransactions(writer *jsonLinesWriter) error {
+	for _, key := range re.identifiers[genesis.TransactionsIdentifier] {
+		txType, hash, err := genesis.GetKeyTypeAndHash(string(key))
+		if err != nil {
+			return err
+		}
+
+		value, err := re.hardforkStorer.Get(genesis.TransactionsIdentifier, key)
+		if err != nil {
+			return err
+		}
+
+		object, err := genesis.NewObject(txType)
+		if err != nil {
+			return err
+		}
+		err = json.Unmarshal(value, object)
+		if err != nil {
+			return err
+		}
+		tx, ok := object.(data.TransactionHandler)
+		if !ok {
+			return fmt.Errorf("%w: wanted a transaction handler", update.ErrWrongTypeAssertion)
+		}
+
+		err = writer.write(&TransactionRecord{
+			Hash:        hex.EncodeToString(hash),
+			Type:        txTypeNames[txType],
+			Nonce:       tx.GetNonce(),
+			Sender:      encodeAddress(re.addressPubKeyConverter, tx.GetSndAddr()),
+			Receiver:    encodeAddress(re.addressPubKeyConverter, tx.GetRcvAddr()),
+			Value:       bigIntToString(tx.GetValue()),
+			GasPrice:    tx.GetGasPrice(),
+			GasLimit:    tx.GetGasLimit(),
+			Data:        string(tx.GetData()),
+			Transaction: value,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (re *readableExporter) exportAccounts() error {
+	accountsWriter, err := newJsonLinesWriter(re.outputFolder, AccountsFileName)
+	if err != nil {
+		return err
+	}
+	codeWriter, err := newJsonLinesWriter(re.outputFolder, CodeFileName)
+	if err != nil {
+		_ = accountsWriter.close()
+		return err
+	}
+
+	err = re.writeAccounts(accountsWriter, codeWriter)
+	errCode := re.closeWriter(codeWriter, CodeFileName, err)
+
+	return re.closeWriter(accountsWriter, AccountsFileName, errCode)
+}
+
+func (re *readableExporter) writeAccounts(accountsWriter *jsonLinesWriter, codeWriter *jsonLinesWriter) error {
+	for _, identifier := range re.getUserAccountsTrieIdentifiers() {
+		_, shardID, err := genesis.GetTrieTypeAndShId(identifier)
+		if err != nil {
+			return err
+		}
+
+		keys := re.identifiers[identifier]
+		for i := 1; i < len(keys); i++ {
+			err = re.writeAccountsTrieLeaf(accountsWriter, codeWriter, identifier, shardID, keys[i])
+			if err != nil {
+				return fmt.Errorf("%w identifier %s", err, identifier)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (re *readableExporter) getUserAccountsTrieIdentifiers() []string {
+	identifiers := make([]string, 0)
+	for identifier := range re.identifiers {
+		if !strings.HasPrefix(identifier, genesis.TrieIdentifier+atSep) {
+			continue
+		}
+
+		accType, _, err := genesis.GetTrieTypeAndShId(identifier)
+		if err != nil || accType != genesis.UserAccount {
+			continue
+		}
+
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	return identifiers
+}
+
+func (re *readableExporter) writeAccountsTrieLeaf(
+	accountsWriter *jsonLinesWriter,
+	codeWriter *jsonLinesWriter,
+	identifier string,
+	shardID uint32,
+	key []byte,
+) error {
+	keyType, leafKey, err := genesis.GetKeyTypeAndHash(string(key))
+	if err != nil {
+		return err
+	}
+	if keyType != genesis.UserAccount {
+		return update.ErrKeyTypeMismatch
+	}
+
+	value, err := re.hardforkStorer.Get(identifier, key)
+	if err != nil {
+		return err
+	}
+
+	account := &state.UserAccountData{}
+	err = re.marshalizer.Unmarshal(account, value)
+	isAccount := err == nil && bytes.Equal(account.Address, leafKey)
+	if !isAccount {
+		return codeWriter.write(re.createCodeRecord(shardID, leafKey, value))
+	}
+
+	record := &AccountRecord{
+		ShardID:         shardID,
+		Address:         encodeAddress(re.addressPubKeyConverter, account.Address),
+		Nonce:           account.Nonce,
+		Balance:         bigIntToString(account.Balance),
+		DeveloperReward: bigIntToString(account.DeveloperReward),
+		OwnerAddress:    encodeAddress(re.addressPubKeyConverter, account.OwnerAddress),
+		UserName:        string(account.UserName),
+		CodeHash:        hex.EncodeToString(account.CodeHash),
+		CodeMetadata:    hex.EncodeToString(account.CodeMetadata),
+		RootHash:        hex.EncodeToString(account.RootHash),
+	}
+
+	err = re.addDataTrie(record, shardID, account)
+	if err != nil {
+		return err
+	}
+
+	return accountsWriter.write(record)
+}
+
+func (re *readableExporter) createCodeRecord(shardID uint32, key []byte, value []byte) *CodeRecord {
+	record := &CodeRecord{
+		ShardID: shardID,
+		Key:     hex.EncodeToString(key),
+	}
+
+	codeEntry := &state.CodeEntry{}
+	err := re.marshalizer.Unmarshal(codeEntry, value)
+	if err != nil || len(codeEntry.Code) == 0 {
+		record.Raw = hex.EncodeToString(value)
+		return record
+	}
+
+	record.Code = hex.EncodeToString(codeEntry.Code)
+	record.NumReferences = codeEntry.NumReferences
+
+	return record
+}
+
+func (re *readableExporter) addDataTrie(record *AccountRecord, shardID uint32, account *state.UserAccountData) error {
+	if len(account.RootHash) == 0 {
+		return nil
+	}
+
+	identifier := createTrieIdentifier(createDataTrieKey(shardID, account.RootHash))
+	keys, found := re.identifiers[identifier]
+	if !found {
+		log.Warn("data trie not found in export",
+			"address", record.Address,
+			"root hash", account.RootHash,
+		)
+		return nil
+	}
+
+	for i := 1; i < len(keys); i++ {
+		keyType, dataKey, err := genesis.GetKeyTypeAndHash(string(keys[i]))
+		if err != nil {
+			return err
+		}
+		if keyType != genesis.DataTrie {
+			return update.ErrKeyTypeMismatch
+		}
+
+		value, err := re.hardforkStorer.Get(identifier, keys[i])
+		if err != nil {
+			return err
+		}
+
+		value = trimDataTrieValue(value, dataKey, account.Address)
+		record.DataTrie = append(record.DataTrie, &DataTrieEntry{
+			Key:   hex.EncodeToString(dataKey),
+			Value: hex.EncodeToString(value),
+		})
+
+		esdtBalance, isESDT := decodeESDTBalance(dataKey, value, re.marshalizer)
+		if isESDT {
+			record.ESDTBalances = append(record.ESDTBalances, esdtBalance)
+		}
+	}
+
+	return nil
+}
+
+func (re *readableExporter) exportValidators() error {
+	if len(re.nodesSetupFile) == 0 {
+		log.Debug("no nodes setup file provided, validators not exported")
+		return nil
+	}
+
+	nodesSetup := &sharding.NodesSetup{}
+	err := core.LoadJsonFile(nodesSetup, re.nodesSetupFile)
+	if err != nil {
+		return err
+	}
+
+	writer, err := newJsonLinesWriter(re.outputFolder, ValidatorsFileName)
+	if err != nil {
+		return err
+	}
+
+	for _, initialNode := range nodesSetup.InitialNodes {
+		err = writer.write(&ValidatorRecord{
+			PubKey:        initialNode.PubKey,
+			Address:       initialNode.Address,
+			InitialRating: initialNode.InitialRating,
+		})
+		if err != nil {
+			break
+		}
+	}
+
+	err = re.closeWriter(writer, ValidatorsFileName, err)
+	if err != nil {
+		return err
+	}
+
+	return copyFile(re.nodesSetupFile, filepath.Join(re.outputFolder, common.NodesSetupJsonFileName))
+}
+
+func (re *readableExporter) readJson(identifier string, key []byte, object interface{}) error {
+	value, err := re.hardforkStorer.Get(identifier, key)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(value, object)
+}
+
+func (re *readableExporter) closeWriter(writer *jsonLinesWriter, fileName string, errWrite error) error {
+	errClose := writer.close()
+	if errWrite != nil {
+		return errWrite
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	log.Debug("readable export file written", "file", fileName, "num records", writer.numLines)
+
+	return nil
+}
+
+func copyFile(source string, destination string) error {
+	buff, err := ioutil.ReadFile(filepath.Clean(source))
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(destination, buff, core.FileModeUserReadWrite)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (re *readableExporter) IsInterfaceNil() bool {
+	return re == nil
+}
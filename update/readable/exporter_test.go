@@ -0,0 +1,334 @@
+package readable
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/ElrondNetwork/elrond-go/update/storing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTokenIdentifier = "TKN-abcdef"
+
+var (
+	testAddress0     = bytes.Repeat([]byte{1}, 32)
+	testAddress1     = bytes.Repeat([]byte{2}, 32)
+	testMetaAddress  = bytes.Repeat([]byte{3}, 32)
+	testCode         = []byte("contract code")
+	testPlainDataKey = []byte("key")
+)
+
+type testExport struct {
+	hardforkStorer      update.HardforkStorer
+	epochStartMetaBlock *block.MetaBlock
+	scr                 *smartContractResult.SmartContractResult
+	scrHash             []byte
+	miniBlockHash       []byte
+	nodesSetupFile      string
+}
+
+func createHardforkStorer(t *testing.T) update.HardforkStorer {
+	hs, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   mock.NewStorerMock(),
+		KeyValue:    mock.NewStorerMock(),
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+	})
+	require.Nil(t, err)
+
+	return hs
+}
+
+func createAddressPubKeyConverter() core.PubkeyConverter {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, log)
+
+	return converter
+}
+
+// createTestExport writes in a hardfork storer the same entries as the hardfork exporter would write for a shard 0
+// with two accounts, one of them a contract with a data trie holding an ESDT balance, and a metachain with one account
+func createTestExport(t *testing.T) *testExport {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := &testscommon.HasherMock{}
+	te := &testExport{
+		hardforkStorer: createHardforkStorer(t),
+	}
+
+	te.scr = &smartContractResult.SmartContractResult{Nonce: 1, Value: big.NewInt(5), SndAddr: testMetaAddress, RcvAddr: testAddress0}
+	te.scrHash, _ = core.CalculateHash(marshalizer, hasher, te.scr)
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{te.scrHash}, SenderShardID: core.MetachainShardId, ReceiverShardID: 0, Type: block.SmartContractResultBlock}
+	te.miniBlockHash, _ = core.CalculateHash(marshalizer, hasher, miniBlock)
+
+	unFinishedMetaBlock := &block.MetaBlock{Nonce: 10, ChainID: []byte("chainID")}
+	unFinishedMetaBlockHash, _ := core.CalculateHash(marshalizer, hasher, unFinishedMetaBlock)
+
+	te.epochStartMetaBlock = &block.MetaBlock{
+		Nonce:   10,
+		Epoch:   3,
+		ChainID: []byte("chainID"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{
+					ShardID:                 0,
+					FirstPendingMetaBlock:   unFinishedMetaBlockHash,
+					PendingMiniBlockHeaders: []block.MiniBlockHeader{{Hash: te.miniBlockHash, SenderShardID: core.MetachainShardId}},
+				},
+			},
+		},
+	}
+	te.epochStartMetaBlock.EpochStart.LastFinalizedHeaders[0].RootHash = te.exportShard(t, 0, [][]byte{testAddress0, testAddress1})
+	te.epochStartMetaBlock.RootHash = te.exportShard(t, core.MetachainShardId, [][]byte{testMetaAddress})
+
+	te.writeJson(t, genesis.EpochStartMetaBlockIdentifier, genesis.CreateVersionKey(te.epochStartMetaBlock, []byte("h1")), te.epochStartMetaBlock)
+	te.writeJson(t, genesis.UnFinishedMetaBlocksIdentifier, genesis.CreateVersionKey(unFinishedMetaBlock, []byte("h2")), unFinishedMetaBlock)
+	te.writeJson(t, genesis.MiniBlocksIdentifier, genesis.CreateMiniBlockKey(string(te.miniBlockHash)), miniBlock)
+	te.writeJson(t, genesis.TransactionsIdentifier, genesis.CreateTransactionKey(string(te.scrHash), te.scr), te.scr)
+	for _, identifier := range []string{
+		genesis.EpochStartMetaBlockIdentifier,
+		genesis.UnFinishedMetaBlocksIdentifier,
+		genesis.MiniBlocksIdentifier,
+		genesis.TransactionsIdentifier,
+	} {
+		require.Nil(t, te.hardforkStorer.FinishedIdentifier(identifier))
+	}
+
+	nodesSetup := &sharding.NodesSetup{
+		RoundDuration: 6000,
+		InitialNodes: []*sharding.InitialNode{
+			{PubKey: "aaaa", Address: createAddressPubKeyConverter().Encode(testAddress0), InitialRating: 50},
+		},
+	}
+	buff, _ := json.Marshal(nodesSetup)
+	te.nodesSetupFile = filepath.Join(t.TempDir(), common.NodesSetupJsonFileName)
+	require.Nil(t, ioutil.WriteFile(te.nodesSetupFile, buff, core.FileModeUserReadWrite))
+
+	return te
+}
+
+func (te *testExport) exportShard(t *testing.T, shardID uint32, addresses [][]byte) []byte {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := &testscommon.HasherMock{}
+	mainTrie, err := newInMemoryTrie(marshalizer, hasher)
+	require.Nil(t, err)
+	accountsDB, err := state.NewAccountsDB(mainTrie, hasher, marshalizer, factory.NewAccountCreator(), disabled.NewDisabledStoragePruningManager())
+	require.Nil(t, err)
+
+	for idx, address := range addresses {
+		account, _ := accountsDB.LoadAccount(address)
+		userAccount := account.(state.UserAccountHandler)
+		_ = userAccount.AddToBalance(big.NewInt(int64(1000 * (idx + 1))))
+		userAccount.IncreaseNonce(uint64(idx))
+		if idx == 1 {
+			userAccount.SetCode(testCode)
+			userAccount.SetOwnerAddress(addresses[0])
+			esdtValue, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(77)})
+			_ = userAccount.DataTrieTracker().SaveKeyValue([]byte(core.ElrondProtectedKeyPrefix+core.ESDTKeyIdentifier+testTokenIdentifier), esdtValue)
+			_ = userAccount.DataTrieTracker().SaveKeyValue(testPlainDataKey, []byte("value"))
+		}
+		require.Nil(t, accountsDB.SaveAccount(userAccount))
+	}
+	rootHash, err := accountsDB.Commit()
+	require.Nil(t, err)
+
+	te.exportTrie(t, genesis.CreateTrieIdentifier(shardID, genesis.UserAccount), genesis.UserAccount, shardID, mainTrie, rootHash)
+
+	for _, address := range addresses {
+		account, _ := accountsDB.LoadAccount(address)
+		userAccount := account.(state.UserAccountHandler)
+		if len(userAccount.GetRootHash()) == 0 {
+			continue
+		}
+
+		dataTrie, errGet := accountsDB.GetTrie(userAccount.GetRootHash())
+		require.Nil(t, errGet)
+		te.exportTrie(t, createDataTrieKey(shardID, userAccount.GetRootHash()), genesis.DataTrie, shardID, dataTrie, userAccount.GetRootHash())
+	}
+
+	return rootHash
+}
+
+func (te *testExport) exportTrie(t *testing.T, trieKey string, accType genesis.Type, shardID uint32, tr common.Trie, rootHash []byte) {
+	identifier := createTrieIdentifier(trieKey)
+	require.Nil(t, te.hardforkStorer.Write(identifier, []byte(genesis.CreateRootHashKey(trieKey)), rootHash))
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash)
+	require.Nil(t, err)
+	for leaf := range leavesChannel {
+		key := genesis.CreateAccountKey(accType, shardID, leaf.Key())
+		require.Nil(t, te.hardforkStorer.Write(identifier, []byte(key), leaf.Value()))
+	}
+	require.Nil(t, te.hardforkStorer.FinishedIdentifier(identifier))
+}
+
+func (te *testExport) writeJson(t *testing.T, identifier string, key string, object interface{}) {
+	buff, err := json.Marshal(object)
+	require.Nil(t, err)
+	require.Nil(t, te.hardforkStorer.Write(identifier, []byte(key), buff))
+}
+
+func createMockArgsReadableExporter(hs update.HardforkStorer, outputFolder string) ArgsReadableExporter {
+	return ArgsReadableExporter{
+		HardforkStorer:         hs,
+		Marshalizer:            &marshal.GogoProtoMarshalizer{},
+		AddressPubKeyConverter: createAddressPubKeyConverter(),
+		OutputFolder:           outputFolder,
+	}
+}
+
+func readRecords(t *testing.T, folder string, fileName string, newRecord func() interface{}) []interface{} {
+	records := make([]interface{}, 0)
+	err := readJsonLines(folder, fileName, newRecord, func(record interface{}) error {
+		records = append(records, record)
+		return nil
+	})
+	require.Nil(t, err)
+
+	return records
+}
+
+func TestNewReadableExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hardfork storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		re, err := NewReadableExporter(createMockArgsReadableExporter(nil, "folder"))
+		assert.True(t, check.IfNil(re))
+		assert.Equal(t, update.ErrNilHardforkStorer, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReadableExporter(&mock.HardforkStorerStub{}, "folder")
+		args.Marshalizer = nil
+		re, err := NewReadableExporter(args)
+		assert.True(t, check.IfNil(re))
+		assert.Equal(t, update.ErrNilMarshalizer, err)
+	})
+	t.Run("nil address pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReadableExporter(&mock.HardforkStorerStub{}, "folder")
+		args.AddressPubKeyConverter = nil
+		re, err := NewReadableExporter(args)
+		assert.True(t, check.IfNil(re))
+		assert.Equal(t, update.ErrNilPubKeyConverter, err)
+	})
+	t.Run("empty output folder should error", func(t *testing.T) {
+		t.Parallel()
+
+		re, err := NewReadableExporter(createMockArgsReadableExporter(&mock.HardforkStorerStub{}, ""))
+		assert.True(t, check.IfNil(re))
+		assert.Equal(t, update.ErrEmptyExportFolderPath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		re, err := NewReadableExporter(createMockArgsReadableExporter(&mock.HardforkStorerStub{}, "folder"))
+		assert.False(t, check.IfNil(re))
+		assert.Nil(t, err)
+	})
+}
+
+func TestReadableExporter_ExportShouldWriteDecodedRecords(t *testing.T) {
+	t.Parallel()
+
+	te := createTestExport(t)
+	outputFolder := t.TempDir()
+	args := createMockArgsReadableExporter(te.hardforkStorer, outputFolder)
+	args.NodesSetupFile = te.nodesSetupFile
+	re, _ := NewReadableExporter(args)
+
+	err := re.Export()
+	require.Nil(t, err)
+
+	converter := createAddressPubKeyConverter()
+	accounts := readRecords(t, outputFolder, AccountsFileName, func() interface{} { return &AccountRecord{} })
+	require.Equal(t, 3, len(accounts))
+	contractFound := false
+	for _, record := range accounts {
+		account := record.(*AccountRecord)
+		if account.Address != converter.Encode(testAddress1) {
+			continue
+		}
+
+		contractFound = true
+		assert.Equal(t, uint32(0), account.ShardID)
+		assert.Equal(t, "2000", account.Balance)
+		assert.Equal(t, uint64(1), account.Nonce)
+		assert.Equal(t, converter.Encode(testAddress0), account.OwnerAddress)
+		assert.Equal(t, 2, len(account.DataTrie))
+		require.Equal(t, 1, len(account.ESDTBalances))
+		assert.Equal(t, &ESDTBalance{TokenIdentifier: testTokenIdentifier, Balance: "77"}, account.ESDTBalances[0])
+		for _, entry := range account.DataTrie {
+			if entry.Key == hex.EncodeToString(testPlainDataKey) {
+				assert.Equal(t, hex.EncodeToString([]byte("value")), entry.Value)
+			}
+		}
+	}
+	assert.True(t, contractFound)
+
+	code := readRecords(t, outputFolder, CodeFileName, func() interface{} { return &CodeRecord{} })
+	require.Equal(t, 1, len(code))
+	assert.Equal(t, hex.EncodeToString(testCode), code[0].(*CodeRecord).Code)
+	assert.Equal(t, uint32(1), code[0].(*CodeRecord).NumReferences)
+
+	transactions := readRecords(t, outputFolder, TransactionsFileName, func() interface{} { return &TransactionRecord{} })
+	require.Equal(t, 1, len(transactions))
+	tx := transactions[0].(*TransactionRecord)
+	assert.Equal(t, hex.EncodeToString(te.scrHash), tx.Hash)
+	assert.Equal(t, "scr", tx.Type)
+	assert.Equal(t, converter.Encode(testMetaAddress), tx.Sender)
+	assert.Equal(t, "5", tx.Value)
+
+	miniBlocks := readRecords(t, outputFolder, MiniBlocksFileName, func() interface{} { return &MiniBlockRecord{} })
+	require.Equal(t, 1, len(miniBlocks))
+	assert.Equal(t, []string{hex.EncodeToString(te.scrHash)}, miniBlocks[0].(*MiniBlockRecord).TxHashes)
+
+	metaBlocks := readRecords(t, outputFolder, MetaBlocksFileName, func() interface{} { return &MetaBlockRecord{} })
+	require.Equal(t, 2, len(metaBlocks))
+	assert.Equal(t, EpochStartMetaBlockKind, metaBlocks[0].(*MetaBlockRecord).Kind)
+	assert.Equal(t, UnFinishedMetaBlockKind, metaBlocks[1].(*MetaBlockRecord).Kind)
+
+	validators := readRecords(t, outputFolder, ValidatorsFileName, func() interface{} { return &ValidatorRecord{} })
+	require.Equal(t, 1, len(validators))
+	assert.Equal(t, "aaaa", validators[0].(*ValidatorRecord).PubKey)
+	assert.FileExists(t, filepath.Join(outputFolder, common.NodesSetupJsonFileName))
+}
+
+func TestSplitTokenIdentifierAndNonce(t *testing.T) {
+	t.Parallel()
+
+	tokenIdentifier, nonce := splitTokenIdentifierAndNonce([]byte(testTokenIdentifier))
+	assert.Equal(t, testTokenIdentifier, string(tokenIdentifier))
+	assert.Equal(t, uint64(0), nonce)
+
+	tokenIdentifier, nonce = splitTokenIdentifierAndNonce(append([]byte(testTokenIdentifier), big.NewInt(300).Bytes()...))
+	assert.Equal(t, testTokenIdentifier, string(tokenIdentifier))
+	assert.Equal(t, uint64(300), nonce)
+
+	tokenIdentifier, nonce = splitTokenIdentifierAndNonce([]byte("TKN"))
+	assert.Equal(t, "TKN", string(tokenIdentifier))
+	assert.Equal(t, uint64(0), nonce)
+}
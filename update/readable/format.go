@@ -0,0 +1,107 @@
+package readable
+
+import "encoding/json"
+
+// The readable export is a folder holding one JSON-lines file for each kind of exported data. Every line of a file
+// is a self-contained JSON object, so the files can be processed as streams. Byte arrays are hex encoded, addresses
+// are bech32 encoded and big integers are written as base 10 strings.
+const (
+	// AccountsFileName holds one AccountRecord for each user account of each shard
+	AccountsFileName = "accounts.jsonl"
+	// CodeFileName holds one CodeRecord for each accounts trie leaf that is not an account (the smart contracts code)
+	CodeFileName = "code.jsonl"
+	// ValidatorsFileName holds one ValidatorRecord for each validator exported in the nodes setup file
+	ValidatorsFileName = "validators.jsonl"
+	// TransactionsFileName holds one TransactionRecord for each pending transaction
+	TransactionsFileName = "transactions.jsonl"
+	// MiniBlocksFileName holds one MiniBlockRecord for each pending miniBlock
+	MiniBlocksFileName = "miniBlocks.jsonl"
+	// MetaBlocksFileName holds one MetaBlockRecord for the epoch start metaBlock and each unFinished metaBlock
+	MetaBlocksFileName = "metaBlocks.jsonl"
+)
+
+const (
+	// EpochStartMetaBlockKind marks the epoch start metaBlock record
+	EpochStartMetaBlockKind = "epochStart"
+	// UnFinishedMetaBlockKind marks an unFinished metaBlock record
+	UnFinishedMetaBlockKind = "unFinished"
+)
+
+// AccountRecord is a user account along with its data trie. The root hash and the ESDT balances are informative
+// only: the root hash is recomputed from the data trie entries and the ESDT balances are decoded from them when
+// converting back to the hardfork format
+type AccountRecord struct {
+	ShardID         uint32           `json:"shardID"`
+	Address         string           `json:"address"`
+	Nonce           uint64           `json:"nonce"`
+	Balance         string           `json:"balance"`
+	DeveloperReward string           `json:"developerReward"`
+	OwnerAddress    string           `json:"ownerAddress,omitempty"`
+	UserName        string           `json:"userName,omitempty"`
+	CodeHash        string           `json:"codeHash,omitempty"`
+	CodeMetadata    string           `json:"codeMetadata,omitempty"`
+	RootHash        string           `json:"rootHash,omitempty"`
+	ESDTBalances    []*ESDTBalance   `json:"esdtBalances,omitempty"`
+	DataTrie        []*DataTrieEntry `json:"dataTrie,omitempty"`
+}
+
+// ESDTBalance is the balance of an ESDT token, decoded from the data trie of an account
+type ESDTBalance struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce"`
+	Balance         string `json:"balance"`
+}
+
+// DataTrieEntry is a key-value pair from the data trie of an account
+type DataTrieEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// CodeRecord is an accounts trie leaf that is not an account, usually a smart contract code saved under its code
+// hash. Leaves that can not be decoded as code are kept as they are in the Raw field
+type CodeRecord struct {
+	ShardID       uint32 `json:"shardID"`
+	Key           string `json:"key"`
+	Code          string `json:"code,omitempty"`
+	NumReferences uint32 `json:"numReferences,omitempty"`
+	Raw           string `json:"raw,omitempty"`
+}
+
+// ValidatorRecord is a validator exported in the nodes setup file
+type ValidatorRecord struct {
+	PubKey        string `json:"pubKey"`
+	Address       string `json:"address"`
+	InitialRating uint32 `json:"initialRating"`
+}
+
+// TransactionRecord is a pending transaction. The decoded fields are informative only, the transaction is
+// converted back from the Transaction field
+type TransactionRecord struct {
+	Hash        string          `json:"hash"`
+	Type        string          `json:"type"`
+	Nonce       uint64          `json:"nonce"`
+	Sender      string          `json:"sender,omitempty"`
+	Receiver    string          `json:"receiver,omitempty"`
+	Value       string          `json:"value"`
+	GasPrice    uint64          `json:"gasPrice"`
+	GasLimit    uint64          `json:"gasLimit"`
+	Data        string          `json:"data,omitempty"`
+	Transaction json.RawMessage `json:"transaction"`
+}
+
+// MiniBlockRecord is a pending miniBlock
+type MiniBlockRecord struct {
+	Hash            string   `json:"hash"`
+	SenderShardID   uint32   `json:"senderShardID"`
+	ReceiverShardID uint32   `json:"receiverShardID"`
+	Type            int32    `json:"type"`
+	TxHashes        []string `json:"txHashes"`
+	Reserved        string   `json:"reserved,omitempty"`
+}
+
+// MetaBlockRecord is one of the exported metaBlocks, kept in the same JSON format as in the hardfork export
+type MetaBlockRecord struct {
+	Kind      string          `json:"kind"`
+	MetaBlock json.RawMessage `json:"metaBlock"`
+}
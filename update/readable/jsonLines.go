@@ -0,0 +1,88 @@
+package readable
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+type jsonLinesWriter struct {
+	file     *os.File
+	writer   *bufio.Writer
+	encoder  *json.Encoder
+	numLines int
+}
+
+func newJsonLinesWriter(folder string, fileName string) (*jsonLinesWriter, error) {
+	file, err := os.OpenFile(filepath.Join(folder, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := bufio.NewWriter(file)
+
+	return &jsonLinesWriter{
+		file:    file,
+		writer:  writer,
+		encoder: json.NewEncoder(writer),
+	}, nil
+}
+
+func (jlw *jsonLinesWriter) write(record interface{}) error {
+	jlw.numLines++
+
+	return jlw.encoder.Encode(record)
+}
+
+func (jlw *jsonLinesWriter) close() error {
+	errFlush := jlw.writer.Flush()
+	errClose := jlw.file.Close()
+	if errFlush != nil {
+		return errFlush
+	}
+
+	return errClose
+}
+
+// readJsonLines decodes each line of the provided file in a new record and calls the handler with it. A missing
+// file is treated as a file without records
+func readJsonLines(
+	folder string,
+	fileName string,
+	newRecord func() interface{},
+	handler func(record interface{}) error,
+) error {
+	file, err := os.Open(filepath.Join(folder, fileName))
+	if os.IsNotExist(err) {
+		log.Debug("readable export file not found", "file", fileName)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	for line := 1; ; line++ {
+		record := newRecord()
+		err = decoder.Decode(record)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w in file %s, record %d", err, fileName, line)
+		}
+
+		err = handler(record)
+		if err != nil {
+			return fmt.Errorf("%w in file %s, record %d", err, fileName, line)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package sync
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+)
+
+var _ update.StateSyncer = (*storageStateSyncer)(nil)
+
+// ArgsNewStorageStateSyncer defines the arguments needed to create a state syncer reading an existing chain database
+type ArgsNewStorageStateSyncer struct {
+	MetaBlockStorer     storage.Storer
+	AccountsDBs         map[uint32]state.AccountsAdapter
+	ValidatorAccountsDB state.AccountsAdapter
+	Marshalizer         marshal.Marshalizer
+	Hasher              hashing.Hasher
+}
+
+// storageStateSyncer provides the state of an existing chain at an epoch start, read from the databases of that
+// chain instead of being synced from the network. The pending miniblocks of the epoch start block are dropped, so
+// the provided state can start a new chain without the transactions that were in flight in the source chain
+type storageStateSyncer struct {
+	metaBlockStorer      storage.Storer
+	accountsDBs          map[uint32]state.AccountsAdapter
+	validatorAccountsDB  state.AccountsAdapter
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+	mutState             sync.RWMutex
+	epochStartMetaBlock  *block.MetaBlock
+	unFinishedMetaBlocks map[string]*block.MetaBlock
+	tries                map[string]common.Trie
+}
+
+// NewStorageStateSyncer creates a state syncer reading the state of an existing chain from its databases
+func NewStorageStateSyncer(args ArgsNewStorageStateSyncer) (*storageStateSyncer, error) {
+	if check.IfNil(args.MetaBlockStorer) {
+		return nil, update.ErrNilStorage
+	}
+	if len(args.AccountsDBs) == 0 {
+		return nil, update.ErrNilAccounts
+	}
+	for shardID, accountsDB := range args.AccountsDBs {
+		if check.IfNil(accountsDB) {
+			return nil, fmt.Errorf("%w for shard %d", update.ErrNilAccounts, shardID)
+		}
+	}
+	if check.IfNil(args.ValidatorAccountsDB) {
+		return nil, fmt.Errorf("%w for validators", update.ErrNilAccounts)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, update.ErrNilHasher
+	}
+
+	return &storageStateSyncer{
+		metaBlockStorer:     args.MetaBlockStorer,
+		accountsDBs:         args.AccountsDBs,
+		validatorAccountsDB: args.ValidatorAccountsDB,
+		marshalizer:         args.Marshalizer,
+		hasher:              args.Hasher,
+	}, nil
+}
+
+// SyncAllState loads the epoch start metablock of the provided epoch and recreates the tries of all shards
+func (sss *storageStateSyncer) SyncAllState(epoch uint32) error {
+	metaBlock, err := sss.loadEpochStartMetaBlock(epoch)
+	if err != nil {
+		return err
+	}
+
+	tries := make(map[string]common.Trie)
+	err = sss.recreateUserAccountsTries(core.MetachainShardId, metaBlock.RootHash, tries)
+	if err != nil {
+		return err
+	}
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		err = sss.recreateUserAccountsTries(shardData.ShardID, shardData.RootHash, tries)
+		if err != nil {
+			return err
+		}
+	}
+
+	validatorTrie, err := sss.validatorAccountsDB.GetTrie(metaBlock.ValidatorStatsRootHash)
+	if err != nil {
+		return fmt.Errorf("%w while recreating the validator accounts trie", err)
+	}
+	tries[genesis.CreateTrieIdentifier(core.MetachainShardId, genesis.ValidatorAccount)] = validatorTrie
+
+	metaBlockHash, err := core.CalculateHash(sss.marshalizer, sss.hasher, metaBlock)
+	if err != nil {
+		return err
+	}
+
+	sss.mutState.Lock()
+	sss.epochStartMetaBlock = dropPendingMiniBlocks(metaBlock, metaBlockHash)
+	sss.unFinishedMetaBlocks = map[string]*block.MetaBlock{string(metaBlockHash): metaBlock}
+	sss.tries = tries
+	sss.mutState.Unlock()
+
+	log.Debug("state loaded from storage",
+		"epoch", epoch,
+		"epoch start metablock nonce", metaBlock.Nonce,
+		"num tries", len(tries),
+	)
+
+	return nil
+}
+
+func (sss *storageStateSyncer) loadEpochStartMetaBlock(epoch uint32) (*block.MetaBlock, error) {
+	buff, err := sss.metaBlockStorer.Get([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the epoch start metablock for epoch %d", err, epoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = sss.marshalizer.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+	if !metaBlock.IsStartOfEpochBlock() && metaBlock.Nonce > 0 {
+		return nil, update.ErrNotEpochStartBlock
+	}
+
+	return metaBlock, nil
+}
+
+func (sss *storageStateSyncer) recreateUserAccountsTries(shardID uint32, rootHash []byte, tries map[string]common.Trie) error {
+	accountsDB, ok := sss.accountsDBs[shardID]
+	if !ok {
+		return fmt.Errorf("%w for shard %d", update.ErrNilAccounts, shardID)
+	}
+
+	recreatedTries, err := accountsDB.RecreateAllTries(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while recreating the tries of shard %d", err, shardID)
+	}
+
+	accountsIdentifier := genesis.CreateTrieIdentifier(shardID, genesis.UserAccount)
+	dataTrieIdentifier := genesis.CreateTrieIdentifier(shardID, genesis.DataTrie)
+	for hash, recreatedTrie := range recreatedTries {
+		if hash == string(rootHash) {
+			tries[accountsIdentifier] = recreatedTrie
+			continue
+		}
+
+		tries[genesis.AddRootHashToIdentifier(dataTrieIdentifier, hash)] = recreatedTrie
+	}
+
+	return nil
+}
+
+// dropPendingMiniBlocks returns a copy of the epoch start metablock in which every shard has no pending miniblocks
+// and has the epoch start metablock itself as the first pending metablock
+func dropPendingMiniBlocks(metaBlock *block.MetaBlock, metaBlockHash []byte) *block.MetaBlock {
+	metaBlockCopy := *metaBlock
+	lastFinalizedHeaders := make([]block.EpochStartShardData, 0, len(metaBlock.EpochStart.LastFinalizedHeaders))
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		shardData.PendingMiniBlockHeaders = nil
+		shardData.FirstPendingMetaBlock = metaBlockHash
+		lastFinalizedHeaders = append(lastFinalizedHeaders, shardData)
+	}
+	metaBlockCopy.EpochStart.LastFinalizedHeaders = lastFinalizedHeaders
+
+	return &metaBlockCopy
+}
+
+// GetEpochStartMetaBlock returns the epoch start metablock without the pending miniblocks
+func (sss *storageStateSyncer) GetEpochStartMetaBlock() (*block.MetaBlock, error) {
+	sss.mutState.RLock()
+	defer sss.mutState.RUnlock()
+
+	if sss.epochStartMetaBlock == nil {
+		return nil, update.ErrNotSynced
+	}
+
+	return sss.epochStartMetaBlock, nil
+}
+
+// GetUnFinishedMetaBlocks returns the original epoch start metablock, the only one needed as all the pending
+// miniblocks are dropped
+func (sss *storageStateSyncer) GetUnFinishedMetaBlocks() (map[string]*block.MetaBlock, error) {
+	sss.mutState.RLock()
+	defer sss.mutState.RUnlock()
+
+	if sss.unFinishedMetaBlocks == nil {
+		return nil, update.ErrNotSynced
+	}
+
+	return sss.unFinishedMetaBlocks, nil
+}
+
+// GetAllTries returns the recreated tries
+func (sss *storageStateSyncer) GetAllTries() (map[string]common.Trie, error) {
+	sss.mutState.RLock()
+	defer sss.mutState.RUnlock()
+
+	if sss.tries == nil {
+		return nil, update.ErrNotSynced
+	}
+
+	return sss.tries, nil
+}
+
+// GetAllTransactions returns an empty map as the pending transactions are dropped
+func (sss *storageStateSyncer) GetAllTransactions() (map[string]data.TransactionHandler, error) {
+	return make(map[string]data.TransactionHandler), nil
+}
+
+// GetAllMiniBlocks returns an empty map as the pending miniblocks are dropped
+func (sss *storageStateSyncer) GetAllMiniBlocks() (map[string]*block.MiniBlock, error) {
+	return make(map[string]*block.MiniBlock), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sss *storageStateSyncer) IsInterfaceNil() bool {
+	return sss == nil
+}
@@ -0,0 +1,232 @@
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsStubWithDataTrie(dataTrieRootHash string) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		RecreateAllTriesCalled: func(rootHash []byte) (map[string]common.Trie, error) {
+			return map[string]common.Trie{
+				string(rootHash): &trieMock.TrieStub{},
+				dataTrieRootHash: &trieMock.TrieStub{},
+			}, nil
+		},
+	}
+}
+
+func createMockArgsNewStorageStateSyncer() ArgsNewStorageStateSyncer {
+	return ArgsNewStorageStateSyncer{
+		MetaBlockStorer: mock.NewStorerMock(),
+		AccountsDBs: map[uint32]state.AccountsAdapter{
+			0:                     createAccountsStubWithDataTrie("dataTrie0"),
+			core.MetachainShardId: createAccountsStubWithDataTrie("dataTrieMeta"),
+		},
+		ValidatorAccountsDB: &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{}, nil
+			},
+		},
+		Marshalizer: &mock.MarshalizerMock{},
+		Hasher:      &mock.HasherMock{},
+	}
+}
+
+func createEpochStartMetaBlock() *block.MetaBlock {
+	return &block.MetaBlock{
+		Nonce:                  100,
+		Epoch:                  3,
+		RootHash:               []byte("metaRootHash"),
+		ValidatorStatsRootHash: []byte("validatorRootHash"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{
+					ShardID:               0,
+					RootHash:              []byte("shard0RootHash"),
+					FirstPendingMetaBlock: []byte("firstPendingMetaBlock"),
+					PendingMiniBlockHeaders: []block.MiniBlockHeader{
+						{Hash: []byte("pendingMiniBlock")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewStorageStateSyncer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil meta block storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewStorageStateSyncer()
+		args.MetaBlockStorer = nil
+		sss, err := NewStorageStateSyncer(args)
+		assert.Nil(t, sss)
+		assert.Equal(t, update.ErrNilStorage, err)
+	})
+	t.Run("no accounts DBs should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewStorageStateSyncer()
+		args.AccountsDBs = nil
+		sss, err := NewStorageStateSyncer(args)
+		assert.Nil(t, sss)
+		assert.Equal(t, update.ErrNilAccounts, err)
+	})
+	t.Run("nil accounts DB should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewStorageStateSyncer()
+		args.AccountsDBs[1] = nil
+		sss, err := NewStorageStateSyncer(args)
+		assert.Nil(t, sss)
+		assert.True(t, errors.Is(err, update.ErrNilAccounts))
+	})
+	t.Run("nil validator accounts DB should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewStorageStateSyncer()
+		args.ValidatorAccountsDB = nil
+		sss, err := NewStorageStateSyncer(args)
+		assert.Nil(t, sss)
+		assert.True(t, errors.Is(err, update.ErrNilAccounts))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewStorageStateSyncer()
+		args.Marshalizer = nil
+		sss, err := NewStorageStateSyncer(args)
+		assert.Nil(t, sss)
+		assert.Equal(t, update.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewStorageStateSyncer()
+		args.Hasher = nil
+		sss, err := NewStorageStateSyncer(args)
+		assert.Nil(t, sss)
+		assert.Equal(t, update.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sss, err := NewStorageStateSyncer(createMockArgsNewStorageStateSyncer())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sss))
+	})
+}
+
+func TestStorageStateSyncer_GettersBeforeSyncShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sss, _ := NewStorageStateSyncer(createMockArgsNewStorageStateSyncer())
+
+	_, err := sss.GetEpochStartMetaBlock()
+	assert.Equal(t, update.ErrNotSynced, err)
+	_, err = sss.GetUnFinishedMetaBlocks()
+	assert.Equal(t, update.ErrNotSynced, err)
+	_, err = sss.GetAllTries()
+	assert.Equal(t, update.ErrNotSynced, err)
+}
+
+func TestStorageStateSyncer_SyncAllStateMissingMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sss, _ := NewStorageStateSyncer(createMockArgsNewStorageStateSyncer())
+
+	err := sss.SyncAllState(3)
+	assert.NotNil(t, err)
+}
+
+func TestStorageStateSyncer_SyncAllStateNotEpochStartShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewStorageStateSyncer()
+	metaBlock := &block.MetaBlock{Nonce: 100, Epoch: 3}
+	buff, _ := args.Marshalizer.Marshal(metaBlock)
+	_ = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(3)), buff)
+	sss, _ := NewStorageStateSyncer(args)
+
+	err := sss.SyncAllState(3)
+	assert.Equal(t, update.ErrNotEpochStartBlock, err)
+}
+
+func TestStorageStateSyncer_SyncAllStateMissingShardAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewStorageStateSyncer()
+	delete(args.AccountsDBs, 0)
+	buff, _ := args.Marshalizer.Marshal(createEpochStartMetaBlock())
+	_ = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(3)), buff)
+	sss, _ := NewStorageStateSyncer(args)
+
+	err := sss.SyncAllState(3)
+	assert.True(t, errors.Is(err, update.ErrNilAccounts))
+}
+
+func TestStorageStateSyncer_SyncAllStateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewStorageStateSyncer()
+	epochStartMetaBlock := createEpochStartMetaBlock()
+	buff, _ := args.Marshalizer.Marshal(epochStartMetaBlock)
+	_ = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(3)), buff)
+	sss, _ := NewStorageStateSyncer(args)
+
+	err := sss.SyncAllState(3)
+	require.Nil(t, err)
+
+	tries, err := sss.GetAllTries()
+	require.Nil(t, err)
+	expectedIdentifiers := []string{
+		genesis.CreateTrieIdentifier(0, genesis.UserAccount),
+		genesis.AddRootHashToIdentifier(genesis.CreateTrieIdentifier(0, genesis.DataTrie), "dataTrie0"),
+		genesis.CreateTrieIdentifier(core.MetachainShardId, genesis.UserAccount),
+		genesis.AddRootHashToIdentifier(genesis.CreateTrieIdentifier(core.MetachainShardId, genesis.DataTrie), "dataTrieMeta"),
+		genesis.CreateTrieIdentifier(core.MetachainShardId, genesis.ValidatorAccount),
+	}
+	assert.Equal(t, len(expectedIdentifiers), len(tries))
+	for _, identifier := range expectedIdentifiers {
+		_, found := tries[identifier]
+		assert.True(t, found, identifier)
+	}
+
+	metaBlockHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, epochStartMetaBlock)
+	unFinishedMetaBlocks, err := sss.GetUnFinishedMetaBlocks()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(unFinishedMetaBlocks))
+	assert.Equal(t, epochStartMetaBlock, unFinishedMetaBlocks[string(metaBlockHash)])
+
+	syncedMetaBlock, err := sss.GetEpochStartMetaBlock()
+	require.Nil(t, err)
+	shardData := syncedMetaBlock.EpochStart.LastFinalizedHeaders[0]
+	assert.Equal(t, 0, len(shardData.PendingMiniBlockHeaders))
+	assert.Equal(t, metaBlockHash, shardData.FirstPendingMetaBlock)
+	assert.Equal(t, 1, len(unFinishedMetaBlocks[string(metaBlockHash)].EpochStart.LastFinalizedHeaders[0].PendingMiniBlockHeaders))
+
+	pendingMiniBlocks, err := update.GetPendingMiniBlocks(syncedMetaBlock, unFinishedMetaBlocks)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(pendingMiniBlocks))
+
+	miniBlocks, _ := sss.GetAllMiniBlocks()
+	assert.Equal(t, 0, len(miniBlocks))
+	transactions, _ := sss.GetAllTransactions()
+	assert.Equal(t, 0, len(transactions))
+}
@@ -41,6 +41,21 @@ func (t *trigger) SetReceivedExecutingEpoch(
 	t.epoch = epoch
 }
 
+func (t *trigger) ArmedFromGovernance() bool {
+	t.mutTriggered.RLock()
+	defer t.mutTriggered.RUnlock()
+
+	return t.armedFromGovernance
+}
+
+func (gw *governanceWatcher) CheckProposals(epoch uint32) {
+	gw.checkProposals(epoch)
+}
+
+func IsSoftwareVersionCompatible(nodeVersion string, requiredVersion string) bool {
+	return isSoftwareVersionCompatible(nodeVersion, requiredVersion)
+}
+
 func (t *trigger) ComputeTriggerStartOfEpoch(epoch uint32) bool {
 	return t.computeTriggerStartOfEpoch(epoch)
 }
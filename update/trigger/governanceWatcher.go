@@ -0,0 +1,250 @@
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/update"
+)
+
+// hardforkProposalType is the type of the governance proposals that hold a hardfork epoch and software version
+const hardforkProposalType = "hardfork"
+const versionSeparator = "/"
+const versionSuffixSeparator = "-"
+
+// ArgsGovernanceWatcher holds the arguments needed to create a governance watcher
+type ArgsGovernanceWatcher struct {
+	ProposalsProvider      update.HardforkProposalsProvider
+	HardforkTrigger        update.GovernanceHardforkTrigger
+	EpochConfirmedNotifier update.EpochChangeConfirmedNotifier
+	AppStatusHandler       core.AppStatusHandler
+	SoftwareVersion        string
+}
+
+type governanceHardfork struct {
+	reference          string
+	epoch              uint32
+	newSoftwareVersion string
+}
+
+// governanceWatcher checks, at each confirmed epoch, the hardfork proposals voted in the governance system smart
+// contract and arms the hardfork trigger for the epoch of the first passed proposal that is not in the past, if the
+// node runs the software version required by the proposal.
+// The confirmed epochs are handed over to a go routine, as they are notified from the block processing path
+type governanceWatcher struct {
+	proposalsProvider update.HardforkProposalsProvider
+	hardforkTrigger   update.GovernanceHardforkTrigger
+	appStatusHandler  core.AppStatusHandler
+	softwareVersion   string
+	chEpochConfirmed  chan uint32
+	cancelFunc        func()
+	mutHardfork       sync.RWMutex
+	hardfork          *governanceHardfork
+}
+
+// NewGovernanceWatcher creates a watcher that connects the governance hardfork proposals to the hardfork trigger
+func NewGovernanceWatcher(args ArgsGovernanceWatcher) (*governanceWatcher, error) {
+	if check.IfNil(args.ProposalsProvider) {
+		return nil, update.ErrNilHardforkProposalsProvider
+	}
+	if check.IfNil(args.HardforkTrigger) {
+		return nil, update.ErrNilGovernanceHardforkTrigger
+	}
+	if check.IfNil(args.EpochConfirmedNotifier) {
+		return nil, update.ErrNilEpochConfirmedNotifier
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, update.ErrNilAppStatusHandler
+	}
+	if len(args.SoftwareVersion) == 0 {
+		return nil, update.ErrEmptySoftwareVersion
+	}
+
+	gw := &governanceWatcher{
+		proposalsProvider: args.ProposalsProvider,
+		hardforkTrigger:   args.HardforkTrigger,
+		appStatusHandler:  args.AppStatusHandler,
+		softwareVersion:   args.SoftwareVersion,
+		chEpochConfirmed:  make(chan uint32, 1),
+	}
+
+	var ctx context.Context
+	ctx, gw.cancelFunc = context.WithCancel(context.Background())
+	go gw.processEpochs(ctx)
+
+	args.EpochConfirmedNotifier.RegisterForEpochChangeConfirmed(gw.epochConfirmed)
+
+	return gw, nil
+}
+
+// epochConfirmed does not block the caller. A pending epoch not yet checked is replaced by the newly confirmed one
+func (gw *governanceWatcher) epochConfirmed(epoch uint32) {
+	select {
+	case <-gw.chEpochConfirmed:
+	default:
+	}
+
+	select {
+	case gw.chEpochConfirmed <- epoch:
+	default:
+		log.Debug("governanceWatcher: epoch confirmed notification dropped", "epoch", epoch)
+	}
+}
+
+func (gw *governanceWatcher) processEpochs(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("governanceWatcher's go routine is stopping...")
+			return
+		case epoch := <-gw.chEpochConfirmed:
+			gw.checkProposals(epoch)
+		}
+	}
+}
+
+func (gw *governanceWatcher) checkProposals(epoch uint32) {
+	proposals, err := gw.proposalsProvider.GetHardforkProposals()
+	if err != nil {
+		log.Debug("governanceWatcher: cannot get the hardfork proposals", "epoch", epoch, "error", err)
+		return
+	}
+
+	hardfork := gw.selectHardfork(proposals, epoch)
+	if hardfork == nil {
+		return
+	}
+
+	versionMatches := gw.publishMetrics(hardfork, epoch)
+	if !versionMatches {
+		// the node would hardfork with a software version that is not the voted one, so it does not arm the trigger
+		return
+	}
+
+	// the armed trigger starts the hardfork by itself at the start of the hardfork epoch
+	if gw.isArmedFor(hardfork) {
+		return
+	}
+
+	err = gw.hardforkTrigger.TriggerFromGovernance(hardfork.epoch)
+	if err != nil {
+		log.Warn("governanceWatcher: cannot arm the hardfork trigger",
+			"proposal", hardfork.reference,
+			"epoch", hardfork.epoch,
+			"error", err,
+		)
+		return
+	}
+
+	gw.mutHardfork.Lock()
+	gw.hardfork = hardfork
+	gw.mutHardfork.Unlock()
+}
+
+func (gw *governanceWatcher) isArmedFor(hardfork *governanceHardfork) bool {
+	gw.mutHardfork.RLock()
+	defer gw.mutHardfork.RUnlock()
+
+	return gw.hardfork != nil && *gw.hardfork == *hardfork
+}
+
+// selectHardfork returns the passed hardfork proposal with the lowest hardfork epoch that is not in the past
+func (gw *governanceWatcher) selectHardfork(proposals *common.GovernanceProposalsResponse, currentEpoch uint32) *governanceHardfork {
+	if proposals == nil {
+		return nil
+	}
+
+	var selected *governanceHardfork
+	for _, proposal := range proposals.Proposals {
+		isPassedHardfork := proposal.Type == hardforkProposalType && proposal.Closed && proposal.Passed
+		if !isPassedHardfork {
+			continue
+		}
+		if proposal.EpochToHardFork < currentEpoch {
+			log.Trace("governanceWatcher: passed hardfork proposal is in the past",
+				"proposal", proposal.Reference,
+				"epoch", proposal.EpochToHardFork,
+			)
+			continue
+		}
+		if selected != nil && selected.epoch <= proposal.EpochToHardFork {
+			continue
+		}
+
+		selected = &governanceHardfork{
+			reference:          proposal.Reference,
+			epoch:              proposal.EpochToHardFork,
+			newSoftwareVersion: proposal.NewSoftwareVersion,
+		}
+	}
+
+	return selected
+}
+
+// publishMetrics publishes the scheduled hardfork and returns true if the node runs the required software version
+func (gw *governanceWatcher) publishMetrics(hardfork *governanceHardfork, currentEpoch uint32) bool {
+	epochsRemaining := hardfork.epoch - currentEpoch
+	versionMatches := isSoftwareVersionCompatible(gw.softwareVersion, hardfork.newSoftwareVersion)
+
+	gw.appStatusHandler.SetUInt64Value(common.MetricHardforkEpoch, uint64(hardfork.epoch))
+	gw.appStatusHandler.SetUInt64Value(common.MetricHardforkEpochsRemaining, uint64(epochsRemaining))
+	gw.appStatusHandler.SetStringValue(common.MetricHardforkSoftwareVersion, hardfork.newSoftwareVersion)
+	gw.appStatusHandler.SetStringValue(common.MetricHardforkSoftwareVersionMatches, fmt.Sprintf("%v", versionMatches))
+
+	logArgs := []interface{}{
+		"proposal", hardfork.reference,
+		"hardfork epoch", hardfork.epoch,
+		"epochs remaining", epochsRemaining,
+		"required software version", hardfork.newSoftwareVersion,
+		"node software version", gw.softwareVersion,
+	}
+	if !versionMatches {
+		log.Error("governance hardfork requires a different software version, the hardfork trigger is not armed", logArgs...)
+		return false
+	}
+
+	log.Info("governance hardfork scheduled", logArgs...)
+
+	return true
+}
+
+// isSoftwareVersionCompatible returns true if the release part of the node version, the one before the first
+// separator, is the required version or is built on top of it (for example v1.2.3-1-gabcdef for v1.2.3)
+func isSoftwareVersionCompatible(nodeVersion string, requiredVersion string) bool {
+	if len(requiredVersion) == 0 {
+		return true
+	}
+
+	release := strings.Split(nodeVersion, versionSeparator)[0]
+
+	return release == requiredVersion || strings.HasPrefix(release, requiredVersion+versionSuffixSeparator)
+}
+
+// ScheduledHardforkEpoch returns the epoch of the governance hardfork the trigger was armed for, if any
+func (gw *governanceWatcher) ScheduledHardforkEpoch() (uint32, bool) {
+	gw.mutHardfork.RLock()
+	defer gw.mutHardfork.RUnlock()
+
+	if gw.hardfork == nil {
+		return 0, false
+	}
+
+	return gw.hardfork.epoch, true
+}
+
+// Close stops the go routine that checks the hardfork proposals
+func (gw *governanceWatcher) Close() error {
+	gw.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gw *governanceWatcher) IsInterfaceNil() bool {
+	return gw == nil
+}
@@ -0,0 +1,326 @@
+package trigger_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/ElrondNetwork/elrond-go/update/trigger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsGovernanceWatcher() trigger.ArgsGovernanceWatcher {
+	return trigger.ArgsGovernanceWatcher{
+		ProposalsProvider:      &mock.HardforkProposalsProviderStub{},
+		HardforkTrigger:        &mock.GovernanceHardforkTriggerStub{},
+		EpochConfirmedNotifier: &mock.EpochStartNotifierStub{},
+		AppStatusHandler:       &statusHandler.AppStatusHandlerStub{},
+		SoftwareVersion:        "v1.3.0-0-gabcdef/go1.15.5/linux-amd64/abcdef",
+	}
+}
+
+func createHardforkProposal(reference string, epoch uint32, passed bool, closed bool) *common.GovernanceProposalResponse {
+	return &common.GovernanceProposalResponse{
+		Reference:          reference,
+		Type:               "hardfork",
+		Passed:             passed,
+		Closed:             closed,
+		EpochToHardFork:    epoch,
+		NewSoftwareVersion: "v1.3.0",
+	}
+}
+
+func TestNewGovernanceWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proposals provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernanceWatcher()
+		args.ProposalsProvider = nil
+		gw, err := trigger.NewGovernanceWatcher(args)
+		assert.True(t, check.IfNil(gw))
+		assert.Equal(t, update.ErrNilHardforkProposalsProvider, err)
+	})
+	t.Run("nil hardfork trigger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernanceWatcher()
+		args.HardforkTrigger = nil
+		gw, err := trigger.NewGovernanceWatcher(args)
+		assert.True(t, check.IfNil(gw))
+		assert.Equal(t, update.ErrNilGovernanceHardforkTrigger, err)
+	})
+	t.Run("nil epoch confirmed notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernanceWatcher()
+		args.EpochConfirmedNotifier = nil
+		gw, err := trigger.NewGovernanceWatcher(args)
+		assert.True(t, check.IfNil(gw))
+		assert.Equal(t, update.ErrNilEpochConfirmedNotifier, err)
+	})
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernanceWatcher()
+		args.AppStatusHandler = nil
+		gw, err := trigger.NewGovernanceWatcher(args)
+		assert.True(t, check.IfNil(gw))
+		assert.Equal(t, update.ErrNilAppStatusHandler, err)
+	})
+	t.Run("empty software version should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernanceWatcher()
+		args.SoftwareVersion = ""
+		gw, err := trigger.NewGovernanceWatcher(args)
+		assert.True(t, check.IfNil(gw))
+		assert.Equal(t, update.ErrEmptySoftwareVersion, err)
+	})
+	t.Run("should work and register for epoch change confirmed", func(t *testing.T) {
+		t.Parallel()
+
+		registered := false
+		args := createMockArgsGovernanceWatcher()
+		args.EpochConfirmedNotifier = &mock.EpochStartNotifierStub{
+			RegisterForEpochChangeConfirmedCalled: func(handler func(epoch uint32)) {
+				registered = true
+			},
+		}
+		gw, err := trigger.NewGovernanceWatcher(args)
+		assert.False(t, check.IfNil(gw))
+		assert.Nil(t, err)
+		assert.True(t, registered)
+	})
+}
+
+func TestGovernanceWatcher_CheckProposalsShouldArmTriggerOnceForTheFirstPassedHardfork(t *testing.T) {
+	t.Parallel()
+
+	armedEpochs := make([]uint32, 0)
+	metrics := statusHandler.NewAppStatusHandlerMock()
+	stringMetrics := make(map[string]string)
+	args := createMockArgsGovernanceWatcher()
+	args.ProposalsProvider = &mock.HardforkProposalsProviderStub{
+		GetHardforkProposalsCalled: func() (*common.GovernanceProposalsResponse, error) {
+			return &common.GovernanceProposalsResponse{
+				Proposals: []*common.GovernanceProposalResponse{
+					{Reference: "general", Type: "general", Passed: true, Closed: true},
+					createHardforkProposal("past", 2, true, true),
+					createHardforkProposal("not passed", 6, false, true),
+					createHardforkProposal("not closed", 6, false, false),
+					createHardforkProposal("later", 9, true, true),
+					createHardforkProposal("first", 7, true, true),
+				},
+			}, nil
+		},
+	}
+	args.HardforkTrigger = &mock.GovernanceHardforkTriggerStub{
+		TriggerFromGovernanceCalled: func(epoch uint32) error {
+			armedEpochs = append(armedEpochs, epoch)
+			return nil
+		},
+	}
+	args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+		SetUInt64ValueHandler: metrics.SetUInt64Value,
+		SetStringValueHandler: func(key string, value string) {
+			stringMetrics[key] = value
+		},
+	}
+	gw, _ := trigger.NewGovernanceWatcher(args)
+	defer func() {
+		_ = gw.Close()
+	}()
+
+	_, isScheduled := gw.ScheduledHardforkEpoch()
+	assert.False(t, isScheduled)
+
+	gw.CheckProposals(4)
+	assert.Equal(t, []uint32{7}, armedEpochs)
+	assert.Equal(t, uint64(7), metrics.GetUint64(common.MetricHardforkEpoch))
+	assert.Equal(t, uint64(3), metrics.GetUint64(common.MetricHardforkEpochsRemaining))
+	assert.Equal(t, "v1.3.0", stringMetrics[common.MetricHardforkSoftwareVersion])
+	assert.Equal(t, "true", stringMetrics[common.MetricHardforkSoftwareVersionMatches])
+
+	gw.CheckProposals(7)
+	assert.Equal(t, []uint32{7}, armedEpochs, "an armed trigger should not be triggered again")
+	assert.Equal(t, uint64(0), metrics.GetUint64(common.MetricHardforkEpochsRemaining))
+
+	epoch, isScheduled := gw.ScheduledHardforkEpoch()
+	assert.True(t, isScheduled)
+	assert.Equal(t, uint32(7), epoch)
+}
+
+func TestGovernanceWatcher_CheckProposalsWithSoftwareVersionMismatchShouldNotArm(t *testing.T) {
+	t.Parallel()
+
+	stringMetrics := make(map[string]string)
+	args := createMockArgsGovernanceWatcher()
+	args.HardforkTrigger = &mock.GovernanceHardforkTriggerStub{
+		TriggerFromGovernanceCalled: func(epoch uint32) error {
+			assert.Fail(t, "should not arm the trigger")
+			return nil
+		},
+	}
+	args.SoftwareVersion = "v1.2.9-0-gabcdef/go1.15.5/linux-amd64/abcdef"
+	args.ProposalsProvider = &mock.HardforkProposalsProviderStub{
+		GetHardforkProposalsCalled: func() (*common.GovernanceProposalsResponse, error) {
+			return &common.GovernanceProposalsResponse{
+				Proposals: []*common.GovernanceProposalResponse{createHardforkProposal("hardfork", 5, true, true)},
+			}, nil
+		},
+	}
+	args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			stringMetrics[key] = value
+		},
+	}
+	gw, _ := trigger.NewGovernanceWatcher(args)
+	defer func() {
+		_ = gw.Close()
+	}()
+
+	gw.CheckProposals(4)
+	assert.Equal(t, "false", stringMetrics[common.MetricHardforkSoftwareVersionMatches])
+	_, isScheduled := gw.ScheduledHardforkEpoch()
+	assert.False(t, isScheduled)
+}
+
+func TestGovernanceWatcher_CheckProposalsWithoutProposalsShouldNotArm(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernanceWatcher()
+	args.HardforkTrigger = &mock.GovernanceHardforkTriggerStub{
+		TriggerFromGovernanceCalled: func(epoch uint32) error {
+			assert.Fail(t, "should not have armed the trigger")
+			return nil
+		},
+	}
+	args.ProposalsProvider = &mock.HardforkProposalsProviderStub{
+		GetHardforkProposalsCalled: func() (*common.GovernanceProposalsResponse, error) {
+			return nil, errors.New("governance state cannot be returned by a shard node")
+		},
+	}
+	gw, _ := trigger.NewGovernanceWatcher(args)
+	defer func() {
+		_ = gw.Close()
+	}()
+
+	gw.CheckProposals(4)
+	_, isScheduled := gw.ScheduledHardforkEpoch()
+	assert.False(t, isScheduled)
+}
+
+func TestGovernanceWatcher_CheckProposalsShouldRetryArmingAfterTriggerError(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	args := createMockArgsGovernanceWatcher()
+	args.ProposalsProvider = &mock.HardforkProposalsProviderStub{
+		GetHardforkProposalsCalled: func() (*common.GovernanceProposalsResponse, error) {
+			return &common.GovernanceProposalsResponse{
+				Proposals: []*common.GovernanceProposalResponse{createHardforkProposal("hardfork", 5, true, true)},
+			}, nil
+		},
+	}
+	args.HardforkTrigger = &mock.GovernanceHardforkTriggerStub{
+		TriggerFromGovernanceCalled: func(epoch uint32) error {
+			numCalls++
+			if numCalls == 1 {
+				return errors.New("expected error")
+			}
+
+			return nil
+		},
+	}
+	gw, _ := trigger.NewGovernanceWatcher(args)
+	defer func() {
+		_ = gw.Close()
+	}()
+
+	gw.CheckProposals(3)
+	_, isScheduled := gw.ScheduledHardforkEpoch()
+	assert.False(t, isScheduled)
+
+	gw.CheckProposals(4)
+	gw.CheckProposals(5)
+	assert.Equal(t, 2, numCalls)
+	epoch, isScheduled := gw.ScheduledHardforkEpoch()
+	assert.True(t, isScheduled)
+	assert.Equal(t, uint32(5), epoch)
+}
+
+func TestGovernanceWatcher_EpochConfirmedShouldNotBlockTheNotifier(t *testing.T) {
+	t.Parallel()
+
+	var epochConfirmed func(epoch uint32)
+	chRelease := make(chan struct{})
+	chArmed := make(chan uint32, 10)
+	args := createMockArgsGovernanceWatcher()
+	args.EpochConfirmedNotifier = &mock.EpochStartNotifierStub{
+		RegisterForEpochChangeConfirmedCalled: func(handler func(epoch uint32)) {
+			epochConfirmed = handler
+		},
+	}
+	args.ProposalsProvider = &mock.HardforkProposalsProviderStub{
+		GetHardforkProposalsCalled: func() (*common.GovernanceProposalsResponse, error) {
+			<-chRelease
+
+			return &common.GovernanceProposalsResponse{
+				Proposals: []*common.GovernanceProposalResponse{createHardforkProposal("hardfork", 8, true, true)},
+			}, nil
+		},
+	}
+	args.HardforkTrigger = &mock.GovernanceHardforkTriggerStub{
+		TriggerFromGovernanceCalled: func(epoch uint32) error {
+			chArmed <- epoch
+			return nil
+		},
+	}
+	gw, _ := trigger.NewGovernanceWatcher(args)
+	defer func() {
+		_ = gw.Close()
+	}()
+	require.NotNil(t, epochConfirmed)
+
+	chNotified := make(chan struct{})
+	go func() {
+		epochConfirmed(4)
+		epochConfirmed(5)
+		epochConfirmed(6)
+		close(chNotified)
+	}()
+
+	select {
+	case <-chNotified:
+	case <-time.After(time.Second):
+		require.Fail(t, "the epoch confirmed notification blocked")
+	}
+
+	close(chRelease)
+	select {
+	case epoch := <-chArmed:
+		assert.Equal(t, uint32(8), epoch)
+	case <-time.After(time.Second):
+		require.Fail(t, "the trigger was not armed")
+	}
+}
+
+func TestIsSoftwareVersionCompatible(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, trigger.IsSoftwareVersionCompatible("v1.3.0-0-gabcdef/go1.15.5/linux-amd64", ""))
+	assert.True(t, trigger.IsSoftwareVersionCompatible("v1.3.0/go1.15.5/linux-amd64", "v1.3.0"))
+	assert.True(t, trigger.IsSoftwareVersionCompatible("v1.3.0-0-gabcdef/go1.15.5/linux-amd64", "v1.3.0"))
+	assert.True(t, trigger.IsSoftwareVersionCompatible("v1.3.0-rc1-2-gabcdef/go1.15.5", "v1.3.0-rc1"))
+	assert.False(t, trigger.IsSoftwareVersionCompatible("v1.3.01-0-gabcdef/go1.15.5/linux-amd64", "v1.3.0"))
+	assert.False(t, trigger.IsSoftwareVersionCompatible("v1.2.9/go1.15.5/linux-amd64", "v1.3.0"))
+	assert.False(t, trigger.IsSoftwareVersionCompatible("undefined", "v1.3.0"))
+}
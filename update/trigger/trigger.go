@@ -54,6 +54,7 @@ type trigger struct {
 	shouldTriggerFromEpochChange bool
 	isTriggerSelf                bool
 	triggerReceived              bool
+	armedFromGovernance          bool
 	triggerExecuting             bool
 	epoch                        uint32
 	round                        uint64
@@ -157,7 +158,7 @@ func (t *trigger) computeTriggerStartOfEpoch(receivedTrigger uint32) bool {
 	t.mutTriggered.Lock()
 	defer t.mutTriggered.Unlock()
 
-	if !t.triggerReceived {
+	if !t.triggerReceived && !t.armedFromGovernance {
 		return false
 	}
 	if t.triggerExecuting {
@@ -210,6 +211,61 @@ func (t *trigger) Trigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return nil
 }
 
+// TriggerFromGovernance will arm the trigger for the epoch of a passed governance hardfork proposal. A trigger
+// received manually or from the P2P network overrides the governance one. If this node is the trigger node, the
+// trigger message is also broadcast, so the nodes that can not read the governance state will start the hardfork
+func (t *trigger) TriggerFromGovernance(epoch uint32) error {
+	if !t.enabled {
+		return update.ErrTriggerNotEnabled
+	}
+	if epoch < minimumEpochForHarfork {
+		return fmt.Errorf("%w, minimum epoch accepted is %d", update.ErrInvalidEpoch, minimumEpochForHarfork)
+	}
+	if t.isTriggerSelf {
+		return t.Trigger(epoch, false)
+	}
+
+	shouldTrigger, err := t.computeAndSetGovernanceTrigger(epoch)
+	if err != nil {
+		return err
+	}
+	if !shouldTrigger {
+		return nil
+	}
+
+	t.doTrigger()
+
+	return nil
+}
+
+func (t *trigger) computeAndSetGovernanceTrigger(epoch uint32) (bool, error) {
+	t.mutTriggered.Lock()
+	defer t.mutTriggered.Unlock()
+
+	if t.triggerExecuting {
+		return false, update.ErrTriggerAlreadyInAction
+	}
+	if t.triggerReceived {
+		log.Debug("governance hardfork trigger ignored as a trigger was already received",
+			"governance epoch", epoch, "trigger epoch", t.epoch)
+		return false, nil
+	}
+
+	if !t.armedFromGovernance || t.epoch != epoch {
+		log.Info("hardfork trigger armed from governance", "epoch", epoch)
+	}
+	t.armedFromGovernance = true
+	t.epoch = epoch
+
+	if epoch > t.epochProvider.MetaEpoch() {
+		return false, nil
+	}
+
+	t.triggerExecuting = true
+
+	return true, nil
+}
+
 func (t *trigger) computeHardforkRound(withEarlyEndOfEpoch bool) uint64 {
 	if !withEarlyEndOfEpoch {
 		return disabledRoundForForceEpochStart
@@ -492,3 +492,129 @@ func TestTrigger_AddCloserShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, trig.Closers()[0] == cs) //pointer testing
 }
+
+//------- TriggerFromGovernance
+
+func TestTrigger_TriggerFromGovernanceNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHardforkTrigger()
+	arg.Enabled = false
+	trig, _ := trigger.NewTrigger(arg)
+
+	err := trig.TriggerFromGovernance(trigger.MinimumEpochForHarfork + 1)
+	assert.Equal(t, update.ErrTriggerNotEnabled, err)
+	assert.False(t, trig.ArmedFromGovernance())
+}
+
+func TestTrigger_TriggerFromGovernanceWrongEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHardforkTrigger()
+	trig, _ := trigger.NewTrigger(arg)
+
+	err := trig.TriggerFromGovernance(trigger.MinimumEpochForHarfork - 1)
+	assert.True(t, errors.Is(err, update.ErrInvalidEpoch))
+}
+
+func TestTrigger_TriggerFromGovernanceFutureEpochShouldArmAndWaitForEpoch(t *testing.T) {
+	t.Parallel()
+
+	numExportCalls := int32(0)
+	arg := createMockArgHardforkTrigger()
+	arg.ExportFactoryHandler = &mock.ExportFactoryHandlerStub{
+		CreateCalled: func() (update.ExportHandler, error) {
+			atomic.AddInt32(&numExportCalls, 1)
+			return &mock.ExportHandlerStub{}, nil
+		},
+	}
+	trig, _ := trigger.NewTrigger(arg)
+	hardforkEpoch := uint32(trigger.MinimumEpochForHarfork + 2)
+
+	err := trig.TriggerFromGovernance(hardforkEpoch)
+	assert.Nil(t, err)
+	assert.True(t, trig.ArmedFromGovernance())
+	assert.Equal(t, hardforkEpoch, trig.Epoch())
+	assert.False(t, trig.TriggerExecuting())
+
+	// the governance trigger is not broadcast by a node that is not the trigger node
+	_, wasTriggered := trig.RecordedTriggerMessage()
+	assert.False(t, wasTriggered)
+
+	assert.False(t, trig.ComputeTriggerStartOfEpoch(hardforkEpoch-1))
+	assert.True(t, trig.ComputeTriggerStartOfEpoch(hardforkEpoch))
+
+	// delay as to execute the async calls
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numExportCalls))
+}
+
+func TestTrigger_TriggerFromGovernanceReachedEpochShouldTrigger(t *testing.T) {
+	t.Parallel()
+
+	numExportCalls := int32(0)
+	arg := createMockArgHardforkTrigger()
+	arg.ExportFactoryHandler = &mock.ExportFactoryHandlerStub{
+		CreateCalled: func() (update.ExportHandler, error) {
+			atomic.AddInt32(&numExportCalls, 1)
+			return &mock.ExportHandlerStub{}, nil
+		},
+	}
+	trig, _ := trigger.NewTrigger(arg)
+
+	err := trig.TriggerFromGovernance(trigger.MinimumEpochForHarfork)
+	assert.Nil(t, err)
+	assert.True(t, trig.TriggerExecuting())
+
+	// delay as to execute the async calls
+	time.Sleep(time.Second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numExportCalls))
+
+	err = trig.TriggerFromGovernance(trigger.MinimumEpochForHarfork)
+	assert.Equal(t, update.ErrTriggerAlreadyInAction, err)
+}
+
+func TestTrigger_TriggerManuallyShouldOverrideGovernance(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHardforkTrigger()
+	trig, _ := trigger.NewTrigger(arg)
+	governanceEpoch := uint32(trigger.MinimumEpochForHarfork + 2)
+	manualEpoch := uint32(trigger.MinimumEpochForHarfork + 5)
+
+	err := trig.TriggerFromGovernance(governanceEpoch)
+	assert.Nil(t, err)
+	assert.Equal(t, governanceEpoch, trig.Epoch())
+
+	err = trig.Trigger(manualEpoch, false)
+	assert.Nil(t, err)
+	assert.Equal(t, manualEpoch, trig.Epoch())
+
+	err = trig.TriggerFromGovernance(governanceEpoch)
+	assert.Nil(t, err)
+	assert.Equal(t, manualEpoch, trig.Epoch())
+	assert.False(t, trig.ComputeTriggerStartOfEpoch(governanceEpoch))
+}
+
+func TestTrigger_TriggerFromGovernanceOnTriggerNodeShouldBroadcast(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHardforkTrigger()
+	arg.SelfPubKeyBytes = arg.TriggerPubKeyBytes
+	trig, _ := trigger.NewTrigger(arg)
+	hardforkEpoch := uint32(trigger.MinimumEpochForHarfork + 2)
+
+	err := trig.TriggerFromGovernance(hardforkEpoch)
+	assert.Nil(t, err)
+
+	payload, wasTriggered := trig.RecordedTriggerMessage()
+	assert.Nil(t, payload)
+	assert.True(t, wasTriggered)
+	assert.Equal(t, hardforkEpoch, trig.Epoch())
+
+	select {
+	case <-trig.NotifyTriggerReceived():
+	case <-time.After(time.Second):
+		assert.Fail(t, "should have write on the notify channel")
+	}
+}
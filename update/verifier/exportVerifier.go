@@ -0,0 +1,473 @@
+package verifier
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	triesFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+)
+
+var log = logger.GetOrCreate("update/verifier")
+
+const atSep = "@"
+const maxTrieLevelInMemory = uint(5)
+
+// ArgsExportVerifier is the argument structure used to create a new export verifier
+type ArgsExportVerifier struct {
+	HardforkStorer update.HardforkStorer
+	Marshalizer    marshal.Marshalizer
+	Hasher         hashing.Hasher
+}
+
+type exportedData struct {
+	epochStartMetaBlock  *block.MetaBlock
+	unFinishedMetaBlocks map[string]*block.MetaBlock
+	rootHashes           map[uint32][]byte
+	miniBlocks           map[string]*block.MiniBlock
+	txHashes             map[string]struct{}
+	numDataTries         int
+	issues               []string
+}
+
+type exportVerifier struct {
+	hardforkStorer update.HardforkStorer
+	marshalizer    marshal.Marshalizer
+	hasher         hashing.Hasher
+}
+
+// NewExportVerifier creates a verifier for the content of a hardfork export
+func NewExportVerifier(args ArgsExportVerifier) (*exportVerifier, error) {
+	if check.IfNil(args.HardforkStorer) {
+		return nil, update.ErrNilHardforkStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, update.ErrNilHasher
+	}
+
+	return &exportVerifier{
+		hardforkStorer: args.HardforkStorer,
+		marshalizer:    args.Marshalizer,
+		hasher:         args.Hasher,
+	}, nil
+}
+
+// Verify re-imports the exported state in memory and checks it against the exported root hashes and the epoch start
+// metaBlock. It also checks that all the pending miniBlocks and their transactions were exported. The hardfork storer
+// is closed at the end of the verification, so this function can be called only once
+func (ev *exportVerifier) Verify() (*ExportReport, error) {
+	exported, err := ev.readExport()
+	if err != nil {
+		_ = ev.hardforkStorer.Close()
+		return nil, err
+	}
+
+	recomputedRootHashes, err := ev.reImportState(exported.rootHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	metaHash, err := core.CalculateHash(ev.marshalizer, ev.hasher, exported.epochStartMetaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ExportReport{
+		ChainID:                 string(exported.epochStartMetaBlock.ChainID),
+		Epoch:                   exported.epochStartMetaBlock.Epoch,
+		Round:                   exported.epochStartMetaBlock.Round,
+		Nonce:                   exported.epochStartMetaBlock.Nonce,
+		EpochStartMetaBlockHash: hex.EncodeToString(metaHash),
+		NumDataTries:            exported.numDataTries,
+		NumUnFinishedMetaBlocks: len(exported.unFinishedMetaBlocks),
+		NumMiniBlocks:           len(exported.miniBlocks),
+		NumTransactions:         len(exported.txHashes),
+		Issues:                  exported.issues,
+	}
+
+	ev.checkRootHashes(report, exported, recomputedRootHashes)
+	ev.checkPendingMiniBlocks(report, exported)
+
+	sort.Strings(report.Issues)
+
+	log.Debug("hardfork export verified",
+		"epoch", report.Epoch,
+		"num shards", len(report.Shards),
+		"num miniBlocks", report.NumMiniBlocks,
+		"num transactions", report.NumTransactions,
+		"num issues", len(report.Issues),
+	)
+
+	return report, nil
+}
+
+func (ev *exportVerifier) readExport() (*exportedData, error) {
+	exported := &exportedData{
+		unFinishedMetaBlocks: make(map[string]*block.MetaBlock),
+		rootHashes:           make(map[uint32][]byte),
+		miniBlocks:           make(map[string]*block.MiniBlock),
+		txHashes:             make(map[string]struct{}),
+		issues:               make([]string, 0),
+	}
+
+	var errFound error
+	ev.hardforkStorer.RangeKeys(func(identifier string, keys [][]byte) bool {
+		var err error
+		switch identifier {
+		case genesis.EpochStartMetaBlockIdentifier:
+			err = ev.readEpochStartMetaBlock(exported, identifier, keys)
+		case genesis.UnFinishedMetaBlocksIdentifier:
+			err = ev.readUnFinishedMetaBlocks(exported, identifier, keys)
+		case genesis.MiniBlocksIdentifier:
+			err = ev.readMiniBlocks(exported, identifier, keys)
+		case genesis.TransactionsIdentifier:
+			err = ev.readTransactionHashes(exported, keys)
+		default:
+			if !strings.HasPrefix(identifier, genesis.TrieIdentifier+atSep) {
+				return true
+			}
+			err = ev.readTrie(exported, identifier, keys)
+		}
+		if err != nil {
+			errFound = fmt.Errorf("%w identifier %s", err, identifier)
+			return false
+		}
+
+		return true
+	})
+	if errFound != nil {
+		return nil, errFound
+	}
+	if exported.epochStartMetaBlock == nil {
+		return nil, update.ErrNilEpochStartMetaBlock
+	}
+
+	return exported, nil
+}
+
+func (ev *exportVerifier) readEpochStartMetaBlock(exported *exportedData, identifier string, keys [][]byte) error {
+	if len(keys) != 1 {
+		return update.ErrExpectedOneStartOfEpochMetaBlock
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err := ev.readJson(identifier, keys[0], metaBlock)
+	if err != nil {
+		return err
+	}
+
+	exported.epochStartMetaBlock = metaBlock
+
+	return nil
+}
+
+func (ev *exportVerifier) readUnFinishedMetaBlocks(exported *exportedData, identifier string, keys [][]byte) error {
+	for _, key := range keys {
+		metaBlock := &block.MetaBlock{}
+		err := ev.readJson(identifier, key, metaBlock)
+		if err != nil {
+			return err
+		}
+
+		hash, err := core.CalculateHash(ev.marshalizer, ev.hasher, metaBlock)
+		if err != nil {
+			return err
+		}
+
+		exported.unFinishedMetaBlocks[string(hash)] = metaBlock
+	}
+
+	return nil
+}
+
+func (ev *exportVerifier) readMiniBlocks(exported *exportedData, identifier string, keys [][]byte) error {
+	for _, key := range keys {
+		_, hash, err := genesis.GetKeyTypeAndHash(string(key))
+		if err != nil {
+			return err
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = ev.readJson(identifier, key, miniBlock)
+		if err != nil {
+			return err
+		}
+
+		exported.miniBlocks[string(hash)] = miniBlock
+	}
+
+	return nil
+}
+
+func (ev *exportVerifier) readTransactionHashes(exported *exportedData, keys [][]byte) error {
+	for _, key := range keys {
+		_, hash, err := genesis.GetKeyTypeAndHash(string(key))
+		if err != nil {
+			return err
+		}
+
+		exported.txHashes[string(hash)] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ev *exportVerifier) readTrie(exported *exportedData, identifier string, keys [][]byte) error {
+	accType, shardID, err := genesis.GetTrieTypeAndShId(identifier)
+	if err != nil {
+		return err
+	}
+
+	// validator tries are only exported as the nodes setup file
+	if accType == genesis.ValidatorAccount {
+		return nil
+	}
+
+	rootHash, err := ev.readRootHash(identifier, keys)
+	if err != nil {
+		return err
+	}
+
+	if accType == genesis.DataTrie {
+		exported.numDataTries++
+		return ev.checkDataTrie(exported, identifier, keys, rootHash)
+	}
+
+	exported.rootHashes[shardID] = rootHash
+
+	return nil
+}
+
+func (ev *exportVerifier) readRootHash(identifier string, keys [][]byte) ([]byte, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w missing root hash", update.ErrImportingData)
+	}
+
+	keyType, _, err := genesis.GetKeyTypeAndHash(string(keys[0]))
+	if err != nil {
+		return nil, err
+	}
+	if keyType != genesis.RootHash {
+		return nil, fmt.Errorf("%w wanted a roothash, got %v", update.ErrWrongTypeAssertion, keyType)
+	}
+
+	return ev.hardforkStorer.Get(identifier, keys[0])
+}
+
+func (ev *exportVerifier) checkDataTrie(exported *exportedData, identifier string, keys [][]byte, exportedRootHash []byte) error {
+	dataTrie, err := ev.createInMemoryTrie()
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i < len(keys); i++ {
+		value, errGet := ev.hardforkStorer.Get(identifier, keys[i])
+		if errGet != nil {
+			return errGet
+		}
+
+		keyType, key, errKey := genesis.GetKeyTypeAndHash(string(keys[i]))
+		if errKey != nil {
+			return errKey
+		}
+		if keyType != genesis.DataTrie {
+			return update.ErrKeyTypeMismatch
+		}
+
+		err = dataTrie.Update(key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	rootHash, err := dataTrie.RootHash()
+	if err != nil {
+		return err
+	}
+
+	isEmptyExport := len(exportedRootHash) == 0 || bytes.Equal(exportedRootHash, trie.EmptyTrieHash)
+	if isEmptyExport && len(keys) == 1 {
+		return nil
+	}
+	if !bytes.Equal(rootHash, exportedRootHash) {
+		exported.issues = append(exported.issues, fmt.Sprintf("data trie %s: recomputed root hash %s does not match exported root hash %s",
+			identifier, hex.EncodeToString(rootHash), hex.EncodeToString(exportedRootHash)))
+	}
+
+	return nil
+}
+
+func (ev *exportVerifier) reImportState(exportedRootHashes map[uint32][]byte) (map[uint32][]byte, error) {
+	userTrieStorage, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	if err != nil {
+		return nil, err
+	}
+	peerTrieStorage, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	if err != nil {
+		return nil, err
+	}
+
+	importer, err := genesis.NewStateImport(genesis.ArgsNewStateImport{
+		Hasher:      ev.hasher,
+		Marshalizer: ev.marshalizer,
+		TrieStorageManagers: map[string]common.StorageManager{
+			triesFactory.UserAccountTrie: userTrieStorage,
+			triesFactory.PeerAccountTrie: peerTrieStorage,
+		},
+		HardforkStorer: ev.hardforkStorer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = importer.ImportAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return ev.getRecomputedRootHashes(importer, exportedRootHashes)
+}
+
+func (ev *exportVerifier) getRecomputedRootHashes(importer update.ImportHandler, exportedRootHashes map[uint32][]byte) (map[uint32][]byte, error) {
+	recomputedRootHashes := make(map[uint32][]byte)
+	for shardID := range exportedRootHashes {
+		accountsDB := importer.GetAccountsDBForShard(shardID)
+		if check.IfNil(accountsDB) {
+			continue
+		}
+
+		rootHash, err := accountsDB.RootHash()
+		if err != nil {
+			return nil, err
+		}
+
+		recomputedRootHashes[shardID] = rootHash
+	}
+
+	return recomputedRootHashes, nil
+}
+
+func (ev *exportVerifier) checkRootHashes(report *ExportReport, exported *exportedData, recomputedRootHashes map[uint32][]byte) {
+	epochStartRootHashes := getEpochStartRootHashes(exported.epochStartMetaBlock)
+
+	shardIDs := make([]uint32, 0, len(epochStartRootHashes))
+	for shardID := range epochStartRootHashes {
+		shardIDs = append(shardIDs, shardID)
+	}
+	for shardID := range exported.rootHashes {
+		_, found := epochStartRootHashes[shardID]
+		if !found {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	report.Shards = make([]*ShardRootHashReport, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		exportedRootHash, isExported := exported.rootHashes[shardID]
+		epochStartRootHash, isInMetaBlock := epochStartRootHashes[shardID]
+		recomputedRootHash := recomputedRootHashes[shardID]
+
+		report.Shards = append(report.Shards, &ShardRootHashReport{
+			ShardID:            shardID,
+			ExportedRootHash:   hex.EncodeToString(exportedRootHash),
+			RecomputedRootHash: hex.EncodeToString(recomputedRootHash),
+			EpochStartRootHash: hex.EncodeToString(epochStartRootHash),
+		})
+
+		if !isExported {
+			report.Issues = append(report.Issues, fmt.Sprintf("shard %d: accounts trie is missing from the export", shardID))
+			continue
+		}
+		if !isInMetaBlock {
+			report.Issues = append(report.Issues, fmt.Sprintf("shard %d: accounts trie is not referenced by the epoch start metaBlock", shardID))
+		}
+		if !bytes.Equal(recomputedRootHash, exportedRootHash) {
+			report.Issues = append(report.Issues, fmt.Sprintf("shard %d: recomputed root hash %s does not match exported root hash %s",
+				shardID, hex.EncodeToString(recomputedRootHash), hex.EncodeToString(exportedRootHash)))
+		}
+		if isInMetaBlock && !bytes.Equal(exportedRootHash, epochStartRootHash) {
+			report.Issues = append(report.Issues, fmt.Sprintf("shard %d: exported root hash %s does not match epoch start root hash %s",
+				shardID, hex.EncodeToString(exportedRootHash), hex.EncodeToString(epochStartRootHash)))
+		}
+	}
+}
+
+func getEpochStartRootHashes(metaBlock *block.MetaBlock) map[uint32][]byte {
+	rootHashes := make(map[uint32][]byte)
+	rootHashes[core.MetachainShardId] = metaBlock.RootHash
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		rootHashes[shardData.ShardID] = shardData.RootHash
+	}
+
+	return rootHashes
+}
+
+func (ev *exportVerifier) checkPendingMiniBlocks(report *ExportReport, exported *exportedData) {
+	pendingMiniBlocks, err := update.GetPendingMiniBlocks(exported.epochStartMetaBlock, exported.unFinishedMetaBlocks)
+	if err != nil {
+		report.Issues = append(report.Issues, fmt.Sprintf("pending miniBlocks could not be computed: %s", err.Error()))
+		return
+	}
+
+	report.NumPendingMiniBlocks = len(pendingMiniBlocks)
+	for _, miniBlockHeader := range pendingMiniBlocks {
+		miniBlock, found := exported.miniBlocks[string(miniBlockHeader.Hash)]
+		if !found {
+			report.Issues = append(report.Issues, fmt.Sprintf("pending miniBlock %s from shard %d to shard %d is missing from the export",
+				hex.EncodeToString(miniBlockHeader.Hash), miniBlockHeader.SenderShardID, miniBlockHeader.ReceiverShardID))
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			_, found = exported.txHashes[string(txHash)]
+			if !found {
+				report.Issues = append(report.Issues, fmt.Sprintf("pending miniBlock %s: transaction %s is missing from the export",
+					hex.EncodeToString(miniBlockHeader.Hash), hex.EncodeToString(txHash)))
+			}
+		}
+	}
+}
+
+func (ev *exportVerifier) createInMemoryTrie() (common.Trie, error) {
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.NewTrie(trieStorage, ev.marshalizer, ev.hasher, maxTrieLevelInMemory)
+}
+
+func (ev *exportVerifier) readJson(identifier string, key []byte, object interface{}) error {
+	value, err := ev.hardforkStorer.Get(identifier, key)
+	if err != nil {
+		return fmt.Errorf("%w, key not found for %s, error: %s",
+			update.ErrImportingData, hex.EncodeToString(key), err.Error())
+	}
+
+	return json.Unmarshal(value, object)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ev *exportVerifier) IsInterfaceNil() bool {
+	return ev == nil
+}
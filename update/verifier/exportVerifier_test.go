@@ -0,0 +1,277 @@
+package verifier
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/ElrondNetwork/elrond-go/update/storing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type exportBuilder struct {
+	t                   *testing.T
+	hardforkStorer      update.HardforkStorer
+	marshalizer         *mock.MarshalizerMock
+	hasher              *testscommon.HasherMock
+	epochStartMetaBlock *block.MetaBlock
+	miniBlock           *block.MiniBlock
+	miniBlockHash       []byte
+	tx                  *transaction.Transaction
+	txHash              []byte
+}
+
+func newExportBuilder(t *testing.T) *exportBuilder {
+	hs, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   mock.NewStorerMock(),
+		KeyValue:    mock.NewStorerMock(),
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+	require.Nil(t, err)
+
+	eb := &exportBuilder{
+		t:              t,
+		hardforkStorer: hs,
+		marshalizer:    &mock.MarshalizerMock{},
+		hasher:         &testscommon.HasherMock{},
+	}
+
+	eb.tx = &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: []byte("sender"), RcvAddr: []byte("receiver")}
+	eb.txHash, err = core.CalculateHash(eb.marshalizer, eb.hasher, eb.tx)
+	require.Nil(t, err)
+
+	eb.miniBlock = &block.MiniBlock{TxHashes: [][]byte{eb.txHash}, SenderShardID: 1, ReceiverShardID: 0}
+	eb.miniBlockHash, err = core.CalculateHash(eb.marshalizer, eb.hasher, eb.miniBlock)
+	require.Nil(t, err)
+
+	unFinishedMetaBlock := &block.MetaBlock{Nonce: 10, ChainID: []byte("chainID")}
+	unFinishedMetaBlockHash, err := core.CalculateHash(eb.marshalizer, eb.hasher, unFinishedMetaBlock)
+	require.Nil(t, err)
+	eb.writeJson(genesis.UnFinishedMetaBlocksIdentifier, genesis.CreateVersionKey(unFinishedMetaBlock, unFinishedMetaBlockHash), unFinishedMetaBlock)
+
+	eb.epochStartMetaBlock = &block.MetaBlock{
+		Nonce:   10,
+		Round:   11,
+		Epoch:   2,
+		ChainID: []byte("chainID"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{
+					ShardID:               0,
+					FirstPendingMetaBlock: unFinishedMetaBlockHash,
+					PendingMiniBlockHeaders: []block.MiniBlockHeader{
+						{Hash: eb.miniBlockHash, SenderShardID: 1, ReceiverShardID: 0},
+					},
+				},
+			},
+		},
+	}
+	eb.epochStartMetaBlock.EpochStart.LastFinalizedHeaders[0].RootHash = eb.exportAccounts(0, []string{"addr0", "addr1"})
+	eb.epochStartMetaBlock.RootHash = eb.exportAccounts(core.MetachainShardId, []string{"addr2"})
+
+	return eb
+}
+
+func (eb *exportBuilder) exportAccounts(shardID uint32, addresses []string) []byte {
+	tr, err := createTrieForTests()
+	require.Nil(eb.t, err)
+	accountsDB, err := state.NewAccountsDB(tr, eb.hasher, eb.marshalizer, factory.NewAccountCreator(), disabled.NewDisabledStoragePruningManager())
+	require.Nil(eb.t, err)
+
+	for idx, address := range addresses {
+		account, errLoad := accountsDB.LoadAccount([]byte(address))
+		require.Nil(eb.t, errLoad)
+		userAccount := account.(state.UserAccountHandler)
+		require.Nil(eb.t, userAccount.AddToBalance(big.NewInt(int64(idx+1))))
+		require.Nil(eb.t, accountsDB.SaveAccount(userAccount))
+	}
+	rootHash, err := accountsDB.Commit()
+	require.Nil(eb.t, err)
+
+	trieIdentifier := genesis.CreateTrieIdentifier(shardID, genesis.UserAccount)
+	identifier := genesis.TrieIdentifier + atSep + trieIdentifier
+	require.Nil(eb.t, eb.hardforkStorer.Write(identifier, []byte(genesis.CreateRootHashKey(trieIdentifier)), rootHash))
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash)
+	require.Nil(eb.t, err)
+	for leaf := range leavesChannel {
+		key := genesis.CreateAccountKey(genesis.UserAccount, shardID, leaf.Key())
+		require.Nil(eb.t, eb.hardforkStorer.Write(identifier, []byte(key), leaf.Value()))
+	}
+	require.Nil(eb.t, eb.hardforkStorer.FinishedIdentifier(identifier))
+
+	return rootHash
+}
+
+func (eb *exportBuilder) writeJson(identifier string, key string, object interface{}) {
+	buff, err := json.Marshal(object)
+	require.Nil(eb.t, err)
+	require.Nil(eb.t, eb.hardforkStorer.Write(identifier, []byte(key), buff))
+}
+
+func (eb *exportBuilder) finish(withTransaction bool) update.HardforkStorer {
+	eb.writeJson(genesis.EpochStartMetaBlockIdentifier, genesis.CreateVersionKey(eb.epochStartMetaBlock, []byte("hash")), eb.epochStartMetaBlock)
+	eb.writeJson(genesis.MiniBlocksIdentifier, genesis.CreateMiniBlockKey(string(eb.miniBlockHash)), eb.miniBlock)
+	if withTransaction {
+		eb.writeJson(genesis.TransactionsIdentifier, genesis.CreateTransactionKey(string(eb.txHash), eb.tx), eb.tx)
+	}
+
+	for _, identifier := range []string{
+		genesis.EpochStartMetaBlockIdentifier,
+		genesis.UnFinishedMetaBlocksIdentifier,
+		genesis.MiniBlocksIdentifier,
+		genesis.TransactionsIdentifier,
+	} {
+		require.Nil(eb.t, eb.hardforkStorer.FinishedIdentifier(identifier))
+	}
+
+	return eb.hardforkStorer
+}
+
+func createMockArgsExportVerifier(hs update.HardforkStorer) ArgsExportVerifier {
+	return ArgsExportVerifier{
+		HardforkStorer: hs,
+		Marshalizer:    &mock.MarshalizerMock{},
+		Hasher:         &testscommon.HasherMock{},
+	}
+}
+
+func createTrieForTests() (common.Trie, error) {
+	ev := &exportVerifier{marshalizer: &mock.MarshalizerMock{}, hasher: &testscommon.HasherMock{}}
+
+	return ev.createInMemoryTrie()
+}
+
+func TestNewExportVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hardfork storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ev, err := NewExportVerifier(createMockArgsExportVerifier(nil))
+		assert.True(t, check.IfNil(ev))
+		assert.Equal(t, update.ErrNilHardforkStorer, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExportVerifier(&mock.HardforkStorerStub{})
+		args.Marshalizer = nil
+		ev, err := NewExportVerifier(args)
+		assert.True(t, check.IfNil(ev))
+		assert.Equal(t, update.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExportVerifier(&mock.HardforkStorerStub{})
+		args.Hasher = nil
+		ev, err := NewExportVerifier(args)
+		assert.True(t, check.IfNil(ev))
+		assert.Equal(t, update.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ev, err := NewExportVerifier(createMockArgsExportVerifier(&mock.HardforkStorerStub{}))
+		assert.False(t, check.IfNil(ev))
+		assert.Nil(t, err)
+	})
+}
+
+func TestExportVerifier_VerifyConsistentExportShouldWork(t *testing.T) {
+	t.Parallel()
+
+	eb := newExportBuilder(t)
+	ev, _ := NewExportVerifier(createMockArgsExportVerifier(eb.finish(true)))
+
+	report, err := ev.Verify()
+	require.Nil(t, err)
+	assert.True(t, report.IsValid(), strings.Join(report.Issues, "\n"))
+	assert.Equal(t, "chainID", report.ChainID)
+	assert.Equal(t, uint32(2), report.Epoch)
+	require.Equal(t, 2, len(report.Shards))
+	assert.Equal(t, uint32(0), report.Shards[0].ShardID)
+	assert.Equal(t, core.MetachainShardId, report.Shards[1].ShardID)
+	for _, shard := range report.Shards {
+		assert.Equal(t, shard.ExportedRootHash, shard.RecomputedRootHash)
+		assert.Equal(t, shard.ExportedRootHash, shard.EpochStartRootHash)
+	}
+	assert.Equal(t, 1, report.NumPendingMiniBlocks)
+	assert.Equal(t, 1, report.NumMiniBlocks)
+	assert.Equal(t, 1, report.NumTransactions)
+	assert.Equal(t, 1, report.NumUnFinishedMetaBlocks)
+}
+
+func TestExportVerifier_VerifyMissingTransactionShouldReportIssue(t *testing.T) {
+	t.Parallel()
+
+	eb := newExportBuilder(t)
+	ev, _ := NewExportVerifier(createMockArgsExportVerifier(eb.finish(false)))
+
+	report, err := ev.Verify()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.Issues))
+	assert.True(t, strings.Contains(report.Issues[0], "is missing from the export"))
+}
+
+func TestExportVerifier_VerifyRootHashMismatchShouldReportIssue(t *testing.T) {
+	t.Parallel()
+
+	eb := newExportBuilder(t)
+	eb.epochStartMetaBlock.RootHash = []byte("another root hash")
+	ev, _ := NewExportVerifier(createMockArgsExportVerifier(eb.finish(true)))
+
+	report, err := ev.Verify()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.Issues))
+	assert.True(t, strings.Contains(report.Issues[0], "does not match epoch start root hash"))
+}
+
+func TestExportVerifier_VerifyMissingShardTrieShouldReportIssue(t *testing.T) {
+	t.Parallel()
+
+	eb := newExportBuilder(t)
+	eb.epochStartMetaBlock.EpochStart.LastFinalizedHeaders = append(eb.epochStartMetaBlock.EpochStart.LastFinalizedHeaders,
+		block.EpochStartShardData{ShardID: 1, RootHash: []byte("root hash"), FirstPendingMetaBlock: eb.epochStartMetaBlock.EpochStart.LastFinalizedHeaders[0].FirstPendingMetaBlock})
+	ev, _ := NewExportVerifier(createMockArgsExportVerifier(eb.finish(true)))
+
+	report, err := ev.Verify()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.Issues))
+	assert.Equal(t, "shard 1: accounts trie is missing from the export", report.Issues[0])
+}
+
+func TestExportVerifier_VerifyMissingEpochStartMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	hs := &mock.HardforkStorerStub{
+		RangeKeysCalled: func(handler func(identifier string, keys [][]byte) bool) {},
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	ev, _ := NewExportVerifier(createMockArgsExportVerifier(hs))
+
+	report, err := ev.Verify()
+	assert.Nil(t, report)
+	assert.True(t, errors.Is(err, update.ErrNilEpochStartMetaBlock))
+	assert.True(t, closeCalled)
+}
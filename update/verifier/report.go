@@ -0,0 +1,40 @@
+package verifier
+
+// ShardRootHashReport holds the accounts trie root hashes of one shard, as found in the export, as recomputed
+// after the re-import and as committed in the epoch start metaBlock
+type ShardRootHashReport struct {
+	ShardID            uint32 `json:"shardID"`
+	ExportedRootHash   string `json:"exportedRootHash"`
+	RecomputedRootHash string `json:"recomputedRootHash"`
+	EpochStartRootHash string `json:"epochStartRootHash"`
+}
+
+// ExportReport is the result of verifying a hardfork export. All its fields are deterministic so that the reports
+// produced by different validators on the same export hash to the same value
+type ExportReport struct {
+	ChainID                 string                 `json:"chainID"`
+	Epoch                   uint32                 `json:"epoch"`
+	Round                   uint64                 `json:"round"`
+	Nonce                   uint64                 `json:"nonce"`
+	EpochStartMetaBlockHash string                 `json:"epochStartMetaBlockHash"`
+	Shards                  []*ShardRootHashReport `json:"shards"`
+	NumDataTries            int                    `json:"numDataTries"`
+	NumUnFinishedMetaBlocks int                    `json:"numUnFinishedMetaBlocks"`
+	NumPendingMiniBlocks    int                    `json:"numPendingMiniBlocks"`
+	NumMiniBlocks           int                    `json:"numMiniBlocks"`
+	NumTransactions         int                    `json:"numTransactions"`
+	Issues                  []string               `json:"issues"`
+}
+
+// IsValid returns true if no inconsistency was found in the export
+func (er *ExportReport) IsValid() bool {
+	return len(er.Issues) == 0
+}
+
+// SignedExportReport is an export report along with its hash and the signature of the validator that produced it
+type SignedExportReport struct {
+	Report     *ExportReport `json:"report"`
+	ReportHash string        `json:"reportHash"`
+	PublicKey  string        `json:"publicKey"`
+	Signature  string        `json:"signature"`
+}
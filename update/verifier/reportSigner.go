@@ -0,0 +1,125 @@
+package verifier
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/update"
+)
+
+// ArgsReportSigner is the argument structure used to create a new report signer
+type ArgsReportSigner struct {
+	Hasher       hashing.Hasher
+	SingleSigner crypto.SingleSigner
+	KeyGenerator crypto.KeyGenerator
+	PrivateKey   crypto.PrivateKey
+}
+
+type reportSigner struct {
+	hasher       hashing.Hasher
+	singleSigner crypto.SingleSigner
+	keyGenerator crypto.KeyGenerator
+	privateKey   crypto.PrivateKey
+}
+
+// NewReportSigner creates a component able to sign export reports and to verify the reports signed by other validators
+func NewReportSigner(args ArgsReportSigner) (*reportSigner, error) {
+	if check.IfNil(args.Hasher) {
+		return nil, update.ErrNilHasher
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, update.ErrNilSingleSigner
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return nil, update.ErrNilKeyGenerator
+	}
+	if check.IfNil(args.PrivateKey) {
+		return nil, update.ErrNilPrivateKey
+	}
+
+	return &reportSigner{
+		hasher:       args.Hasher,
+		singleSigner: args.SingleSigner,
+		keyGenerator: args.KeyGenerator,
+		privateKey:   args.PrivateKey,
+	}, nil
+}
+
+// Sign computes the hash of the provided report and signs it with the private key of the validator
+func (rs *reportSigner) Sign(report *ExportReport) (*SignedExportReport, error) {
+	reportHash, err := rs.computeReportHash(report)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := rs.singleSigner.Sign(rs.privateKey, reportHash)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := rs.privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedExportReport{
+		Report:     report,
+		ReportHash: hex.EncodeToString(reportHash),
+		PublicKey:  hex.EncodeToString(publicKey),
+		Signature:  hex.EncodeToString(signature),
+	}, nil
+}
+
+// Verify checks that the report hash matches the report content and that the signature was issued by the public key
+// contained in the signed report
+func (rs *reportSigner) Verify(signedReport *SignedExportReport) error {
+	if signedReport == nil {
+		return update.ErrNilExportReport
+	}
+
+	reportHash, err := rs.computeReportHash(signedReport.Report)
+	if err != nil {
+		return err
+	}
+	if hex.EncodeToString(reportHash) != signedReport.ReportHash {
+		return fmt.Errorf("%w, computed %s, provided %s",
+			update.ErrExportReportHashMismatch, hex.EncodeToString(reportHash), signedReport.ReportHash)
+	}
+
+	publicKeyBytes, err := hex.DecodeString(signedReport.PublicKey)
+	if err != nil {
+		return err
+	}
+	publicKey, err := rs.keyGenerator.PublicKeyFromByteArray(publicKeyBytes)
+	if err != nil {
+		return err
+	}
+	signature, err := hex.DecodeString(signedReport.Signature)
+	if err != nil {
+		return err
+	}
+
+	return rs.singleSigner.Verify(publicKey, reportHash, signature)
+}
+
+func (rs *reportSigner) computeReportHash(report *ExportReport) ([]byte, error) {
+	if report == nil {
+		return nil, update.ErrNilExportReport
+	}
+
+	buff, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.hasher.Compute(string(buff)), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *reportSigner) IsInterfaceNil() bool {
+	return rs == nil
+}
@@ -0,0 +1,142 @@
+package verifier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsReportSigner() ArgsReportSigner {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk, _ := keyGen.GeneratePair()
+
+	return ArgsReportSigner{
+		Hasher:       &testscommon.HasherMock{},
+		SingleSigner: &mclSig.BlsSingleSigner{},
+		KeyGenerator: keyGen,
+		PrivateKey:   sk,
+	}
+}
+
+func createReportForTests() *ExportReport {
+	return &ExportReport{
+		ChainID: "chainID",
+		Epoch:   2,
+		Shards: []*ShardRootHashReport{
+			{
+				ShardID:            0,
+				ExportedRootHash:   "aa",
+				RecomputedRootHash: "aa",
+				EpochStartRootHash: "aa",
+			},
+		},
+		Issues: make([]string, 0),
+	}
+}
+
+func TestNewReportSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReportSigner()
+		args.Hasher = nil
+		rs, err := NewReportSigner(args)
+		assert.True(t, check.IfNil(rs))
+		assert.Equal(t, update.ErrNilHasher, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReportSigner()
+		args.SingleSigner = nil
+		rs, err := NewReportSigner(args)
+		assert.True(t, check.IfNil(rs))
+		assert.Equal(t, update.ErrNilSingleSigner, err)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReportSigner()
+		args.KeyGenerator = nil
+		rs, err := NewReportSigner(args)
+		assert.True(t, check.IfNil(rs))
+		assert.Equal(t, update.ErrNilKeyGenerator, err)
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReportSigner()
+		args.PrivateKey = nil
+		rs, err := NewReportSigner(args)
+		assert.True(t, check.IfNil(rs))
+		assert.Equal(t, update.ErrNilPrivateKey, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := NewReportSigner(createMockArgsReportSigner())
+		assert.False(t, check.IfNil(rs))
+		assert.Nil(t, err)
+	})
+}
+
+func TestReportSigner_SignNilReportShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rs, _ := NewReportSigner(createMockArgsReportSigner())
+	signedReport, err := rs.Sign(nil)
+	assert.Nil(t, signedReport)
+	assert.Equal(t, update.ErrNilExportReport, err)
+}
+
+func TestReportSigner_SignAndVerifyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	signer, _ := NewReportSigner(createMockArgsReportSigner())
+	otherValidator, _ := NewReportSigner(createMockArgsReportSigner())
+
+	signedReport, err := signer.Sign(createReportForTests())
+	require.Nil(t, err)
+	assert.Nil(t, otherValidator.Verify(signedReport))
+
+	otherSignedReport, err := otherValidator.Sign(createReportForTests())
+	require.Nil(t, err)
+	assert.Equal(t, signedReport.ReportHash, otherSignedReport.ReportHash)
+	assert.NotEqual(t, signedReport.PublicKey, otherSignedReport.PublicKey)
+}
+
+func TestReportSigner_VerifyTamperedReportShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rs, _ := NewReportSigner(createMockArgsReportSigner())
+	signedReport, err := rs.Sign(createReportForTests())
+	require.Nil(t, err)
+
+	signedReport.Report.Issues = append(signedReport.Report.Issues, "new issue")
+	err = rs.Verify(signedReport)
+	assert.True(t, errors.Is(err, update.ErrExportReportHashMismatch))
+}
+
+func TestReportSigner_VerifyWrongSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rs, _ := NewReportSigner(createMockArgsReportSigner())
+	signedReport, err := rs.Sign(createReportForTests())
+	require.Nil(t, err)
+
+	otherSignedReport, err := rs.Sign(&ExportReport{ChainID: "other"})
+	require.Nil(t, err)
+	signedReport.Signature = otherSignedReport.Signature
+
+	assert.NotNil(t, rs.Verify(signedReport))
+}
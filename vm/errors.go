@@ -247,3 +247,15 @@ var ErrProposalNotFound = errors.New("proposal was not found in storage")
 
 // ErrInvalidNumOfInitialWhiteListedAddress signals that 0 initial whiteListed addresses were provided to the governance contract
 var ErrInvalidNumOfInitialWhiteListedAddress = errors.New("0 initial whiteListed addresses provided to the governance contract")
+
+// ErrNilSystemSCRegistry signals that a nil system smart contracts registry was provided
+var ErrNilSystemSCRegistry = errors.New("nil system smart contracts registry")
+
+// ErrNilSystemSCDescriptor signals that a nil system smart contract descriptor was provided
+var ErrNilSystemSCDescriptor = errors.New("nil system smart contract descriptor")
+
+// ErrInvalidSystemSCDescriptor signals that an invalid system smart contract descriptor was provided
+var ErrInvalidSystemSCDescriptor = errors.New("invalid system smart contract descriptor")
+
+// ErrDuplicateSystemSCDescriptor signals that a system smart contract was already registered with the same name or address
+var ErrDuplicateSystemSCDescriptor = errors.New("duplicate system smart contract descriptor")
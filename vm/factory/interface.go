@@ -0,0 +1,8 @@
+package factory
+
+// SystemSCRegistry defines the registry the system smart contracts are created from
+type SystemSCRegistry interface {
+	Register(descriptor *SystemSCDescriptor) error
+	Descriptors() []*SystemSCDescriptor
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,189 @@
+package factory
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+// CreateDefaultSystemSCRegistry creates a registry holding the protocol's system smart contracts. A new system smart
+// contract only has to add its descriptor here. The set and the order of the registered contracts are part of the
+// consensus, so they are not configurable
+func CreateDefaultSystemSCRegistry() (*systemSCRegistry, error) {
+	registry := NewSystemSCRegistry()
+	descriptors := []*SystemSCDescriptor{
+		{
+			Name:       "staking",
+			Address:    vm.StakingSCAddress,
+			ForGenesis: true,
+			Create:     createStakingContract,
+		},
+		{
+			Name:       "validator",
+			Address:    vm.ValidatorSCAddress,
+			ForGenesis: true,
+			Create:     createValidatorContract,
+		},
+		{
+			Name:       "esdt",
+			Address:    vm.ESDTSCAddress,
+			ForGenesis: true,
+			Create:     createESDTContract,
+		},
+		{
+			Name:       "governance",
+			Address:    vm.GovernanceSCAddress,
+			ForGenesis: true,
+			Create:     createGovernanceContract,
+		},
+		{
+			Name:    "delegation manager",
+			Address: vm.DelegationManagerSCAddress,
+			Create:  createDelegationManagerContract,
+		},
+		{
+			Name:    "delegation",
+			Address: vm.FirstDelegationSCAddress,
+			Create:  createDelegationContract,
+		},
+	}
+
+	for _, descriptor := range descriptors {
+		err := registry.Register(descriptor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+func createStakingContract(args ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+	argsStaking := systemSmartContracts.ArgsNewStakingSmartContract{
+		MinNumNodes:          uint64(args.NodesConfigProvider.MinNumberOfNodes()),
+		StakingSCConfig:      args.SystemSCConfig.StakingSystemSCConfig,
+		Eei:                  args.SystemEI,
+		StakingAccessAddr:    vm.ValidatorSCAddress,
+		JailAccessAddr:       vm.JailingAddress,
+		EndOfEpochAccessAddr: vm.EndOfEpochAddress,
+		GasCost:              args.GasCost,
+		Marshalizer:          args.Marshalizer,
+		EpochNotifier:        args.EpochNotifier,
+		EpochConfig:          *args.EpochConfig,
+	}
+	staking, err := systemSmartContracts.NewStakingSmartContract(argsStaking)
+	return staking, err
+}
+
+func createValidatorContract(args ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+	argsValidator := systemSmartContracts.ArgsValidatorSmartContract{
+		Eei:                      args.SystemEI,
+		SigVerifier:              args.SigVerifier,
+		StakingSCConfig:          args.SystemSCConfig.StakingSystemSCConfig,
+		StakingSCAddress:         vm.StakingSCAddress,
+		EndOfEpochAddress:        vm.EndOfEpochAddress,
+		ValidatorSCAddress:       vm.ValidatorSCAddress,
+		GasCost:                  args.GasCost,
+		Marshalizer:              args.Marshalizer,
+		GenesisTotalSupply:       args.Economics.GenesisTotalSupply(),
+		EpochNotifier:            args.EpochNotifier,
+		MinDeposit:               args.SystemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
+		DelegationMgrEnableEpoch: args.EpochConfig.EnableEpochs.DelegationManagerEnableEpoch,
+		DelegationMgrSCAddress:   vm.DelegationManagerSCAddress,
+		GovernanceSCAddress:      vm.GovernanceSCAddress,
+		EpochConfig:              *args.EpochConfig,
+		ShardCoordinator:         args.ShardCoordinator,
+	}
+	validatorSC, err := systemSmartContracts.NewValidatorSmartContract(argsValidator)
+	return validatorSC, err
+}
+
+func createESDTContract(args ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+	argsESDT := systemSmartContracts.ArgsNewESDTSmartContract{
+		Eei:                    args.SystemEI,
+		GasCost:                args.GasCost,
+		ESDTSCAddress:          vm.ESDTSCAddress,
+		Marshalizer:            args.Marshalizer,
+		Hasher:                 args.Hasher,
+		ESDTSCConfig:           args.SystemSCConfig.ESDTSystemSCConfig,
+		EpochNotifier:          args.EpochNotifier,
+		AddressPubKeyConverter: args.AddressPubKeyConverter,
+		EndOfEpochSCAddress:    vm.EndOfEpochAddress,
+		EpochConfig:            *args.EpochConfig,
+	}
+	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
+	return esdt, err
+}
+
+func createGovernanceContract(args ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+	firstWhitelistAddress, err := args.AddressPubKeyConverter.Decode(args.SystemSCConfig.GovernanceSystemSCConfig.FirstWhitelistedAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for GovernanceSystemSCConfig.FirstWhitelistedAddress in systemSCFactory", vm.ErrInvalidAddress)
+	}
+
+	argsGovernance := systemSmartContracts.ArgsNewGovernanceContract{
+		Eei:                         args.SystemEI,
+		GasCost:                     args.GasCost,
+		GovernanceConfig:            args.SystemSCConfig.GovernanceSystemSCConfig,
+		Marshalizer:                 args.Marshalizer,
+		Hasher:                      args.Hasher,
+		GovernanceSCAddress:         vm.GovernanceSCAddress,
+		DelegationMgrSCAddress:      vm.DelegationManagerSCAddress,
+		ValidatorSCAddress:          vm.ValidatorSCAddress,
+		EpochNotifier:               args.EpochNotifier,
+		EpochConfig:                 *args.EpochConfig,
+		InitialWhiteListedAddresses: [][]byte{firstWhitelistAddress},
+	}
+	governance, err := systemSmartContracts.NewGovernanceContract(argsGovernance)
+	return governance, err
+}
+
+func createDelegationContract(args ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+	addTokensAddress, err := args.AddressPubKeyConverter.Decode(args.SystemSCConfig.DelegationManagerSystemSCConfig.ConfigChangeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for DelegationManagerSystemSCConfig.ConfigChangeAddress in systemSCFactory", vm.ErrInvalidAddress)
+	}
+
+	argsDelegation := systemSmartContracts.ArgsNewDelegation{
+		DelegationSCConfig:     args.SystemSCConfig.DelegationSystemSCConfig,
+		StakingSCConfig:        args.SystemSCConfig.StakingSystemSCConfig,
+		Eei:                    args.SystemEI,
+		SigVerifier:            args.SigVerifier,
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
+		StakingSCAddress:       vm.StakingSCAddress,
+		ValidatorSCAddress:     vm.ValidatorSCAddress,
+		GasCost:                args.GasCost,
+		Marshalizer:            args.Marshalizer,
+		EpochNotifier:          args.EpochNotifier,
+		EndOfEpochAddress:      vm.EndOfEpochAddress,
+		GovernanceSCAddress:    vm.GovernanceSCAddress,
+		EpochConfig:            *args.EpochConfig,
+		AddTokensAddress:       addTokensAddress,
+	}
+	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
+	return delegation, err
+}
+
+func createDelegationManagerContract(args ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+	configChangeAddres, err := args.AddressPubKeyConverter.Decode(args.SystemSCConfig.DelegationManagerSystemSCConfig.ConfigChangeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for DelegationManagerSystemSCConfig.ConfigChangeAddress in systemSCFactory", vm.ErrInvalidAddress)
+	}
+
+	argsDelegationManager := systemSmartContracts.ArgsNewDelegationManager{
+		DelegationMgrSCConfig:  args.SystemSCConfig.DelegationManagerSystemSCConfig,
+		DelegationSCConfig:     args.SystemSCConfig.DelegationSystemSCConfig,
+		Eei:                    args.SystemEI,
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
+		StakingSCAddress:       vm.StakingSCAddress,
+		ValidatorSCAddress:     vm.ValidatorSCAddress,
+		ConfigChangeAddress:    configChangeAddres,
+		GasCost:                args.GasCost,
+		Marshalizer:            args.Marshalizer,
+		EpochNotifier:          args.EpochNotifier,
+		EpochConfig:            *args.EpochConfig,
+	}
+	delegationManager, err := systemSmartContracts.NewDelegationManagerSystemSC(argsDelegationManager)
+	return delegationManager, err
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/vm"
-	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -32,6 +31,7 @@ type systemSCFactory struct {
 	addressPubKeyConverter core.PubkeyConverter
 	epochConfig            *config.EpochConfig
 	shardCoordinator       sharding.Coordinator
+	registry               SystemSCRegistry
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
@@ -48,6 +48,7 @@ type ArgsNewSystemSCFactory struct {
 	AddressPubKeyConverter core.PubkeyConverter
 	EpochConfig            *config.EpochConfig
 	ShardCoordinator       sharding.Coordinator
+	Registry               SystemSCRegistry
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -82,6 +83,9 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, fmt.Errorf("%w in NewSystemSCFactory", vm.ErrNilShardCoordinator)
 	}
+	if check.IfNil(args.Registry) {
+		return nil, fmt.Errorf("%w in NewSystemSCFactory", vm.ErrNilSystemSCRegistry)
+	}
 
 	scf := &systemSCFactory{
 		systemEI:               args.SystemEI,
@@ -95,6 +99,7 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		epochConfig:            args.EpochConfig,
 		shardCoordinator:       args.ShardCoordinator,
+		registry:               args.Registry,
 	}
 
 	err := scf.createGasConfig(args.GasSchedule.LatestGasSchedule())
@@ -168,178 +173,46 @@ func (scf *systemSCFactory) GasScheduleChange(gasSchedule map[string]map[string]
 	log.Debug("new gas schedule was set")
 }
 
-func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, error) {
-	argsStaking := systemSmartContracts.ArgsNewStakingSmartContract{
-		MinNumNodes:          uint64(scf.nodesConfigProvider.MinNumberOfNodes()),
-		StakingSCConfig:      scf.systemSCConfig.StakingSystemSCConfig,
-		Eei:                  scf.systemEI,
-		StakingAccessAddr:    vm.ValidatorSCAddress,
-		JailAccessAddr:       vm.JailingAddress,
-		EndOfEpochAccessAddr: vm.EndOfEpochAddress,
-		GasCost:              scf.gasCost,
-		Marshalizer:          scf.marshalizer,
-		EpochNotifier:        scf.epochNotifier,
-		EpochConfig:          *scf.epochConfig,
-	}
-	staking, err := systemSmartContracts.NewStakingSmartContract(argsStaking)
-	return staking, err
-}
-
-func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, error) {
-	args := systemSmartContracts.ArgsValidatorSmartContract{
-		Eei:                      scf.systemEI,
-		SigVerifier:              scf.sigVerifier,
-		StakingSCConfig:          scf.systemSCConfig.StakingSystemSCConfig,
-		StakingSCAddress:         vm.StakingSCAddress,
-		EndOfEpochAddress:        vm.EndOfEpochAddress,
-		ValidatorSCAddress:       vm.ValidatorSCAddress,
-		GasCost:                  scf.gasCost,
-		Marshalizer:              scf.marshalizer,
-		GenesisTotalSupply:       scf.economics.GenesisTotalSupply(),
-		EpochNotifier:            scf.epochNotifier,
-		MinDeposit:               scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
-		DelegationMgrEnableEpoch: scf.epochConfig.EnableEpochs.DelegationManagerEnableEpoch,
-		DelegationMgrSCAddress:   vm.DelegationManagerSCAddress,
-		GovernanceSCAddress:      vm.GovernanceSCAddress,
-		EpochConfig:              *scf.epochConfig,
-		ShardCoordinator:         scf.shardCoordinator,
-	}
-	validatorSC, err := systemSmartContracts.NewValidatorSmartContract(args)
-	return validatorSC, err
-}
-
-func (scf *systemSCFactory) createESDTContract() (vm.SystemSmartContract, error) {
-	argsESDT := systemSmartContracts.ArgsNewESDTSmartContract{
-		Eei:                    scf.systemEI,
+func (scf *systemSCFactory) createArgsCreateSystemSC() ArgsCreateSystemSC {
+	return ArgsCreateSystemSC{
+		SystemEI:               scf.systemEI,
+		Economics:              scf.economics,
+		NodesConfigProvider:    scf.nodesConfigProvider,
+		SigVerifier:            scf.sigVerifier,
 		GasCost:                scf.gasCost,
-		ESDTSCAddress:          vm.ESDTSCAddress,
 		Marshalizer:            scf.marshalizer,
 		Hasher:                 scf.hasher,
-		ESDTSCConfig:           scf.systemSCConfig.ESDTSystemSCConfig,
+		SystemSCConfig:         scf.systemSCConfig,
 		EpochNotifier:          scf.epochNotifier,
 		AddressPubKeyConverter: scf.addressPubKeyConverter,
-		EndOfEpochSCAddress:    vm.EndOfEpochAddress,
-		EpochConfig:            *scf.epochConfig,
-	}
-	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
-	return esdt, err
-}
-
-func (scf *systemSCFactory) createGovernanceContract() (vm.SystemSmartContract, error) {
-	firstWhitelistAddress, err := scf.addressPubKeyConverter.Decode(scf.systemSCConfig.GovernanceSystemSCConfig.FirstWhitelistedAddress)
-	if err != nil {
-		return nil, fmt.Errorf("%w for GovernanceSystemSCConfig.FirstWhitelistedAddress in systemSCFactory", vm.ErrInvalidAddress)
+		EpochConfig:            scf.epochConfig,
+		ShardCoordinator:       scf.shardCoordinator,
 	}
-
-	argsGovernance := systemSmartContracts.ArgsNewGovernanceContract{
-		Eei:                         scf.systemEI,
-		GasCost:                     scf.gasCost,
-		GovernanceConfig:            scf.systemSCConfig.GovernanceSystemSCConfig,
-		Marshalizer:                 scf.marshalizer,
-		Hasher:                      scf.hasher,
-		GovernanceSCAddress:         vm.GovernanceSCAddress,
-		DelegationMgrSCAddress:      vm.DelegationManagerSCAddress,
-		ValidatorSCAddress:          vm.ValidatorSCAddress,
-		EpochNotifier:               scf.epochNotifier,
-		EpochConfig:                 *scf.epochConfig,
-		InitialWhiteListedAddresses: [][]byte{firstWhitelistAddress},
-	}
-	governance, err := systemSmartContracts.NewGovernanceContract(argsGovernance)
-	return governance, err
 }
 
-func (scf *systemSCFactory) createDelegationContract() (vm.SystemSmartContract, error) {
-	addTokensAddress, err := scf.addressPubKeyConverter.Decode(scf.systemSCConfig.DelegationManagerSystemSCConfig.ConfigChangeAddress)
-	if err != nil {
-		return nil, fmt.Errorf("%w for DelegationManagerSystemSCConfig.ConfigChangeAddress in systemSCFactory", vm.ErrInvalidAddress)
-	}
+func (scf *systemSCFactory) addSystemSCs(forGenesis bool) error {
+	for _, descriptor := range scf.registry.Descriptors() {
+		if descriptor.ForGenesis != forGenesis {
+			continue
+		}
 
-	argsDelegation := systemSmartContracts.ArgsNewDelegation{
-		DelegationSCConfig:     scf.systemSCConfig.DelegationSystemSCConfig,
-		StakingSCConfig:        scf.systemSCConfig.StakingSystemSCConfig,
-		Eei:                    scf.systemEI,
-		SigVerifier:            scf.sigVerifier,
-		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
-		StakingSCAddress:       vm.StakingSCAddress,
-		ValidatorSCAddress:     vm.ValidatorSCAddress,
-		GasCost:                scf.gasCost,
-		Marshalizer:            scf.marshalizer,
-		EpochNotifier:          scf.epochNotifier,
-		EndOfEpochAddress:      vm.EndOfEpochAddress,
-		GovernanceSCAddress:    vm.GovernanceSCAddress,
-		EpochConfig:            *scf.epochConfig,
-		AddTokensAddress:       addTokensAddress,
-	}
-	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
-	return delegation, err
-}
+		systemSC, err := descriptor.Create(scf.createArgsCreateSystemSC())
+		if err != nil {
+			return err
+		}
 
-func (scf *systemSCFactory) createDelegationManagerContract() (vm.SystemSmartContract, error) {
-	configChangeAddres, err := scf.addressPubKeyConverter.Decode(scf.systemSCConfig.DelegationManagerSystemSCConfig.ConfigChangeAddress)
-	if err != nil {
-		return nil, fmt.Errorf("%w for DelegationManagerSystemSCConfig.ConfigChangeAddress in systemSCFactory", vm.ErrInvalidAddress)
+		err = scf.systemSCsContainer.Add(descriptor.Address, systemSC)
+		if err != nil {
+			return err
+		}
 	}
 
-	argsDelegationManager := systemSmartContracts.ArgsNewDelegationManager{
-		DelegationMgrSCConfig:  scf.systemSCConfig.DelegationManagerSystemSCConfig,
-		DelegationSCConfig:     scf.systemSCConfig.DelegationSystemSCConfig,
-		Eei:                    scf.systemEI,
-		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
-		StakingSCAddress:       vm.StakingSCAddress,
-		ValidatorSCAddress:     vm.ValidatorSCAddress,
-		ConfigChangeAddress:    configChangeAddres,
-		GasCost:                scf.gasCost,
-		Marshalizer:            scf.marshalizer,
-		EpochNotifier:          scf.epochNotifier,
-		EpochConfig:            *scf.epochConfig,
-	}
-	delegationManager, err := systemSmartContracts.NewDelegationManagerSystemSC(argsDelegationManager)
-	return delegationManager, err
+	return scf.systemEI.SetSystemSCContainer(scf.systemSCsContainer)
 }
 
 // CreateForGenesis instantiates all the system smart contracts and returns a container containing them to be used in the genesis process
 func (scf *systemSCFactory) CreateForGenesis() (vm.SystemSCContainer, error) {
-	staking, err := scf.createStakingContract()
-	if err != nil {
-		return nil, err
-	}
-
-	err = scf.systemSCsContainer.Add(vm.StakingSCAddress, staking)
-	if err != nil {
-		return nil, err
-	}
-
-	validatorSC, err := scf.createValidatorContract()
-	if err != nil {
-		return nil, err
-	}
-
-	err = scf.systemSCsContainer.Add(vm.ValidatorSCAddress, validatorSC)
-	if err != nil {
-		return nil, err
-	}
-
-	esdt, err := scf.createESDTContract()
-	if err != nil {
-		return nil, err
-	}
-
-	err = scf.systemSCsContainer.Add(vm.ESDTSCAddress, esdt)
-	if err != nil {
-		return nil, err
-	}
-
-	governance, err := scf.createGovernanceContract()
-	if err != nil {
-		return nil, err
-	}
-
-	err = scf.systemSCsContainer.Add(vm.GovernanceSCAddress, governance)
-	if err != nil {
-		return nil, err
-	}
-
-	err = scf.systemEI.SetSystemSCContainer(scf.systemSCsContainer)
+	err := scf.addSystemSCs(true)
 	if err != nil {
 		return nil, err
 	}
@@ -354,27 +227,7 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
-	delegationManager, err := scf.createDelegationManagerContract()
-	if err != nil {
-		return nil, err
-	}
-
-	err = scf.systemSCsContainer.Add(vm.DelegationManagerSCAddress, delegationManager)
-	if err != nil {
-		return nil, err
-	}
-
-	delegation, err := scf.createDelegationContract()
-	if err != nil {
-		return nil, err
-	}
-
-	err = scf.systemSCsContainer.Add(vm.FirstDelegationSCAddress, delegation)
-	if err != nil {
-		return nil, err
-	}
-
-	err = scf.systemEI.SetSystemSCContainer(scf.systemSCsContainer)
+	err = scf.addSystemSCs(false)
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 	gasMap := arwenConfig.MakeGasMapForTests()
 	gasMap = defaults.FillGasMapInternal(gasMap, 1)
 	gasSchedule := mock.NewGasScheduleNotifierMock(gasMap)
+	registry, _ := CreateDefaultSystemSCRegistry()
 	return ArgsNewSystemSCFactory{
 		SystemEI:            &mock.SystemEIStub{},
 		Economics:           &mock.EconomicsHandlerStub{},
@@ -76,6 +77,7 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 			},
 		},
 		ShardCoordinator: &mock.ShardCoordinatorStub{},
+		Registry:         registry,
 	}
 }
 
@@ -189,6 +191,17 @@ func TestNewSystemSCFactory_NilShardCoordinator(t *testing.T) {
 	assert.True(t, errors.Is(err, vm.ErrNilShardCoordinator))
 }
 
+func TestNewSystemSCFactory_NilRegistry(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockNewSystemScFactoryArgs()
+	arguments.Registry = nil
+	scFactory, err := NewSystemSCFactory(arguments)
+
+	assert.True(t, check.IfNil(scFactory))
+	assert.True(t, errors.Is(err, vm.ErrNilSystemSCRegistry))
+}
+
 func TestNewSystemSCFactory_Ok(t *testing.T) {
 	t.Parallel()
 
@@ -291,6 +304,33 @@ func TestSystemSCFactory_CreateForGenesis(t *testing.T) {
 	assert.Equal(t, 4, container.Len())
 }
 
+func TestSystemSCFactory_CreateFromCustomRegistry(t *testing.T) {
+	t.Parallel()
+
+	customAddress := []byte("custom system SC address")
+	registry := NewSystemSCRegistry()
+	err := registry.Register(&SystemSCDescriptor{
+		Name:    "custom",
+		Address: customAddress,
+		Create: func(args ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+			assert.Equal(t, uint64(1), args.GasCost.MetaChainSystemSCsCost.Get)
+			return &mock.SystemSCStub{}, nil
+		},
+	})
+	require.Nil(t, err)
+
+	arguments := createMockNewSystemScFactoryArgs()
+	arguments.Registry = registry
+	scFactory, _ := NewSystemSCFactory(arguments)
+
+	container, err := scFactory.Create()
+	require.Nil(t, err)
+	assert.Equal(t, 1, container.Len())
+
+	_, err = container.Get(customAddress)
+	assert.Nil(t, err)
+}
+
 func TestSystemSCFactory_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
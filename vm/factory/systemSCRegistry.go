@@ -0,0 +1,110 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+// ArgsCreateSystemSC holds the components a system smart contract can be built from
+type ArgsCreateSystemSC struct {
+	SystemEI               vm.ContextHandler
+	Economics              vm.EconomicsHandler
+	NodesConfigProvider    vm.NodesConfigProvider
+	SigVerifier            vm.MessageSignVerifier
+	GasCost                vm.GasCost
+	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	SystemSCConfig         *config.SystemSmartContractsConfig
+	EpochNotifier          vm.EpochNotifier
+	AddressPubKeyConverter core.PubkeyConverter
+	EpochConfig            *config.EpochConfig
+	ShardCoordinator       sharding.Coordinator
+}
+
+// SystemSCCreateFunc creates a system smart contract out of the provided components
+type SystemSCCreateFunc func(args ArgsCreateSystemSC) (vm.SystemSmartContract, error)
+
+// SystemSCDescriptor declares everything the system smart contracts factory needs in order to create a system smart
+// contract. The contracts handle their own activation epochs, while the gas schedule is checked as a whole on decoding
+type SystemSCDescriptor struct {
+	// Name identifies the contract in logs and errors
+	Name string
+	// Address is the address the contract is reachable at
+	Address []byte
+	// ForGenesis marks the contracts that are created for the genesis process as well
+	ForGenesis bool
+	// Create instantiates the contract
+	Create SystemSCCreateFunc
+}
+
+type systemSCRegistry struct {
+	mut         sync.RWMutex
+	descriptors []*SystemSCDescriptor
+}
+
+// NewSystemSCRegistry creates an empty system smart contracts registry
+func NewSystemSCRegistry() *systemSCRegistry {
+	return &systemSCRegistry{
+		descriptors: make([]*SystemSCDescriptor, 0),
+	}
+}
+
+// Register adds the provided descriptor to the registry. Contracts are created in the order they were registered
+func (reg *systemSCRegistry) Register(descriptor *SystemSCDescriptor) error {
+	err := checkSystemSCDescriptor(descriptor)
+	if err != nil {
+		return err
+	}
+
+	reg.mut.Lock()
+	defer reg.mut.Unlock()
+
+	for _, registered := range reg.descriptors {
+		if registered.Name == descriptor.Name || string(registered.Address) == string(descriptor.Address) {
+			return fmt.Errorf("%w: %s", vm.ErrDuplicateSystemSCDescriptor, descriptor.Name)
+		}
+	}
+
+	reg.descriptors = append(reg.descriptors, descriptor)
+
+	return nil
+}
+
+func checkSystemSCDescriptor(descriptor *SystemSCDescriptor) error {
+	if descriptor == nil {
+		return vm.ErrNilSystemSCDescriptor
+	}
+	if len(descriptor.Name) == 0 {
+		return fmt.Errorf("%w: empty name", vm.ErrInvalidSystemSCDescriptor)
+	}
+	if len(descriptor.Address) == 0 {
+		return fmt.Errorf("%w: empty address for %s", vm.ErrInvalidSystemSCDescriptor, descriptor.Name)
+	}
+	if descriptor.Create == nil {
+		return fmt.Errorf("%w: nil create function for %s", vm.ErrInvalidSystemSCDescriptor, descriptor.Name)
+	}
+	return nil
+}
+
+// Descriptors returns the registered descriptors, in the registration order
+func (reg *systemSCRegistry) Descriptors() []*SystemSCDescriptor {
+	reg.mut.RLock()
+	defer reg.mut.RUnlock()
+
+	descriptors := make([]*SystemSCDescriptor, len(reg.descriptors))
+	copy(descriptors, reg.descriptors)
+
+	return descriptors
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reg *systemSCRegistry) IsInterfaceNil() bool {
+	return reg == nil
+}
@@ -0,0 +1,93 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSystemSCDescriptor(name string, address []byte) *SystemSCDescriptor {
+	return &SystemSCDescriptor{
+		Name:    name,
+		Address: address,
+		Create: func(_ ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+			return &mock.SystemSCStub{}, nil
+		},
+	}
+}
+
+func TestSystemSCRegistry_RegisterInvalidDescriptorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	registry := NewSystemSCRegistry()
+	assert.False(t, check.IfNil(registry))
+
+	err := registry.Register(nil)
+	assert.Equal(t, vm.ErrNilSystemSCDescriptor, err)
+
+	err = registry.Register(createMockSystemSCDescriptor("", []byte("address")))
+	assert.True(t, errors.Is(err, vm.ErrInvalidSystemSCDescriptor))
+
+	err = registry.Register(createMockSystemSCDescriptor("name", nil))
+	assert.True(t, errors.Is(err, vm.ErrInvalidSystemSCDescriptor))
+
+	descriptor := createMockSystemSCDescriptor("name", []byte("address"))
+	descriptor.Create = nil
+	err = registry.Register(descriptor)
+	assert.True(t, errors.Is(err, vm.ErrInvalidSystemSCDescriptor))
+
+	assert.Equal(t, 0, len(registry.Descriptors()))
+}
+
+func TestSystemSCRegistry_RegisterDuplicateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	registry := NewSystemSCRegistry()
+	err := registry.Register(createMockSystemSCDescriptor("first", []byte("address 1")))
+	require.Nil(t, err)
+
+	err = registry.Register(createMockSystemSCDescriptor("first", []byte("address 2")))
+	assert.True(t, errors.Is(err, vm.ErrDuplicateSystemSCDescriptor))
+
+	err = registry.Register(createMockSystemSCDescriptor("second", []byte("address 1")))
+	assert.True(t, errors.Is(err, vm.ErrDuplicateSystemSCDescriptor))
+
+	err = registry.Register(createMockSystemSCDescriptor("second", []byte("address 2")))
+	assert.Nil(t, err)
+
+	descriptors := registry.Descriptors()
+	require.Equal(t, 2, len(descriptors))
+	assert.Equal(t, "first", descriptors[0].Name)
+	assert.Equal(t, "second", descriptors[1].Name)
+}
+
+func TestCreateDefaultSystemSCRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry, err := CreateDefaultSystemSCRegistry()
+	require.Nil(t, err)
+
+	descriptors := registry.Descriptors()
+	expectedAddresses := [][]byte{
+		vm.StakingSCAddress,
+		vm.ValidatorSCAddress,
+		vm.ESDTSCAddress,
+		vm.GovernanceSCAddress,
+		vm.DelegationManagerSCAddress,
+		vm.FirstDelegationSCAddress,
+	}
+	require.Equal(t, len(expectedAddresses), len(descriptors))
+	numForGenesis := 0
+	for i, descriptor := range descriptors {
+		assert.Equal(t, expectedAddresses[i], descriptor.Address)
+		if descriptor.ForGenesis {
+			numForGenesis++
+		}
+	}
+	assert.Equal(t, 4, numForGenesis)
+}
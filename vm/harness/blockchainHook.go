@@ -0,0 +1,134 @@
+package harness
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// accountsBlockchainHook is a minimal blockchain hook reading directly from an accounts adapter. All the
+// addresses are considered to be in the metachain
+type accountsBlockchainHook struct {
+	accounts   state.AccountsAdapter
+	mutBlock   sync.RWMutex
+	nonce      uint64
+	round      uint64
+	epoch      uint32
+	randomSeed []byte
+}
+
+func (bh *accountsBlockchainHook) setBlock(nonce uint64, round uint64, epoch uint32, randomSeed []byte) {
+	bh.mutBlock.Lock()
+	bh.nonce = nonce
+	bh.round = round
+	bh.epoch = epoch
+	bh.randomSeed = randomSeed
+	bh.mutBlock.Unlock()
+}
+
+// GetStorageData returns the value saved under the provided key in the account's data trie
+func (bh *accountsBlockchainHook) GetStorageData(accountAddress []byte, index []byte) ([]byte, error) {
+	account, err := bh.GetUserAccount(accountAddress)
+	if err == state.ErrAccNotFound {
+		return make([]byte, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return account.AccountDataHandler().RetrieveValue(index)
+}
+
+// CurrentNonce returns the nonce of the current block
+func (bh *accountsBlockchainHook) CurrentNonce() uint64 {
+	bh.mutBlock.RLock()
+	defer bh.mutBlock.RUnlock()
+
+	return bh.nonce
+}
+
+// CurrentRound returns the round of the current block
+func (bh *accountsBlockchainHook) CurrentRound() uint64 {
+	bh.mutBlock.RLock()
+	defer bh.mutBlock.RUnlock()
+
+	return bh.round
+}
+
+// CurrentEpoch returns the epoch of the current block
+func (bh *accountsBlockchainHook) CurrentEpoch() uint32 {
+	bh.mutBlock.RLock()
+	defer bh.mutBlock.RUnlock()
+
+	return bh.epoch
+}
+
+// CurrentRandomSeed returns the random seed of the current block
+func (bh *accountsBlockchainHook) CurrentRandomSeed() []byte {
+	bh.mutBlock.RLock()
+	defer bh.mutBlock.RUnlock()
+
+	return bh.randomSeed
+}
+
+// GetUserAccount returns the existing user account of the provided address
+func (bh *accountsBlockchainHook) GetUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	account, err := bh.accounts.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, state.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+// GetCode returns the code of the provided account
+func (bh *accountsBlockchainHook) GetCode(account vmcommon.UserAccountHandler) []byte {
+	return bh.accounts.GetCode(account.GetCodeHash())
+}
+
+// GetShardOfAddress returns the metachain shard ID
+func (bh *accountsBlockchainHook) GetShardOfAddress(_ []byte) uint32 {
+	return core.MetachainShardId
+}
+
+// IsSmartContract returns true if the provided address is a smart contract address
+func (bh *accountsBlockchainHook) IsSmartContract(address []byte) bool {
+	return core.IsSmartContractAddress(address)
+}
+
+// IsPayable returns true for any address
+func (bh *accountsBlockchainHook) IsPayable(_ []byte) (bool, error) {
+	return true, nil
+}
+
+// NumberOfShards returns 1
+func (bh *accountsBlockchainHook) NumberOfShards() uint32 {
+	return 1
+}
+
+// Close does nothing
+func (bh *accountsBlockchainHook) Close() error {
+	return nil
+}
+
+// GetSnapshot returns the journal length of the accounts adapter
+func (bh *accountsBlockchainHook) GetSnapshot() int {
+	return bh.accounts.JournalLen()
+}
+
+// RevertToSnapshot reverts the accounts adapter to the provided snapshot
+func (bh *accountsBlockchainHook) RevertToSnapshot(snapshot int) error {
+	return bh.accounts.RevertToSnapshot(snapshot)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bh *accountsBlockchainHook) IsInterfaceNil() bool {
+	return bh == nil
+}
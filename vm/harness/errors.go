@@ -0,0 +1,6 @@
+package harness
+
+import "errors"
+
+// ErrNilEpochConfig signals that a nil epoch config was provided
+var ErrNilEpochConfig = errors.New("nil epoch config")
@@ -0,0 +1,334 @@
+package harness
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	systemVMFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+)
+
+const maxTrieLevelInMemory = uint(5)
+
+// ArgsSystemSCHarness holds the arguments needed to create a system smart contracts harness
+type ArgsSystemSCHarness struct {
+	Registry               systemVMFactory.SystemSCRegistry
+	GasSchedule            core.GasScheduleNotifier
+	SystemSCConfig         *config.SystemSmartContractsConfig
+	EpochConfig            *config.EpochConfig
+	AddressPubKeyConverter core.PubkeyConverter
+	Economics              vm.EconomicsHandler
+	NodesConfigProvider    vm.NodesConfigProvider
+	SigVerifier            vm.MessageSignVerifier
+	ChanceComputer         sharding.ChanceComputer
+}
+
+// SystemSCHarness runs the system smart contracts of a registry through the environment interface, against in
+// memory accounts. The output of each successful call is applied and committed, so that consecutive calls build
+// on each other
+type SystemSCHarness struct {
+	accounts           state.AccountsAdapter
+	validatorAccounts  state.AccountsAdapter
+	blockchainHook     *accountsBlockchainHook
+	epochNotifier      vm.EpochNotifier
+	systemEI           vm.ContextHandler
+	systemSCsContainer vm.SystemSCContainer
+}
+
+// NewSystemSCHarness creates the in memory accounts, the environment interface and all the system smart contracts
+// the provided registry declares
+func NewSystemSCHarness(args ArgsSystemSCHarness) (*SystemSCHarness, error) {
+	if check.IfNil(args.Registry) {
+		return nil, vm.ErrNilSystemSCRegistry
+	}
+	if check.IfNil(args.GasSchedule) {
+		return nil, vm.ErrNilGasSchedule
+	}
+	if args.SystemSCConfig == nil {
+		return nil, vm.ErrNilSystemSCConfig
+	}
+	if args.EpochConfig == nil {
+		return nil, ErrNilEpochConfig
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, vm.ErrNilAddressPubKeyConverter
+	}
+	if check.IfNil(args.Economics) {
+		return nil, vm.ErrNilEconomicsData
+	}
+	if check.IfNil(args.NodesConfigProvider) {
+		return nil, vm.ErrNilNodesConfigProvider
+	}
+	if check.IfNil(args.SigVerifier) {
+		return nil, vm.ErrNilMessageSignVerifier
+	}
+	if check.IfNil(args.ChanceComputer) {
+		return nil, vm.ErrNilChanceComputer
+	}
+
+	accounts, err := createInMemoryAccounts(factory.NewAccountCreator())
+	if err != nil {
+		return nil, err
+	}
+	validatorAccounts, err := createInMemoryAccounts(factory.NewPeerAccountCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	blockchainHook := &accountsBlockchainHook{
+		accounts: accounts,
+	}
+	systemEI, err := systemSmartContracts.NewVMContext(
+		blockchainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		validatorAccounts,
+		args.ChanceComputer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(1, core.MetachainShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	epochNotifier := forking.NewGenericEpochNotifier()
+	scFactory, err := systemVMFactory.NewSystemSCFactory(systemVMFactory.ArgsNewSystemSCFactory{
+		SystemEI:               systemEI,
+		Economics:              args.Economics,
+		NodesConfigProvider:    args.NodesConfigProvider,
+		SigVerifier:            args.SigVerifier,
+		GasSchedule:            args.GasSchedule,
+		Marshalizer:            &marshal.GogoProtoMarshalizer{},
+		Hasher:                 sha256.NewSha256(),
+		SystemSCConfig:         args.SystemSCConfig,
+		EpochNotifier:          epochNotifier,
+		AddressPubKeyConverter: args.AddressPubKeyConverter,
+		EpochConfig:            args.EpochConfig,
+		ShardCoordinator:       shardCoordinator,
+		Registry:               args.Registry,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	container, err := scFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SystemSCHarness{
+		accounts:           accounts,
+		validatorAccounts:  validatorAccounts,
+		blockchainHook:     blockchainHook,
+		epochNotifier:      epochNotifier,
+		systemEI:           systemEI,
+		systemSCsContainer: container,
+	}, nil
+}
+
+func createInMemoryAccounts(accountFactory state.AccountFactory) (state.AccountsAdapter, error) {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := sha256.NewSha256()
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := trie.NewTrie(trieStorage, marshalizer, hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(tr, hasher, marshalizer, accountFactory, disabled.NewDisabledStoragePruningManager())
+}
+
+// SetBlock sets the current block information and notifies the epoch to the system smart contracts
+func (h *SystemSCHarness) SetBlock(nonce uint64, round uint64, epoch uint32) {
+	h.blockchainHook.setBlock(nonce, round, epoch, []byte("random seed"))
+	h.epochNotifier.CheckEpoch(&block.MetaBlock{Nonce: nonce, Round: round, Epoch: epoch})
+}
+
+// SetBalance sets the balance of the provided address
+func (h *SystemSCHarness) SetBalance(address []byte, balance *big.Int) error {
+	account, err := h.loadUserAccount(address)
+	if err != nil {
+		return err
+	}
+
+	err = account.SubFromBalance(account.GetBalance())
+	if err != nil {
+		return err
+	}
+	err = account.AddToBalance(balance)
+	if err != nil {
+		return err
+	}
+
+	return h.saveAndCommit(account)
+}
+
+// GetBalance returns the balance of the provided address
+func (h *SystemSCHarness) GetBalance(address []byte) *big.Int {
+	account, err := h.blockchainHook.GetUserAccount(address)
+	if err != nil {
+		return big.NewInt(0)
+	}
+
+	return account.GetBalance()
+}
+
+// GetStorage returns the value saved by a contract under the provided key
+func (h *SystemSCHarness) GetStorage(address []byte, key []byte) []byte {
+	value, err := h.blockchainHook.GetStorageData(address, key)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+// Accounts returns the in memory user accounts
+func (h *SystemSCHarness) Accounts() state.AccountsAdapter {
+	return h.accounts
+}
+
+// ValidatorAccounts returns the in memory validator accounts
+func (h *SystemSCHarness) ValidatorAccounts() state.AccountsAdapter {
+	return h.validatorAccounts
+}
+
+// SystemSCContainer returns the container holding the created system smart contracts
+func (h *SystemSCHarness) SystemSCContainer() vm.SystemSCContainer {
+	return h.systemSCsContainer
+}
+
+// Call runs the provided call the same way the system VM does. The call value is moved from the caller and the
+// output is applied to the accounts only if the call succeeded
+func (h *SystemSCHarness) Call(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	if input == nil {
+		return nil, vm.ErrInputArgsIsNil
+	}
+	if input.CallValue == nil {
+		input.CallValue = big.NewInt(0)
+	}
+
+	h.systemEI.CleanCache()
+	h.systemEI.SetSCAddress(input.RecipientAddr)
+	h.systemEI.AddTxValueToSmartContract(input.CallValue, input.RecipientAddr)
+	h.systemEI.SetGasProvided(input.GasProvided)
+
+	contract, err := h.systemEI.GetContract(input.RecipientAddr)
+	if err != nil {
+		return nil, vm.ErrUnknownSystemSmartContract
+	}
+
+	returnCode := contract.Execute(input)
+	vmOutput := h.systemEI.CreateVMOutput()
+	vmOutput.ReturnCode = returnCode
+	if returnCode != vmcommon.Ok {
+		return vmOutput, nil
+	}
+
+	err = h.applyVMOutput(input, vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+func (h *SystemSCHarness) applyVMOutput(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) error {
+	if input.CallValue.Sign() > 0 {
+		caller, err := h.loadUserAccount(input.CallerAddr)
+		if err != nil {
+			return err
+		}
+		err = caller.SubFromBalance(input.CallValue)
+		if err != nil {
+			return err
+		}
+		err = h.accounts.SaveAccount(caller)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		err := h.applyOutputAccount(outputAccount)
+		if err != nil {
+			_ = h.accounts.RevertToSnapshot(0)
+			return err
+		}
+	}
+
+	_, err := h.accounts.Commit()
+	return err
+}
+
+func (h *SystemSCHarness) applyOutputAccount(outputAccount *vmcommon.OutputAccount) error {
+	account, err := h.loadUserAccount(outputAccount.Address)
+	if err != nil {
+		return err
+	}
+
+	if outputAccount.BalanceDelta != nil {
+		err = account.AddToBalance(outputAccount.BalanceDelta)
+		if err != nil {
+			return err
+		}
+	}
+	for _, storageUpdate := range outputAccount.StorageUpdates {
+		err = account.DataTrieTracker().SaveKeyValue(storageUpdate.Offset, storageUpdate.Data)
+		if err != nil {
+			return err
+		}
+	}
+	if len(outputAccount.Code) > 0 {
+		account.SetCode(outputAccount.Code)
+	}
+
+	return h.accounts.SaveAccount(account)
+}
+
+func (h *SystemSCHarness) loadUserAccount(address []byte) (state.UserAccountHandler, error) {
+	account, err := h.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, state.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+func (h *SystemSCHarness) saveAndCommit(account state.UserAccountHandler) error {
+	err := h.accounts.SaveAccount(account)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.accounts.Commit()
+	return err
+}
@@ -0,0 +1,241 @@
+package harness
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	arwenConfig "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	systemVMFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts/defaults"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var counterSCAddress = append(make([]byte, 30), 9, 255)
+
+// counterSC is a minimal system smart contract counting its calls, usable starting with its enable epoch
+type counterSC struct {
+	eei         vm.SystemEI
+	enableEpoch uint32
+	flagEnabled atomic.Flag
+}
+
+func (c *counterSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.Function != "increment" {
+		c.eei.AddReturnMessage("invalid function")
+		return vmcommon.UserError
+	}
+
+	counter := big.NewInt(0).SetBytes(c.eei.GetStorage([]byte("counter")))
+	counter.Add(counter, big.NewInt(1))
+	c.eei.SetStorage([]byte("counter"), counter.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (c *counterSC) CanUseContract() bool {
+	return c.flagEnabled.IsSet()
+}
+
+func (c *counterSC) EpochConfirmed(epoch uint32, _ uint64) {
+	c.flagEnabled.Toggle(epoch >= c.enableEpoch)
+}
+
+func (c *counterSC) SetNewGasCost(_ vm.GasCost) {
+}
+
+func (c *counterSC) IsInterfaceNil() bool {
+	return c == nil
+}
+
+func createMockArgsSystemSCHarness(registry systemVMFactory.SystemSCRegistry) ArgsSystemSCHarness {
+	gasMap := arwenConfig.MakeGasMapForTests()
+	gasMap = defaults.FillGasMapInternal(gasMap, 1)
+
+	return ArgsSystemSCHarness{
+		Registry:    registry,
+		GasSchedule: mock.NewGasScheduleNotifierMock(gasMap),
+		SystemSCConfig: &config.SystemSmartContractsConfig{
+			ESDTSystemSCConfig: config.ESDTSystemSCConfig{
+				BaseIssuingCost: "1000",
+				OwnerAddress:    "aaaaaa",
+			},
+			GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
+				Active: config.GovernanceSystemSCConfigActive{
+					ProposalCost:     "500",
+					MinQuorum:        "50",
+					MinPassThreshold: "50",
+					MinVetoThreshold: "50",
+				},
+				FirstWhitelistedAddress: "3132333435363738393031323334353637383930313233343536373839303234",
+			},
+			StakingSystemSCConfig: config.StakingSystemSCConfig{
+				GenesisNodePrice:         "1000",
+				UnJailValue:              "10",
+				MinStepValue:             "10",
+				MinStakeValue:            "1",
+				UnBondPeriod:             1,
+				NumRoundsWithoutBleed:    1,
+				MaximumPercentageToBleed: 1,
+				BleedPercentagePerRound:  1,
+				MaxNumberOfNodesForStake: 100,
+				MinUnstakeTokensValue:    "1",
+			},
+			DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+				MaxServiceFee: 10000,
+			},
+			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+				MinCreationDeposit:  "10",
+				MinStakeAmount:      "10",
+				ConfigChangeAddress: "3132333435363738393031323334353637383930313233343536373839303234",
+			},
+		},
+		EpochConfig: &config.EpochConfig{
+			EnableEpochs: config.EnableEpochs{
+				StakingV2EnableEpoch: 1,
+			},
+		},
+		AddressPubKeyConverter: &mock.PubkeyConverterMock{},
+		Economics:              &mock.EconomicsHandlerStub{},
+		NodesConfigProvider:    &mock.NodesConfigProviderStub{},
+		SigVerifier:            &mock.MessageSignVerifierMock{},
+		ChanceComputer:         &mock.RaterMock{},
+	}
+}
+
+func createCounterRegistry(t *testing.T) systemVMFactory.SystemSCRegistry {
+	registry := systemVMFactory.NewSystemSCRegistry()
+	err := registry.Register(&systemVMFactory.SystemSCDescriptor{
+		Name:    "counter",
+		Address: counterSCAddress,
+		Create: func(args systemVMFactory.ArgsCreateSystemSC) (vm.SystemSmartContract, error) {
+			counter := &counterSC{
+				eei:         args.SystemEI,
+				enableEpoch: args.EpochConfig.EnableEpochs.StakingV2EnableEpoch,
+			}
+			args.EpochNotifier.RegisterNotifyHandler(counter)
+
+			return counter, nil
+		},
+	})
+	require.Nil(t, err)
+
+	return registry
+}
+
+func createCallInput(caller []byte, recipient []byte, function string, value *big.Int, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			Arguments:   arguments,
+			CallValue:   value,
+			GasProvided: 1000000,
+		},
+		RecipientAddr: recipient,
+		Function:      function,
+	}
+}
+
+func TestNewSystemSCHarness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil registry", func(t *testing.T) {
+		h, err := NewSystemSCHarness(createMockArgsSystemSCHarness(nil))
+		assert.Nil(t, h)
+		assert.Equal(t, vm.ErrNilSystemSCRegistry, err)
+	})
+	t.Run("nil epoch config", func(t *testing.T) {
+		args := createMockArgsSystemSCHarness(createCounterRegistry(t))
+		args.EpochConfig = nil
+		h, err := NewSystemSCHarness(args)
+		assert.Nil(t, h)
+		assert.Equal(t, ErrNilEpochConfig, err)
+	})
+	t.Run("nil economics", func(t *testing.T) {
+		args := createMockArgsSystemSCHarness(createCounterRegistry(t))
+		args.Economics = nil
+		h, err := NewSystemSCHarness(args)
+		assert.Nil(t, h)
+		assert.Equal(t, vm.ErrNilEconomicsData, err)
+	})
+	t.Run("nil nodes config provider", func(t *testing.T) {
+		args := createMockArgsSystemSCHarness(createCounterRegistry(t))
+		args.NodesConfigProvider = nil
+		h, err := NewSystemSCHarness(args)
+		assert.Nil(t, h)
+		assert.Equal(t, vm.ErrNilNodesConfigProvider, err)
+	})
+	t.Run("nil signature verifier", func(t *testing.T) {
+		args := createMockArgsSystemSCHarness(createCounterRegistry(t))
+		args.SigVerifier = nil
+		h, err := NewSystemSCHarness(args)
+		assert.Nil(t, h)
+		assert.Equal(t, vm.ErrNilMessageSignVerifier, err)
+	})
+	t.Run("nil chance computer", func(t *testing.T) {
+		args := createMockArgsSystemSCHarness(createCounterRegistry(t))
+		args.ChanceComputer = nil
+		h, err := NewSystemSCHarness(args)
+		assert.Nil(t, h)
+		assert.Equal(t, vm.ErrNilChanceComputer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		h, err := NewSystemSCHarness(createMockArgsSystemSCHarness(createCounterRegistry(t)))
+		assert.Nil(t, err)
+		require.NotNil(t, h)
+		assert.Equal(t, 1, h.SystemSCContainer().Len())
+	})
+}
+
+func TestSystemSCHarness_CallRegisteredContract(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewSystemSCHarness(createMockArgsSystemSCHarness(createCounterRegistry(t)))
+	require.Nil(t, err)
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	_, err = h.Call(createCallInput(caller, counterSCAddress, "increment", nil))
+	assert.True(t, errors.Is(err, vm.ErrUnknownSystemSmartContract))
+
+	h.SetBlock(10, 10, 1)
+	for i := 0; i < 2; i++ {
+		vmOutput, errCall := h.Call(createCallInput(caller, counterSCAddress, "increment", nil))
+		require.Nil(t, errCall)
+		assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	}
+	assert.Equal(t, []byte{2}, h.GetStorage(counterSCAddress, []byte("counter")))
+
+	vmOutput, err := h.Call(createCallInput(caller, counterSCAddress, "decrement", nil))
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+	assert.Equal(t, []byte{2}, h.GetStorage(counterSCAddress, []byte("counter")))
+}
+
+func TestSystemSCHarness_CallBuiltInContractShouldMoveValue(t *testing.T) {
+	t.Parallel()
+
+	registry, err := systemVMFactory.CreateDefaultSystemSCRegistry()
+	require.Nil(t, err)
+	h, err := NewSystemSCHarness(createMockArgsSystemSCHarness(registry))
+	require.Nil(t, err)
+	h.SetBlock(1, 1, 1)
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	err = h.SetBalance(caller, big.NewInt(5000))
+	require.Nil(t, err)
+
+	vmOutput, err := h.Call(createCallInput(caller, vm.ESDTSCAddress, "issue", big.NewInt(1000),
+		[]byte("Token"), []byte("TKN"), big.NewInt(100).Bytes(), big.NewInt(2).Bytes()))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+
+	assert.Equal(t, big.NewInt(4000), h.GetBalance(caller))
+	assert.Equal(t, big.NewInt(1000), h.GetBalance(vm.ESDTSCAddress))
+}